@@ -0,0 +1,254 @@
+package archive
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// TimeFormat is the format of the timestamp of the Snapshots,
+// which is the name of the files on the archive directory
+const TimeFormat = "20060102T150405Z"
+
+// ext is the extension of the Snapshot files
+const ext = ".json.gz"
+
+// Manifest describes the run that generated the Snapshot
+type Manifest struct {
+	Provider string    `json:"provider"`
+	Region   string    `json:"region,omitempty"`
+	Version  string    `json:"version"`
+	Time     time.Time `json:"time"`
+	Filters  string    `json:"filters,omitempty"`
+
+	// Counts is the number of Resources
+	// imported of each resource type
+	Counts map[string]int `json:"counts"`
+}
+
+// Resource is a resource imported on the run
+type Resource struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// String returns the address of the Resource with the ID
+func (r Resource) String() string {
+	return fmt.Sprintf("%s.%s (%s)", r.Type, r.Name, r.ID)
+}
+
+// Snapshot is the Manifest and the Resources of a run
+type Snapshot struct {
+	Manifest  Manifest   `json:"manifest"`
+	Resources []Resource `json:"resources"`
+}
+
+// Recorder is an io.Writer that writes to the out and records the Resources
+// written from the provider.Event it receives as provider.EventWriter.
+// The Events are also written to the out if it's a provider.EventWriter
+type Recorder struct {
+	io.Writer
+
+	mx        sync.Mutex
+	resources []Resource
+}
+
+// NewRecorder returns a new Recorder that writes to out
+func NewRecorder(out io.Writer) *Recorder {
+	return &Recorder{
+		Writer:    out,
+		resources: make([]Resource, 0),
+	}
+}
+
+// WriteEvent records the Resource of the e if it's a provider.EventWritten
+func (r *Recorder) WriteEvent(e provider.Event) error {
+	if e.Kind == provider.EventWritten {
+		r.mx.Lock()
+		r.resources = append(r.resources, Resource{Type: e.Type, ID: e.ID, Name: e.Name})
+		r.mx.Unlock()
+	}
+
+	if ew, ok := r.Writer.(provider.EventWriter); ok {
+		return ew.WriteEvent(e)
+	}
+
+	return nil
+}
+
+// Snapshot returns the Snapshot of the Resources recorded with the m,
+// the Counts of the m are calculated from them
+func (r *Recorder) Snapshot(m Manifest) Snapshot {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	resources := make([]Resource, len(r.resources))
+	copy(resources, r.resources)
+	sortResources(resources)
+
+	m.Counts = make(map[string]int)
+	for _, res := range resources {
+		m.Counts[res.Type]++
+	}
+
+	return Snapshot{
+		Manifest:  m,
+		Resources: resources,
+	}
+}
+
+// Write writes the s compressed on the dir, with the Time of the
+// Manifest as name, and returns the path of the file written
+func Write(dir string, s Snapshot) (string, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not create the archive directory %s", dir)
+	}
+
+	filep := filepath.Join(dir, s.Manifest.Time.UTC().Format(TimeFormat)+ext)
+	f, err := os.OpenFile(filep, os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not open the snapshot %s", filep)
+	}
+	defer f.Close()
+
+	zw := gzip.NewWriter(f)
+	err = json.NewEncoder(zw).Encode(s)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not write the snapshot %s", filep)
+	}
+
+	err = zw.Close()
+	if err != nil {
+		return "", errors.Wrapf(err, "could not write the snapshot %s", filep)
+	}
+
+	return filep, nil
+}
+
+// Read reads the Snapshot t, which is the timestamp of
+// one of the Snapshots of the dir or the path to one
+func Read(dir, t string) (*Snapshot, error) {
+	filep := t
+	if _, err := time.Parse(TimeFormat, t); err == nil {
+		filep = filepath.Join(dir, t+ext)
+	}
+
+	f, err := os.Open(filep)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open the snapshot %s", t)
+	}
+	defer f.Close()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid snapshot %s", t)
+	}
+	defer zr.Close()
+
+	var s Snapshot
+	err = json.NewDecoder(zr).Decode(&s)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid snapshot %s", t)
+	}
+
+	return &s, nil
+}
+
+// List returns the timestamps of the Snapshots of the dir sorted
+func List(dir string) ([]string, error) {
+	des, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read the archive directory %s", dir)
+	}
+
+	ts := make([]string, 0, len(des))
+	for _, de := range des {
+		if de.IsDir() || !strings.HasSuffix(de.Name(), ext) {
+			continue
+		}
+		t := strings.TrimSuffix(de.Name(), ext)
+		if _, err := time.Parse(TimeFormat, t); err != nil {
+			continue
+		}
+		ts = append(ts, t)
+	}
+	sort.Strings(ts)
+
+	return ts, nil
+}
+
+// Diff is the difference between two Snapshots, the Resources
+// are identified by the type and the ID
+type Diff struct {
+	From Manifest `json:"from"`
+	To   Manifest `json:"to"`
+
+	// Added are the Resources that are
+	// only on the To Snapshot
+	Added []Resource `json:"added"`
+
+	// Removed are the Resources that are
+	// only on the From Snapshot
+	Removed []Resource `json:"removed"`
+}
+
+// NewDiff returns the Diff from the Snapshot from to the to
+func NewDiff(from, to Snapshot) Diff {
+	d := Diff{
+		From:    from.Manifest,
+		To:      to.Manifest,
+		Added:   make([]Resource, 0),
+		Removed: make([]Resource, 0),
+	}
+
+	fromIdx := indexResources(from.Resources)
+	toIdx := indexResources(to.Resources)
+
+	for k, r := range toIdx {
+		if _, ok := fromIdx[k]; !ok {
+			d.Added = append(d.Added, r)
+		}
+	}
+	for k, r := range fromIdx {
+		if _, ok := toIdx[k]; !ok {
+			d.Removed = append(d.Removed, r)
+		}
+	}
+
+	sortResources(d.Added)
+	sortResources(d.Removed)
+
+	return d
+}
+
+// indexResources returns the rs indexed by the type and the ID
+func indexResources(rs []Resource) map[string]Resource {
+	idx := make(map[string]Resource, len(rs))
+	for _, r := range rs {
+		idx[r.Type+"/"+r.ID] = r
+	}
+	return idx
+}
+
+// sortResources sorts the rs by type and ID
+func sortResources(rs []Resource) {
+	sort.Slice(rs, func(i, j int) bool {
+		if rs[i].Type != rs[j].Type {
+			return rs[i].Type < rs[j].Type
+		}
+		return rs[i].ID < rs[j].ID
+	})
+}
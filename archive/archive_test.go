@@ -0,0 +1,93 @@
+package archive_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/archive"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+func TestRecorder(t *testing.T) {
+	var (
+		out    bytes.Buffer
+		events bytes.Buffer
+		r      = archive.NewRecorder(provider.NewNDJSONWriter(&out, &events))
+	)
+
+	require.NoError(t, r.WriteEvent(provider.Event{Kind: provider.EventDiscovered, Type: "aws_instance", ID: "i-1"}))
+	require.NoError(t, r.WriteEvent(provider.Event{Kind: provider.EventWritten, Type: "aws_instance", ID: "i-2", Name: "back"}))
+	require.NoError(t, r.WriteEvent(provider.Event{Kind: provider.EventWritten, Type: "aws_instance", ID: "i-1", Name: "front"}))
+	require.NoError(t, r.WriteEvent(provider.Event{Kind: provider.EventWritten, Type: "aws_eip", ID: "eip-1", Name: "ip"}))
+
+	s := r.Snapshot(archive.Manifest{Provider: "aws"})
+	assert.Equal(t, []archive.Resource{
+		{Type: "aws_eip", ID: "eip-1", Name: "ip"},
+		{Type: "aws_instance", ID: "i-1", Name: "front"},
+		{Type: "aws_instance", ID: "i-2", Name: "back"},
+	}, s.Resources)
+	assert.Equal(t, map[string]int{"aws_eip": 1, "aws_instance": 2}, s.Manifest.Counts)
+
+	// The events are still written
+	assert.Equal(t, 4, bytes.Count(events.Bytes(), []byte("\n")))
+}
+
+func TestWriteRead(t *testing.T) {
+	dir := t.TempDir()
+	s := archive.Snapshot{
+		Manifest: archive.Manifest{
+			Provider: "aws",
+			Version:  "v0.8.1",
+			Time:     time.Date(2022, 4, 12, 10, 30, 0, 0, time.UTC),
+			Counts:   map[string]int{"aws_instance": 1},
+		},
+		Resources: []archive.Resource{{Type: "aws_instance", ID: "i-1", Name: "front"}},
+	}
+
+	filep, err := archive.Write(dir, s)
+	require.NoError(t, err)
+
+	ts, err := archive.List(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"20220412T103000Z"}, ts)
+
+	rs, err := archive.Read(dir, ts[0])
+	require.NoError(t, err)
+	assert.Equal(t, s, *rs)
+
+	rs, err = archive.Read(dir, filep)
+	require.NoError(t, err)
+	assert.Equal(t, s, *rs)
+
+	_, err = archive.Read(dir, "20220412T103001Z")
+	assert.Error(t, err)
+}
+
+func TestNewDiff(t *testing.T) {
+	from := archive.Snapshot{
+		Resources: []archive.Resource{
+			{Type: "aws_instance", ID: "i-1", Name: "front"},
+			{Type: "aws_instance", ID: "i-2", Name: "back"},
+		},
+	}
+	to := archive.Snapshot{
+		Resources: []archive.Resource{
+			{Type: "aws_instance", ID: "i-1", Name: "front"},
+			{Type: "aws_instance", ID: "i-3", Name: "back"},
+			{Type: "aws_eip", ID: "eip-1", Name: "ip"},
+		},
+	}
+
+	d := archive.NewDiff(from, to)
+	assert.Equal(t, []archive.Resource{
+		{Type: "aws_eip", ID: "eip-1", Name: "ip"},
+		{Type: "aws_instance", ID: "i-3", Name: "back"},
+	}, d.Added)
+	assert.Equal(t, []archive.Resource{
+		{Type: "aws_instance", ID: "i-2", Name: "back"},
+	}, d.Removed)
+}
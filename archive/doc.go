@@ -0,0 +1,3 @@
+// Package archive provides the Snapshots of the resources imported
+// on each run, so the changes of the estate can be compared over time
+package archive
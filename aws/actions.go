@@ -0,0 +1,267 @@
+package aws
+
+import (
+	"sort"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/pkg/errors"
+)
+
+// baseActions are the IAM actions done by the
+// Provider itself, so they are always required
+var baseActions = []string{"ec2:DescribeRegions", "sts:GetCallerIdentity"}
+
+// requiredActions are the IAM actions of the Reader calls done to read
+// each one of the resource types, which include the ones used to read
+// the parents of the resource type, like the Servers of the Transfer Users.
+// When a new resource type is added to 'resources' it has to be added here
+var requiredActions = map[ResourceType][]string{
+	ALB:                                {"elasticloadbalancing:DescribeLoadBalancers"},
+	ALBListener:                        {"elasticloadbalancing:DescribeListeners", "elasticloadbalancing:DescribeLoadBalancers"},
+	ALBListenerCertificate:             {"elasticloadbalancing:DescribeListenerCertificates", "elasticloadbalancing:DescribeListeners", "elasticloadbalancing:DescribeLoadBalancers"},
+	ALBListenerRule:                    {"elasticloadbalancing:DescribeListeners", "elasticloadbalancing:DescribeLoadBalancers", "elasticloadbalancing:DescribeRules"},
+	ALBTargetGroup:                     {"elasticloadbalancing:DescribeTargetGroups"},
+	ALBTargetGroupAttachment:           {"elasticloadbalancing:DescribeTargetGroups", "elasticloadbalancing:DescribeTargetHealth"},
+	APIGatewayDeployment:               {"apigateway:GET"},
+	APIGatewayMethod:                   {"apigateway:GET"},
+	APIGatewayResource:                 {"apigateway:GET"},
+	APIGatewayRestAPI:                  {"apigateway:GET"},
+	APIGatewayStage:                    {"apigateway:GET"},
+	APIGatewayV2API:                    {"apigateway:GET"},
+	APIGatewayV2Route:                  {"apigateway:GET"},
+	APIGatewayV2Stage:                  {"apigateway:GET"},
+	AppmeshMesh:                        {"appmesh:ListMeshes"},
+	AppmeshVirtualNode:                 {"appmesh:ListMeshes", "appmesh:ListVirtualNodes"},
+	AppmeshVirtualRouter:               {"appmesh:ListMeshes", "appmesh:ListVirtualRouters"},
+	AppmeshVirtualService:              {"appmesh:ListMeshes", "appmesh:ListVirtualServices"},
+	AthenaWorkgroup:                    {"athena:ListWorkGroups"},
+	AutoscalingGroup:                   {"autoscaling:DescribeAutoScalingGroups"},
+	AutoscalingPolicy:                  {"autoscaling:DescribePolicies"},
+	AutoscalingSchedule:                {"autoscaling:DescribeScheduledActions"},
+	BatchJobDefinition:                 {"batch:DescribeJobDefinitions"},
+	CloudfrontCachePolicy:              {"cloudfront:ListCachePolicies"},
+	CloudfrontDistribution:             {"cloudfront:ListDistributions"},
+	CloudfrontFunction:                 {"cloudfront:ListFunctions"},
+	CloudfrontOriginAccessIdentity:     {"cloudfront:ListCloudFrontOriginAccessIdentities"},
+	CloudfrontPublicKey:                {"cloudfront:ListPublicKeys"},
+	CloudwatchMetricAlarm:              {"cloudwatch:DescribeAlarms"},
+	DaxCluster:                         {"dax:DescribeClusters"},
+	DBInstance:                         {"rds:DescribeDBInstances"},
+	DBParameterGroup:                   {"rds:DescribeDBParameterGroups"},
+	DBSubnetGroup:                      {"rds:DescribeDBSubnetGroups"},
+	DirectoryServiceDirectory:          {"ds:DescribeDirectories"},
+	DmsReplicationInstance:             {"dms:DescribeReplicationInstances"},
+	DXGateway:                          {"directconnect:DescribeDirectConnectGateways"},
+	DynamodbGlobalTable:                {"dynamodb:ListGlobalTables"},
+	DynamodbTable:                      {"dynamodb:ListTables"},
+	EBSVolume:                          {"ec2:DescribeVolumes"},
+	ECSCluster:                         {"ecs:DescribeClusters", "ecs:ListClusters"},
+	ECSService:                         {"ecs:DescribeClusters", "ecs:DescribeServices", "ecs:ListClusters", "ecs:ListServices"},
+	EC2CapacityReservation:             {"ec2:DescribeCapacityReservations"},
+	EC2Fleet:                           {"ec2:DescribeFleets"},
+	EC2TransitGateway:                  {"ec2:DescribeTransitGateways"},
+	EC2TransitGatewayVPCAttachment:     {"ec2:DescribeTransitGatewayVpcAttachments"},
+	EC2TransitGatewayRouteTable:        {"ec2:DescribeTransitGatewayRouteTables"},
+	EC2TransitGatewayMulticastDomain:   {"ec2:DescribeTransitGatewayMulticastDomains"},
+	EC2TransitGatewayPeeringAttachment: {"ec2:DescribeTransitGatewayPeeringAttachments"},
+	EC2TransitGatewayPeeringAttachmentAccepter: {"ec2:DescribeTransitGatewayPeeringAttachments"},
+	EC2TransitGatewayPrefixListReference:       {"ec2:DescribeTransitGatewayRouteTables", "ec2:GetTransitGatewayPrefixListReferences"},
+	EC2TransitGatewayRoute:                     {"ec2:DescribeTransitGatewayRouteTables", "ec2:SearchTransitGatewayRoutes"},
+	EC2TransitGatewayRouteTableAssociation:     {"ec2:DescribeTransitGatewayRouteTables", "ec2:GetTransitGatewayRouteTableAssociations"},
+	EC2TransitGatewayRouteTablePropagation:     {"ec2:DescribeTransitGatewayRouteTables", "ec2:GetTransitGatewayRouteTablePropagations"},
+	EC2TransitGatewayVPCAttachmentAccepter:     {"ec2:DescribeTransitGatewayVpcAttachments"},
+	EFSFileSystem:                              {"elasticfilesystem:DescribeFileSystems"},
+	EIP:                                        {"ec2:DescribeAddresses"},
+	EKSCluster:                                 {"eks:DescribeCluster", "eks:ListClusters"},
+	ElasticacheCluster:                         {"elasticache:DescribeCacheClusters"},
+	ElasticacheParameterGroup:                  {"elasticache:DescribeCacheParameterGroups"},
+	ElasticacheReplicationGroup:                {"elasticache:DescribeReplicationGroups"},
+	ElasticacheSubnetGroup:                     {"elasticache:DescribeCacheSubnetGroups"},
+	ElasticBeanstalkApplication:                {"elasticbeanstalk:DescribeApplications"},
+	ElasticsearchDomain:                        {"es:DescribeElasticsearchDomains", "es:ListDomainNames"},
+	ElasticsearchDomainPolicy:                  {"es:DescribeElasticsearchDomains", "es:ListDomainNames"},
+	ELB:                                        {"elasticloadbalancing:DescribeLoadBalancers"},
+	EMRCluster:                                 {"elasticmapreduce:ListClusters"},
+	FsxLustreFileSystem:                        {"fsx:DescribeFileSystems"},
+	GlueCatalogDatabase:                        {"glue:GetDatabases"},
+	GlueCatalogTable:                           {"glue:GetDatabases", "glue:GetTables"},
+	IAMAccessKey:                               {"iam:ListAccessKeys", "iam:ListUsers"},
+	IAMAccountAlias:                            {"iam:ListAccountAliases"},
+	IAMAccountPasswordPolicy:                   {"iam:GetAccountPasswordPolicy"},
+	IAMGroup:                                   {"iam:ListGroups"},
+	IAMGroupMembership:                         {"iam:GetGroup", "iam:ListGroups"},
+	IAMGroupPolicyAttachment:                   {"iam:ListAttachedGroupPolicies", "iam:ListGroups"},
+	IAMGroupPolicy:                             {"iam:ListGroupPolicies", "iam:ListGroups"},
+	IAMInstanceProfile:                         {"iam:ListInstanceProfiles"},
+	IAMOpenidConnectProvider:                   {"iam:ListOpenIDConnectProviders"},
+	IAMPolicy:                                  {"iam:ListPolicies"},
+	IAMRole:                                    {"iam:ListRoles"},
+	IAMRolePolicyAttachment:                    {"iam:ListAttachedRolePolicies", "iam:ListRoles"},
+	IAMRolePolicy:                              {"iam:ListRolePolicies", "iam:ListRoles"},
+	IAMSAMLProvider:                            {"iam:ListSAMLProviders"},
+	IAMServerCertificate:                       {"iam:ListServerCertificates"},
+	IAMUser:                                    {"iam:ListUsers"},
+	IAMUserGroupMembership:                     {"iam:ListGroupsForUser", "iam:ListUsers"},
+	IAMUserPolicyAttachment:                    {"iam:ListAttachedUserPolicies", "iam:ListUsers"},
+	IAMUserPolicy:                              {"iam:ListUserPolicies", "iam:ListUsers"},
+	IAMUserSSHKey:                              {"iam:ListSSHPublicKeys", "iam:ListUsers"},
+	Instance:                                   {"ec2:DescribeInstances"},
+	InternetGateway:                            {"ec2:DescribeInternetGateways"},
+	KeyPair:                                    {"ec2:DescribeKeyPairs"},
+	KinesisFirehoseDeliveryStream:              {"firehose:ListDeliveryStreams"},
+	KinesisStream:                              {"kinesis:ListStreams"},
+	LambdaEventSourceMapping:                   {"lambda:ListEventSourceMappings"},
+	LambdaFunction:                             {"lambda:ListFunctions"},
+	LambdaFunctionURL:                          {"lambda:ListFunctionUrlConfigs", "lambda:ListFunctions"},
+	LambdaLayerVersion:                         {"lambda:ListLayerVersions", "lambda:ListLayers"},
+	LambdaPermission:                           {"lambda:GetPolicy", "lambda:ListFunctions"},
+	LaunchConfiguration:                        {"autoscaling:DescribeLaunchConfigurations"},
+	LaunchTemplate:                             {"ec2:DescribeLaunchTemplates"},
+	LB:                                         {"elasticloadbalancing:DescribeLoadBalancers"},
+	LBCookieStickinessPolicy:                   {"elasticloadbalancing:DescribeLoadBalancerPolicies", "elasticloadbalancing:DescribeLoadBalancers"},
+	LBListener:                                 {"elasticloadbalancing:DescribeListeners", "elasticloadbalancing:DescribeLoadBalancers"},
+	LBListenerCertificate:                      {"elasticloadbalancing:DescribeListenerCertificates", "elasticloadbalancing:DescribeListeners", "elasticloadbalancing:DescribeLoadBalancers"},
+	LBListenerRule:                             {"elasticloadbalancing:DescribeListeners", "elasticloadbalancing:DescribeLoadBalancers", "elasticloadbalancing:DescribeRules"},
+	LBTargetGroup:                              {"elasticloadbalancing:DescribeTargetGroups"},
+	LBTargetGroupAttachment:                    {"elasticloadbalancing:DescribeTargetGroups", "elasticloadbalancing:DescribeTargetHealth"},
+	LightsailInstance:                          {"lightsail:GetInstances"},
+	MediaStoreContainer:                        {"mediastore:ListContainers"},
+	MemoryDBCluster:                            {"memorydb:DescribeClusters"},
+	MQBroker:                                   {"mq:ListBrokers"},
+	MQConfiguration:                            {"mq:ListConfigurations"},
+	MSKCluster:                                 {"kafka:ListClusters"},
+	MSKConfiguration:                           {"kafka:ListConfigurations"},
+	MWAAEnvironment:                            {"airflow:ListEnvironments"},
+	NatGateway:                                 {"ec2:DescribeNatGateways"},
+	NeptuneCluster:                             {"rds:DescribeDBClusters"},
+	RDSCluster:                                 {"rds:DescribeDBClusters"},
+	RDSGlobalCluster:                           {"rds:DescribeGlobalClusters"},
+	RedshiftCluster:                            {"redshift:DescribeClusters"},
+	Route53DelegationSet:                       {"route53:ListReusableDelegationSets"},
+	Route53HealthCheck:                         {"route53:ListHealthChecks"},
+	Route53QueryLog:                            {"route53:ListQueryLoggingConfigs"},
+	Route53Record:                              {"route53:ListHostedZones", "route53:ListResourceRecordSets"},
+	Route53ResolverEndpoint:                    {"route53resolver:ListResolverEndpoints"},
+	Route53ResolverRuleAssociation:             {"route53resolver:ListResolverRuleAssociations"},
+	Route53ZoneAssociation:                     {"route53:ListHostedZones", "route53:ListVPCAssociationAuthorizations"},
+	Route53Zone:                                {"route53:ListHostedZones"},
+	RouteTable:                                 {"ec2:DescribeRouteTables"},
+	S3Bucket:                                   {"s3:GetBucketLocation", "s3:ListAllMyBuckets"},
+	SecretsmanagerSecret:                       {"secretsmanager:ListSecrets"},
+	SecurityGroup:                              {"ec2:DescribeSecurityGroups"},
+	SecurityGroupRule:                          {"ec2:DescribeSecurityGroups"},
+	ServiceDiscoveryHTTPNamespace:              {"servicediscovery:ListNamespaces"},
+	ServiceDiscoveryPrivateDNSNamespace:        {"route53:GetHostedZone", "servicediscovery:ListNamespaces"},
+	ServiceDiscoveryPublicDNSNamespace:         {"servicediscovery:ListNamespaces"},
+	ServiceDiscoveryService:                    {"servicediscovery:ListServices"},
+	SESActiveReceiptRuleSet:                    {"ses:DescribeActiveReceiptRuleSet"},
+	SESConfigurationSet:                        {"ses:ListConfigurationSets"},
+	SESDomainDKIM:                              {"ses:ListIdentities"},
+	SESDomainIdentity:                          {"ses:ListIdentities"},
+	SESDomainMailFrom:                          {"ses:ListIdentities"},
+	SESIdentityNotificationTopic:               {"ses:GetIdentityNotificationAttributes", "ses:ListIdentities"},
+	SESReceiptFilter:                           {"ses:ListReceiptFilters"},
+	SESReceiptRule:                             {"ses:DescribeActiveReceiptRuleSet"},
+	SESReceiptRuleSet:                          {"ses:DescribeActiveReceiptRuleSet"},
+	SESTemplate:                                {"ses:ListTemplates"},
+	ShieldProtection:                           {"shield:ListProtections"},
+	SpotFleetRequest:                           {"ec2:DescribeSpotFleetRequests"},
+	SQSQueue:                                   {"sqs:ListQueues"},
+	SSMParameter:                               {"ssm:DescribeParameters"},
+	SSOAdminAccountAssignment:                  {"sso:ListAccountAssignments", "sso:ListInstances", "sso:ListPermissionSets"},
+	SSOAdminManagedPolicyAttachment:            {"sso:ListInstances", "sso:ListManagedPoliciesInPermissionSet", "sso:ListPermissionSets"},
+	SSOAdminPermissionSet:                      {"sso:ListInstances", "sso:ListPermissionSets"},
+	StoragegatewayGateway:                      {"storagegateway:ListGateways"},
+	Subnet:                                     {"ec2:DescribeSubnets"},
+	SyntheticsCanary:                           {"synthetics:DescribeCanaries"},
+	TransferServer:                             {"transfer:ListServers"},
+	TransferUser:                               {"transfer:ListServers", "transfer:ListUsers"},
+	VolumeAttachment:                           {"ec2:DescribeVolumes"},
+	VPCPeeringConnection:                       {"ec2:DescribeVpcPeeringConnections"},
+	VPCPeeringConnectionAccepter:               {"ec2:DescribeVpcPeeringConnections"},
+	VPC:                                        {"ec2:DescribeVpcs"},
+	VPCEndpoint:                                {"ec2:DescribeVpcEndpoints"},
+	VPNGateway:                                 {"ec2:DescribeVpnGateways"},
+	WAFV2IPSet:                                 {"wafv2:ListIPSets"},
+	WAFV2RuleGroup:                             {"wafv2:ListRuleGroups"},
+	WAFV2WebACL:                                {"wafv2:ListWebACLs"},
+	XRayGroup:                                  {"xray:GetGroups"},
+	XRaySamplingRule:                           {"xray:GetSamplingRules"},
+}
+
+// RequiredActions returns the sorted list of IAM actions needed to read
+// the types, the ones excluded by f are ignored
+func RequiredActions(types []string, f *filter.Filter) ([]string, error) {
+	actions := make(map[string]struct{})
+	for _, a := range baseActions {
+		actions[a] = struct{}{}
+	}
+
+	for _, t := range types {
+		if f.IsExcluded(t) {
+			continue
+		}
+
+		rt, err := ResourceTypeString(t)
+		if err != nil {
+			return nil, err
+		}
+
+		// If it's not implemented it'll
+		// not be read so nothing is required
+		if _, ok := resources[rt]; !ok {
+			continue
+		}
+
+		ras, ok := requiredActions[rt]
+		if !ok {
+			return nil, errors.Errorf("the required actions of the resource %q are not defined", t)
+		}
+
+		for _, a := range ras {
+			actions[a] = struct{}{}
+		}
+	}
+
+	res := make([]string, 0, len(actions))
+	for a := range actions {
+		res = append(res, a)
+	}
+	sort.Strings(res)
+
+	return res, nil
+}
+
+// Policy is an IAM policy document
+type Policy struct {
+	Version   string            `json:"Version"`
+	Statement []PolicyStatement `json:"Statement"`
+}
+
+// PolicyStatement is a statement of an IAM policy document
+type PolicyStatement struct {
+	Effect   string   `json:"Effect"`
+	Action   []string `json:"Action"`
+	Resource string   `json:"Resource"`
+}
+
+// RequiredPolicy returns the minimal IAM policy
+// document that allows to read the types,
+// the ones excluded by f are ignored
+func RequiredPolicy(types []string, f *filter.Filter) (*Policy, error) {
+	actions, err := RequiredActions(types, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Policy{
+		Version: "2012-10-17",
+		Statement: []PolicyStatement{
+			{
+				Effect:   "Allow",
+				Action:   actions,
+				Resource: "*",
+			},
+		},
+	}, nil
+}
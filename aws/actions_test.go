@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredActions(t *testing.T) {
+	t.Run("AllDefined", func(t *testing.T) {
+		for rt := range resources {
+			_, ok := requiredActions[rt]
+			assert.True(t, ok, "missing the required actions of %q", rt)
+		}
+	})
+	t.Run("Success", func(t *testing.T) {
+		actions, err := RequiredActions([]string{"aws_instance", "aws_transfer_user"}, &filter.Filter{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"ec2:DescribeInstances",
+			"ec2:DescribeRegions",
+			"sts:GetCallerIdentity",
+			"transfer:ListServers",
+			"transfer:ListUsers",
+		}, actions)
+	})
+	t.Run("Excluded", func(t *testing.T) {
+		actions, err := RequiredActions([]string{"aws_instance", "aws_transfer_user"}, &filter.Filter{Exclude: []string{"aws_transfer_user"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"ec2:DescribeInstances",
+			"ec2:DescribeRegions",
+			"sts:GetCallerIdentity",
+		}, actions)
+	})
+	t.Run("ErrorUnknown", func(t *testing.T) {
+		_, err := RequiredActions([]string{"aws_unknown"}, &filter.Filter{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	awsSDK "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/pkg/errors"
+)
+
+// configResourceType is the ResourceType of an AWS Config
+// resource type and the property of the Config item which
+// value is the import ID of the ResourceType
+type configResourceType struct {
+	rt       ResourceType
+	property string
+}
+
+// configResourceTypes maps the AWS Config resource types
+// to the ResourceType to import them with
+var configResourceTypes = map[string]configResourceType{
+	"AWS::AutoScaling::AutoScalingGroup":        {AutoscalingGroup, "resourceName"},
+	"AWS::AutoScaling::LaunchConfiguration":     {LaunchConfiguration, "resourceName"},
+	"AWS::CloudFront::Distribution":             {CloudfrontDistribution, "resourceId"},
+	"AWS::CloudWatch::Alarm":                    {CloudwatchMetricAlarm, "resourceName"},
+	"AWS::DynamoDB::Table":                      {DynamodbTable, "resourceName"},
+	"AWS::EC2::EIP":                             {EIP, "resourceId"},
+	"AWS::EC2::Instance":                        {Instance, "resourceId"},
+	"AWS::EC2::InternetGateway":                 {InternetGateway, "resourceId"},
+	"AWS::EC2::LaunchTemplate":                  {LaunchTemplate, "resourceId"},
+	"AWS::EC2::NatGateway":                      {NatGateway, "resourceId"},
+	"AWS::EC2::RouteTable":                      {RouteTable, "resourceId"},
+	"AWS::EC2::SecurityGroup":                   {SecurityGroup, "resourceId"},
+	"AWS::EC2::Subnet":                          {Subnet, "resourceId"},
+	"AWS::EC2::TransitGateway":                  {EC2TransitGateway, "resourceId"},
+	"AWS::EC2::Volume":                          {EBSVolume, "resourceId"},
+	"AWS::EC2::VPC":                             {VPC, "resourceId"},
+	"AWS::EC2::VPCEndpoint":                     {VPCEndpoint, "resourceId"},
+	"AWS::EC2::VPCPeeringConnection":            {VPCPeeringConnection, "resourceId"},
+	"AWS::EC2::VPNGateway":                      {VPNGateway, "resourceId"},
+	"AWS::ECS::Cluster":                         {ECSCluster, "resourceName"},
+	"AWS::EFS::FileSystem":                      {EFSFileSystem, "resourceId"},
+	"AWS::EKS::Cluster":                         {EKSCluster, "resourceName"},
+	"AWS::ElastiCache::CacheCluster":            {ElasticacheCluster, "resourceName"},
+	"AWS::ElasticLoadBalancing::LoadBalancer":   {ELB, "resourceName"},
+	"AWS::ElasticLoadBalancingV2::LoadBalancer": {LB, "arn"},
+	"AWS::Elasticsearch::Domain":                {ElasticsearchDomain, "resourceName"},
+	"AWS::IAM::Group":                           {IAMGroup, "resourceName"},
+	"AWS::IAM::Policy":                          {IAMPolicy, "arn"},
+	"AWS::IAM::Role":                            {IAMRole, "resourceName"},
+	"AWS::IAM::User":                            {IAMUser, "resourceName"},
+	"AWS::Kinesis::Stream":                      {KinesisStream, "resourceName"},
+	"AWS::Lambda::Function":                     {LambdaFunction, "resourceName"},
+	"AWS::RDS::DBCluster":                       {RDSCluster, "resourceName"},
+	"AWS::RDS::DBInstance":                      {DBInstance, "resourceName"},
+	"AWS::RDS::DBSubnetGroup":                   {DBSubnetGroup, "resourceName"},
+	"AWS::Route53::HostedZone":                  {Route53Zone, "resourceId"},
+	"AWS::S3::Bucket":                           {S3Bucket, "resourceName"},
+	"AWS::SecretsManager::Secret":               {SecretsmanagerSecret, "arn"},
+}
+
+// configItem is the result of the
+// query done to the Configuration Aggregator
+type configItem struct {
+	ResourceType string `json:"resourceType"`
+	ResourceID   string `json:"resourceId"`
+	ResourceName string `json:"resourceName"`
+	ARN          string `json:"arn"`
+}
+
+// importID returns the value of the property
+func (ci configItem) importID(property string) string {
+	switch property {
+	case "resourceName":
+		return ci.ResourceName
+	case "arn":
+		return ci.ARN
+	default:
+		return ci.ResourceID
+	}
+}
+
+// AggregatorTargets returns the Targets, with the format 'TYPE.ID', of the
+// Resources recorded by the AWS Config Configuration Aggregator, so those
+// are imported without calling the Describe/List APIs of each resource type.
+// As the Resources can only be imported with the credentials and region of the p,
+// only the ones of its account and region are returned, filtered by
+// the Include and Exclude of the f. The p has to be an AWS Provider,
+// with multiple regions only the default one is used
+func AggregatorTargets(ctx context.Context, p provider.Provider, aggregator string, f *filter.Filter) ([]string, error) {
+	a, ok := defaultRegion(p)
+	if !ok {
+		return nil, errors.Errorf("the provider %q is not an AWS provider", p.String())
+	}
+
+	cts := make([]string, 0, len(configResourceTypes))
+	for ct, crt := range configResourceTypes {
+		if !f.IsIncluded(crt.rt.String()) || f.IsExcluded(crt.rt.String()) {
+			continue
+		}
+		cts = append(cts, fmt.Sprintf("'%s'", ct))
+	}
+	if len(cts) == 0 {
+		return nil, nil
+	}
+	sort.Strings(cts)
+
+	expr := fmt.Sprintf(
+		"SELECT resourceType, resourceId, resourceName, arn WHERE accountId = '%s' AND awsRegion = '%s' AND resourceType IN (%s)",
+		a.awsr.GetAccountID(), a.awsr.GetRegion(), strings.Join(cts, ", "),
+	)
+
+	results, err := a.awsr.GetConfigAggregateResources(ctx, &configservice.SelectAggregateResourceConfigInput{
+		ConfigurationAggregatorName: awsSDK.String(aggregator),
+		Expression:                  awsSDK.String(expr),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query the Configuration Aggregator %q", aggregator)
+	}
+
+	targets := make([]string, 0, len(results))
+	for _, r := range results {
+		var ci configItem
+		err = json.Unmarshal([]byte(awsSDK.StringValue(r)), &ci)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid result from the Configuration Aggregator %q", aggregator)
+		}
+
+		crt, ok := configResourceTypes[ci.ResourceType]
+		if !ok {
+			continue
+		}
+
+		// Not all the items have all
+		// the properties so are skipped
+		id := ci.importID(crt.property)
+		if id == "" {
+			continue
+		}
+
+		targets = append(targets, fmt.Sprintf("%s.%s", crt.rt, id))
+	}
+
+	return targets, nil
+}
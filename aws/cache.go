@@ -48,6 +48,76 @@ func getAPIGatewayRestApis(ctx context.Context, a *aws, rt string, filters *filt
 	return ids, nil
 }
 
+func cacheAPIGatewayV2APIs(ctx context.Context, a *aws, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := a.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = apiGatewayV2APIs(ctx, a, rt, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		err = a.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+func getAPIGatewayV2APIs(ctx context.Context, a *aws, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheAPIGatewayV2APIs(ctx, a, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rs))
+	for _, i := range rs {
+		ids = append(ids, i.ID())
+	}
+
+	return ids, nil
+}
+
+func cacheTransferServers(ctx context.Context, a *aws, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := a.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = transferServers(ctx, a, rt, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		err = a.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+func getTransferServers(ctx context.Context, a *aws, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheTransferServers(ctx, a, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rs))
+	for _, i := range rs {
+		ids = append(ids, i.ID())
+	}
+
+	return ids, nil
+}
+
 func cacheLoadBalancersV2(ctx context.Context, a *aws, rt string, filters *filter.Filter) ([]provider.Resource, error) {
 	// if both aws_alb and aws_lb defined, keep only aws_alb
 	if filters.IsIncluded("aws_alb", "aws_lb") && (!filters.IsExcluded("aws_alb") && rt == "aws_lb") {
@@ -243,6 +313,43 @@ func getIAMUserNames(ctx context.Context, a *aws, rt string, filters *filter.Fil
 	return names, nil
 }
 
+func cacheLambdaFunctions(ctx context.Context, a *aws, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := a.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = lambdaFunctions(ctx, a, rt, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		err = a.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+func getLambdaFunctionNames(ctx context.Context, a *aws, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheLambdaFunctions(ctx, a, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the actual needed value
+	// TODO cach this result too
+	names := make([]string, 0, len(rs))
+	for _, i := range rs {
+		names = append(names, i.ID())
+	}
+
+	return names, nil
+}
+
 func cacheRoute53Zones(ctx context.Context, a *aws, rt string, filters *filter.Filter) ([]provider.Resource, error) {
 	rs, err := a.cache.Get(rt)
 	if err != nil {
@@ -424,3 +531,73 @@ func getTransitGatewayRouteTablesIDs(ctx context.Context, a *aws, rt string, fil
 
 	return ids, nil
 }
+
+func cacheSSOAdminPermissionSets(ctx context.Context, a *aws, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := a.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = ssoadminPermissionSets(ctx, a, rt, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		err = a.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+func getSSOAdminPermissionSets(ctx context.Context, a *aws, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheSSOAdminPermissionSets(ctx, a, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(rs))
+	for _, i := range rs {
+		ids = append(ids, i.ID())
+	}
+
+	return ids, nil
+}
+
+func cacheAppmeshMeshes(ctx context.Context, a *aws, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := a.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = appmeshMeshes(ctx, a, rt, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		err = a.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+func getAppmeshMeshes(ctx context.Context, a *aws, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheAppmeshMeshes(ctx, a, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(rs))
+	for _, i := range rs {
+		names = append(names, i.ID())
+	}
+
+	return names, nil
+}
@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	awsSDK "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/pkg/errors"
+)
+
+// cloudFormationTypes maps the CloudFormation resource types
+// to the ResourceType which import ID is the PhysicalResourceId
+// of the CloudFormation resource
+var cloudFormationTypes = map[string]ResourceType{
+	"AWS::ApiGateway::RestApi":                  APIGatewayRestAPI,
+	"AWS::ApiGatewayV2::Api":                    APIGatewayV2API,
+	"AWS::AutoScaling::AutoScalingGroup":        AutoscalingGroup,
+	"AWS::AutoScaling::LaunchConfiguration":     LaunchConfiguration,
+	"AWS::CloudFront::Distribution":             CloudfrontDistribution,
+	"AWS::CloudWatch::Alarm":                    CloudwatchMetricAlarm,
+	"AWS::DynamoDB::Table":                      DynamodbTable,
+	"AWS::EC2::Instance":                        Instance,
+	"AWS::EC2::CapacityReservation":             EC2CapacityReservation,
+	"AWS::EC2::EC2Fleet":                        EC2Fleet,
+	"AWS::EC2::InternetGateway":                 InternetGateway,
+	"AWS::EC2::KeyPair":                         KeyPair,
+	"AWS::EC2::LaunchTemplate":                  LaunchTemplate,
+	"AWS::EC2::NatGateway":                      NatGateway,
+	"AWS::EC2::RouteTable":                      RouteTable,
+	"AWS::EC2::SecurityGroup":                   SecurityGroup,
+	"AWS::EC2::SpotFleet":                       SpotFleetRequest,
+	"AWS::EC2::Subnet":                          Subnet,
+	"AWS::EC2::TransitGateway":                  EC2TransitGateway,
+	"AWS::EC2::Volume":                          EBSVolume,
+	"AWS::EC2::VPC":                             VPC,
+	"AWS::EC2::VPCEndpoint":                     VPCEndpoint,
+	"AWS::EC2::VPCPeeringConnection":            VPCPeeringConnection,
+	"AWS::EC2::VPNGateway":                      VPNGateway,
+	"AWS::ECS::Cluster":                         ECSCluster,
+	"AWS::EFS::FileSystem":                      EFSFileSystem,
+	"AWS::EKS::Cluster":                         EKSCluster,
+	"AWS::ElastiCache::CacheCluster":            ElasticacheCluster,
+	"AWS::ElastiCache::ParameterGroup":          ElasticacheParameterGroup,
+	"AWS::ElastiCache::ReplicationGroup":        ElasticacheReplicationGroup,
+	"AWS::ElastiCache::SubnetGroup":             ElasticacheSubnetGroup,
+	"AWS::ElasticLoadBalancing::LoadBalancer":   ELB,
+	"AWS::ElasticLoadBalancingV2::Listener":     LBListener,
+	"AWS::ElasticLoadBalancingV2::ListenerRule": LBListenerRule,
+	"AWS::ElasticLoadBalancingV2::LoadBalancer": LB,
+	"AWS::ElasticLoadBalancingV2::TargetGroup":  LBTargetGroup,
+	"AWS::Elasticsearch::Domain":                ElasticsearchDomain,
+	"AWS::IAM::Group":                           IAMGroup,
+	"AWS::IAM::InstanceProfile":                 IAMInstanceProfile,
+	"AWS::IAM::ManagedPolicy":                   IAMPolicy,
+	"AWS::IAM::Role":                            IAMRole,
+	"AWS::IAM::User":                            IAMUser,
+	"AWS::Kinesis::Stream":                      KinesisStream,
+	"AWS::Lambda::EventSourceMapping":           LambdaEventSourceMapping,
+	"AWS::Lambda::Function":                     LambdaFunction,
+	"AWS::Lambda::LayerVersion":                 LambdaLayerVersion,
+	"AWS::MemoryDB::Cluster":                    MemoryDBCluster,
+	"AWS::MSK::Cluster":                         MSKCluster,
+	"AWS::MSK::Configuration":                   MSKConfiguration,
+	"AWS::RDS::DBCluster":                       RDSCluster,
+	"AWS::RDS::DBInstance":                      DBInstance,
+	"AWS::RDS::DBParameterGroup":                DBParameterGroup,
+	"AWS::RDS::DBSubnetGroup":                   DBSubnetGroup,
+	"AWS::Route53::HostedZone":                  Route53Zone,
+	"AWS::S3::Bucket":                           S3Bucket,
+	"AWS::SecretsManager::Secret":               SecretsmanagerSecret,
+	"AWS::ServiceDiscovery::HttpNamespace":      ServiceDiscoveryHTTPNamespace,
+	"AWS::ServiceDiscovery::PublicDnsNamespace": ServiceDiscoveryPublicDNSNamespace,
+	"AWS::ServiceDiscovery::Service":            ServiceDiscoveryService,
+	"AWS::SQS::Queue":                           SQSQueue,
+	"AWS::SSM::Parameter":                       SSMParameter,
+	"AWS::Synthetics::Canary":                   SyntheticsCanary,
+	"AWS::XRay::Group":                          XRayGroup,
+}
+
+// StackTargets returns the Targets, with the format 'TYPE.ID', of the Resources
+// of the CloudFormation stack so only those are imported. The p has to be an AWS Provider,
+// with multiple regions only the default one is used.
+// The CloudFormation resource types that can not be imported are returned as skipped
+// with the format 'TYPE LOGICAL-ID'
+func StackTargets(ctx context.Context, p provider.Provider, stack string) ([]string, []string, error) {
+	a, ok := defaultRegion(p)
+	if !ok {
+		return nil, nil, errors.Errorf("the provider %q is not an AWS provider", p.String())
+	}
+
+	// The DescribeStackResources only returns the first 100
+	// Resources of the stack so the ListStackResources is used
+	srs, err := a.awsr.GetCloudFormationStackResources(ctx, &cloudformation.ListStackResourcesInput{
+		StackName: awsSDK.String(stack),
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "unable to list the resources of the stack %q", stack)
+	}
+
+	targets := make([]string, 0, len(srs))
+	skipped := make([]string, 0)
+	for _, sr := range srs {
+		// The Resources that failed to be created
+		// or have been deleted have no physical ID
+		if sr.PhysicalResourceId == nil || *sr.PhysicalResourceId == "" {
+			continue
+		}
+
+		rt, ok := cloudFormationTypes[awsSDK.StringValue(sr.ResourceType)]
+		if !ok {
+			skipped = append(skipped, fmt.Sprintf("%s %s", awsSDK.StringValue(sr.ResourceType), awsSDK.StringValue(sr.LogicalResourceId)))
+			continue
+		}
+
+		targets = append(targets, fmt.Sprintf("%s.%s", rt, *sr.PhysicalResourceId))
+	}
+
+	return targets, skipped, nil
+}
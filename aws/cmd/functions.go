@@ -19,6 +19,22 @@ var (
 			// Returned values are commented in the interface doc comment block.
 			`,
 		},
+		Function{
+			// The Methods are embedded on the Resources of the RestAPI
+			// so it has a custom implementation that iterates over them
+			FnName:         "GetAPIGatewayMethods",
+			Entity:         "Resources",
+			SingularEntity: "Method",
+			Prefix:         "Get",
+			Service:        "apigateway",
+			IsMap:          true,
+			NoGenerateFn:   true,
+			Documentation: `
+			// GetAPIGatewayMethods returns the Methods of the Resources on the given input
+			// indexed by RESOURCE-ID/HTTP-METHOD
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
 		Function{
 			FnName:                     "GetAPIGatewayResources",
 			Entity:                     "Resources",
@@ -61,6 +77,44 @@ var (
 			`,
 		},
 
+		// apigatewayv2
+		Function{
+			FnName:          "GetAPIGatewayV2APIs",
+			Entity:          "Apis",
+			FnAttributeList: "Items",
+			SingularEntity:  "Api",
+			Prefix:          "Get",
+			Service:         "apigatewayv2",
+			Documentation: `
+			// GetAPIGatewayV2APIs returns the Apis on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetAPIGatewayV2Routes",
+			Entity:          "Routes",
+			FnAttributeList: "Items",
+			SingularEntity:  "Route",
+			Prefix:          "Get",
+			Service:         "apigatewayv2",
+			Documentation: `
+			// GetAPIGatewayV2Routes returns the Routes on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetAPIGatewayV2Stages",
+			Entity:          "Stages",
+			FnAttributeList: "Items",
+			SingularEntity:  "Stage",
+			Prefix:          "Get",
+			Service:         "apigatewayv2",
+			Documentation: `
+			// GetAPIGatewayV2Stages returns the Stages on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
 		// Athena
 		Function{
 			FnName:          "GetAthenaWorkGroups",
@@ -75,6 +129,56 @@ var (
 			`,
 		},
 
+		// appmesh
+		Function{
+			FnName:          "GetAppMeshMeshes",
+			Entity:          "Meshes",
+			FnAttributeList: "Meshes",
+			SingularEntity:  "MeshRef",
+			Prefix:          "List",
+			Service:         "appmesh",
+			Documentation: `
+			// GetAppMeshMeshes returns the App Mesh Meshes on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetAppMeshVirtualNodes",
+			Entity:          "VirtualNodes",
+			FnAttributeList: "VirtualNodes",
+			SingularEntity:  "VirtualNodeRef",
+			Prefix:          "List",
+			Service:         "appmesh",
+			Documentation: `
+			// GetAppMeshVirtualNodes returns the App Mesh Virtual Nodes on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetAppMeshVirtualRouters",
+			Entity:          "VirtualRouters",
+			FnAttributeList: "VirtualRouters",
+			SingularEntity:  "VirtualRouterRef",
+			Prefix:          "List",
+			Service:         "appmesh",
+			Documentation: `
+			// GetAppMeshVirtualRouters returns the App Mesh Virtual Routers on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetAppMeshVirtualServices",
+			Entity:          "VirtualServices",
+			FnAttributeList: "VirtualServices",
+			SingularEntity:  "VirtualServiceRef",
+			Prefix:          "List",
+			Service:         "appmesh",
+			Documentation: `
+			// GetAppMeshVirtualServices returns the App Mesh Virtual Services on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
 		// autoscaling
 		Function{
 			Entity:         "AutoScalingGroups",
@@ -133,9 +237,24 @@ var (
 			`,
 		},
 
+		// cloudformation
+		Function{
+			FnName:          "GetCloudFormationStackResources",
+			Entity:          "StackResources",
+			FnAttributeList: "StackResourceSummaries",
+			SingularEntity:  "StackResourceSummary",
+			Prefix:          "List",
+			Service:         "cloudformation",
+			Documentation: `
+			// GetCloudFormationStackResources returns the Resources of the Stack on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
 		// cloudfront
 		Function{
 			FnName:                     "GetCloudFrontDistributions",
+			IsGlobal:                   true,
 			Entity:                     "Distributions",
 			Prefix:                     "List",
 			Service:                    "cloudfront",
@@ -150,6 +269,7 @@ var (
 		},
 		Function{
 			Entity:                     "CloudFrontOriginAccessIdentities",
+			IsGlobal:                   true,
 			Prefix:                     "List",
 			Service:                    "cloudfront",
 			SingularEntity:             "OriginAccessIdentitySummary",
@@ -163,6 +283,7 @@ var (
 		},
 		Function{
 			FnName:                     "GetCloudFrontPublicKeys",
+			IsGlobal:                   true,
 			Entity:                     "PublicKeys",
 			SingularEntity:             "PublicKeySummary",
 			FnAttributeList:            "PublicKeyList.Items",
@@ -175,6 +296,50 @@ var (
 			// Returned values are commented in the interface doc comment block.
 			`,
 		},
+		Function{
+			FnName:                     "GetCloudFrontCachePolicies",
+			Entity:                     "CachePolicies",
+			SingularEntity:             "CachePolicySummary",
+			FnAttributeList:            "CachePolicyList.Items",
+			FnPaginationAttribute:      "CachePolicyList.NextMarker",
+			FnInputPaginationAttribute: "Marker",
+			Prefix:                     "List",
+			Service:                    "cloudfront",
+			IsGlobal:                   true,
+			Documentation: `
+			// GetCloudFrontCachePolicies returns all the CloudFront Cache Policies on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:                     "GetCloudFrontFunctions",
+			Entity:                     "Functions",
+			SingularEntity:             "FunctionSummary",
+			FnAttributeList:            "FunctionList.Items",
+			FnPaginationAttribute:      "FunctionList.NextMarker",
+			FnInputPaginationAttribute: "Marker",
+			Prefix:                     "List",
+			Service:                    "cloudfront",
+			IsGlobal:                   true,
+			Documentation: `
+			// GetCloudFrontFunctions returns all the CloudFront Functions on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
+		// cloudtrail
+		Function{
+			FnName:          "GetCloudTrailEvents",
+			Entity:          "Events",
+			FnAttributeList: "Events",
+			SingularEntity:  "Event",
+			Prefix:          "Lookup",
+			Service:         "cloudtrail",
+			Documentation: `
+			// GetCloudTrailEvents returns the CloudTrail Events on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
 
 		// cloudwatch
 		Function{
@@ -204,6 +369,19 @@ var (
 			// supported resources, etc.
 			`,
 		},
+		Function{
+			FnName:          "GetConfigAggregateResources",
+			Entity:          "AggregateResourceConfig",
+			FnAttributeList: "Results",
+			Prefix:          "Select",
+			Service:         "configservice",
+			FnOutput:        "string",
+			Documentation: `
+			// GetConfigAggregateResources returns the JSON of the AWS Config items of
+			// the Configuration Aggregator that match the Expression on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
 
 		// dax
 		Function{
@@ -570,6 +748,39 @@ var (
 			// Returned values are commented in the interface doc comment block.
 			`,
 		},
+		Function{
+			FnName:  "GetCapacityReservations",
+			Entity:  "CapacityReservations",
+			Prefix:  "Describe",
+			Service: "ec2",
+			Documentation: `
+			// GetCapacityReservations returns the ec2 Capacity Reservations on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:         "GetFleets",
+			Entity:         "Fleets",
+			SingularEntity: "FleetData",
+			Prefix:         "Describe",
+			Service:        "ec2",
+			Documentation: `
+			// GetFleets returns the ec2 Fleets on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetSpotFleetRequests",
+			Entity:          "SpotFleetRequests",
+			FnAttributeList: "SpotFleetRequestConfigs",
+			SingularEntity:  "SpotFleetRequestConfig",
+			Prefix:          "Describe",
+			Service:         "ec2",
+			Documentation: `
+			// GetSpotFleetRequests returns the ec2 Spot Fleet Requests on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
 		// ECS
 		Function{
 			FnName:          "GetECSClustersArns",
@@ -678,6 +889,20 @@ var (
 			// Returned values are commented in the interface doc comment block.
 			`,
 		},
+		Function{
+			FnName:                     "GetElastiCacheParameterGroups",
+			Entity:                     "CacheParameterGroups",
+			FnAttributeList:            "CacheParameterGroups",
+			SingularEntity:             "CacheParameterGroup",
+			Prefix:                     "Describe",
+			Service:                    "elasticache",
+			FnPaginationAttribute:      "Marker",
+			FnInputPaginationAttribute: "Marker",
+			Documentation: `
+			// GetElastiCacheParameterGroups returns the Elasticache Parameter Groups on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
 		Function{
 			FnName:                     "GetElastiCacheReplicationGroups",
 			Entity:                     "ReplicationGroups",
@@ -692,6 +917,20 @@ var (
 			// Returned values are commented in the interface doc comment block.
 			`,
 		},
+		Function{
+			FnName:                     "GetElastiCacheSubnetGroups",
+			Entity:                     "CacheSubnetGroups",
+			FnAttributeList:            "CacheSubnetGroups",
+			SingularEntity:             "CacheSubnetGroup",
+			Prefix:                     "Describe",
+			Service:                    "elasticache",
+			FnPaginationAttribute:      "Marker",
+			FnInputPaginationAttribute: "Marker",
+			Documentation: `
+			// GetElastiCacheSubnetGroups returns the Elasticache Subnet Groups on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
 		Function{
 			HasNotPagination: true,
 			FnName:           "GetElastiCacheTags",
@@ -917,6 +1156,23 @@ var (
 			`,
 		},
 
+		// firehose
+		Function{
+			// The ListDeliveryStreams has no pagination token
+			// so it has a custom implementation that uses the
+			// last name returned as the start of the next page
+			FnName:       "GetKinesisFirehoseDeliveryStreams",
+			Entity:       "DeliveryStreams",
+			FnOutput:     "string",
+			Prefix:       "List",
+			Service:      "firehose",
+			NoGenerateFn: true,
+			Documentation: `
+			// GetKinesisFirehoseDeliveryStreams returns the Kinesis Firehose Delivery Stream names on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
 		// fsx
 		Function{
 			FnName:          "GetFSXFileSystems",
@@ -961,6 +1217,7 @@ var (
 		Function{
 			Entity:                "AccessKeys",
 			Prefix:                "List",
+			IsGlobal:              true,
 			Service:               "iam",
 			FnAttributeList:       "AccessKeyMetadata",
 			SingularEntity:        "AccessKeyMetadata",
@@ -973,6 +1230,7 @@ var (
 		Function{
 			Entity:                "AccountAliases",
 			Prefix:                "List",
+			IsGlobal:              true,
 			Service:               "iam",
 			FnPaginationAttribute: "Marker",
 			FnOutput:              "string",
@@ -988,6 +1246,7 @@ var (
 			SingularEntity:   "PasswordPolicy",
 			HasNotPagination: true,
 			HasNoSlice:       true,
+			IsGlobal:         true,
 			Service:          "iam",
 			Documentation: `
 			// GetAccountPasswordPolicy returns the IAM AccountPasswordPolicy on the given input
@@ -1000,6 +1259,7 @@ var (
 			SingularEntity:        "AttachedPolicy",
 			FnPaginationAttribute: "Marker",
 			Prefix:                "List",
+			IsGlobal:              true,
 			Service:               "iam",
 			Documentation: `
 			// GetAttachedGroupPolicies returns the IAM AttachedGroupPolicies on the given input
@@ -1012,6 +1272,7 @@ var (
 			FnPaginationAttribute: "Marker",
 			FnAttributeList:       "AttachedPolicies",
 			SingularEntity:        "AttachedPolicy",
+			IsGlobal:              true,
 			Service:               "iam",
 			Documentation: `
 			// GetAttachedRolePolicies returns the IAM AttachedRolePolicies on the given input
@@ -1024,6 +1285,7 @@ var (
 			FnAttributeList:       "AttachedPolicies",
 			SingularEntity:        "AttachedPolicy",
 			Prefix:                "List",
+			IsGlobal:              true,
 			Service:               "iam",
 			Documentation: `
 			// GetAttachedUserPolicies returns the IAM AttachedUserPolicies on the given input
@@ -1037,6 +1299,7 @@ var (
 			FnAttributeList:       "Users",
 			SingularEntity:        "User",
 			FnPaginationAttribute: "Marker",
+			IsGlobal:              true,
 			Service:               "iam",
 			Documentation: `
 			// GetGroupUsers returns a list of IAM users that are in the specified IAM group
@@ -1046,6 +1309,7 @@ var (
 		Function{
 			Entity:                "GroupPolicies",
 			Prefix:                "List",
+			IsGlobal:              true,
 			Service:               "iam",
 			FnOutput:              "string",
 			FnAttributeList:       "PolicyNames",
@@ -1059,6 +1323,7 @@ var (
 			Entity:                "Groups",
 			Prefix:                "List",
 			FnPaginationAttribute: "Marker",
+			IsGlobal:              true,
 			Service:               "iam",
 			Documentation: `
 			// GetGroups returns the IAM Groups on the given input
@@ -1068,6 +1333,7 @@ var (
 		Function{
 			Entity:                "GroupsForUser",
 			Prefix:                "List",
+			IsGlobal:              true,
 			Service:               "iam",
 			FnAttributeList:       "Groups",
 			SingularEntity:        "Group",
@@ -1080,6 +1346,7 @@ var (
 		Function{
 			Entity:                "InstanceProfiles",
 			Prefix:                "List",
+			IsGlobal:              true,
 			Service:               "iam",
 			FnPaginationAttribute: "Marker",
 			Documentation: `
@@ -1091,6 +1358,7 @@ var (
 		Function{
 			Entity:           "OpenIDConnectProviders",
 			Prefix:           "List",
+			IsGlobal:         true,
 			Service:          "iam",
 			HasNotPagination: true,
 			FnAttributeList:  "OpenIDConnectProviderList",
@@ -1103,6 +1371,7 @@ var (
 		Function{
 			Entity:                "Policies",
 			Prefix:                "List",
+			IsGlobal:              true,
 			Service:               "iam",
 			FnPaginationAttribute: "Marker",
 			Documentation: `
@@ -1110,12 +1379,28 @@ var (
 			// Returned values are commented in the interface doc comment block.
 			`,
 		},
+		Function{
+			FnName:                "GetPrincipalPolicySimulation",
+			Entity:                "PrincipalPolicy",
+			FnAttributeList:       "EvaluationResults",
+			SingularEntity:        "EvaluationResult",
+			Prefix:                "Simulate",
+			FnPaginationAttribute: "Marker",
+			IsGlobal:              true,
+			Service:               "iam",
+			Documentation: `
+			// GetPrincipalPolicySimulation returns the IAM EvaluationResults of simulating the
+			// policies of the principal with the actions on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
 		Function{
 			FnOutput:              "string",
 			FnAttributeList:       "PolicyNames",
 			Entity:                "RolePolicies",
 			FnPaginationAttribute: "Marker",
 			Prefix:                "List",
+			IsGlobal:              true,
 			Service:               "iam",
 			Documentation: `
 			// GetRolePolicies returns the IAM RolePolicies on the given input
@@ -1126,6 +1411,7 @@ var (
 			Entity:                "Roles",
 			Prefix:                "List",
 			FnPaginationAttribute: "Marker",
+			IsGlobal:              true,
 			Service:               "iam",
 			Documentation: `
 			// GetRoles returns the IAM Roles on the given input
@@ -1138,6 +1424,7 @@ var (
 			FnAttributeList:  "SAMLProviderList",
 			SingularEntity:   "SAMLProviderListEntry",
 			Prefix:           "List",
+			IsGlobal:         true,
 			Service:          "iam",
 			Documentation: `
 			// GetSAMLProviders returns the IAM SAMLProviders on the given input
@@ -1150,6 +1437,7 @@ var (
 			SingularEntity:        "ServerCertificateMetadata",
 			Prefix:                "List",
 			FnPaginationAttribute: "Marker",
+			IsGlobal:              true,
 			Service:               "iam",
 			Documentation: `
 			// GetServerCertificates returns the IAM ServerCertificates on the given input
@@ -1161,6 +1449,7 @@ var (
 			SingularEntity:        "SSHPublicKeyMetadata",
 			FnPaginationAttribute: "Marker",
 			Prefix:                "List",
+			IsGlobal:              true,
 			Service:               "iam",
 			Documentation: `
 			// GetSSHPublicKeys returns the IAM SSHPublicKeys on the given input
@@ -1170,6 +1459,7 @@ var (
 		Function{
 			Entity:                "UserPolicies",
 			Prefix:                "List",
+			IsGlobal:              true,
 			Service:               "iam",
 			FnPaginationAttribute: "Marker",
 			FnOutput:              "string",
@@ -1183,6 +1473,7 @@ var (
 			Entity:                "Users",
 			Prefix:                "List",
 			FnPaginationAttribute: "Marker",
+			IsGlobal:              true,
 			Service:               "iam",
 			Documentation: `
 			// GetUsers returns the IAM Users on the given input
@@ -1190,6 +1481,32 @@ var (
 			`,
 		},
 
+		// kafka
+		Function{
+			FnName:          "GetMSKClusters",
+			Entity:          "Clusters",
+			FnAttributeList: "ClusterInfoList",
+			SingularEntity:  "ClusterInfo",
+			Prefix:          "List",
+			Service:         "kafka",
+			Documentation: `
+			// GetMSKClusters returns the MSK Clusters on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetMSKConfigurations",
+			Entity:          "Configurations",
+			FnAttributeList: "Configurations",
+			SingularEntity:  "Configuration",
+			Prefix:          "List",
+			Service:         "kafka",
+			Documentation: `
+			// GetMSKConfigurations returns the MSK Configurations on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
 		// kinesis
 		Function{
 			FnName:           "GetKinesisStreams",
@@ -1220,6 +1537,104 @@ var (
 			// Returned values are commented in the interface doc comment block.
 			`,
 		},
+		Function{
+			FnName:                     "GetLambdaEventSourceMappings",
+			Entity:                     "EventSourceMappings",
+			FnAttributeList:            "EventSourceMappings",
+			SingularEntity:             "EventSourceMappingConfiguration",
+			Prefix:                     "List",
+			Service:                    "lambda",
+			FnPaginationAttribute:      "NextMarker",
+			FnInputPaginationAttribute: "Marker",
+			Documentation: `
+			// GetLambdaEventSourceMappings returns the lambda EventSourceMappings on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:           "GetLambdaFunctionCode",
+			Entity:           "Function",
+			FnAttributeList:  "Code",
+			FnOutput:         "lambda.FunctionCodeLocation",
+			Prefix:           "Get",
+			Service:          "lambda",
+			HasNotPagination: true,
+			HasNoSlice:       true,
+			Documentation: `
+			// GetLambdaFunctionCode returns the lambda Function Code location on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:                     "GetLambdaFunctionURLConfigs",
+			Entity:                     "FunctionUrlConfigs",
+			FnAttributeList:            "FunctionUrlConfigs",
+			SingularEntity:             "FunctionUrlConfig",
+			Prefix:                     "List",
+			Service:                    "lambda",
+			FnPaginationAttribute:      "NextMarker",
+			FnInputPaginationAttribute: "Marker",
+			Documentation: `
+			// GetLambdaFunctionURLConfigs returns the lambda FunctionUrlConfigs on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:                     "GetLambdaLayers",
+			Entity:                     "Layers",
+			FnAttributeList:            "Layers",
+			SingularEntity:             "LayersListItem",
+			Prefix:                     "List",
+			Service:                    "lambda",
+			FnPaginationAttribute:      "NextMarker",
+			FnInputPaginationAttribute: "Marker",
+			Documentation: `
+			// GetLambdaLayers returns the lambda Layers on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:                     "GetLambdaLayerVersions",
+			Entity:                     "LayerVersions",
+			FnAttributeList:            "LayerVersions",
+			SingularEntity:             "LayerVersionsListItem",
+			Prefix:                     "List",
+			Service:                    "lambda",
+			FnPaginationAttribute:      "NextMarker",
+			FnInputPaginationAttribute: "Marker",
+			Documentation: `
+			// GetLambdaLayerVersions returns the lambda LayerVersions on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:           "GetLambdaLayerVersionContent",
+			Entity:           "LayerVersionByArn",
+			FnAttributeList:  "Content",
+			FnOutput:         "lambda.LayerVersionContentOutput",
+			Prefix:           "Get",
+			Service:          "lambda",
+			HasNotPagination: true,
+			HasNoSlice:       true,
+			Documentation: `
+			// GetLambdaLayerVersionContent returns the lambda LayerVersion Content location on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:           "GetLambdaPolicy",
+			Entity:           "Policy",
+			FnAttributeList:  "Policy",
+			FnOutput:         "string",
+			Prefix:           "Get",
+			Service:          "lambda",
+			HasNotPagination: true,
+			HasNoSlice:       true,
+			Documentation: `
+			// GetLambdaPolicy returns the lambda Function Policy on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
 
 		// lightsail
 		Function{
@@ -1251,6 +1666,20 @@ var (
 		  `,
 		},
 
+		// memorydb
+		Function{
+			FnName:          "GetMemoryDBClusters",
+			Entity:          "Clusters",
+			FnAttributeList: "Clusters",
+			SingularEntity:  "Cluster",
+			Prefix:          "Describe",
+			Service:         "memorydb",
+			Documentation: `
+			// GetMemoryDBClusters returns the MemoryDB Clusters on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
 		// mq
 		Function{
 			FnName:          "GetMQBrokers",
@@ -1264,6 +1693,32 @@ var (
 		  // Returned values are commented in the interface doc comment block.
 		  `,
 		},
+		Function{
+			FnName:          "GetMQConfigurations",
+			Entity:          "Configurations",
+			FnAttributeList: "Configurations",
+			SingularEntity:  "Configuration",
+			Prefix:          "List",
+			Service:         "mq",
+			Documentation: `
+			// GetMQConfigurations returns the MQ Configurations on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
+		// mwaa
+		Function{
+			FnName:          "GetMWAAEnvironments",
+			Entity:          "Environments",
+			FnAttributeList: "Environments",
+			FnOutput:        "string",
+			Prefix:          "List",
+			Service:         "mwaa",
+			Documentation: `
+			// GetMWAAEnvironments returns the names of the MWAA Environments on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
 
 		// neptune
 		Function{
@@ -1372,9 +1827,10 @@ var (
 
 		// route53
 		Function{
-			Entity:  "QueryLoggingConfigs",
-			Prefix:  "List",
-			Service: "route53",
+			Entity:   "QueryLoggingConfigs",
+			Prefix:   "List",
+			IsGlobal: true,
+			Service:  "route53",
 			Documentation: `
 			// GetQueryLoggingConfigs returns the Route53 QueryLoggingConfigs on the given input
 			// Returned values are commented in the interface doc comment block.
@@ -1383,6 +1839,7 @@ var (
 		Function{
 			Entity:                     "HealthChecks",
 			Prefix:                     "List",
+			IsGlobal:                   true,
 			Service:                    "route53",
 			FnPaginationAttribute:      "NextMarker",
 			FnInputPaginationAttribute: "Marker",
@@ -1394,6 +1851,7 @@ var (
 		Function{
 			Entity:                     "HostedZones",
 			Prefix:                     "List",
+			IsGlobal:                   true,
 			Service:                    "route53",
 			FnPaginationAttribute:      "NextMarker",
 			FnInputPaginationAttribute: "Marker",
@@ -1403,11 +1861,27 @@ var (
 			`,
 		},
 		Function{
-			Entity:                     "ResourceRecordSets",
-			Prefix:                     "List",
-			Service:                    "route53",
-			FnInputPaginationAttribute: "StartRecordName",
-			FnPaginationAttribute:      "NextRecordName",
+			FnName:           "GetHostedZoneVPCs",
+			Entity:           "HostedZone",
+			FnAttributeList:  "VPCs",
+			SingularEntity:   "VPC",
+			Prefix:           "Get",
+			IsGlobal:         true,
+			Service:          "route53",
+			HasNotPagination: true,
+			Documentation: `
+			// GetHostedZoneVPCs returns the VPCs associated to the Route53 HostedZone on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			// The pagination uses the name, type and identifier
+			// of the next record so it has a custom implementation
+			Entity:       "ResourceRecordSets",
+			Prefix:       "List",
+			IsGlobal:     true,
+			Service:      "route53",
+			NoGenerateFn: true,
 			Documentation: `
 			// GetResourceRecordSets returns the Route53 ResourceRecordSets on the given input
 			// Returned values are commented in the interface doc comment block.
@@ -1418,6 +1892,7 @@ var (
 			FnAttributeList:            "DelegationSets",
 			SingularEntity:             "DelegationSet",
 			Prefix:                     "List",
+			IsGlobal:                   true,
 			Service:                    "route53",
 			FnPaginationAttribute:      "NextMarker",
 			FnInputPaginationAttribute: "Marker",
@@ -1429,6 +1904,7 @@ var (
 		Function{
 			Entity:          "VPCAssociationAuthorizations",
 			Prefix:          "List",
+			IsGlobal:        true,
 			Service:         "route53",
 			FnAttributeList: "VPCs",
 			SingularEntity:  "VPC",
@@ -1522,6 +1998,46 @@ var (
 			`,
 		},
 
+		// secretsmanager
+		Function{
+			FnName:          "GetSecretsManagerSecrets",
+			Entity:          "Secrets",
+			FnAttributeList: "SecretList",
+			SingularEntity:  "SecretListEntry",
+			Prefix:          "List",
+			Service:         "secretsmanager",
+			Documentation: `
+			// GetSecretsManagerSecrets returns the SecretsManager Secrets on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
+		// servicediscovery
+		Function{
+			FnName:          "GetServiceDiscoveryNamespaces",
+			Entity:          "Namespaces",
+			FnAttributeList: "Namespaces",
+			SingularEntity:  "NamespaceSummary",
+			Prefix:          "List",
+			Service:         "servicediscovery",
+			Documentation: `
+			// GetServiceDiscoveryNamespaces returns the Service Discovery Namespaces on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetServiceDiscoveryServices",
+			Entity:          "Services",
+			FnAttributeList: "Services",
+			SingularEntity:  "ServiceSummary",
+			Prefix:          "List",
+			Service:         "servicediscovery",
+			Documentation: `
+			// GetServiceDiscoveryServices returns the Service Discovery Services on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
 		// ses
 		Function{
 			Entity:           "ActiveReceiptRuleSet",
@@ -1604,6 +2120,21 @@ var (
 			`,
 		},
 
+		// shield
+		Function{
+			FnName:          "GetShieldProtections",
+			IsGlobal:        true,
+			Entity:          "Protections",
+			FnAttributeList: "Protections",
+			SingularEntity:  "Protection",
+			Prefix:          "List",
+			Service:         "shield",
+			Documentation: `
+			// GetShieldProtections returns the Shield Protections on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
 		// sqs
 		Function{
 			FnName:          "GetSQSQueues",
@@ -1618,6 +2149,70 @@ var (
 			`,
 		},
 
+		// ssm
+		Function{
+			FnName:          "GetSSMParameters",
+			Entity:          "Parameters",
+			FnAttributeList: "Parameters",
+			SingularEntity:  "ParameterMetadata",
+			Prefix:          "Describe",
+			Service:         "ssm",
+			Documentation: `
+			// GetSSMParameters returns the SSM Parameters on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
+		// ssoadmin
+		Function{
+			FnName:          "GetSSOAdminInstances",
+			Entity:          "Instances",
+			FnAttributeList: "Instances",
+			SingularEntity:  "InstanceMetadata",
+			Prefix:          "List",
+			Service:         "ssoadmin",
+			Documentation: `
+			// GetSSOAdminInstances returns the SSO Admin Instances on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetSSOAdminPermissionSets",
+			Entity:          "PermissionSets",
+			FnAttributeList: "PermissionSets",
+			FnOutput:        "string",
+			Prefix:          "List",
+			Service:         "ssoadmin",
+			Documentation: `
+			// GetSSOAdminPermissionSets returns the SSO Admin Permission Sets on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetSSOAdminManagedPoliciesInPermissionSet",
+			Entity:          "ManagedPoliciesInPermissionSet",
+			FnAttributeList: "AttachedManagedPolicies",
+			SingularEntity:  "AttachedManagedPolicy",
+			Prefix:          "List",
+			Service:         "ssoadmin",
+			Documentation: `
+			// GetSSOAdminManagedPoliciesInPermissionSet returns the SSO Admin Managed Policies In Permission Set on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetSSOAdminAccountAssignments",
+			Entity:          "AccountAssignments",
+			FnAttributeList: "AccountAssignments",
+			SingularEntity:  "AccountAssignment",
+			Prefix:          "List",
+			Service:         "ssoadmin",
+			Documentation: `
+			// GetSSOAdminAccountAssignments returns the SSO Admin Account Assignments on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
 		// storagegateway
 		Function{
 			FnName:                     "GetStorageGatewayGateways",
@@ -1633,5 +2228,112 @@ var (
 			// Returned values are commented in the interface doc comment block.
 			`,
 		},
+
+		// synthetics
+		Function{
+			FnName:          "GetSyntheticsCanaries",
+			Entity:          "Canaries",
+			FnAttributeList: "Canaries",
+			SingularEntity:  "Canary",
+			Prefix:          "Describe",
+			Service:         "synthetics",
+			Documentation: `
+			// GetSyntheticsCanaries returns the Synthetics Canaries on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
+		// transfer
+		Function{
+			FnName:          "GetTransferServers",
+			Entity:          "Servers",
+			FnAttributeList: "Servers",
+			SingularEntity:  "ListedServer",
+			Prefix:          "List",
+			Service:         "transfer",
+			Documentation: `
+			// GetTransferServers returns the Transfer Servers on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetTransferUsers",
+			Entity:          "Users",
+			FnAttributeList: "Users",
+			SingularEntity:  "ListedUser",
+			Prefix:          "List",
+			Service:         "transfer",
+			Documentation: `
+			// GetTransferUsers returns the Transfer Users on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
+		// wafv2
+		Function{
+			// The CLOUDFRONT scope is only available on the global
+			// region so it has a custom implementation that uses
+			// the client of the Scope of the input
+			FnName:         "GetWAFV2IPSets",
+			Entity:         "IPSets",
+			SingularEntity: "IPSetSummary",
+			Prefix:         "List",
+			Service:        "wafv2",
+			NoGenerateFn:   true,
+			Documentation: `
+			// GetWAFV2IPSets returns the WAFV2 IPSets of the Scope on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:         "GetWAFV2RuleGroups",
+			Entity:         "RuleGroups",
+			SingularEntity: "RuleGroupSummary",
+			Prefix:         "List",
+			Service:        "wafv2",
+			NoGenerateFn:   true,
+			Documentation: `
+			// GetWAFV2RuleGroups returns the WAFV2 RuleGroups of the Scope on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:         "GetWAFV2WebACLs",
+			Entity:         "WebACLs",
+			SingularEntity: "WebACLSummary",
+			Prefix:         "List",
+			Service:        "wafv2",
+			NoGenerateFn:   true,
+			Documentation: `
+			// GetWAFV2WebACLs returns the WAFV2 WebACLs of the Scope on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+
+		// xray
+		Function{
+			FnName:          "GetXRayGroups",
+			Entity:          "Groups",
+			FnAttributeList: "Groups",
+			SingularEntity:  "GroupSummary",
+			Prefix:          "Get",
+			Service:         "xray",
+			Documentation: `
+			// GetXRayGroups returns the XRay Groups on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
+		Function{
+			FnName:          "GetXRaySamplingRules",
+			Entity:          "SamplingRules",
+			FnAttributeList: "SamplingRuleRecords",
+			SingularEntity:  "SamplingRuleRecord",
+			Prefix:          "Get",
+			Service:         "xray",
+			Documentation: `
+			// GetXRaySamplingRules returns the XRay SamplingRuleRecords on the given input
+			// Returned values are commented in the interface doc comment block.
+			`,
+		},
 	}
 )
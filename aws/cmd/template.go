@@ -41,9 +41,21 @@ const (
 		// GetAccountID returns the current ID for the account used
 		GetAccountID() string
 
+		// GetCallerARN returns the ARN of the identity
+		// of the credentials used
+		GetCallerARN() string
+
 		// GetRegion returns the currently used region for the Connector
 		GetRegion() string
 
+		// GetPartition returns the partition of the region
+		// used (aws, aws-cn, aws-us-gov)
+		GetPartition() string
+
+		// GetAPIStats returns the statistics of the calls done
+		// to each operation of the AWS APIs, to diagnose the throttling
+		GetAPIStats() []APIStats
+
 		{{ range . }}
 			{{ .Documentation -}}
 			{{ .Signature }}
@@ -62,7 +74,11 @@ const (
 			{{ end -}}
 
 			if c.svc.{{.Service}} == nil {
-				c.svc.{{.Service}} = {{.Service}}.New(c.svc.session)
+				{{ if .IsGlobal -}}
+					c.svc.{{.Service}} = {{.Service}}.New(c.svc.globalSession)
+				{{- else -}}
+					c.svc.{{.Service}} = {{.Service}}.New(c.svc.session)
+				{{- end }}
 			}
 
 			{{ if .HasNoSlice }}
@@ -205,6 +221,15 @@ type Function struct {
 
 	// If the value is a map
 	IsMap bool
+
+	// IsGlobal means that the Service is global, like CloudFront,
+	// so it's always called on the global region of the partition
+	// and not on the region of the connector. All the Functions
+	// of the same Service have to have the same value and the
+	// resource types read with them have to be on the
+	// globalResourceTypes, so they are only read once
+	// when importing multiple regions
+	IsGlobal bool
 }
 
 // Name builds a name simply using "Get{{.Entity}}"
@@ -35,6 +35,10 @@ type Reader interface {
 	// GetRegion returns the currently used region for the Connector
 	GetRegion() string
 
+	// GetPartition returns the partition of the region
+	// used (aws, aws-cn, aws-us-gov)
+	GetPartition() string
+
 	// GetInstances returns all EC2 instances based on the input given.
 	// Returned values are commented in the interface doc comment block.
 	GetInstances(ctx context.Context, input *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error)
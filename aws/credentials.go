@@ -0,0 +1,87 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+
+	awsSDK "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/pkg/errors"
+)
+
+const (
+	// CredentialsSourceIMDSv2 reads the credentials of the role of the
+	// EC2 Instance from the Instance Metadata Service only with the
+	// token-based (IMDSv2) requests
+	CredentialsSourceIMDSv2 = "imdsv2"
+
+	// CredentialsSourceECS reads the credentials of the role of the
+	// ECS Task from the endpoint of the container defined
+	// on the AWS_CONTAINER_CREDENTIALS_RELATIVE_URI
+	CredentialsSourceECS = "ecs"
+)
+
+const (
+	// ecsCredentialsRelativeURIEnv is the env variable set by
+	// ECS on the containers with the path to fetch the credentials
+	ecsCredentialsRelativeURIEnv = "AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"
+
+	// ecsCredentialsHost is the host of the
+	// endpoint of the ECS Task credentials
+	ecsCredentialsHost = "http://169.254.170.2"
+
+	// imdsTokenHeader is the header in which the
+	// IMDSv2 token is sent on the requests
+	imdsTokenHeader = "x-aws-ec2-metadata-token"
+)
+
+// requireIMDSTokenHandler fails the requests to the Instance Metadata Service
+// that have no token, as the SDK falls back to IMDSv1 when it
+// can not fetch one. The 'GetToken' is the one fetching it
+var requireIMDSTokenHandler = request.NamedHandler{
+	Name: "terracognita.RequireIMDSTokenHandler",
+	Fn: func(r *request.Request) {
+		if r.Error != nil || r.Operation.Name == "GetToken" {
+			return
+		}
+
+		if r.HTTPRequest.Header.Get(imdsTokenHeader) == "" {
+			r.Error = awserr.New("IMDSv2TokenRequired", "could not fetch the IMDSv2 token and the IMDSv1 requests are not allowed", nil)
+		}
+	},
+}
+
+// newSourceCredentials returns the Credentials read from the source,
+// which are refreshed when they expire, so no static keys are needed
+func newSourceCredentials(source string, cfg *awsSDK.Config) (*credentials.Credentials, error) {
+	switch source {
+	case CredentialsSourceIMDSv2:
+		sess, err := session.NewSession(cfg)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to initialize the session of the Instance Metadata Service")
+		}
+
+		c := ec2metadata.New(sess)
+		c.Handlers.Sign.PushBackNamed(requireIMDSTokenHandler)
+
+		return ec2rolecreds.NewCredentialsWithClient(c), nil
+	case CredentialsSourceECS:
+		uri := os.Getenv(ecsCredentialsRelativeURIEnv)
+		if uri == "" {
+			return nil, errors.Errorf("the credentials source %q requires the %s to be defined", source, ecsCredentialsRelativeURIEnv)
+		}
+
+		dcfg := defaults.Config().Copy(cfg)
+
+		return endpointcreds.NewCredentialsClient(*dcfg, defaults.Handlers(), fmt.Sprintf("%s%s", ecsCredentialsHost, uri)), nil
+	default:
+		return nil, errors.Errorf("invalid credentials source %q, the supported ones are %s and %s", source, CredentialsSourceIMDSv2, CredentialsSourceECS)
+	}
+}
@@ -0,0 +1,79 @@
+package aws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	awsSDK "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newIMDSServer returns a fake Instance Metadata Service
+// with the role 'role', if withToken is false the
+// IMDSv2 token can not be fetched
+func newIMDSServer(withToken bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/latest/api/token":
+			if !withToken {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Header().Set("x-aws-ec2-metadata-token-ttl-seconds", "21600")
+			w.Write([]byte("token"))
+		case "/latest/meta-data/iam/security-credentials/":
+			w.Write([]byte("role"))
+		case "/latest/meta-data/iam/security-credentials/role":
+			w.Write([]byte(`{
+				"Code": "Success",
+				"AccessKeyId": "access",
+				"SecretAccessKey": "secret",
+				"Token": "session",
+				"Expiration": "` + time.Now().Add(time.Hour).UTC().Format(time.RFC3339) + `"
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestNewSourceCredentials(t *testing.T) {
+	t.Run("IMDSv2", func(t *testing.T) {
+		s := newIMDSServer(true)
+		defer s.Close()
+
+		creds, err := newSourceCredentials(CredentialsSourceIMDSv2, &awsSDK.Config{Endpoint: awsSDK.String(s.URL)})
+		require.NoError(t, err)
+
+		v, err := creds.Get()
+		require.NoError(t, err)
+		assert.Equal(t, "access", v.AccessKeyID)
+		assert.Equal(t, "secret", v.SecretAccessKey)
+		assert.Equal(t, "session", v.SessionToken)
+	})
+	t.Run("IMDSv2ErrorNoToken", func(t *testing.T) {
+		s := newIMDSServer(false)
+		defer s.Close()
+
+		creds, err := newSourceCredentials(CredentialsSourceIMDSv2, &awsSDK.Config{Endpoint: awsSDK.String(s.URL)})
+		require.NoError(t, err)
+
+		_, err = creds.Get()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "IMDSv2TokenRequired")
+	})
+	t.Run("ErrorECSNoURI", func(t *testing.T) {
+		os.Unsetenv(ecsCredentialsRelativeURIEnv)
+
+		_, err := newSourceCredentials(CredentialsSourceECS, nil)
+		assert.Error(t, err)
+	})
+	t.Run("ErrorInvalid", func(t *testing.T) {
+		_, err := newSourceCredentials("invalid", nil)
+		assert.Error(t, err)
+	})
+}
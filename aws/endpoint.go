@@ -0,0 +1,41 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// newEndpointResolver returns an endpoints.Resolver that uses the endpoints
+// for the services defined on it, the endpoint for all the other services
+// if defined and the default resolver for the rest
+func newEndpointResolver(endpoint string, eps map[string]string) endpoints.Resolver {
+	return endpoints.ResolverFunc(func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		url, ok := eps[service]
+		if !ok {
+			url = endpoint
+		}
+		if url == "" {
+			return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+		}
+
+		return endpoints.ResolvedEndpoint{
+			URL:           url,
+			SigningRegion: region,
+		}, nil
+	})
+}
+
+// tfEndpoints returns the endpoints to configure the TF Client with,
+// if the endpoint is defined it's used for all the services
+// of the partition that have not been defined on the eps
+func tfEndpoints(endpoint string, eps map[string]string) map[string]string {
+	res := make(map[string]string)
+	if endpoint != "" {
+		for s := range endpoints.AwsPartition().Services() {
+			res[s] = endpoint
+		}
+	}
+	for s, url := range eps {
+		res[s] = url
+	}
+	return res
+}
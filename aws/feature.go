@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FeatureServices are the services which resource types can be
+// included or excluded by feature with the FeatureExcludes, so
+// the depth of the import can be controlled per service
+var FeatureServices = []string{
+	"autoscaling",
+	"cloudfront",
+	"ec2",
+	"elasticache",
+	"iam",
+	"lambda",
+	"lb",
+	"route53",
+	"ses",
+	"ssoadmin",
+	"wafv2",
+}
+
+// Features returns the features of the service, which are the
+// resource types with the prefix 'aws_SERVICE_' without it
+// (ex: 'user' for 'aws_iam_user') and sorted by name
+func Features(service string) []string {
+	prefix := fmt.Sprintf("aws_%s_", service)
+
+	fs := make([]string, 0)
+	for _, rt := range ResourceTypeStrings() {
+		if strings.HasPrefix(rt, prefix) {
+			fs = append(fs, strings.TrimPrefix(rt, prefix))
+		}
+	}
+	sort.Strings(fs)
+
+	return fs
+}
+
+// FeatureExcludes returns the resource types of the service that have to be
+// excluded to import only the features. The features can be in singular
+// or plural (ex: 'users' for 'user') and the ones prefixed with '!' are
+// excluded, if any feature is not prefixed then only those are included
+func FeatureExcludes(service string, features []string) ([]string, error) {
+	all := Features(service)
+	if len(all) == 0 {
+		return nil, errors.Errorf("the service %q has no features", service)
+	}
+
+	var hasIncluded bool
+	included := make(map[string]struct{})
+	excluded := make(map[string]struct{})
+	for _, f := range features {
+		f = strings.ToLower(strings.TrimSpace(f))
+		if f == "" {
+			continue
+		}
+
+		neg := strings.HasPrefix(f, "!")
+		if neg {
+			f = strings.TrimPrefix(f, "!")
+		}
+
+		ft, ok := matchFeature(all, f)
+		if !ok {
+			return nil, errors.Errorf("invalid feature %q for the service %q, the valid ones are: %s", f, service, strings.Join(all, ", "))
+		}
+
+		if neg {
+			excluded[ft] = struct{}{}
+		} else {
+			hasIncluded = true
+			included[ft] = struct{}{}
+		}
+	}
+
+	excludes := make([]string, 0)
+	for _, ft := range all {
+		_, inc := included[ft]
+		_, exc := excluded[ft]
+		if exc || (hasIncluded && !inc) {
+			excludes = append(excludes, fmt.Sprintf("aws_%s_%s", service, ft))
+		}
+	}
+
+	return excludes, nil
+}
+
+// matchFeature returns the feature of fs that
+// f is equal to in singular or plural
+func matchFeature(fs []string, f string) (string, bool) {
+	for _, ft := range fs {
+		if f == ft || f == ft+"s" || f == ft+"es" || (strings.HasSuffix(ft, "y") && f == strings.TrimSuffix(ft, "y")+"ies") {
+			return ft, true
+		}
+	}
+	return "", false
+}
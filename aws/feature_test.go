@@ -0,0 +1,55 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureServices(t *testing.T) {
+	for _, s := range FeatureServices {
+		assert.NotEmpty(t, Features(s), s)
+	}
+}
+
+func TestFeatureExcludes(t *testing.T) {
+	t.Run("Included", func(t *testing.T) {
+		excludes, err := FeatureExcludes("ses", []string{"templates", "Receipt_Rule"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"aws_ses_active_receipt_rule_set",
+			"aws_ses_configuration_set",
+			"aws_ses_domain_dkim",
+			"aws_ses_domain_identity",
+			"aws_ses_domain_mail_from",
+			"aws_ses_identity_notification_topic",
+			"aws_ses_receipt_filter",
+			"aws_ses_receipt_rule_set",
+		}, excludes)
+	})
+
+	t.Run("Excluded", func(t *testing.T) {
+		excludes, err := FeatureExcludes("iam", []string{"!access_keys", "!user_ssh_key", "!policies"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"aws_iam_access_key", "aws_iam_policy", "aws_iam_user_ssh_key"}, excludes)
+	})
+
+	t.Run("IncludedAndExcluded", func(t *testing.T) {
+		excludes, err := FeatureExcludes("iam", []string{"users", "groups", "!users"})
+		require.NoError(t, err)
+		assert.NotContains(t, excludes, "aws_iam_group")
+		assert.Contains(t, excludes, "aws_iam_user")
+		assert.Contains(t, excludes, "aws_iam_access_key")
+	})
+
+	t.Run("InvalidFeature", func(t *testing.T) {
+		_, err := FeatureExcludes("iam", []string{"mfa_devices"})
+		assert.Error(t, err)
+	})
+
+	t.Run("InvalidService", func(t *testing.T) {
+		_, err := FeatureExcludes("potato", []string{"users"})
+		assert.Error(t, err)
+	})
+}
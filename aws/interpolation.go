@@ -0,0 +1,25 @@
+package aws
+
+import (
+	"regexp"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+var (
+	// securityGroupMatcher matches the Security Groups referenced
+	// with the owner, like on the rules of the Security Groups,
+	// with the format 'OWNER-ID/sg-ID'
+	securityGroupMatcher = provider.NewRegexpMatcher(regexp.MustCompile(`^(?:\d{12}/)?(sg-[0-9a-f]+)$`), false, 20)
+
+	// ec2ARNMatcher matches the EC2 resources referenced by the
+	// ARN, with the format 'arn:PARTITION:ec2:REGION:ACCOUNT:TYPE/ID',
+	// instead of by the ID
+	ec2ARNMatcher = provider.NewRegexpMatcher(regexp.MustCompile(`^(?:arn:[^:]+:ec2:[^:]*:\d{12}:[a-z-]+/)?((?:[a-z]+-)+[0-9a-f]{8,17})$`), false, 10)
+)
+
+// InterpolationMatchers returns the Matchers used to resolve
+// the values to interpolate that have other formats
+func (a *aws) InterpolationMatchers() []provider.Matcher {
+	return []provider.Matcher{securityGroupMatcher, ec2ARNMatcher}
+}
@@ -0,0 +1,50 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityGroupMatcher(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Value string
+		NV    string
+		OK    bool
+	}{
+		{Name: "ID", Value: "sg-0a1b2c3d", NV: "sg-0a1b2c3d", OK: true},
+		{Name: "WithOwner", Value: "123456789012/sg-0a1b2c3d", NV: "sg-0a1b2c3d", OK: true},
+		{Name: "OtherType", Value: "subnet-0a1b2c3d", OK: false},
+		{Name: "Name", Value: "default", OK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			nv, ok := securityGroupMatcher.Match(tt.Value)
+			assert.Equal(t, tt.OK, ok)
+			assert.Equal(t, tt.NV, nv)
+		})
+	}
+}
+
+func TestEC2ARNMatcher(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Value string
+		NV    string
+		OK    bool
+	}{
+		{Name: "ID", Value: "subnet-0a1b2c3d", NV: "subnet-0a1b2c3d", OK: true},
+		{Name: "ARN", Value: "arn:aws:ec2:eu-west-1:123456789012:subnet/subnet-0a1b2c3d", NV: "subnet-0a1b2c3d", OK: true},
+		{Name: "ARNMultipleDashes", Value: "arn:aws-cn:ec2:cn-north-1:123456789012:transit-gateway-attachment/tgw-attach-0a1b2c3d4e5f", NV: "tgw-attach-0a1b2c3d4e5f", OK: true},
+		{Name: "OtherService", Value: "arn:aws:iam::123456789012:role/subnet-0a1b2c3d", OK: false},
+		{Name: "Name", Value: "front", OK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			nv, ok := ec2ARNMatcher.Match(tt.Value)
+			assert.Equal(t, tt.OK, ok)
+			assert.Equal(t, tt.NV, nv)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	awsSDK "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/pkg/errors"
+)
+
+// configureLambdaPackage downloads the packages of the Lambda Functions
+// and Layer Versions to the lambdaPackagesDir, if it's defined, and sets
+// the 'filename' on the cfg so the HCL can be applied
+func (a *aws) configureLambdaPackage(r provider.Resource, cfg map[string]interface{}) error {
+	if a.lambdaPackagesDir == "" {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	var location, name string
+	switch r.Type() {
+	case LambdaFunction.String():
+		// The Functions deployed from an image
+		// do not have a package to download
+		if pt, ok := r.Data().GetOk("package_type"); ok && pt.(string) != lambda.PackageTypeZip {
+			return nil
+		}
+
+		code, err := a.awsr.GetLambdaFunctionCode(ctx, &lambda.GetFunctionInput{
+			FunctionName: awsSDK.String(r.ID()),
+		})
+		if err != nil {
+			return err
+		}
+		if code == nil || code.Location == nil {
+			return nil
+		}
+
+		location = *code.Location
+		name = r.ID()
+	case LambdaLayerVersion.String():
+		content, err := a.awsr.GetLambdaLayerVersionContent(ctx, &lambda.GetLayerVersionByArnInput{
+			Arn: awsSDK.String(r.ID()),
+		})
+		if err != nil {
+			return err
+		}
+		if content == nil || content.Location == nil {
+			return nil
+		}
+
+		// The ARN Resource has the format
+		// 'layer:NAME:VERSION'
+		parn, err := arn.Parse(r.ID())
+		if err != nil {
+			return err
+		}
+
+		location = *content.Location
+		name = strings.Join(strings.Split(parn.Resource, ":")[1:], "_")
+	default:
+		return nil
+	}
+
+	filename := filepath.Join(a.lambdaPackagesDir, fmt.Sprintf("%s.zip", name))
+	err := a.downloadPackage(ctx, location, filename)
+	if err != nil {
+		return err
+	}
+
+	cfg["filename"] = filename
+
+	return nil
+}
+
+// downloadPackage downloads the package from the location,
+// which is a presigned URL, and writes it to the filename
+func (a *aws) downloadPackage(ctx context.Context, location, filename string) error {
+	err := os.MkdirAll(filepath.Dir(filename), 0700)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not download the package %s", filename)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("could not download the package %s, status code %d", filename, res.StatusCode)
+	}
+
+	f, err := os.OpenFile(filename, os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, res.Body)
+	if err != nil {
+		return errors.Wrapf(err, "could not write the package %s", filename)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsSDK "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// creationEventPrefixes are the prefixes of the names of the
+// CloudTrail events that create resources
+var creationEventPrefixes = []string{"Create", "Run", "Allocate", "Register", "Put"}
+
+// ResourceOwner returns the owner of the r from the owner tags and,
+// if none and ownerCloudTrail is enabled, the identity that created
+// it from the CloudTrail events
+func (a *aws) ResourceOwner(ctx context.Context, r provider.Resource) (string, string, error) {
+	if o, s := provider.OwnerFromTags(a, r); o != "" {
+		return o, s, nil
+	}
+
+	if !a.ownerCloudTrail {
+		return "", "", nil
+	}
+
+	events, err := a.awsr.GetCloudTrailEvents(ctx, &cloudtrail.LookupEventsInput{
+		LookupAttributes: []*cloudtrail.LookupAttribute{
+			{
+				AttributeKey:   awsSDK.String(cloudtrail.LookupAttributeKeyResourceName),
+				AttributeValue: awsSDK.String(r.ID()),
+			},
+		},
+	})
+	if err != nil {
+		return "", "", errors.Wrapf(err, "could not lookup the CloudTrail events of %s", r.ID())
+	}
+
+	// The events are sorted from the newest to
+	// the oldest so the last creation is the one
+	var owner, source string
+	for _, e := range events {
+		name := awsSDK.StringValue(e.EventName)
+		if !isCreationEvent(name) || awsSDK.StringValue(e.Username) == "" {
+			continue
+		}
+		owner = awsSDK.StringValue(e.Username)
+		source = fmt.Sprintf("cloudtrail %s", name)
+	}
+
+	return owner, source, nil
+}
+
+func isCreationEvent(name string) bool {
+	for _, p := range creationEventPrefixes {
+		if strings.HasPrefix(name, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/pkg/errors"
+)
+
+// List of the parameters supported by AWS
+// on top of the provider.ParameterRegion
+const (
+	parameterAccountID = "account_id"
+	parameterVPCCIDR   = "vpc_cidr"
+)
+
+// Parameter returns the value of the parameter name. The vpc_cidr
+// is only supported if the region has only one non default VPC
+// as if not it's not possible to know which one to use
+func (a *aws) Parameter(ctx context.Context, name string) (string, error) {
+	switch name {
+	case parameterAccountID:
+		return a.awsr.GetAccountID(), nil
+	case parameterVPCCIDR:
+		vpcs, err := a.awsr.GetVpcs(ctx, nil)
+		if err != nil {
+			return "", err
+		}
+
+		var cidrs []string
+		for _, v := range vpcs {
+			if v.IsDefault != nil && *v.IsDefault {
+				continue
+			}
+			cidrs = append(cidrs, *v.CidrBlock)
+		}
+
+		if len(cidrs) != 1 {
+			return "", errors.Wrapf(errcode.ErrProviderParameterNoValue, "expected 1 non default VPC and found %d", len(cidrs))
+		}
+
+		return cidrs[0], nil
+	default:
+		return "", errcode.ErrProviderParameterNotSupported
+	}
+}
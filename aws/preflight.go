@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	awsSDK "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/pkg/errors"
+)
+
+// PreflightCheck is the result of checking if the
+// credentials can read a resource type
+type PreflightCheck struct {
+	ResourceType string
+
+	// Action is the first IAM action, of the ones
+	// needed to read the type, that is not allowed
+	Action string
+
+	// Err is the reason why the Action is not allowed
+	Err error
+}
+
+// Allowed returns if the resource type can be read
+func (p PreflightCheck) Allowed() bool { return p.Err == nil }
+
+// Preflight simulates with the IAM policy simulator (iam:SimulatePrincipalPolicy)
+// the policies of the credentials with the IAM actions needed to read each one of
+// the types, so the missing permissions can be reported before the import starts
+// without doing the Describe/List calls. The p has to be an AWS Provider,
+// with multiple regions only the default one is checked.
+// The SCPs and permission boundaries of the Organization are not simulated
+func Preflight(ctx context.Context, p provider.Provider, types []string, f *filter.Filter) ([]PreflightCheck, error) {
+	a, ok := defaultRegion(p)
+	if !ok {
+		return nil, errors.Errorf("the provider %q is not an AWS provider", p.String())
+	}
+
+	checks := make([]PreflightCheck, 0, len(types))
+	typesActions := make([][]string, 0, len(types))
+	actions := make(map[string]struct{})
+	for _, t := range types {
+		if f.IsExcluded(t) {
+			continue
+		}
+
+		rt, err := ResourceTypeString(t)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := resources[rt]; !ok {
+			return nil, errors.Errorf("the resource %q it's not implemented", t)
+		}
+
+		ras, ok := requiredActions[rt]
+		if !ok {
+			return nil, errors.Errorf("the required actions of the resource %q are not defined", t)
+		}
+
+		for _, ra := range ras {
+			actions[ra] = struct{}{}
+		}
+		checks = append(checks, PreflightCheck{ResourceType: t})
+		typesActions = append(typesActions, ras)
+	}
+
+	principal, isRoot, err := simulationPrincipal(a.awsr.GetCallerARN())
+	if err != nil {
+		return nil, err
+	}
+
+	// The root user is allowed to do everything
+	// and can not be simulated
+	if isRoot || len(actions) == 0 {
+		return checks, nil
+	}
+
+	// All the actions are simulated on the same call
+	names := make([]string, 0, len(actions))
+	for ra := range actions {
+		names = append(names, ra)
+	}
+
+	results, err := a.awsr.GetPrincipalPolicySimulation(ctx, &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: awsSDK.String(principal),
+		ActionNames:     awsSDK.StringSlice(names),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not simulate the policies of %s, the iam:SimulatePrincipalPolicy is required", principal)
+	}
+
+	decisions := make(map[string]string, len(results))
+	for _, r := range results {
+		decisions[awsSDK.StringValue(r.EvalActionName)] = awsSDK.StringValue(r.EvalDecision)
+	}
+
+	for i, ras := range typesActions {
+		for _, ra := range ras {
+			d := decisions[ra]
+			if d == iam.PolicyEvaluationDecisionTypeAllowed {
+				continue
+			}
+			if d == "" {
+				d = "not simulated"
+			}
+			checks[i].Action = ra
+			checks[i].Err = errors.Errorf("the action %s is %s for %s", ra, d, principal)
+			break
+		}
+	}
+
+	return checks, nil
+}
+
+// simulationPrincipal returns the ARN of the IAM principal which policies
+// are simulated for the callerARN, the assumed roles are simulated as the
+// role, which has to be on the root path. The isRoot is true if it's the
+// root user of the account
+func simulationPrincipal(callerARN string) (principal string, isRoot bool, err error) {
+	a, err := arn.Parse(callerARN)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "invalid caller ARN %q", callerARN)
+	}
+
+	switch {
+	case a.Service == "iam" && a.Resource == "root":
+		return callerARN, true, nil
+	case a.Service == "iam":
+		return callerARN, false, nil
+	case a.Service == "sts" && strings.HasPrefix(a.Resource, "assumed-role/"):
+		// The format is 'assumed-role/ROLE/SESSION'
+		rs := strings.Split(a.Resource, "/")
+		if len(rs) < 3 {
+			return "", false, errors.Errorf("invalid assumed role ARN %q", callerARN)
+		}
+		a.Service = "iam"
+		a.Resource = "role/" + rs[1]
+		return a.String(), false, nil
+	default:
+		return "", false, errors.Errorf("the policies of %q can not be simulated, only the IAM users and roles can", callerARN)
+	}
+}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awsSDK "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/aws/reader"
+	"github.com/cycloidio/terracognita/filter"
+)
+
+// simulationReader is a reader.Reader that
+// simulates the policies with the allowed actions
+type simulationReader struct {
+	reader.Reader
+	callerARN string
+	allowed   map[string]bool
+
+	principal string
+}
+
+func (r *simulationReader) GetCallerARN() string { return r.callerARN }
+
+func (r *simulationReader) GetPrincipalPolicySimulation(ctx context.Context, input *iam.SimulatePrincipalPolicyInput) ([]*iam.EvaluationResult, error) {
+	r.principal = awsSDK.StringValue(input.PolicySourceArn)
+	res := make([]*iam.EvaluationResult, 0, len(input.ActionNames))
+	for _, a := range input.ActionNames {
+		d := iam.PolicyEvaluationDecisionTypeImplicitDeny
+		if r.allowed[awsSDK.StringValue(a)] {
+			d = iam.PolicyEvaluationDecisionTypeAllowed
+		}
+		res = append(res, &iam.EvaluationResult{EvalActionName: a, EvalDecision: awsSDK.String(d)})
+	}
+	return res, nil
+}
+
+func TestPreflight(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		sr := &simulationReader{
+			callerARN: "arn:aws:sts::123456789012:assumed-role/ReadOnly/session",
+			allowed:   map[string]bool{"ec2:DescribeVpcs": true},
+		}
+		a := &aws{awsr: sr}
+
+		checks, err := Preflight(context.Background(), a, []string{"aws_vpc", "aws_vpn_gateway"}, &filter.Filter{})
+		require.NoError(t, err)
+
+		assert.Equal(t, "arn:aws:iam::123456789012:role/ReadOnly", sr.principal)
+		require.Len(t, checks, 2)
+		assert.True(t, checks[0].Allowed())
+		assert.False(t, checks[1].Allowed())
+		assert.Equal(t, "ec2:DescribeVpnGateways", checks[1].Action)
+	})
+	t.Run("SuccessRoot", func(t *testing.T) {
+		sr := &simulationReader{callerARN: "arn:aws:iam::123456789012:root"}
+		a := &aws{awsr: sr}
+
+		checks, err := Preflight(context.Background(), a, []string{"aws_vpc"}, &filter.Filter{})
+		require.NoError(t, err)
+
+		assert.Equal(t, "", sr.principal)
+		require.Len(t, checks, 1)
+		assert.True(t, checks[0].Allowed())
+	})
+	t.Run("ErrorFederatedUser", func(t *testing.T) {
+		a := &aws{awsr: &simulationReader{callerARN: "arn:aws:sts::123456789012:federated-user/bob"}}
+
+		_, err := Preflight(context.Background(), a, []string{"aws_vpc"}, &filter.Filter{})
+		assert.Error(t, err)
+	})
+}
@@ -3,7 +3,10 @@ package aws
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
 
+	awsSDK "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/cycloidio/terracognita/aws/reader"
 	"github.com/cycloidio/terracognita/cache"
@@ -28,6 +31,16 @@ var skippableCodes = map[string]struct{}{
 	"RequestError":          struct{}{},
 }
 
+// TFProviderVersion is the version of the AWS Terraform
+// Provider used to import the resources
+const TFProviderVersion = "4.9.0"
+
+// brokenResourceTypes are the resource types with known problems
+// when imported with the TFProviderVersion and the reason of it,
+// they are skipped unless forced. It has to be reviewed each
+// time the TFProviderVersion is updated
+var brokenResourceTypes = map[string]string{}
+
 type aws struct {
 	awsr reader.Reader
 
@@ -37,12 +50,122 @@ type aws struct {
 	configuration map[string]interface{}
 
 	cache cache.Cache
+
+	// httpClient is used for the requests
+	// done outside of the AWS SDK
+	httpClient *http.Client
+
+	// lambdaPackagesDir is the directory to download
+	// the packages of the Lambdas, if empty they
+	// are not downloaded
+	lambdaPackagesDir string
+
+	// ownerCloudTrail enables the lookup on CloudTrail
+	// of the identity that created the Resources
+	// without owner tags
+	ownerCloudTrail bool
+
+	// securityGroupRules is one of the SecurityGroupRules,
+	// the style to import the rules of the Security Groups
+	securityGroupRules string
+
+	// alias is the alias of the provider when it's one of the
+	// regions of a multiRegion that is not the default one,
+	// the global services are not read on them
+	alias string
+}
+
+// Options are the optional configuration of the AWS Provider,
+// the zero value is the default one
+type Options struct {
+	// Partition is optional as it's detected from the region
+	Partition string
+
+	// Endpoint is the custom endpoint used for all the services
+	Endpoint string
+
+	// Endpoints are the custom endpoints per
+	// service (ex: ec2 => http://localhost:4566)
+	Endpoints map[string]string
+
+	// Proxy (http, https or socks5) is used
+	// for all the requests if defined
+	Proxy string
+
+	// DisableIMDS avoids the lookups to the instance
+	// metadata when resolving the credentials
+	DisableIMDS bool
+
+	// CredentialsSource (CredentialsSourceIMDSv2 or CredentialsSourceECS),
+	// if defined, is where the credentials are read from instead of
+	// the accessKey, secretKey and sessionToken
+	CredentialsSource string
+
+	// LambdaPackagesDir, if defined, is where the packages of the
+	// Lambdas are downloaded so they can be referenced from the HCL
+	LambdaPackagesDir string
+
+	// OwnerCloudTrail enables the lookup on CloudTrail of the creator
+	// of the Resources without owner tags when reporting the owners
+	OwnerCloudTrail bool
+
+	// SecurityGroupRules is one of the SecurityGroupRules used to import
+	// the rules of the Security Groups, SecurityGroupRulesInline if empty
+	SecurityGroupRules string
 }
 
-// NewProvider returns an AWS Provider
-func NewProvider(ctx context.Context, accessKey, secretKey, region, sessionToken string) (provider.Provider, error) {
+// NewProvider returns an AWS Provider configured with the opts
+func NewProvider(ctx context.Context, accessKey, secretKey, region, sessionToken string, opts Options) (provider.Provider, error) {
+	if opts.SecurityGroupRules == "" {
+		opts.SecurityGroupRules = SecurityGroupRulesInline
+	}
+	if !isValidSecurityGroupRules(opts.SecurityGroupRules) {
+		return nil, errors.Errorf("invalid Security Group rules %q, the supported ones are: %s", opts.SecurityGroupRules, strings.Join(SecurityGroupRules, ", "))
+	}
+
+	if opts.CredentialsSource == CredentialsSourceIMDSv2 && opts.DisableIMDS {
+		return nil, errors.Errorf("the credentials source %q can not be used with the IMDS disabled", opts.CredentialsSource)
+	}
+
+	var awscfg *awsSDK.Config
+	if opts.Endpoint != "" || len(opts.Endpoints) != 0 || opts.Proxy != "" || opts.CredentialsSource != "" {
+		awscfg = &awsSDK.Config{
+			DisableSSL: awsSDK.Bool(false),
+			MaxRetries: awsSDK.Int(3),
+		}
+	}
+
+	if opts.Endpoint != "" || len(opts.Endpoints) != 0 {
+		awscfg.EndpointResolver = newEndpointResolver(opts.Endpoint, opts.Endpoints)
+		// The custom endpoints, like LocalStack, do not
+		// support the virtual hosted-style requests
+		awscfg.S3ForcePathStyle = awsSDK.Bool(true)
+	}
+
+	hc := http.DefaultClient
+	if opts.Proxy != "" {
+		var err error
+		hc, err = newProxyHTTPClient(opts.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		awscfg.HTTPClient = hc
+	}
+
+	if opts.CredentialsSource != "" {
+		creds, err := newSourceCredentials(opts.CredentialsSource, nil)
+		if err != nil {
+			return nil, err
+		}
+		awscfg.Credentials = creds
+
+		// The TF Client resolves them from the
+		// same source when no keys are given
+		accessKey, secretKey, sessionToken = "", "", ""
+	}
+
 	log.Get().Log("func", "reader.New", "msg", "configuring aws Reader")
-	awsr, err := reader.New(ctx, accessKey, secretKey, region, sessionToken, nil)
+	awsr, err := reader.New(ctx, accessKey, secretKey, region, sessionToken, opts.Partition, awscfg)
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize 'reader' because: %s", err)
 	}
@@ -54,6 +177,19 @@ func NewProvider(ctx context.Context, accessKey, secretKey, region, sessionToken
 		Token:     sessionToken,
 	}
 
+	if opts.Endpoint != "" || len(opts.Endpoints) != 0 {
+		cfg.Endpoints = tfEndpoints(opts.Endpoint, opts.Endpoints)
+		cfg.S3UsePathStyle = true
+	}
+
+	if opts.Proxy != "" {
+		cfg.HTTPProxy = opts.Proxy
+	}
+
+	if opts.DisableIMDS {
+		cfg.SkipMetadataApiCheck = true
+	}
+
 	log.Get().Log("func", "aws.NewProvider", "msg", "configuring TF Client")
 	awsClient, diags := cfg.Client(ctx)
 	if diags.HasError() {
@@ -75,9 +211,25 @@ func NewProvider(ctx context.Context, accessKey, secretKey, region, sessionToken
 		configuration: map[string]interface{}{
 			"region": region,
 		},
+		httpClient:        hc,
+		lambdaPackagesDir: opts.LambdaPackagesDir,
+		ownerCloudTrail:   opts.OwnerCloudTrail,
+
+		securityGroupRules: opts.SecurityGroupRules,
 	}, nil
 }
 
+// ConfigureResource completes the cfg of the r with the
+// values that can not be read from the TF Provider
+func (a *aws) ConfigureResource(r provider.Resource, cfg map[string]interface{}) error {
+	if r.Type() == SecurityGroup.String() {
+		a.configureSecurityGroup(r, cfg)
+		return nil
+	}
+
+	return a.configureLambdaPackage(r, cfg)
+}
+
 func (a *aws) ResourceTypes() []string {
 	return ResourceTypeStrings()
 }
@@ -125,4 +277,36 @@ func (a *aws) HasResourceType(t string) bool {
 	return err == nil
 }
 func (a *aws) Source() string                        { return "hashicorp/aws" }
+func (a *aws) Version() string                       { return TFProviderVersion }
 func (a *aws) Configuration() map[string]interface{} { return a.configuration }
+
+// APIStats returns the statistics of the calls done
+// to the AWS APIs to read the resources
+func (a *aws) APIStats() []provider.APIStats {
+	rstats := a.awsr.GetAPIStats()
+	stats := make([]provider.APIStats, 0, len(rstats))
+	for _, s := range rstats {
+		stats = append(stats, provider.APIStats{
+			Service:     s.Service,
+			Operation:   s.Operation,
+			Calls:       s.Calls,
+			Retries:     s.Retries,
+			Throttles:   s.Throttles,
+			Duration:    s.Duration,
+			MaxDuration: s.MaxDuration,
+		})
+	}
+
+	return stats
+}
+
+// BrokenTypes returns the resource types with known
+// problems with the TFProviderVersion
+func (a *aws) BrokenTypes() map[string]string { return brokenResourceTypes }
+
+// Alias returns the alias of the region when it's
+// not the default one of a multiRegion
+func (a *aws) Alias() string { return a.alias }
+
+// Aliases returns nil as only one region is imported
+func (a *aws) Aliases() map[string]map[string]interface{} { return nil }
@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// proxySchemes are the schemes supported
+// by the http.Transport for the proxies
+var proxySchemes = map[string]struct{}{
+	"http":   struct{}{},
+	"https":  struct{}{},
+	"socks5": struct{}{},
+}
+
+// newProxyHTTPClient returns an http.Client that
+// sends all the requests through the proxy
+func newProxyHTTPClient(proxy string) (*http.Client, error) {
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid proxy %q", proxy)
+	}
+
+	if _, ok := proxySchemes[u.Scheme]; !ok {
+		return nil, errors.Errorf("invalid proxy %q, the supported schemes are http, https and socks5", proxy)
+	}
+
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = http.ProxyURL(u)
+
+	return &http.Client{Transport: t}, nil
+}
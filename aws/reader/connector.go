@@ -7,12 +7,17 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
+	"github.com/aws/aws-sdk-go/service/appmesh/appmeshiface"
 	"github.com/aws/aws-sdk-go/service/athena/athenaiface"
 	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/batch/batchiface"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/configservice/configserviceiface"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice/databasemigrationserviceiface"
@@ -31,14 +36,18 @@ import (
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/emr/emriface"
+	"github.com/aws/aws-sdk-go/service/firehose/firehoseiface"
 	"github.com/aws/aws-sdk-go/service/fsx/fsxiface"
 	"github.com/aws/aws-sdk-go/service/glue/glueiface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kafka/kafkaiface"
 	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/lightsail/lightsailiface"
 	"github.com/aws/aws-sdk-go/service/mediastore/mediastoreiface"
+	"github.com/aws/aws-sdk-go/service/memorydb/memorydbiface"
 	"github.com/aws/aws-sdk-go/service/mq/mqiface"
+	"github.com/aws/aws-sdk-go/service/mwaa/mwaaiface"
 	"github.com/aws/aws-sdk-go/service/neptune/neptuneiface"
 	"github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/aws/aws-sdk-go/service/redshift/redshiftiface"
@@ -46,11 +55,20 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53resolver/route53resolveriface"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager/s3manageriface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface"
 	"github.com/aws/aws-sdk-go/service/ses/sesiface"
+	"github.com/aws/aws-sdk-go/service/shield/shieldiface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/aws/aws-sdk-go/service/ssoadmin/ssoadminiface"
 	"github.com/aws/aws-sdk-go/service/storagegateway/storagegatewayiface"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/aws/aws-sdk-go/service/synthetics/syntheticsiface"
+	"github.com/aws/aws-sdk-go/service/transfer/transferiface"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	"github.com/aws/aws-sdk-go/service/xray/xrayiface"
 )
 
 //go:generate go run ../cmd/ -output reader.go
@@ -61,16 +79,29 @@ import (
 //
 // While the region has to be a valid AWS region
 //
+// If the accessKey is empty and the config has Credentials they are used instead
+// of the static ones, like the ones of the EC2 Instance or ECS Task roles.
+//
+// The partition (aws, aws-cn, aws-us-gov) is detected from the region, if it's
+// given it'll be validated against the region. If the region is empty the
+// default region of the partition is used to do the initial calls.
+//
 // An error is returned if any of the needed AWS request for creating the reader returns an AWS error, in such case it
 // will have any of the common error codes (see below) or EmptyStaticCreds code or a go standard error in case that no
 // regions are matched with the ones available, at the time, in AWS.
 // See:
 //  * https://docs.aws.amazon.com/AWSEC2/latest/APIReference/errors-overview.html#CommonErrors
 //  * https://docs.aws.amazon.com/STS/latest/APIReference/CommonErrors.html
-func New(ctx context.Context, accessKey, secretKey, region, sessionToken string, config *aws.Config) (Reader, error) {
+func New(ctx context.Context, accessKey, secretKey, region, sessionToken, partition string, config *aws.Config) (Reader, error) {
 	var c = connector{}
 
-	creds, ec2s, sts, err := configureAWS(accessKey, secretKey, region, sessionToken)
+	p, err := resolvePartition(region, partition)
+	if err != nil {
+		return nil, err
+	}
+	c.partition = p.ID()
+
+	creds, ec2s, sts, err := configureAWS(accessKey, secretKey, region, sessionToken, p, config)
 	if err != nil {
 		return nil, err
 	}
@@ -96,25 +127,40 @@ func New(ctx context.Context, accessKey, secretKey, region, sessionToken string,
 // In order to start making calls, only calling New is required.
 type connector struct {
 	region    string
+	partition string
 	svc       *serviceConnector
 	creds     *credentials.Credentials
 	accountID *string
+	callerARN *string
+	stats     *apiStats
 }
 
 func (c *connector) GetAccountID() string {
 	return *c.accountID
 }
 
+func (c *connector) GetCallerARN() string {
+	return aws.StringValue(c.callerARN)
+}
+
 func (c *connector) GetRegion() string {
 	return c.region
 }
 
+func (c *connector) GetPartition() string {
+	return c.partition
+}
+
 type serviceConnector struct {
 	apigateway               apigatewayiface.APIGatewayAPI
+	apigatewayv2             apigatewayv2iface.ApiGatewayV2API
+	appmesh                  appmeshiface.AppMeshAPI
 	athena                   athenaiface.AthenaAPI
 	autoscaling              autoscalingiface.AutoScalingAPI
 	batch                    batchiface.BatchAPI
+	cloudformation           cloudformationiface.CloudFormationAPI
 	cloudfront               cloudfrontiface.CloudFrontAPI
+	cloudtrail               cloudtrailiface.CloudTrailAPI
 	cloudwatch               cloudwatchiface.CloudWatchAPI
 	configservice            configserviceiface.ConfigServiceAPI
 	databasemigrationservice databasemigrationserviceiface.DatabaseMigrationServiceAPI
@@ -132,14 +178,19 @@ type serviceConnector struct {
 	elb                      elbiface.ELBAPI
 	elbv2                    elbv2iface.ELBV2API
 	emr                      emriface.EMRAPI
+	firehose                 firehoseiface.FirehoseAPI
 	fsx                      fsxiface.FSxAPI
+	globalSession            *session.Session
 	glue                     glueiface.GlueAPI
 	iam                      iamiface.IAMAPI
+	kafka                    kafkaiface.KafkaAPI
 	kinesis                  kinesisiface.KinesisAPI
 	lambda                   lambdaiface.LambdaAPI
 	lightsail                lightsailiface.LightsailAPI
 	mediastore               mediastoreiface.MediaStoreAPI
+	memorydb                 memorydbiface.MemoryDBAPI
 	mq                       mqiface.MQAPI
+	mwaa                     mwaaiface.MWAAAPI
 	neptune                  neptuneiface.NeptuneAPI
 	rds                      rdsiface.RDSAPI
 	redshift                 redshiftiface.RedshiftAPI
@@ -148,48 +199,122 @@ type serviceConnector struct {
 	route53                  route53iface.Route53API
 	s3downloader             s3manageriface.DownloaderAPI
 	s3                       s3iface.S3API
+	secretsmanager           secretsmanageriface.SecretsManagerAPI
+	servicediscovery         servicediscoveryiface.ServiceDiscoveryAPI
 	ses                      sesiface.SESAPI
 	session                  *session.Session
+	shield                   shieldiface.ShieldAPI
 	sqs                      sqsiface.SQSAPI
+	ssm                      ssmiface.SSMAPI
+	ssoadmin                 ssoadminiface.SSOAdminAPI
 	storagegateway           storagegatewayiface.StorageGatewayAPI
+	synthetics               syntheticsiface.SyntheticsAPI
+	transfer                 transferiface.TransferAPI
+	wafv2                    wafv2iface.WAFV2API
+	wafv2CloudFront          wafv2iface.WAFV2API
+	xray                     xrayiface.XRayAPI
 }
 
-/* The default region is only used to (1) get the list of region and
+/* The default regions are only used to (1) get the list of region and
  * (2) get the account ID associated with the credentials.
  *
- * It is not used as a default region for services, therefore if no
+ * They are not used as a default region for services, therefore if no
  * region is specified when instantiating the connector, then it will
  * not try to establish any connections with AWS services.
  */
-const defaultRegion string = "eu-west-1"
+var defaultRegions = map[string]string{
+	endpoints.AwsPartitionID:      "eu-west-1",
+	endpoints.AwsCnPartitionID:    "cn-north-1",
+	endpoints.AwsUsGovPartitionID: "us-gov-west-1",
+}
+
+// globalRegions are the regions used for the global services,
+// like CloudFront, which only have to be queried once on them
+// independently of the region used for the rest of the services
+var globalRegions = map[string]string{
+	endpoints.AwsPartitionID:      "us-east-1",
+	endpoints.AwsCnPartitionID:    "cn-northwest-1",
+	endpoints.AwsUsGovPartitionID: "us-gov-west-1",
+}
+
+// resolvePartition returns the partition of the region, if the partition
+// is also given it validates that the region belongs to it.
+// If none of them is given the standard 'aws' partition is returned
+func resolvePartition(region, partition string) (endpoints.Partition, error) {
+	var (
+		p  endpoints.Partition
+		ok bool
+	)
+
+	if partition != "" {
+		for _, dp := range endpoints.DefaultPartitions() {
+			if dp.ID() == partition {
+				p = dp
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return p, fmt.Errorf("invalid partition %q", partition)
+		}
+
+		if region != "" {
+			if rp, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); !ok || rp.ID() != p.ID() {
+				return p, fmt.Errorf("the region %q does not belong to the partition %q", region, partition)
+			}
+		}
+
+		return p, nil
+	}
+
+	if region != "" {
+		p, ok = endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+		if ok {
+			return p, nil
+		}
+	}
+
+	return endpoints.AwsPartition(), nil
+}
 
 // configureAWS creates a new static credential with the passed accessKey and
-// secretKey and with it, a sessions which is used to create a EC2 client and
+// secretKey, or uses the Credentials of the config if the accessKey is empty,
+// and with it, a sessions which is used to create a EC2 client and
 // a Security Token Service client.
+// If the config has an EndpointResolver or an HTTPClient they'll be used for those clients.
 // The only AWS error code that this function return is
 // * EmptyStaticCreds
-func configureAWS(accessKey, secretKey, region, token string) (*credentials.Credentials, ec2iface.EC2API, stsiface.STSAPI, error) {
+// or the ones of the provider of the Credentials of the config
+func configureAWS(accessKey, secretKey, region, token string, p endpoints.Partition, config *aws.Config) (*credentials.Credentials, ec2iface.EC2API, stsiface.STSAPI, error) {
 	if region == "" {
-		region = defaultRegion
+		region = defaultRegions[p.ID()]
 	}
 
-	creds := credentials.NewStaticCredentials(accessKey, secretKey, token)
+	var creds *credentials.Credentials
+	if accessKey == "" && config != nil && config.Credentials != nil {
+		creds = config.Credentials
+	} else {
+		creds = credentials.NewStaticCredentials(accessKey, secretKey, token)
+	}
 	_, err := creds.Get()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	sess := session.Must(
-		session.NewSession(&aws.Config{
-			Region:      aws.String(region),
-			DisableSSL:  aws.Bool(false),
-			MaxRetries:  aws.Int(3),
-			Credentials: creds,
-		}),
-	)
+	cfg := &aws.Config{
+		Region:      aws.String(region),
+		DisableSSL:  aws.Bool(false),
+		MaxRetries:  aws.Int(3),
+		Credentials: creds,
+	}
+	if config != nil {
+		cfg.EndpointResolver = config.EndpointResolver
+		cfg.HTTPClient = config.HTTPClient
+	}
+	sess := session.Must(session.NewSession(cfg))
 	return creds, ec2.New(sess), sts.New(sess), nil
 }
 
-// setAccountID retrieves the caller ID from the Security Token Service and set
+// setAccountID retrieves the caller ID and ARN from the Security Token Service and set
 // it in the connector.
 // An AWS error can be returned with one of the common error codes.
 // See https://docs.aws.amazon.com/STS/latest/APIReference/CommonErrors.html
@@ -199,6 +324,7 @@ func (c *connector) setAccountID(ctx context.Context, sts stsiface.STSAPI) error
 		return err
 	}
 	c.accountID = resp.Account
+	c.callerARN = resp.Arn
 	return nil
 }
 
@@ -242,11 +368,21 @@ func (c *connector) setService(config *aws.Config) {
 		}
 	}
 
+	gsess := session.Must(session.NewSession(config.Copy(&aws.Config{
+		Region: aws.String(globalRegions[c.partition]),
+	})))
+
 	config.Region = aws.String(c.region)
 	sess := session.Must(session.NewSession(config))
+
+	c.stats = newAPIStats()
+	c.stats.register(sess)
+	c.stats.register(gsess)
+
 	svc := &serviceConnector{
-		region:  c.region,
-		session: sess,
+		region:        c.region,
+		session:       sess,
+		globalSession: gsess,
 	}
 	c.svc = svc
 }
@@ -0,0 +1,44 @@
+package reader
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+)
+
+// GetAPIGatewayMethods has a custom implementation as the Methods are not
+// listed by themselves but embedded on each Resource of the RestAPI, so all
+// the Resources have to be iterated to get the Methods of each one of them
+func (c *connector) GetAPIGatewayMethods(ctx context.Context, input *apigateway.GetResourcesInput) (map[string]*apigateway.Method, error) {
+	if c.svc.apigateway == nil {
+		c.svc.apigateway = apigateway.New(c.svc.session)
+	}
+
+	if input == nil {
+		input = &apigateway.GetResourcesInput{}
+	}
+	input.Embed = aws.StringSlice([]string{"methods"})
+
+	opt := make(map[string]*apigateway.Method)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.apigateway.GetResourcesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range o.Items {
+			for hm, m := range r.ResourceMethods {
+				opt[fmt.Sprintf("%s/%s", aws.StringValue(r.Id), hm)] = m
+			}
+		}
+
+		input.Position = o.Position
+		hasNextToken = o.Position != nil
+	}
+
+	return opt, nil
+}
@@ -0,0 +1,40 @@
+package reader
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/firehose"
+)
+
+// GetKinesisFirehoseDeliveryStreams has a custom implementation as the
+// ListDeliveryStreams has no pagination token, the next page starts
+// after the last name returned while HasMoreDeliveryStreams is true
+func (c *connector) GetKinesisFirehoseDeliveryStreams(ctx context.Context, input *firehose.ListDeliveryStreamsInput) ([]*string, error) {
+	if c.svc.firehose == nil {
+		c.svc.firehose = firehose.New(c.svc.session)
+	}
+
+	if input == nil {
+		input = &firehose.ListDeliveryStreamsInput{}
+	}
+
+	opt := make([]*string, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.firehose.ListDeliveryStreamsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		opt = append(opt, o.DeliveryStreamNames...)
+
+		hasNextToken = aws.BoolValue(o.HasMoreDeliveryStreams) && len(o.DeliveryStreamNames) != 0
+		if hasNextToken {
+			input.ExclusiveStartDeliveryStreamName = o.DeliveryStreamNames[len(o.DeliveryStreamNames)-1]
+		}
+	}
+
+	return opt, nil
+}
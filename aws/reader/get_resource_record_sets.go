@@ -0,0 +1,38 @@
+package reader
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// GetResourceRecordSets has a custom implementation as the pagination of the
+// ListResourceRecordSets is done with the name, type and identifier of the next
+// record and not with a token, so all of them have to be set on the next input
+func (c *connector) GetResourceRecordSets(ctx context.Context, input *route53.ListResourceRecordSetsInput) ([]*route53.ResourceRecordSet, error) {
+	if c.svc.route53 == nil {
+		c.svc.route53 = route53.New(c.svc.globalSession)
+	}
+
+	opt := make([]*route53.ResourceRecordSet, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.route53.ListResourceRecordSetsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		opt = append(opt, o.ResourceRecordSets...)
+
+		if input == nil {
+			input = &route53.ListResourceRecordSetsInput{}
+		}
+		input.StartRecordName = o.NextRecordName
+		input.StartRecordType = o.NextRecordType
+		input.StartRecordIdentifier = o.NextRecordIdentifier
+		hasNextToken = o.IsTruncated != nil && *o.IsTruncated
+	}
+
+	return opt, nil
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
@@ -25,23 +29,36 @@ import (
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/firehose"
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lightsail"
 	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/memorydb"
 	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/mwaa"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/xray"
 )
 
 // Code generated by github.com/cycloidio/terracognita/aws/cmd; DO NOT EDIT
@@ -67,13 +84,30 @@ type Reader interface {
 	// GetAccountID returns the current ID for the account used
 	GetAccountID() string
 
+	// GetCallerARN returns the ARN of the identity
+	// of the credentials used
+	GetCallerARN() string
+
 	// GetRegion returns the currently used region for the Connector
 	GetRegion() string
 
+	// GetPartition returns the partition of the region
+	// used (aws, aws-cn, aws-us-gov)
+	GetPartition() string
+
+	// GetAPIStats returns the statistics of the calls done
+	// to each operation of the AWS APIs, to diagnose the throttling
+	GetAPIStats() []APIStats
+
 	// GetAPIGatewayDeployments returns the Deployment Functions on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetAPIGatewayDeployments(ctx context.Context, input *apigateway.GetDeploymentsInput) ([]*apigateway.Deployment, error)
 
+	// GetAPIGatewayMethods returns the Methods of the Resources on the given input
+	// indexed by RESOURCE-ID/HTTP-METHOD
+	// Returned values are commented in the interface doc comment block.
+	GetAPIGatewayMethods(ctx context.Context, input *apigateway.GetResourcesInput) (map[string]*apigateway.Method, error)
+
 	// GetAPIGatewayResources returns the Resource Functions on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetAPIGatewayResources(ctx context.Context, input *apigateway.GetResourcesInput) ([]*apigateway.Resource, error)
@@ -86,10 +120,38 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetAPIGatewayStages(ctx context.Context, input *apigateway.GetStagesInput) ([]*apigateway.Stage, error)
 
+	// GetAPIGatewayV2APIs returns the Apis on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetAPIGatewayV2APIs(ctx context.Context, input *apigatewayv2.GetApisInput) ([]*apigatewayv2.Api, error)
+
+	// GetAPIGatewayV2Routes returns the Routes on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetAPIGatewayV2Routes(ctx context.Context, input *apigatewayv2.GetRoutesInput) ([]*apigatewayv2.Route, error)
+
+	// GetAPIGatewayV2Stages returns the Stages on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetAPIGatewayV2Stages(ctx context.Context, input *apigatewayv2.GetStagesInput) ([]*apigatewayv2.Stage, error)
+
 	// GetAthenaDataCatalogs returns the Athena worker groups on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetAthenaWorkGroups(ctx context.Context, input *athena.ListWorkGroupsInput) ([]*athena.WorkGroupSummary, error)
 
+	// GetAppMeshMeshes returns the App Mesh Meshes on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetAppMeshMeshes(ctx context.Context, input *appmesh.ListMeshesInput) ([]*appmesh.MeshRef, error)
+
+	// GetAppMeshVirtualNodes returns the App Mesh Virtual Nodes on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetAppMeshVirtualNodes(ctx context.Context, input *appmesh.ListVirtualNodesInput) ([]*appmesh.VirtualNodeRef, error)
+
+	// GetAppMeshVirtualRouters returns the App Mesh Virtual Routers on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetAppMeshVirtualRouters(ctx context.Context, input *appmesh.ListVirtualRoutersInput) ([]*appmesh.VirtualRouterRef, error)
+
+	// GetAppMeshVirtualServices returns the App Mesh Virtual Services on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetAppMeshVirtualServices(ctx context.Context, input *appmesh.ListVirtualServicesInput) ([]*appmesh.VirtualServiceRef, error)
+
 	// GetAutoScalingGroups returns all AutoScalingGroup belonging to the Account ID based on the input given.
 	// Returned values are commented in the interface doc comment block.
 	GetAutoScalingGroups(ctx context.Context, input *autoscaling.DescribeAutoScalingGroupsInput) ([]*autoscaling.Group, error)
@@ -110,6 +172,10 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetBatchJobDefinitions(ctx context.Context, input *batch.DescribeJobDefinitionsInput) ([]*batch.JobDefinition, error)
 
+	// GetCloudFormationStackResources returns the Resources of the Stack on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetCloudFormationStackResources(ctx context.Context, input *cloudformation.ListStackResourcesInput) ([]*cloudformation.StackResourceSummary, error)
+
 	// GetCloudFrontDistributions returns all the CloudFront Distributions on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetCloudFrontDistributions(ctx context.Context, input *cloudfront.ListDistributionsInput) ([]*cloudfront.DistributionSummary, error)
@@ -122,6 +188,18 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetCloudFrontPublicKeys(ctx context.Context, input *cloudfront.ListPublicKeysInput) ([]*cloudfront.PublicKeySummary, error)
 
+	// GetCloudFrontCachePolicies returns all the CloudFront Cache Policies on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetCloudFrontCachePolicies(ctx context.Context, input *cloudfront.ListCachePoliciesInput) ([]*cloudfront.CachePolicySummary, error)
+
+	// GetCloudFrontFunctions returns all the CloudFront Functions on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetCloudFrontFunctions(ctx context.Context, input *cloudfront.ListFunctionsInput) ([]*cloudfront.FunctionSummary, error)
+
+	// GetCloudTrailEvents returns the CloudTrail Events on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetCloudTrailEvents(ctx context.Context, input *cloudtrail.LookupEventsInput) ([]*cloudtrail.Event, error)
+
 	// GetMetricAlarms returns all cloudwatch alarms based on the input given.
 	// Returned values are commented in the interface doc comment block.
 	GetMetricAlarms(ctx context.Context, input *cloudwatch.DescribeAlarmsInput) ([]*cloudwatch.MetricAlarm, error)
@@ -133,6 +211,11 @@ type Reader interface {
 	// supported resources, etc.
 	GetRecordedResourceCounts(ctx context.Context, input *configservice.GetDiscoveredResourceCountsInput) ([]*configservice.ResourceCount, error)
 
+	// GetConfigAggregateResources returns the JSON of the AWS Config items of
+	// the Configuration Aggregator that match the Expression on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetConfigAggregateResources(ctx context.Context, input *configservice.SelectAggregateResourceConfigInput) ([]*string, error)
+
 	// GetDAXClusters returns the DAX clusters on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetDAXClusters(ctx context.Context, input *dax.DescribeClustersInput) ([]*dax.Cluster, error)
@@ -265,6 +348,18 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetTransitGatewayRouteTablePropagations(ctx context.Context, input *ec2.GetTransitGatewayRouteTablePropagationsInput) ([]*ec2.TransitGatewayRouteTablePropagation, error)
 
+	// GetCapacityReservations returns the ec2 Capacity Reservations on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetCapacityReservations(ctx context.Context, input *ec2.DescribeCapacityReservationsInput) ([]*ec2.CapacityReservation, error)
+
+	// GetFleets returns the ec2 Fleets on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetFleets(ctx context.Context, input *ec2.DescribeFleetsInput) ([]*ec2.FleetData, error)
+
+	// GetSpotFleetRequests returns the ec2 Spot Fleet Requests on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetSpotFleetRequests(ctx context.Context, input *ec2.DescribeSpotFleetRequestsInput) ([]*ec2.SpotFleetRequestConfig, error)
+
 	// GetECSClustersArns returns the ecs clusters arns on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetECSClustersArns(ctx context.Context, input *ecs.ListClustersInput) ([]*string, error)
@@ -297,10 +392,18 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetElastiCacheClusters(ctx context.Context, input *elasticache.DescribeCacheClustersInput) ([]*elasticache.CacheCluster, error)
 
+	// GetElastiCacheParameterGroups returns the Elasticache Parameter Groups on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetElastiCacheParameterGroups(ctx context.Context, input *elasticache.DescribeCacheParameterGroupsInput) ([]*elasticache.CacheParameterGroup, error)
+
 	// GetElastiCacheReplicationGroups returns the EKS Replication groups on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetElastiCacheReplicationGroups(ctx context.Context, input *elasticache.DescribeReplicationGroupsInput) ([]*elasticache.ReplicationGroup, error)
 
+	// GetElastiCacheSubnetGroups returns the Elasticache Subnet Groups on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetElastiCacheSubnetGroups(ctx context.Context, input *elasticache.DescribeCacheSubnetGroupsInput) ([]*elasticache.CacheSubnetGroup, error)
+
 	// GetElastiCacheTags returns a list of tags of Elasticache resources based on its ARN.
 	// Returned values are commented in the interface doc comment block.
 	GetElastiCacheTags(ctx context.Context, input *elasticache.ListTagsForResourceInput) ([]*elasticache.Tag, error)
@@ -369,6 +472,10 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetEMRClusters(ctx context.Context, input *emr.ListClustersInput) ([]*emr.ClusterSummary, error)
 
+	// GetKinesisFirehoseDeliveryStreams returns the Kinesis Firehose Delivery Stream names on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetKinesisFirehoseDeliveryStreams(ctx context.Context, input *firehose.ListDeliveryStreamsInput) ([]*string, error)
+
 	// GetFSXFileSystems returns the fsx filesystems arns on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetFSXFileSystems(ctx context.Context, input *fsx.DescribeFileSystemsInput) ([]*fsx.FileSystem, error)
@@ -433,6 +540,11 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetPolicies(ctx context.Context, input *iam.ListPoliciesInput) ([]*iam.Policy, error)
 
+	// GetPrincipalPolicySimulation returns the IAM EvaluationResults of simulating the
+	// policies of the principal with the actions on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetPrincipalPolicySimulation(ctx context.Context, input *iam.SimulatePrincipalPolicyInput) ([]*iam.EvaluationResult, error)
+
 	// GetRolePolicies returns the IAM RolePolicies on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetRolePolicies(ctx context.Context, input *iam.ListRolePoliciesInput) ([]*string, error)
@@ -461,6 +573,14 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetUsers(ctx context.Context, input *iam.ListUsersInput) ([]*iam.User, error)
 
+	// GetMSKClusters returns the MSK Clusters on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetMSKClusters(ctx context.Context, input *kafka.ListClustersInput) ([]*kafka.ClusterInfo, error)
+
+	// GetMSKConfigurations returns the MSK Configurations on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetMSKConfigurations(ctx context.Context, input *kafka.ListConfigurationsInput) ([]*kafka.Configuration, error)
+
 	// GetKinesisStreams returns the Kinesis Streams on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetKinesisStreams(ctx context.Context, input *kinesis.ListStreamsInput) ([]*string, error)
@@ -469,6 +589,34 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetLambdaFunctions(ctx context.Context, input *lambda.ListFunctionsInput) ([]*lambda.FunctionConfiguration, error)
 
+	// GetLambdaEventSourceMappings returns the lambda EventSourceMappings on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetLambdaEventSourceMappings(ctx context.Context, input *lambda.ListEventSourceMappingsInput) ([]*lambda.EventSourceMappingConfiguration, error)
+
+	// GetLambdaFunctionCode returns the lambda Function Code location on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetLambdaFunctionCode(ctx context.Context, input *lambda.GetFunctionInput) (*lambda.FunctionCodeLocation, error)
+
+	// GetLambdaFunctionURLConfigs returns the lambda FunctionUrlConfigs on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetLambdaFunctionURLConfigs(ctx context.Context, input *lambda.ListFunctionUrlConfigsInput) ([]*lambda.FunctionUrlConfig, error)
+
+	// GetLambdaLayers returns the lambda Layers on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetLambdaLayers(ctx context.Context, input *lambda.ListLayersInput) ([]*lambda.LayersListItem, error)
+
+	// GetLambdaLayerVersions returns the lambda LayerVersions on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetLambdaLayerVersions(ctx context.Context, input *lambda.ListLayerVersionsInput) ([]*lambda.LayerVersionsListItem, error)
+
+	// GetLambdaLayerVersionContent returns the lambda LayerVersion Content location on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetLambdaLayerVersionContent(ctx context.Context, input *lambda.GetLayerVersionByArnInput) (*lambda.LayerVersionContentOutput, error)
+
+	// GetLambdaPolicy returns the lambda Function Policy on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetLambdaPolicy(ctx context.Context, input *lambda.GetPolicyInput) (*string, error)
+
 	// GetLightsailInstances returns the Lightsail Instances on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetLightsailInstances(ctx context.Context, input *lightsail.GetInstancesInput) ([]*lightsail.Instance, error)
@@ -477,10 +625,22 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetMediastoreContainers(ctx context.Context, input *mediastore.ListContainersInput) ([]*mediastore.Container, error)
 
+	// GetMemoryDBClusters returns the MemoryDB Clusters on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetMemoryDBClusters(ctx context.Context, input *memorydb.DescribeClustersInput) ([]*memorydb.Cluster, error)
+
 	// GetMQBrokers returns the MQ Brokers on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetMQBrokers(ctx context.Context, input *mq.ListBrokersInput) ([]*mq.BrokerSummary, error)
 
+	// GetMQConfigurations returns the MQ Configurations on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetMQConfigurations(ctx context.Context, input *mq.ListConfigurationsInput) ([]*mq.Configuration, error)
+
+	// GetMWAAEnvironments returns the names of the MWAA Environments on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetMWAAEnvironments(ctx context.Context, input *mwaa.ListEnvironmentsInput) ([]*string, error)
+
 	// GetNeptuneDBClusters returns the Neptune DBClusters on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetNeptuneDBClusters(ctx context.Context, input *neptune.DescribeDBClustersInput) ([]*neptune.DBCluster, error)
@@ -525,6 +685,10 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetHostedZones(ctx context.Context, input *route53.ListHostedZonesInput) ([]*route53.HostedZone, error)
 
+	// GetHostedZoneVPCs returns the VPCs associated to the Route53 HostedZone on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetHostedZoneVPCs(ctx context.Context, input *route53.GetHostedZoneInput) ([]*route53.VPC, error)
+
 	// GetResourceRecordSets returns the Route53 ResourceRecordSets on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetResourceRecordSets(ctx context.Context, input *route53.ListResourceRecordSetsInput) ([]*route53.ResourceRecordSet, error)
@@ -566,6 +730,18 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetObjectsTags(ctx context.Context, input *s3.GetObjectTaggingInput) ([]*s3.Tag, error)
 
+	// GetSecretsManagerSecrets returns the SecretsManager Secrets on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetSecretsManagerSecrets(ctx context.Context, input *secretsmanager.ListSecretsInput) ([]*secretsmanager.SecretListEntry, error)
+
+	// GetServiceDiscoveryNamespaces returns the Service Discovery Namespaces on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetServiceDiscoveryNamespaces(ctx context.Context, input *servicediscovery.ListNamespacesInput) ([]*servicediscovery.NamespaceSummary, error)
+
+	// GetServiceDiscoveryServices returns the Service Discovery Services on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetServiceDiscoveryServices(ctx context.Context, input *servicediscovery.ListServicesInput) ([]*servicediscovery.ServiceSummary, error)
+
 	// GetActiveReceiptRuleSet returns the SES ActiveReceiptRuleSet on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetActiveReceiptRuleSet(ctx context.Context, input *ses.DescribeActiveReceiptRuleSetInput) (*string, error)
@@ -594,13 +770,69 @@ type Reader interface {
 	// Returned values are commented in the interface doc comment block.
 	GetTemplates(ctx context.Context, input *ses.ListTemplatesInput) ([]*ses.TemplateMetadata, error)
 
+	// GetShieldProtections returns the Shield Protections on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetShieldProtections(ctx context.Context, input *shield.ListProtectionsInput) ([]*shield.Protection, error)
+
 	// GetSQSQueues returns the SQS Queues on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetSQSQueues(ctx context.Context, input *sqs.ListQueuesInput) ([]*string, error)
 
+	// GetSSMParameters returns the SSM Parameters on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetSSMParameters(ctx context.Context, input *ssm.DescribeParametersInput) ([]*ssm.ParameterMetadata, error)
+
+	// GetSSOAdminInstances returns the SSO Admin Instances on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetSSOAdminInstances(ctx context.Context, input *ssoadmin.ListInstancesInput) ([]*ssoadmin.InstanceMetadata, error)
+
+	// GetSSOAdminPermissionSets returns the SSO Admin Permission Sets on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetSSOAdminPermissionSets(ctx context.Context, input *ssoadmin.ListPermissionSetsInput) ([]*string, error)
+
+	// GetSSOAdminManagedPoliciesInPermissionSet returns the SSO Admin Managed Policies In Permission Set on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetSSOAdminManagedPoliciesInPermissionSet(ctx context.Context, input *ssoadmin.ListManagedPoliciesInPermissionSetInput) ([]*ssoadmin.AttachedManagedPolicy, error)
+
+	// GetSSOAdminAccountAssignments returns the SSO Admin Account Assignments on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetSSOAdminAccountAssignments(ctx context.Context, input *ssoadmin.ListAccountAssignmentsInput) ([]*ssoadmin.AccountAssignment, error)
+
 	// GetStorageGatewayGateways returns the StorageGateway Gateways on the given input
 	// Returned values are commented in the interface doc comment block.
 	GetStorageGatewayGateways(ctx context.Context, input *storagegateway.ListGatewaysInput) ([]*storagegateway.GatewayInfo, error)
+
+	// GetSyntheticsCanaries returns the Synthetics Canaries on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetSyntheticsCanaries(ctx context.Context, input *synthetics.DescribeCanariesInput) ([]*synthetics.Canary, error)
+
+	// GetTransferServers returns the Transfer Servers on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetTransferServers(ctx context.Context, input *transfer.ListServersInput) ([]*transfer.ListedServer, error)
+
+	// GetTransferUsers returns the Transfer Users on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetTransferUsers(ctx context.Context, input *transfer.ListUsersInput) ([]*transfer.ListedUser, error)
+
+	// GetWAFV2IPSets returns the WAFV2 IPSets of the Scope on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetWAFV2IPSets(ctx context.Context, input *wafv2.ListIPSetsInput) ([]*wafv2.IPSetSummary, error)
+
+	// GetWAFV2RuleGroups returns the WAFV2 RuleGroups of the Scope on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetWAFV2RuleGroups(ctx context.Context, input *wafv2.ListRuleGroupsInput) ([]*wafv2.RuleGroupSummary, error)
+
+	// GetWAFV2WebACLs returns the WAFV2 WebACLs of the Scope on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetWAFV2WebACLs(ctx context.Context, input *wafv2.ListWebACLsInput) ([]*wafv2.WebACLSummary, error)
+
+	// GetXRayGroups returns the XRay Groups on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetXRayGroups(ctx context.Context, input *xray.GetGroupsInput) ([]*xray.GroupSummary, error)
+
+	// GetXRaySamplingRules returns the XRay SamplingRuleRecords on the given input
+	// Returned values are commented in the interface doc comment block.
+	GetXRaySamplingRules(ctx context.Context, input *xray.GetSamplingRulesInput) ([]*xray.SamplingRuleRecord, error)
 }
 
 func (c *connector) GetAPIGatewayDeployments(ctx context.Context, input *apigateway.GetDeploymentsInput) ([]*apigateway.Deployment, error) {
@@ -723,3825 +955,5173 @@ func (c *connector) GetAPIGatewayStages(ctx context.Context, input *apigateway.G
 	return opt, nil
 }
 
-func (c *connector) GetAthenaWorkGroups(ctx context.Context, input *athena.ListWorkGroupsInput) ([]*athena.WorkGroupSummary, error) {
-	if c.svc.athena == nil {
-		c.svc.athena = athena.New(c.svc.session)
+func (c *connector) GetAPIGatewayV2APIs(ctx context.Context, input *apigatewayv2.GetApisInput) ([]*apigatewayv2.Api, error) {
+	if c.svc.apigatewayv2 == nil {
+		c.svc.apigatewayv2 = apigatewayv2.New(c.svc.session)
 	}
 
-	opt := make([]*athena.WorkGroupSummary, 0)
+	opt := make([]*apigatewayv2.Api, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.athena.ListWorkGroupsWithContext(ctx, input)
+		o, err := c.svc.apigatewayv2.GetApisWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.WorkGroups == nil {
+		if o.Items == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &athena.ListWorkGroupsInput{}
+			input = &apigatewayv2.GetApisInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.WorkGroups...)
+		opt = append(opt, o.Items...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetAutoScalingGroups(ctx context.Context, input *autoscaling.DescribeAutoScalingGroupsInput) ([]*autoscaling.Group, error) {
-	if c.svc.autoscaling == nil {
-		c.svc.autoscaling = autoscaling.New(c.svc.session)
+func (c *connector) GetAPIGatewayV2Routes(ctx context.Context, input *apigatewayv2.GetRoutesInput) ([]*apigatewayv2.Route, error) {
+	if c.svc.apigatewayv2 == nil {
+		c.svc.apigatewayv2 = apigatewayv2.New(c.svc.session)
 	}
 
-	opt := make([]*autoscaling.Group, 0)
+	opt := make([]*apigatewayv2.Route, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.autoscaling.DescribeAutoScalingGroupsWithContext(ctx, input)
+		o, err := c.svc.apigatewayv2.GetRoutesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.AutoScalingGroups == nil {
+		if o.Items == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &autoscaling.DescribeAutoScalingGroupsInput{}
+			input = &apigatewayv2.GetRoutesInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.AutoScalingGroups...)
+		opt = append(opt, o.Items...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLaunchConfigurations(ctx context.Context, input *autoscaling.DescribeLaunchConfigurationsInput) ([]*autoscaling.LaunchConfiguration, error) {
-	if c.svc.autoscaling == nil {
-		c.svc.autoscaling = autoscaling.New(c.svc.session)
+func (c *connector) GetAPIGatewayV2Stages(ctx context.Context, input *apigatewayv2.GetStagesInput) ([]*apigatewayv2.Stage, error) {
+	if c.svc.apigatewayv2 == nil {
+		c.svc.apigatewayv2 = apigatewayv2.New(c.svc.session)
 	}
 
-	opt := make([]*autoscaling.LaunchConfiguration, 0)
+	opt := make([]*apigatewayv2.Stage, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.autoscaling.DescribeLaunchConfigurationsWithContext(ctx, input)
+		o, err := c.svc.apigatewayv2.GetStagesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.LaunchConfigurations == nil {
+		if o.Items == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &autoscaling.DescribeLaunchConfigurationsInput{}
+			input = &apigatewayv2.GetStagesInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.LaunchConfigurations...)
+		opt = append(opt, o.Items...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetAutoScalingPolicies(ctx context.Context, input *autoscaling.DescribePoliciesInput) ([]*autoscaling.ScalingPolicy, error) {
-	if c.svc.autoscaling == nil {
-		c.svc.autoscaling = autoscaling.New(c.svc.session)
+func (c *connector) GetAthenaWorkGroups(ctx context.Context, input *athena.ListWorkGroupsInput) ([]*athena.WorkGroupSummary, error) {
+	if c.svc.athena == nil {
+		c.svc.athena = athena.New(c.svc.session)
 	}
 
-	opt := make([]*autoscaling.ScalingPolicy, 0)
+	opt := make([]*athena.WorkGroupSummary, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.autoscaling.DescribePoliciesWithContext(ctx, input)
+		o, err := c.svc.athena.ListWorkGroupsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ScalingPolicies == nil {
+		if o.WorkGroups == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &autoscaling.DescribePoliciesInput{}
+			input = &athena.ListWorkGroupsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.ScalingPolicies...)
+		opt = append(opt, o.WorkGroups...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetAutoScalingScheduledActions(ctx context.Context, input *autoscaling.DescribeScheduledActionsInput) ([]*autoscaling.ScheduledUpdateGroupAction, error) {
-	if c.svc.autoscaling == nil {
-		c.svc.autoscaling = autoscaling.New(c.svc.session)
+func (c *connector) GetAppMeshMeshes(ctx context.Context, input *appmesh.ListMeshesInput) ([]*appmesh.MeshRef, error) {
+	if c.svc.appmesh == nil {
+		c.svc.appmesh = appmesh.New(c.svc.session)
 	}
 
-	opt := make([]*autoscaling.ScheduledUpdateGroupAction, 0)
+	opt := make([]*appmesh.MeshRef, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.autoscaling.DescribeScheduledActionsWithContext(ctx, input)
+		o, err := c.svc.appmesh.ListMeshesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ScheduledUpdateGroupActions == nil {
+		if o.Meshes == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &autoscaling.DescribeScheduledActionsInput{}
+			input = &appmesh.ListMeshesInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.ScheduledUpdateGroupActions...)
+		opt = append(opt, o.Meshes...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetBatchJobDefinitions(ctx context.Context, input *batch.DescribeJobDefinitionsInput) ([]*batch.JobDefinition, error) {
-	if c.svc.batch == nil {
-		c.svc.batch = batch.New(c.svc.session)
+func (c *connector) GetAppMeshVirtualNodes(ctx context.Context, input *appmesh.ListVirtualNodesInput) ([]*appmesh.VirtualNodeRef, error) {
+	if c.svc.appmesh == nil {
+		c.svc.appmesh = appmesh.New(c.svc.session)
 	}
 
-	opt := make([]*batch.JobDefinition, 0)
+	opt := make([]*appmesh.VirtualNodeRef, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.batch.DescribeJobDefinitionsWithContext(ctx, input)
+		o, err := c.svc.appmesh.ListVirtualNodesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.JobDefinitions == nil {
+		if o.VirtualNodes == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &batch.DescribeJobDefinitionsInput{}
+			input = &appmesh.ListVirtualNodesInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.JobDefinitions...)
+		opt = append(opt, o.VirtualNodes...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetCloudFrontDistributions(ctx context.Context, input *cloudfront.ListDistributionsInput) ([]*cloudfront.DistributionSummary, error) {
-	if c.svc.cloudfront == nil {
-		c.svc.cloudfront = cloudfront.New(c.svc.session)
+func (c *connector) GetAppMeshVirtualRouters(ctx context.Context, input *appmesh.ListVirtualRoutersInput) ([]*appmesh.VirtualRouterRef, error) {
+	if c.svc.appmesh == nil {
+		c.svc.appmesh = appmesh.New(c.svc.session)
 	}
 
-	opt := make([]*cloudfront.DistributionSummary, 0)
+	opt := make([]*appmesh.VirtualRouterRef, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.cloudfront.ListDistributionsWithContext(ctx, input)
+		o, err := c.svc.appmesh.ListVirtualRoutersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.DistributionList == nil {
+		if o.VirtualRouters == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &cloudfront.ListDistributionsInput{}
+			input = &appmesh.ListVirtualRoutersInput{}
 		}
-		input.Marker = o.DistributionList.NextMarker
-		hasNextToken = o.DistributionList.NextMarker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.DistributionList.Items...)
+		opt = append(opt, o.VirtualRouters...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetCloudFrontOriginAccessIdentities(ctx context.Context, input *cloudfront.ListCloudFrontOriginAccessIdentitiesInput) ([]*cloudfront.OriginAccessIdentitySummary, error) {
-	if c.svc.cloudfront == nil {
-		c.svc.cloudfront = cloudfront.New(c.svc.session)
+func (c *connector) GetAppMeshVirtualServices(ctx context.Context, input *appmesh.ListVirtualServicesInput) ([]*appmesh.VirtualServiceRef, error) {
+	if c.svc.appmesh == nil {
+		c.svc.appmesh = appmesh.New(c.svc.session)
 	}
 
-	opt := make([]*cloudfront.OriginAccessIdentitySummary, 0)
+	opt := make([]*appmesh.VirtualServiceRef, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.cloudfront.ListCloudFrontOriginAccessIdentitiesWithContext(ctx, input)
+		o, err := c.svc.appmesh.ListVirtualServicesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.CloudFrontOriginAccessIdentityList == nil {
+		if o.VirtualServices == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &cloudfront.ListCloudFrontOriginAccessIdentitiesInput{}
+			input = &appmesh.ListVirtualServicesInput{}
 		}
-		input.Marker = o.CloudFrontOriginAccessIdentityList.NextMarker
-		hasNextToken = o.CloudFrontOriginAccessIdentityList.NextMarker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.CloudFrontOriginAccessIdentityList.Items...)
+		opt = append(opt, o.VirtualServices...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetCloudFrontPublicKeys(ctx context.Context, input *cloudfront.ListPublicKeysInput) ([]*cloudfront.PublicKeySummary, error) {
-	if c.svc.cloudfront == nil {
-		c.svc.cloudfront = cloudfront.New(c.svc.session)
+func (c *connector) GetAutoScalingGroups(ctx context.Context, input *autoscaling.DescribeAutoScalingGroupsInput) ([]*autoscaling.Group, error) {
+	if c.svc.autoscaling == nil {
+		c.svc.autoscaling = autoscaling.New(c.svc.session)
 	}
 
-	opt := make([]*cloudfront.PublicKeySummary, 0)
+	opt := make([]*autoscaling.Group, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.cloudfront.ListPublicKeysWithContext(ctx, input)
+		o, err := c.svc.autoscaling.DescribeAutoScalingGroupsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.PublicKeyList == nil {
+		if o.AutoScalingGroups == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &cloudfront.ListPublicKeysInput{}
+			input = &autoscaling.DescribeAutoScalingGroupsInput{}
 		}
-		input.Marker = o.PublicKeyList.NextMarker
-		hasNextToken = o.PublicKeyList.NextMarker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.PublicKeyList.Items...)
+		opt = append(opt, o.AutoScalingGroups...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetMetricAlarms(ctx context.Context, input *cloudwatch.DescribeAlarmsInput) ([]*cloudwatch.MetricAlarm, error) {
-	if c.svc.cloudwatch == nil {
-		c.svc.cloudwatch = cloudwatch.New(c.svc.session)
+func (c *connector) GetLaunchConfigurations(ctx context.Context, input *autoscaling.DescribeLaunchConfigurationsInput) ([]*autoscaling.LaunchConfiguration, error) {
+	if c.svc.autoscaling == nil {
+		c.svc.autoscaling = autoscaling.New(c.svc.session)
 	}
 
-	opt := make([]*cloudwatch.MetricAlarm, 0)
+	opt := make([]*autoscaling.LaunchConfiguration, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.cloudwatch.DescribeAlarmsWithContext(ctx, input)
+		o, err := c.svc.autoscaling.DescribeLaunchConfigurationsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.MetricAlarms == nil {
+		if o.LaunchConfigurations == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &cloudwatch.DescribeAlarmsInput{}
+			input = &autoscaling.DescribeLaunchConfigurationsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.MetricAlarms...)
+		opt = append(opt, o.LaunchConfigurations...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetRecordedResourceCounts(ctx context.Context, input *configservice.GetDiscoveredResourceCountsInput) ([]*configservice.ResourceCount, error) {
-	if c.svc.configservice == nil {
-		c.svc.configservice = configservice.New(c.svc.session)
+func (c *connector) GetAutoScalingPolicies(ctx context.Context, input *autoscaling.DescribePoliciesInput) ([]*autoscaling.ScalingPolicy, error) {
+	if c.svc.autoscaling == nil {
+		c.svc.autoscaling = autoscaling.New(c.svc.session)
 	}
 
-	opt := make([]*configservice.ResourceCount, 0)
+	opt := make([]*autoscaling.ScalingPolicy, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.configservice.GetDiscoveredResourceCountsWithContext(ctx, input)
+		o, err := c.svc.autoscaling.DescribePoliciesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ResourceCounts == nil {
+		if o.ScalingPolicies == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &configservice.GetDiscoveredResourceCountsInput{}
+			input = &autoscaling.DescribePoliciesInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.ResourceCounts...)
+		opt = append(opt, o.ScalingPolicies...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetDAXClusters(ctx context.Context, input *dax.DescribeClustersInput) ([]*dax.Cluster, error) {
-	if c.svc.dax == nil {
-		c.svc.dax = dax.New(c.svc.session)
+func (c *connector) GetAutoScalingScheduledActions(ctx context.Context, input *autoscaling.DescribeScheduledActionsInput) ([]*autoscaling.ScheduledUpdateGroupAction, error) {
+	if c.svc.autoscaling == nil {
+		c.svc.autoscaling = autoscaling.New(c.svc.session)
 	}
 
-	opt := make([]*dax.Cluster, 0)
+	opt := make([]*autoscaling.ScheduledUpdateGroupAction, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.dax.DescribeClustersWithContext(ctx, input)
+		o, err := c.svc.autoscaling.DescribeScheduledActionsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Clusters == nil {
+		if o.ScheduledUpdateGroupActions == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &dax.DescribeClustersInput{}
+			input = &autoscaling.DescribeScheduledActionsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Clusters...)
+		opt = append(opt, o.ScheduledUpdateGroupActions...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetDirectConnectGateways(ctx context.Context, input *directconnect.DescribeDirectConnectGatewaysInput) ([]*directconnect.Gateway, error) {
-	if c.svc.directconnect == nil {
-		c.svc.directconnect = directconnect.New(c.svc.session)
+func (c *connector) GetBatchJobDefinitions(ctx context.Context, input *batch.DescribeJobDefinitionsInput) ([]*batch.JobDefinition, error) {
+	if c.svc.batch == nil {
+		c.svc.batch = batch.New(c.svc.session)
 	}
 
-	opt := make([]*directconnect.Gateway, 0)
+	opt := make([]*batch.JobDefinition, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.directconnect.DescribeDirectConnectGatewaysWithContext(ctx, input)
+		o, err := c.svc.batch.DescribeJobDefinitionsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.DirectConnectGateways == nil {
+		if o.JobDefinitions == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &directconnect.DescribeDirectConnectGatewaysInput{}
+			input = &batch.DescribeJobDefinitionsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.DirectConnectGateways...)
+		opt = append(opt, o.JobDefinitions...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetDirectoryServiceDirectories(ctx context.Context, input *directoryservice.DescribeDirectoriesInput) ([]*directoryservice.DirectoryDescription, error) {
-	if c.svc.directoryservice == nil {
-		c.svc.directoryservice = directoryservice.New(c.svc.session)
+func (c *connector) GetCloudFormationStackResources(ctx context.Context, input *cloudformation.ListStackResourcesInput) ([]*cloudformation.StackResourceSummary, error) {
+	if c.svc.cloudformation == nil {
+		c.svc.cloudformation = cloudformation.New(c.svc.session)
 	}
 
-	opt := make([]*directoryservice.DirectoryDescription, 0)
+	opt := make([]*cloudformation.StackResourceSummary, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.directoryservice.DescribeDirectoriesWithContext(ctx, input)
+		o, err := c.svc.cloudformation.ListStackResourcesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.DirectoryDescriptions == nil {
+		if o.StackResourceSummaries == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &directoryservice.DescribeDirectoriesInput{}
+			input = &cloudformation.ListStackResourcesInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.DirectoryDescriptions...)
+		opt = append(opt, o.StackResourceSummaries...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetDMSDescribeReplicationInstances(ctx context.Context, input *databasemigrationservice.DescribeReplicationInstancesInput) ([]*databasemigrationservice.ReplicationInstance, error) {
-	if c.svc.databasemigrationservice == nil {
-		c.svc.databasemigrationservice = databasemigrationservice.New(c.svc.session)
+func (c *connector) GetCloudFrontDistributions(ctx context.Context, input *cloudfront.ListDistributionsInput) ([]*cloudfront.DistributionSummary, error) {
+	if c.svc.cloudfront == nil {
+		c.svc.cloudfront = cloudfront.New(c.svc.globalSession)
 	}
 
-	opt := make([]*databasemigrationservice.ReplicationInstance, 0)
+	opt := make([]*cloudfront.DistributionSummary, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.databasemigrationservice.DescribeReplicationInstancesWithContext(ctx, input)
+		o, err := c.svc.cloudfront.ListDistributionsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ReplicationInstances == nil {
+		if o.DistributionList == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &databasemigrationservice.DescribeReplicationInstancesInput{}
+			input = &cloudfront.ListDistributionsInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.Marker = o.DistributionList.NextMarker
+		hasNextToken = o.DistributionList.NextMarker != nil
 
-		opt = append(opt, o.ReplicationInstances...)
+		opt = append(opt, o.DistributionList.Items...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetDynamodbGlobalTables(ctx context.Context, input *dynamodb.ListGlobalTablesInput) ([]*dynamodb.GlobalTable, error) {
-	if c.svc.dynamodb == nil {
-		c.svc.dynamodb = dynamodb.New(c.svc.session)
+func (c *connector) GetCloudFrontOriginAccessIdentities(ctx context.Context, input *cloudfront.ListCloudFrontOriginAccessIdentitiesInput) ([]*cloudfront.OriginAccessIdentitySummary, error) {
+	if c.svc.cloudfront == nil {
+		c.svc.cloudfront = cloudfront.New(c.svc.globalSession)
 	}
 
-	opt := make([]*dynamodb.GlobalTable, 0)
+	opt := make([]*cloudfront.OriginAccessIdentitySummary, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.dynamodb.ListGlobalTablesWithContext(ctx, input)
+		o, err := c.svc.cloudfront.ListCloudFrontOriginAccessIdentitiesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.GlobalTables == nil {
+		if o.CloudFrontOriginAccessIdentityList == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &dynamodb.ListGlobalTablesInput{}
+			input = &cloudfront.ListCloudFrontOriginAccessIdentitiesInput{}
 		}
-		input.ExclusiveStartGlobalTableName = o.LastEvaluatedGlobalTableName
-		hasNextToken = o.LastEvaluatedGlobalTableName != nil
+		input.Marker = o.CloudFrontOriginAccessIdentityList.NextMarker
+		hasNextToken = o.CloudFrontOriginAccessIdentityList.NextMarker != nil
 
-		opt = append(opt, o.GlobalTables...)
+		opt = append(opt, o.CloudFrontOriginAccessIdentityList.Items...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetDynamodbTables(ctx context.Context, input *dynamodb.ListTablesInput) ([]*string, error) {
-	if c.svc.dynamodb == nil {
-		c.svc.dynamodb = dynamodb.New(c.svc.session)
+func (c *connector) GetCloudFrontPublicKeys(ctx context.Context, input *cloudfront.ListPublicKeysInput) ([]*cloudfront.PublicKeySummary, error) {
+	if c.svc.cloudfront == nil {
+		c.svc.cloudfront = cloudfront.New(c.svc.globalSession)
 	}
 
-	opt := make([]*string, 0)
+	opt := make([]*cloudfront.PublicKeySummary, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.dynamodb.ListTablesWithContext(ctx, input)
+		o, err := c.svc.cloudfront.ListPublicKeysWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TableNames == nil {
+		if o.PublicKeyList == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &dynamodb.ListTablesInput{}
+			input = &cloudfront.ListPublicKeysInput{}
 		}
-		input.ExclusiveStartTableName = o.LastEvaluatedTableName
-		hasNextToken = o.LastEvaluatedTableName != nil
+		input.Marker = o.PublicKeyList.NextMarker
+		hasNextToken = o.PublicKeyList.NextMarker != nil
 
-		opt = append(opt, o.TableNames...)
+		opt = append(opt, o.PublicKeyList.Items...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetAddresses(ctx context.Context, input *ec2.DescribeAddressesInput) ([]*ec2.Address, error) {
-	if c.svc.ec2 == nil {
-		c.svc.ec2 = ec2.New(c.svc.session)
+func (c *connector) GetCloudFrontCachePolicies(ctx context.Context, input *cloudfront.ListCachePoliciesInput) ([]*cloudfront.CachePolicySummary, error) {
+	if c.svc.cloudfront == nil {
+		c.svc.cloudfront = cloudfront.New(c.svc.globalSession)
 	}
 
-	opt := make([]*ec2.Address, 0)
+	opt := make([]*cloudfront.CachePolicySummary, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeAddressesWithContext(ctx, input)
+		o, err := c.svc.cloudfront.ListCachePoliciesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Addresses == nil {
+		if o.CachePolicyList == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &cloudfront.ListCachePoliciesInput{}
+		}
+		input.Marker = o.CachePolicyList.NextMarker
+		hasNextToken = o.CachePolicyList.NextMarker != nil
 
-		opt = append(opt, o.Addresses...)
+		opt = append(opt, o.CachePolicyList.Items...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetImages(ctx context.Context, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
-	if c.svc.ec2 == nil {
-		c.svc.ec2 = ec2.New(c.svc.session)
+func (c *connector) GetCloudFrontFunctions(ctx context.Context, input *cloudfront.ListFunctionsInput) ([]*cloudfront.FunctionSummary, error) {
+	if c.svc.cloudfront == nil {
+		c.svc.cloudfront = cloudfront.New(c.svc.globalSession)
 	}
 
-	opt := make([]*ec2.Image, 0)
+	opt := make([]*cloudfront.FunctionSummary, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeImagesWithContext(ctx, input)
+		o, err := c.svc.cloudfront.ListFunctionsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Images == nil {
+		if o.FunctionList == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &cloudfront.ListFunctionsInput{}
+		}
+		input.Marker = o.FunctionList.NextMarker
+		hasNextToken = o.FunctionList.NextMarker != nil
 
-		opt = append(opt, o.Images...)
+		opt = append(opt, o.FunctionList.Items...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetOwnImages(ctx context.Context, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
-
-	if input == nil {
-		input = &ec2.DescribeImagesInput{}
-	}
-	input.Owners = append(input.Owners, c.accountID)
-	if c.svc.ec2 == nil {
-		c.svc.ec2 = ec2.New(c.svc.session)
+func (c *connector) GetCloudTrailEvents(ctx context.Context, input *cloudtrail.LookupEventsInput) ([]*cloudtrail.Event, error) {
+	if c.svc.cloudtrail == nil {
+		c.svc.cloudtrail = cloudtrail.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.Image, 0)
+	opt := make([]*cloudtrail.Event, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeImagesWithContext(ctx, input)
+		o, err := c.svc.cloudtrail.LookupEventsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Images == nil {
+		if o.Events == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &cloudtrail.LookupEventsInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Images...)
+		opt = append(opt, o.Events...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetInstances(ctx context.Context, input *ec2.DescribeInstancesInput) ([]*ec2.Instance, error) {
-	if c.svc.ec2 == nil {
-		c.svc.ec2 = ec2.New(c.svc.session)
+func (c *connector) GetMetricAlarms(ctx context.Context, input *cloudwatch.DescribeAlarmsInput) ([]*cloudwatch.MetricAlarm, error) {
+	if c.svc.cloudwatch == nil {
+		c.svc.cloudwatch = cloudwatch.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.Instance, 0)
+	opt := make([]*cloudwatch.MetricAlarm, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeInstancesWithContext(ctx, input)
+		o, err := c.svc.cloudwatch.DescribeAlarmsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Reservations == nil {
+		if o.MetricAlarms == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeInstancesInput{}
+			input = &cloudwatch.DescribeAlarmsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		for _, v := range o.Reservations {
-			opt = append(opt, v.Instances...)
-		}
+		opt = append(opt, o.MetricAlarms...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetEC2InternetGateways(ctx context.Context, input *ec2.DescribeInternetGatewaysInput) ([]*ec2.InternetGateway, error) {
-	if c.svc.ec2 == nil {
-		c.svc.ec2 = ec2.New(c.svc.session)
+func (c *connector) GetRecordedResourceCounts(ctx context.Context, input *configservice.GetDiscoveredResourceCountsInput) ([]*configservice.ResourceCount, error) {
+	if c.svc.configservice == nil {
+		c.svc.configservice = configservice.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.InternetGateway, 0)
+	opt := make([]*configservice.ResourceCount, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeInternetGatewaysWithContext(ctx, input)
+		o, err := c.svc.configservice.GetDiscoveredResourceCountsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.InternetGateways == nil {
+		if o.ResourceCounts == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeInternetGatewaysInput{}
+			input = &configservice.GetDiscoveredResourceCountsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.InternetGateways...)
+		opt = append(opt, o.ResourceCounts...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetKeyPairs(ctx context.Context, input *ec2.DescribeKeyPairsInput) ([]*ec2.KeyPairInfo, error) {
-	if c.svc.ec2 == nil {
-		c.svc.ec2 = ec2.New(c.svc.session)
+func (c *connector) GetConfigAggregateResources(ctx context.Context, input *configservice.SelectAggregateResourceConfigInput) ([]*string, error) {
+	if c.svc.configservice == nil {
+		c.svc.configservice = configservice.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.KeyPairInfo, 0)
+	opt := make([]*string, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeKeyPairsWithContext(ctx, input)
+		o, err := c.svc.configservice.SelectAggregateResourceConfigWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.KeyPairs == nil {
+		if o.Results == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &configservice.SelectAggregateResourceConfigInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.KeyPairs...)
+		opt = append(opt, o.Results...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLaunchTemplates(ctx context.Context, input *ec2.DescribeLaunchTemplatesInput) ([]*ec2.LaunchTemplate, error) {
-	if c.svc.ec2 == nil {
-		c.svc.ec2 = ec2.New(c.svc.session)
+func (c *connector) GetDAXClusters(ctx context.Context, input *dax.DescribeClustersInput) ([]*dax.Cluster, error) {
+	if c.svc.dax == nil {
+		c.svc.dax = dax.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.LaunchTemplate, 0)
+	opt := make([]*dax.Cluster, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeLaunchTemplatesWithContext(ctx, input)
+		o, err := c.svc.dax.DescribeClustersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.LaunchTemplates == nil {
+		if o.Clusters == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeLaunchTemplatesInput{}
+			input = &dax.DescribeClustersInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.LaunchTemplates...)
+		opt = append(opt, o.Clusters...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetEC2NatGateways(ctx context.Context, input *ec2.DescribeNatGatewaysInput) ([]*ec2.NatGateway, error) {
-	if c.svc.ec2 == nil {
-		c.svc.ec2 = ec2.New(c.svc.session)
+func (c *connector) GetDirectConnectGateways(ctx context.Context, input *directconnect.DescribeDirectConnectGatewaysInput) ([]*directconnect.Gateway, error) {
+	if c.svc.directconnect == nil {
+		c.svc.directconnect = directconnect.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.NatGateway, 0)
+	opt := make([]*directconnect.Gateway, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeNatGatewaysWithContext(ctx, input)
+		o, err := c.svc.directconnect.DescribeDirectConnectGatewaysWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.NatGateways == nil {
+		if o.DirectConnectGateways == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeNatGatewaysInput{}
+			input = &directconnect.DescribeDirectConnectGatewaysInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.NatGateways...)
+		opt = append(opt, o.DirectConnectGateways...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetSecurityGroups(ctx context.Context, input *ec2.DescribeSecurityGroupsInput) ([]*ec2.SecurityGroup, error) {
-	if c.svc.ec2 == nil {
-		c.svc.ec2 = ec2.New(c.svc.session)
+func (c *connector) GetDirectoryServiceDirectories(ctx context.Context, input *directoryservice.DescribeDirectoriesInput) ([]*directoryservice.DirectoryDescription, error) {
+	if c.svc.directoryservice == nil {
+		c.svc.directoryservice = directoryservice.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.SecurityGroup, 0)
+	opt := make([]*directoryservice.DirectoryDescription, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeSecurityGroupsWithContext(ctx, input)
+		o, err := c.svc.directoryservice.DescribeDirectoriesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.SecurityGroups == nil {
+		if o.DirectoryDescriptions == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeSecurityGroupsInput{}
+			input = &directoryservice.DescribeDirectoriesInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.SecurityGroups...)
+		opt = append(opt, o.DirectoryDescriptions...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetSnapshots(ctx context.Context, input *ec2.DescribeSnapshotsInput) ([]*ec2.Snapshot, error) {
-	if c.svc.ec2 == nil {
-		c.svc.ec2 = ec2.New(c.svc.session)
+func (c *connector) GetDMSDescribeReplicationInstances(ctx context.Context, input *databasemigrationservice.DescribeReplicationInstancesInput) ([]*databasemigrationservice.ReplicationInstance, error) {
+	if c.svc.databasemigrationservice == nil {
+		c.svc.databasemigrationservice = databasemigrationservice.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.Snapshot, 0)
+	opt := make([]*databasemigrationservice.ReplicationInstance, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeSnapshotsWithContext(ctx, input)
+		o, err := c.svc.databasemigrationservice.DescribeReplicationInstancesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Snapshots == nil {
+		if o.ReplicationInstances == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeSnapshotsInput{}
+			input = &databasemigrationservice.DescribeReplicationInstancesInput{}
 		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.Snapshots...)
+		opt = append(opt, o.ReplicationInstances...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetOwnSnapshots(ctx context.Context, input *ec2.DescribeSnapshotsInput) ([]*ec2.Snapshot, error) {
-
-	if input == nil {
-		input = &ec2.DescribeSnapshotsInput{}
-	}
-	input.OwnerIds = append(input.OwnerIds, c.accountID)
-	if c.svc.ec2 == nil {
-		c.svc.ec2 = ec2.New(c.svc.session)
+func (c *connector) GetDynamodbGlobalTables(ctx context.Context, input *dynamodb.ListGlobalTablesInput) ([]*dynamodb.GlobalTable, error) {
+	if c.svc.dynamodb == nil {
+		c.svc.dynamodb = dynamodb.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.Snapshot, 0)
+	opt := make([]*dynamodb.GlobalTable, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeSnapshotsWithContext(ctx, input)
+		o, err := c.svc.dynamodb.ListGlobalTablesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Snapshots == nil {
+		if o.GlobalTables == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeSnapshotsInput{}
+			input = &dynamodb.ListGlobalTablesInput{}
 		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		input.ExclusiveStartGlobalTableName = o.LastEvaluatedGlobalTableName
+		hasNextToken = o.LastEvaluatedGlobalTableName != nil
 
-		opt = append(opt, o.Snapshots...)
+		opt = append(opt, o.GlobalTables...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetSubnets(ctx context.Context, input *ec2.DescribeSubnetsInput) ([]*ec2.Subnet, error) {
-	if c.svc.ec2 == nil {
-		c.svc.ec2 = ec2.New(c.svc.session)
+func (c *connector) GetDynamodbTables(ctx context.Context, input *dynamodb.ListTablesInput) ([]*string, error) {
+	if c.svc.dynamodb == nil {
+		c.svc.dynamodb = dynamodb.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.Subnet, 0)
+	opt := make([]*string, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeSubnetsWithContext(ctx, input)
+		o, err := c.svc.dynamodb.ListTablesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Subnets == nil {
+		if o.TableNames == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeSubnetsInput{}
+			input = &dynamodb.ListTablesInput{}
 		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		input.ExclusiveStartTableName = o.LastEvaluatedTableName
+		hasNextToken = o.LastEvaluatedTableName != nil
 
-		opt = append(opt, o.Subnets...)
+		opt = append(opt, o.TableNames...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetVolumes(ctx context.Context, input *ec2.DescribeVolumesInput) ([]*ec2.Volume, error) {
+func (c *connector) GetAddresses(ctx context.Context, input *ec2.DescribeAddressesInput) ([]*ec2.Address, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.Volume, 0)
+	opt := make([]*ec2.Address, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeVolumesWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeAddressesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Volumes == nil {
+		if o.Addresses == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &ec2.DescribeVolumesInput{}
-		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		hasNextToken = false
 
-		opt = append(opt, o.Volumes...)
+		opt = append(opt, o.Addresses...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetVpcEndpoints(ctx context.Context, input *ec2.DescribeVpcEndpointsInput) ([]*ec2.VpcEndpoint, error) {
+func (c *connector) GetImages(ctx context.Context, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.VpcEndpoint, 0)
+	opt := make([]*ec2.Image, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeVpcEndpointsWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeImagesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.VpcEndpoints == nil {
+		if o.Images == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &ec2.DescribeVpcEndpointsInput{}
-		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		hasNextToken = false
 
-		opt = append(opt, o.VpcEndpoints...)
+		opt = append(opt, o.Images...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetVpcs(ctx context.Context, input *ec2.DescribeVpcsInput) ([]*ec2.Vpc, error) {
+func (c *connector) GetOwnImages(ctx context.Context, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
+
+	if input == nil {
+		input = &ec2.DescribeImagesInput{}
+	}
+	input.Owners = append(input.Owners, c.accountID)
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.Vpc, 0)
+	opt := make([]*ec2.Image, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeVpcsWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeImagesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Vpcs == nil {
+		if o.Images == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &ec2.DescribeVpcsInput{}
-		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		hasNextToken = false
 
-		opt = append(opt, o.Vpcs...)
+		opt = append(opt, o.Images...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetVpcPeeringConnections(ctx context.Context, input *ec2.DescribeVpcPeeringConnectionsInput) ([]*ec2.VpcPeeringConnection, error) {
+func (c *connector) GetInstances(ctx context.Context, input *ec2.DescribeInstancesInput) ([]*ec2.Instance, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.VpcPeeringConnection, 0)
+	opt := make([]*ec2.Instance, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeVpcPeeringConnectionsWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeInstancesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.VpcPeeringConnections == nil {
+		if o.Reservations == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeVpcPeeringConnectionsInput{}
+			input = &ec2.DescribeInstancesInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.VpcPeeringConnections...)
+		for _, v := range o.Reservations {
+			opt = append(opt, v.Instances...)
+		}
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetVPNGateways(ctx context.Context, input *ec2.DescribeVpnGatewaysInput) ([]*ec2.VpnGateway, error) {
+func (c *connector) GetEC2InternetGateways(ctx context.Context, input *ec2.DescribeInternetGatewaysInput) ([]*ec2.InternetGateway, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.VpnGateway, 0)
+	opt := make([]*ec2.InternetGateway, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeVpnGatewaysWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeInternetGatewaysWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.VpnGateways == nil {
+		if o.InternetGateways == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &ec2.DescribeInternetGatewaysInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.VpnGateways...)
+		opt = append(opt, o.InternetGateways...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetRouteTables(ctx context.Context, input *ec2.DescribeRouteTablesInput) ([]*ec2.RouteTable, error) {
+func (c *connector) GetKeyPairs(ctx context.Context, input *ec2.DescribeKeyPairsInput) ([]*ec2.KeyPairInfo, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.RouteTable, 0)
+	opt := make([]*ec2.KeyPairInfo, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeRouteTablesWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeKeyPairsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.RouteTables == nil {
+		if o.KeyPairs == nil {
 			hasNextToken = false
 			continue
 		}
 
 		hasNextToken = false
 
-		opt = append(opt, o.RouteTables...)
+		opt = append(opt, o.KeyPairs...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetTransitGateways(ctx context.Context, input *ec2.DescribeTransitGatewaysInput) ([]*ec2.TransitGateway, error) {
+func (c *connector) GetLaunchTemplates(ctx context.Context, input *ec2.DescribeLaunchTemplatesInput) ([]*ec2.LaunchTemplate, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.TransitGateway, 0)
+	opt := make([]*ec2.LaunchTemplate, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeTransitGatewaysWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeLaunchTemplatesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TransitGateways == nil {
+		if o.LaunchTemplates == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeTransitGatewaysInput{}
+			input = &ec2.DescribeLaunchTemplatesInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.TransitGateways...)
+		opt = append(opt, o.LaunchTemplates...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetTransitGatewayVpcAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayVpcAttachmentsInput) ([]*ec2.TransitGatewayVpcAttachment, error) {
+func (c *connector) GetEC2NatGateways(ctx context.Context, input *ec2.DescribeNatGatewaysInput) ([]*ec2.NatGateway, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.TransitGatewayVpcAttachment, 0)
+	opt := make([]*ec2.NatGateway, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeTransitGatewayVpcAttachmentsWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeNatGatewaysWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TransitGatewayVpcAttachments == nil {
+		if o.NatGateways == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeTransitGatewayVpcAttachmentsInput{}
+			input = &ec2.DescribeNatGatewaysInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.TransitGatewayVpcAttachments...)
+		opt = append(opt, o.NatGateways...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetTransitGatewayRouteTables(ctx context.Context, input *ec2.DescribeTransitGatewayRouteTablesInput) ([]*ec2.TransitGatewayRouteTable, error) {
+func (c *connector) GetSecurityGroups(ctx context.Context, input *ec2.DescribeSecurityGroupsInput) ([]*ec2.SecurityGroup, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.TransitGatewayRouteTable, 0)
+	opt := make([]*ec2.SecurityGroup, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeTransitGatewayRouteTablesWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeSecurityGroupsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TransitGatewayRouteTables == nil {
+		if o.SecurityGroups == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeTransitGatewayRouteTablesInput{}
+			input = &ec2.DescribeSecurityGroupsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.TransitGatewayRouteTables...)
+		opt = append(opt, o.SecurityGroups...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetTransitGatewayMulticast(ctx context.Context, input *ec2.DescribeTransitGatewayMulticastDomainsInput) ([]*ec2.TransitGatewayMulticastDomain, error) {
+func (c *connector) GetSnapshots(ctx context.Context, input *ec2.DescribeSnapshotsInput) ([]*ec2.Snapshot, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.TransitGatewayMulticastDomain, 0)
+	opt := make([]*ec2.Snapshot, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeTransitGatewayMulticastDomainsWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeSnapshotsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TransitGatewayMulticastDomains == nil {
+		if o.Snapshots == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeTransitGatewayMulticastDomainsInput{}
+			input = &ec2.DescribeSnapshotsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.TransitGatewayMulticastDomains...)
+		opt = append(opt, o.Snapshots...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetTransitGatewayPeeringAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput) ([]*ec2.TransitGatewayPeeringAttachment, error) {
+func (c *connector) GetOwnSnapshots(ctx context.Context, input *ec2.DescribeSnapshotsInput) ([]*ec2.Snapshot, error) {
+
+	if input == nil {
+		input = &ec2.DescribeSnapshotsInput{}
+	}
+	input.OwnerIds = append(input.OwnerIds, c.accountID)
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.TransitGatewayPeeringAttachment, 0)
+	opt := make([]*ec2.Snapshot, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.DescribeTransitGatewayPeeringAttachmentsWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeSnapshotsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TransitGatewayPeeringAttachments == nil {
+		if o.Snapshots == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.DescribeTransitGatewayPeeringAttachmentsInput{}
+			input = &ec2.DescribeSnapshotsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.TransitGatewayPeeringAttachments...)
+		opt = append(opt, o.Snapshots...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetTransitGatewayPrefixListReference(ctx context.Context, input *ec2.GetTransitGatewayPrefixListReferencesInput) ([]*ec2.TransitGatewayPrefixListReference, error) {
+func (c *connector) GetSubnets(ctx context.Context, input *ec2.DescribeSubnetsInput) ([]*ec2.Subnet, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.TransitGatewayPrefixListReference, 0)
+	opt := make([]*ec2.Subnet, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.GetTransitGatewayPrefixListReferencesWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeSubnetsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TransitGatewayPrefixListReferences == nil {
+		if o.Subnets == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.GetTransitGatewayPrefixListReferencesInput{}
+			input = &ec2.DescribeSubnetsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.TransitGatewayPrefixListReferences...)
+		opt = append(opt, o.Subnets...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetTransitGatewayRoutes(ctx context.Context, input *ec2.SearchTransitGatewayRoutesInput) ([]*ec2.TransitGatewayRoute, error) {
+func (c *connector) GetVolumes(ctx context.Context, input *ec2.DescribeVolumesInput) ([]*ec2.Volume, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.TransitGatewayRoute, 0)
+	opt := make([]*ec2.Volume, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.SearchTransitGatewayRoutesWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeVolumesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Routes == nil {
+		if o.Volumes == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &ec2.DescribeVolumesInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Routes...)
+		opt = append(opt, o.Volumes...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetTransitGatewayRouteTableAssociations(ctx context.Context, input *ec2.GetTransitGatewayRouteTableAssociationsInput) ([]*ec2.TransitGatewayRouteTableAssociation, error) {
+func (c *connector) GetVpcEndpoints(ctx context.Context, input *ec2.DescribeVpcEndpointsInput) ([]*ec2.VpcEndpoint, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.TransitGatewayRouteTableAssociation, 0)
+	opt := make([]*ec2.VpcEndpoint, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.GetTransitGatewayRouteTableAssociationsWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeVpcEndpointsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Associations == nil {
+		if o.VpcEndpoints == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.GetTransitGatewayRouteTableAssociationsInput{}
+			input = &ec2.DescribeVpcEndpointsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Associations...)
+		opt = append(opt, o.VpcEndpoints...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetTransitGatewayRouteTablePropagations(ctx context.Context, input *ec2.GetTransitGatewayRouteTablePropagationsInput) ([]*ec2.TransitGatewayRouteTablePropagation, error) {
+func (c *connector) GetVpcs(ctx context.Context, input *ec2.DescribeVpcsInput) ([]*ec2.Vpc, error) {
 	if c.svc.ec2 == nil {
 		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ec2.TransitGatewayRouteTablePropagation, 0)
+	opt := make([]*ec2.Vpc, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ec2.GetTransitGatewayRouteTablePropagationsWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeVpcsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TransitGatewayRouteTablePropagations == nil {
+		if o.Vpcs == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ec2.GetTransitGatewayRouteTablePropagationsInput{}
+			input = &ec2.DescribeVpcsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.TransitGatewayRouteTablePropagations...)
+		opt = append(opt, o.Vpcs...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetECSClustersArns(ctx context.Context, input *ecs.ListClustersInput) ([]*string, error) {
-	if c.svc.ecs == nil {
-		c.svc.ecs = ecs.New(c.svc.session)
+func (c *connector) GetVpcPeeringConnections(ctx context.Context, input *ec2.DescribeVpcPeeringConnectionsInput) ([]*ec2.VpcPeeringConnection, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*string, 0)
+	opt := make([]*ec2.VpcPeeringConnection, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ecs.ListClustersWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeVpcPeeringConnectionsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ClusterArns == nil {
+		if o.VpcPeeringConnections == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ecs.ListClustersInput{}
+			input = &ec2.DescribeVpcPeeringConnectionsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.ClusterArns...)
+		opt = append(opt, o.VpcPeeringConnections...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetECSClusters(ctx context.Context, input *ecs.DescribeClustersInput) ([]*ecs.Cluster, error) {
-	if c.svc.ecs == nil {
-		c.svc.ecs = ecs.New(c.svc.session)
+func (c *connector) GetVPNGateways(ctx context.Context, input *ec2.DescribeVpnGatewaysInput) ([]*ec2.VpnGateway, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ecs.Cluster, 0)
+	opt := make([]*ec2.VpnGateway, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ecs.DescribeClustersWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeVpnGatewaysWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Clusters == nil {
+		if o.VpnGateways == nil {
 			hasNextToken = false
 			continue
 		}
 
 		hasNextToken = false
 
-		opt = append(opt, o.Clusters...)
+		opt = append(opt, o.VpnGateways...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetECSServicesArns(ctx context.Context, input *ecs.ListServicesInput) ([]*string, error) {
-	if c.svc.ecs == nil {
-		c.svc.ecs = ecs.New(c.svc.session)
+func (c *connector) GetRouteTables(ctx context.Context, input *ec2.DescribeRouteTablesInput) ([]*ec2.RouteTable, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*string, 0)
+	opt := make([]*ec2.RouteTable, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ecs.ListServicesWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeRouteTablesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ServiceArns == nil {
+		if o.RouteTables == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &ecs.ListServicesInput{}
-		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		hasNextToken = false
 
-		opt = append(opt, o.ServiceArns...)
+		opt = append(opt, o.RouteTables...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetECSServices(ctx context.Context, input *ecs.DescribeServicesInput) ([]*ecs.Service, error) {
-	if c.svc.ecs == nil {
-		c.svc.ecs = ecs.New(c.svc.session)
+func (c *connector) GetTransitGateways(ctx context.Context, input *ec2.DescribeTransitGatewaysInput) ([]*ec2.TransitGateway, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*ecs.Service, 0)
+	opt := make([]*ec2.TransitGateway, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ecs.DescribeServicesWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeTransitGatewaysWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Services == nil {
+		if o.TransitGateways == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &ec2.DescribeTransitGatewaysInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Services...)
+		opt = append(opt, o.TransitGateways...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetEFSFileSystems(ctx context.Context, input *efs.DescribeFileSystemsInput) ([]*efs.FileSystemDescription, error) {
-	if c.svc.efs == nil {
-		c.svc.efs = efs.New(c.svc.session)
+func (c *connector) GetTransitGatewayVpcAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayVpcAttachmentsInput) ([]*ec2.TransitGatewayVpcAttachment, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*efs.FileSystemDescription, 0)
+	opt := make([]*ec2.TransitGatewayVpcAttachment, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.efs.DescribeFileSystemsWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeTransitGatewayVpcAttachmentsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.FileSystems == nil {
+		if o.TransitGatewayVpcAttachments == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &efs.DescribeFileSystemsInput{}
+			input = &ec2.DescribeTransitGatewayVpcAttachmentsInput{}
 		}
-		input.Marker = o.NextMarker
-		hasNextToken = o.NextMarker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.FileSystems...)
+		opt = append(opt, o.TransitGatewayVpcAttachments...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetEKSCluster(ctx context.Context, input *eks.DescribeClusterInput) (*eks.Cluster, error) {
-	if c.svc.eks == nil {
-		c.svc.eks = eks.New(c.svc.session)
+func (c *connector) GetTransitGatewayRouteTables(ctx context.Context, input *ec2.DescribeTransitGatewayRouteTablesInput) ([]*ec2.TransitGatewayRouteTable, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	var opt *eks.Cluster
+	opt := make([]*ec2.TransitGatewayRouteTable, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.eks.DescribeClusterWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeTransitGatewayRouteTablesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Cluster == nil {
+		if o.TransitGatewayRouteTables == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &ec2.DescribeTransitGatewayRouteTablesInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = o.Cluster
+		opt = append(opt, o.TransitGatewayRouteTables...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetEKSClusters(ctx context.Context, input *eks.ListClustersInput) ([]*string, error) {
-	if c.svc.eks == nil {
-		c.svc.eks = eks.New(c.svc.session)
+func (c *connector) GetTransitGatewayMulticast(ctx context.Context, input *ec2.DescribeTransitGatewayMulticastDomainsInput) ([]*ec2.TransitGatewayMulticastDomain, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*string, 0)
+	opt := make([]*ec2.TransitGatewayMulticastDomain, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.eks.ListClustersWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeTransitGatewayMulticastDomainsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Clusters == nil {
+		if o.TransitGatewayMulticastDomains == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &eks.ListClustersInput{}
+			input = &ec2.DescribeTransitGatewayMulticastDomainsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Clusters...)
+		opt = append(opt, o.TransitGatewayMulticastDomains...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetElastiCacheClusters(ctx context.Context, input *elasticache.DescribeCacheClustersInput) ([]*elasticache.CacheCluster, error) {
-	if c.svc.elasticache == nil {
-		c.svc.elasticache = elasticache.New(c.svc.session)
+func (c *connector) GetTransitGatewayPeeringAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput) ([]*ec2.TransitGatewayPeeringAttachment, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*elasticache.CacheCluster, 0)
+	opt := make([]*ec2.TransitGatewayPeeringAttachment, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elasticache.DescribeCacheClustersWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeTransitGatewayPeeringAttachmentsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.CacheClusters == nil {
+		if o.TransitGatewayPeeringAttachments == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &elasticache.DescribeCacheClustersInput{}
+			input = &ec2.DescribeTransitGatewayPeeringAttachmentsInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.CacheClusters...)
+		opt = append(opt, o.TransitGatewayPeeringAttachments...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetElastiCacheReplicationGroups(ctx context.Context, input *elasticache.DescribeReplicationGroupsInput) ([]*elasticache.ReplicationGroup, error) {
-	if c.svc.elasticache == nil {
-		c.svc.elasticache = elasticache.New(c.svc.session)
+func (c *connector) GetTransitGatewayPrefixListReference(ctx context.Context, input *ec2.GetTransitGatewayPrefixListReferencesInput) ([]*ec2.TransitGatewayPrefixListReference, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*elasticache.ReplicationGroup, 0)
+	opt := make([]*ec2.TransitGatewayPrefixListReference, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elasticache.DescribeReplicationGroupsWithContext(ctx, input)
+		o, err := c.svc.ec2.GetTransitGatewayPrefixListReferencesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ReplicationGroups == nil {
+		if o.TransitGatewayPrefixListReferences == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &elasticache.DescribeReplicationGroupsInput{}
+			input = &ec2.GetTransitGatewayPrefixListReferencesInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.ReplicationGroups...)
+		opt = append(opt, o.TransitGatewayPrefixListReferences...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetElastiCacheTags(ctx context.Context, input *elasticache.ListTagsForResourceInput) ([]*elasticache.Tag, error) {
-	if c.svc.elasticache == nil {
-		c.svc.elasticache = elasticache.New(c.svc.session)
+func (c *connector) GetTransitGatewayRoutes(ctx context.Context, input *ec2.SearchTransitGatewayRoutesInput) ([]*ec2.TransitGatewayRoute, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*elasticache.Tag, 0)
+	opt := make([]*ec2.TransitGatewayRoute, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elasticache.ListTagsForResourceWithContext(ctx, input)
+		o, err := c.svc.ec2.SearchTransitGatewayRoutesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TagList == nil {
+		if o.Routes == nil {
 			hasNextToken = false
 			continue
 		}
 
 		hasNextToken = false
 
-		opt = append(opt, o.TagList...)
+		opt = append(opt, o.Routes...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetElasticBeanstalkApplications(ctx context.Context, input *elasticbeanstalk.DescribeApplicationsInput) ([]*elasticbeanstalk.ApplicationDescription, error) {
-	if c.svc.elasticbeanstalk == nil {
-		c.svc.elasticbeanstalk = elasticbeanstalk.New(c.svc.session)
+func (c *connector) GetTransitGatewayRouteTableAssociations(ctx context.Context, input *ec2.GetTransitGatewayRouteTableAssociationsInput) ([]*ec2.TransitGatewayRouteTableAssociation, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*elasticbeanstalk.ApplicationDescription, 0)
+	opt := make([]*ec2.TransitGatewayRouteTableAssociation, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elasticbeanstalk.DescribeApplicationsWithContext(ctx, input)
+		o, err := c.svc.ec2.GetTransitGatewayRouteTableAssociationsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Applications == nil {
+		if o.Associations == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &ec2.GetTransitGatewayRouteTableAssociationsInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Applications...)
+		opt = append(opt, o.Associations...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetElasticsearchDomainNames(ctx context.Context, input *elasticsearchservice.ListDomainNamesInput) ([]*elasticsearchservice.DomainInfo, error) {
-	if c.svc.elasticsearchservice == nil {
-		c.svc.elasticsearchservice = elasticsearchservice.New(c.svc.session)
+func (c *connector) GetTransitGatewayRouteTablePropagations(ctx context.Context, input *ec2.GetTransitGatewayRouteTablePropagationsInput) ([]*ec2.TransitGatewayRouteTablePropagation, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*elasticsearchservice.DomainInfo, 0)
+	opt := make([]*ec2.TransitGatewayRouteTablePropagation, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elasticsearchservice.ListDomainNamesWithContext(ctx, input)
+		o, err := c.svc.ec2.GetTransitGatewayRouteTablePropagationsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.DomainNames == nil {
+		if o.TransitGatewayRouteTablePropagations == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &ec2.GetTransitGatewayRouteTablePropagationsInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.DomainNames...)
+		opt = append(opt, o.TransitGatewayRouteTablePropagations...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetElasticsearchDomains(ctx context.Context, input *elasticsearchservice.DescribeElasticsearchDomainsInput) ([]*elasticsearchservice.ElasticsearchDomainStatus, error) {
-	if c.svc.elasticsearchservice == nil {
-		c.svc.elasticsearchservice = elasticsearchservice.New(c.svc.session)
+func (c *connector) GetCapacityReservations(ctx context.Context, input *ec2.DescribeCapacityReservationsInput) ([]*ec2.CapacityReservation, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*elasticsearchservice.ElasticsearchDomainStatus, 0)
+	opt := make([]*ec2.CapacityReservation, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elasticsearchservice.DescribeElasticsearchDomainsWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeCapacityReservationsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.DomainStatusList == nil {
+		if o.CapacityReservations == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &ec2.DescribeCapacityReservationsInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.DomainStatusList...)
+		opt = append(opt, o.CapacityReservations...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLoadBalancerAttributes(ctx context.Context, input *elb.DescribeLoadBalancerAttributesInput) ([]*elb.AdditionalAttribute, error) {
-	if c.svc.elb == nil {
-		c.svc.elb = elb.New(c.svc.session)
+func (c *connector) GetFleets(ctx context.Context, input *ec2.DescribeFleetsInput) ([]*ec2.FleetData, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*elb.AdditionalAttribute, 0)
+	opt := make([]*ec2.FleetData, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elb.DescribeLoadBalancerAttributesWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeFleetsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.LoadBalancerAttributes == nil {
+		if o.Fleets == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &ec2.DescribeFleetsInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.LoadBalancerAttributes.AdditionalAttributes...)
+		opt = append(opt, o.Fleets...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLoadBalancers(ctx context.Context, input *elb.DescribeLoadBalancersInput) ([]*elb.LoadBalancerDescription, error) {
-	if c.svc.elb == nil {
-		c.svc.elb = elb.New(c.svc.session)
+func (c *connector) GetSpotFleetRequests(ctx context.Context, input *ec2.DescribeSpotFleetRequestsInput) ([]*ec2.SpotFleetRequestConfig, error) {
+	if c.svc.ec2 == nil {
+		c.svc.ec2 = ec2.New(c.svc.session)
 	}
 
-	opt := make([]*elb.LoadBalancerDescription, 0)
+	opt := make([]*ec2.SpotFleetRequestConfig, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elb.DescribeLoadBalancersWithContext(ctx, input)
+		o, err := c.svc.ec2.DescribeSpotFleetRequestsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.LoadBalancerDescriptions == nil {
+		if o.SpotFleetRequestConfigs == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &elb.DescribeLoadBalancersInput{}
+			input = &ec2.DescribeSpotFleetRequestsInput{}
 		}
-		input.Marker = o.NextMarker
-		hasNextToken = o.NextMarker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.LoadBalancerDescriptions...)
+		opt = append(opt, o.SpotFleetRequestConfigs...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLoadBalancerPolicies(ctx context.Context, input *elb.DescribeLoadBalancerPoliciesInput) ([]*elb.PolicyDescription, error) {
-	if c.svc.elb == nil {
-		c.svc.elb = elb.New(c.svc.session)
+func (c *connector) GetECSClustersArns(ctx context.Context, input *ecs.ListClustersInput) ([]*string, error) {
+	if c.svc.ecs == nil {
+		c.svc.ecs = ecs.New(c.svc.session)
 	}
 
-	opt := make([]*elb.PolicyDescription, 0)
+	opt := make([]*string, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elb.DescribeLoadBalancerPoliciesWithContext(ctx, input)
+		o, err := c.svc.ecs.ListClustersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.PolicyDescriptions == nil {
+		if o.ClusterArns == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
-
-		opt = append(opt, o.PolicyDescriptions...)
-
+		if input == nil {
+			input = &ecs.ListClustersInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
+
+		opt = append(opt, o.ClusterArns...)
+
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLoadBalancersTags(ctx context.Context, input *elb.DescribeTagsInput) ([]*elb.TagDescription, error) {
-	if c.svc.elb == nil {
-		c.svc.elb = elb.New(c.svc.session)
+func (c *connector) GetECSClusters(ctx context.Context, input *ecs.DescribeClustersInput) ([]*ecs.Cluster, error) {
+	if c.svc.ecs == nil {
+		c.svc.ecs = ecs.New(c.svc.session)
 	}
 
-	opt := make([]*elb.TagDescription, 0)
+	opt := make([]*ecs.Cluster, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elb.DescribeTagsWithContext(ctx, input)
+		o, err := c.svc.ecs.DescribeClustersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TagDescriptions == nil {
+		if o.Clusters == nil {
 			hasNextToken = false
 			continue
 		}
 
 		hasNextToken = false
 
-		opt = append(opt, o.TagDescriptions...)
+		opt = append(opt, o.Clusters...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetListenerCertificates(ctx context.Context, input *elbv2.DescribeListenerCertificatesInput) ([]*elbv2.Certificate, error) {
-	if c.svc.elbv2 == nil {
-		c.svc.elbv2 = elbv2.New(c.svc.session)
+func (c *connector) GetECSServicesArns(ctx context.Context, input *ecs.ListServicesInput) ([]*string, error) {
+	if c.svc.ecs == nil {
+		c.svc.ecs = ecs.New(c.svc.session)
 	}
 
-	opt := make([]*elbv2.Certificate, 0)
+	opt := make([]*string, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elbv2.DescribeListenerCertificatesWithContext(ctx, input)
+		o, err := c.svc.ecs.ListServicesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Certificates == nil {
+		if o.ServiceArns == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &elbv2.DescribeListenerCertificatesInput{}
+			input = &ecs.ListServicesInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
+
+		opt = append(opt, o.ServiceArns...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetECSServices(ctx context.Context, input *ecs.DescribeServicesInput) ([]*ecs.Service, error) {
+	if c.svc.ecs == nil {
+		c.svc.ecs = ecs.New(c.svc.session)
+	}
+
+	opt := make([]*ecs.Service, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.ecs.DescribeServicesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Services == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = append(opt, o.Services...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetEFSFileSystems(ctx context.Context, input *efs.DescribeFileSystemsInput) ([]*efs.FileSystemDescription, error) {
+	if c.svc.efs == nil {
+		c.svc.efs = efs.New(c.svc.session)
+	}
+
+	opt := make([]*efs.FileSystemDescription, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.efs.DescribeFileSystemsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.FileSystems == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &efs.DescribeFileSystemsInput{}
 		}
 		input.Marker = o.NextMarker
 		hasNextToken = o.NextMarker != nil
 
-		opt = append(opt, o.Certificates...)
+		opt = append(opt, o.FileSystems...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLoadBalancersV2Listeners(ctx context.Context, input *elbv2.DescribeListenersInput) ([]*elbv2.Listener, error) {
-	if c.svc.elbv2 == nil {
-		c.svc.elbv2 = elbv2.New(c.svc.session)
+func (c *connector) GetEKSCluster(ctx context.Context, input *eks.DescribeClusterInput) (*eks.Cluster, error) {
+	if c.svc.eks == nil {
+		c.svc.eks = eks.New(c.svc.session)
+	}
+
+	var opt *eks.Cluster
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.eks.DescribeClusterWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Cluster == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = o.Cluster
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetEKSClusters(ctx context.Context, input *eks.ListClustersInput) ([]*string, error) {
+	if c.svc.eks == nil {
+		c.svc.eks = eks.New(c.svc.session)
+	}
+
+	opt := make([]*string, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.eks.ListClustersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Clusters == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &eks.ListClustersInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
+
+		opt = append(opt, o.Clusters...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetElastiCacheClusters(ctx context.Context, input *elasticache.DescribeCacheClustersInput) ([]*elasticache.CacheCluster, error) {
+	if c.svc.elasticache == nil {
+		c.svc.elasticache = elasticache.New(c.svc.session)
+	}
+
+	opt := make([]*elasticache.CacheCluster, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elasticache.DescribeCacheClustersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.CacheClusters == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &elasticache.DescribeCacheClustersInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.CacheClusters...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetElastiCacheParameterGroups(ctx context.Context, input *elasticache.DescribeCacheParameterGroupsInput) ([]*elasticache.CacheParameterGroup, error) {
+	if c.svc.elasticache == nil {
+		c.svc.elasticache = elasticache.New(c.svc.session)
+	}
+
+	opt := make([]*elasticache.CacheParameterGroup, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elasticache.DescribeCacheParameterGroupsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.CacheParameterGroups == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &elasticache.DescribeCacheParameterGroupsInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.CacheParameterGroups...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetElastiCacheReplicationGroups(ctx context.Context, input *elasticache.DescribeReplicationGroupsInput) ([]*elasticache.ReplicationGroup, error) {
+	if c.svc.elasticache == nil {
+		c.svc.elasticache = elasticache.New(c.svc.session)
+	}
+
+	opt := make([]*elasticache.ReplicationGroup, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elasticache.DescribeReplicationGroupsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.ReplicationGroups == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &elasticache.DescribeReplicationGroupsInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.ReplicationGroups...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetElastiCacheSubnetGroups(ctx context.Context, input *elasticache.DescribeCacheSubnetGroupsInput) ([]*elasticache.CacheSubnetGroup, error) {
+	if c.svc.elasticache == nil {
+		c.svc.elasticache = elasticache.New(c.svc.session)
+	}
+
+	opt := make([]*elasticache.CacheSubnetGroup, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elasticache.DescribeCacheSubnetGroupsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.CacheSubnetGroups == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &elasticache.DescribeCacheSubnetGroupsInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.CacheSubnetGroups...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetElastiCacheTags(ctx context.Context, input *elasticache.ListTagsForResourceInput) ([]*elasticache.Tag, error) {
+	if c.svc.elasticache == nil {
+		c.svc.elasticache = elasticache.New(c.svc.session)
+	}
+
+	opt := make([]*elasticache.Tag, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elasticache.ListTagsForResourceWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.TagList == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = append(opt, o.TagList...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetElasticBeanstalkApplications(ctx context.Context, input *elasticbeanstalk.DescribeApplicationsInput) ([]*elasticbeanstalk.ApplicationDescription, error) {
+	if c.svc.elasticbeanstalk == nil {
+		c.svc.elasticbeanstalk = elasticbeanstalk.New(c.svc.session)
+	}
+
+	opt := make([]*elasticbeanstalk.ApplicationDescription, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elasticbeanstalk.DescribeApplicationsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Applications == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = append(opt, o.Applications...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetElasticsearchDomainNames(ctx context.Context, input *elasticsearchservice.ListDomainNamesInput) ([]*elasticsearchservice.DomainInfo, error) {
+	if c.svc.elasticsearchservice == nil {
+		c.svc.elasticsearchservice = elasticsearchservice.New(c.svc.session)
+	}
+
+	opt := make([]*elasticsearchservice.DomainInfo, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elasticsearchservice.ListDomainNamesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.DomainNames == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = append(opt, o.DomainNames...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetElasticsearchDomains(ctx context.Context, input *elasticsearchservice.DescribeElasticsearchDomainsInput) ([]*elasticsearchservice.ElasticsearchDomainStatus, error) {
+	if c.svc.elasticsearchservice == nil {
+		c.svc.elasticsearchservice = elasticsearchservice.New(c.svc.session)
+	}
+
+	opt := make([]*elasticsearchservice.ElasticsearchDomainStatus, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elasticsearchservice.DescribeElasticsearchDomainsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.DomainStatusList == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = append(opt, o.DomainStatusList...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetLoadBalancerAttributes(ctx context.Context, input *elb.DescribeLoadBalancerAttributesInput) ([]*elb.AdditionalAttribute, error) {
+	if c.svc.elb == nil {
+		c.svc.elb = elb.New(c.svc.session)
+	}
+
+	opt := make([]*elb.AdditionalAttribute, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elb.DescribeLoadBalancerAttributesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.LoadBalancerAttributes == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = append(opt, o.LoadBalancerAttributes.AdditionalAttributes...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetLoadBalancers(ctx context.Context, input *elb.DescribeLoadBalancersInput) ([]*elb.LoadBalancerDescription, error) {
+	if c.svc.elb == nil {
+		c.svc.elb = elb.New(c.svc.session)
+	}
+
+	opt := make([]*elb.LoadBalancerDescription, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elb.DescribeLoadBalancersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.LoadBalancerDescriptions == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &elb.DescribeLoadBalancersInput{}
+		}
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
+
+		opt = append(opt, o.LoadBalancerDescriptions...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetLoadBalancerPolicies(ctx context.Context, input *elb.DescribeLoadBalancerPoliciesInput) ([]*elb.PolicyDescription, error) {
+	if c.svc.elb == nil {
+		c.svc.elb = elb.New(c.svc.session)
+	}
+
+	opt := make([]*elb.PolicyDescription, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elb.DescribeLoadBalancerPoliciesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.PolicyDescriptions == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = append(opt, o.PolicyDescriptions...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetLoadBalancersTags(ctx context.Context, input *elb.DescribeTagsInput) ([]*elb.TagDescription, error) {
+	if c.svc.elb == nil {
+		c.svc.elb = elb.New(c.svc.session)
+	}
+
+	opt := make([]*elb.TagDescription, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elb.DescribeTagsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.TagDescriptions == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = append(opt, o.TagDescriptions...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetListenerCertificates(ctx context.Context, input *elbv2.DescribeListenerCertificatesInput) ([]*elbv2.Certificate, error) {
+	if c.svc.elbv2 == nil {
+		c.svc.elbv2 = elbv2.New(c.svc.session)
+	}
+
+	opt := make([]*elbv2.Certificate, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elbv2.DescribeListenerCertificatesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Certificates == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &elbv2.DescribeListenerCertificatesInput{}
+		}
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
+
+		opt = append(opt, o.Certificates...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetLoadBalancersV2Listeners(ctx context.Context, input *elbv2.DescribeListenersInput) ([]*elbv2.Listener, error) {
+	if c.svc.elbv2 == nil {
+		c.svc.elbv2 = elbv2.New(c.svc.session)
+	}
+
+	opt := make([]*elbv2.Listener, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elbv2.DescribeListenersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Listeners == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &elbv2.DescribeListenersInput{}
+		}
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
+
+		opt = append(opt, o.Listeners...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetLoadBalancersV2(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) ([]*elbv2.LoadBalancer, error) {
+	if c.svc.elbv2 == nil {
+		c.svc.elbv2 = elbv2.New(c.svc.session)
+	}
+
+	opt := make([]*elbv2.LoadBalancer, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elbv2.DescribeLoadBalancersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.LoadBalancers == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &elbv2.DescribeLoadBalancersInput{}
+		}
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
+
+		opt = append(opt, o.LoadBalancers...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetLoadBalancersV2Tags(ctx context.Context, input *elbv2.DescribeTagsInput) ([]*elbv2.TagDescription, error) {
+	if c.svc.elbv2 == nil {
+		c.svc.elbv2 = elbv2.New(c.svc.session)
+	}
+
+	opt := make([]*elbv2.TagDescription, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elbv2.DescribeTagsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.TagDescriptions == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = append(opt, o.TagDescriptions...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetLoadBalancersV2TargetGroupAttributes(ctx context.Context, input *elbv2.DescribeTargetGroupAttributesInput) ([]*elbv2.TargetGroupAttribute, error) {
+	if c.svc.elbv2 == nil {
+		c.svc.elbv2 = elbv2.New(c.svc.session)
+	}
+
+	opt := make([]*elbv2.TargetGroupAttribute, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elbv2.DescribeTargetGroupAttributesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Attributes == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = append(opt, o.Attributes...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetLoadBalancersV2TargetGroups(ctx context.Context, input *elbv2.DescribeTargetGroupsInput) ([]*elbv2.TargetGroup, error) {
+	if c.svc.elbv2 == nil {
+		c.svc.elbv2 = elbv2.New(c.svc.session)
+	}
+
+	opt := make([]*elbv2.TargetGroup, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elbv2.DescribeTargetGroupsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.TargetGroups == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &elbv2.DescribeTargetGroupsInput{}
+		}
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
+
+		opt = append(opt, o.TargetGroups...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetLoadBalancersV2TargetHealth(ctx context.Context, input *elbv2.DescribeTargetHealthInput) ([]*elbv2.TargetHealthDescription, error) {
+	if c.svc.elbv2 == nil {
+		c.svc.elbv2 = elbv2.New(c.svc.session)
+	}
+
+	opt := make([]*elbv2.TargetHealthDescription, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elbv2.DescribeTargetHealthWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.TargetHealthDescriptions == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = append(opt, o.TargetHealthDescriptions...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetLoadBalancersV2Rules(ctx context.Context, input *elbv2.DescribeRulesInput) ([]*elbv2.Rule, error) {
+	if c.svc.elbv2 == nil {
+		c.svc.elbv2 = elbv2.New(c.svc.session)
+	}
+
+	opt := make([]*elbv2.Rule, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.elbv2.DescribeRulesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Rules == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &elbv2.DescribeRulesInput{}
+		}
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
+
+		opt = append(opt, o.Rules...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetEMRClusters(ctx context.Context, input *emr.ListClustersInput) ([]*emr.ClusterSummary, error) {
+	if c.svc.emr == nil {
+		c.svc.emr = emr.New(c.svc.session)
+	}
+
+	opt := make([]*emr.ClusterSummary, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.emr.ListClustersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Clusters == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &emr.ListClustersInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.Clusters...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetFSXFileSystems(ctx context.Context, input *fsx.DescribeFileSystemsInput) ([]*fsx.FileSystem, error) {
+	if c.svc.fsx == nil {
+		c.svc.fsx = fsx.New(c.svc.session)
+	}
+
+	opt := make([]*fsx.FileSystem, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.fsx.DescribeFileSystemsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.FileSystems == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &fsx.DescribeFileSystemsInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
+
+		opt = append(opt, o.FileSystems...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetGlueDatabases(ctx context.Context, input *glue.GetDatabasesInput) ([]*glue.Database, error) {
+	if c.svc.glue == nil {
+		c.svc.glue = glue.New(c.svc.session)
+	}
+
+	opt := make([]*glue.Database, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.glue.GetDatabasesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.DatabaseList == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &glue.GetDatabasesInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
+
+		opt = append(opt, o.DatabaseList...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetGlueTables(ctx context.Context, input *glue.GetTablesInput) ([]*glue.TableData, error) {
+	if c.svc.glue == nil {
+		c.svc.glue = glue.New(c.svc.session)
+	}
+
+	opt := make([]*glue.TableData, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.glue.GetTablesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.TableList == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &glue.GetTablesInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
+
+		opt = append(opt, o.TableList...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetAccessKeys(ctx context.Context, input *iam.ListAccessKeysInput) ([]*iam.AccessKeyMetadata, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*iam.AccessKeyMetadata, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListAccessKeysWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.AccessKeyMetadata == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.ListAccessKeysInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.AccessKeyMetadata...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetAccountAliases(ctx context.Context, input *iam.ListAccountAliasesInput) ([]*string, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*string, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListAccountAliasesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.AccountAliases == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.ListAccountAliasesInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.AccountAliases...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetAccountPasswordPolicy(ctx context.Context, input *iam.GetAccountPasswordPolicyInput) (*iam.PasswordPolicy, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	var opt *iam.PasswordPolicy
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.GetAccountPasswordPolicyWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.PasswordPolicy == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = o.PasswordPolicy
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetAttachedGroupPolicies(ctx context.Context, input *iam.ListAttachedGroupPoliciesInput) ([]*iam.AttachedPolicy, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*iam.AttachedPolicy, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListAttachedGroupPoliciesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.AttachedPolicies == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.ListAttachedGroupPoliciesInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.AttachedPolicies...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetAttachedRolePolicies(ctx context.Context, input *iam.ListAttachedRolePoliciesInput) ([]*iam.AttachedPolicy, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*iam.AttachedPolicy, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListAttachedRolePoliciesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.AttachedPolicies == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.ListAttachedRolePoliciesInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.AttachedPolicies...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetAttachedUserPolicies(ctx context.Context, input *iam.ListAttachedUserPoliciesInput) ([]*iam.AttachedPolicy, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*iam.AttachedPolicy, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListAttachedUserPoliciesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.AttachedPolicies == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.ListAttachedUserPoliciesInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.AttachedPolicies...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetGroupUsers(ctx context.Context, input *iam.GetGroupInput) ([]*iam.User, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*iam.User, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.GetGroupWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Users == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.GetGroupInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.Users...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetGroupPolicies(ctx context.Context, input *iam.ListGroupPoliciesInput) ([]*string, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*string, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListGroupPoliciesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.PolicyNames == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.ListGroupPoliciesInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.PolicyNames...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetGroups(ctx context.Context, input *iam.ListGroupsInput) ([]*iam.Group, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*iam.Group, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListGroupsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Groups == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.ListGroupsInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.Groups...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetGroupsForUser(ctx context.Context, input *iam.ListGroupsForUserInput) ([]*iam.Group, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*iam.Group, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListGroupsForUserWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Groups == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.ListGroupsForUserInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.Groups...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetInstanceProfiles(ctx context.Context, input *iam.ListInstanceProfilesInput) ([]*iam.InstanceProfile, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*iam.InstanceProfile, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListInstanceProfilesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.InstanceProfiles == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.ListInstanceProfilesInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.InstanceProfiles...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetOpenIDConnectProviders(ctx context.Context, input *iam.ListOpenIDConnectProvidersInput) ([]*iam.OpenIDConnectProviderListEntry, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*iam.OpenIDConnectProviderListEntry, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListOpenIDConnectProvidersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.OpenIDConnectProviderList == nil {
+			hasNextToken = false
+			continue
+		}
+
+		hasNextToken = false
+
+		opt = append(opt, o.OpenIDConnectProviderList...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetPolicies(ctx context.Context, input *iam.ListPoliciesInput) ([]*iam.Policy, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*iam.Policy, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListPoliciesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Policies == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.ListPoliciesInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.Policies...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetPrincipalPolicySimulation(ctx context.Context, input *iam.SimulatePrincipalPolicyInput) ([]*iam.EvaluationResult, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*iam.EvaluationResult, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.SimulatePrincipalPolicyWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.EvaluationResults == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.SimulatePrincipalPolicyInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.EvaluationResults...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetRolePolicies(ctx context.Context, input *iam.ListRolePoliciesInput) ([]*string, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*string, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListRolePoliciesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.PolicyNames == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.ListRolePoliciesInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.PolicyNames...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetRoles(ctx context.Context, input *iam.ListRolesInput) ([]*iam.Role, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
+	}
+
+	opt := make([]*iam.Role, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := c.svc.iam.ListRolesWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if o.Roles == nil {
+			hasNextToken = false
+			continue
+		}
+
+		if input == nil {
+			input = &iam.ListRolesInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
+
+		opt = append(opt, o.Roles...)
+
+	}
+
+	return opt, nil
+}
+
+func (c *connector) GetSAMLProviders(ctx context.Context, input *iam.ListSAMLProvidersInput) ([]*iam.SAMLProviderListEntry, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
 	}
 
-	opt := make([]*elbv2.Listener, 0)
+	opt := make([]*iam.SAMLProviderListEntry, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elbv2.DescribeListenersWithContext(ctx, input)
+		o, err := c.svc.iam.ListSAMLProvidersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Listeners == nil {
+		if o.SAMLProviderList == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &elbv2.DescribeListenersInput{}
-		}
-		input.Marker = o.NextMarker
-		hasNextToken = o.NextMarker != nil
+		hasNextToken = false
 
-		opt = append(opt, o.Listeners...)
+		opt = append(opt, o.SAMLProviderList...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLoadBalancersV2(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) ([]*elbv2.LoadBalancer, error) {
-	if c.svc.elbv2 == nil {
-		c.svc.elbv2 = elbv2.New(c.svc.session)
+func (c *connector) GetServerCertificates(ctx context.Context, input *iam.ListServerCertificatesInput) ([]*iam.ServerCertificateMetadata, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
 	}
 
-	opt := make([]*elbv2.LoadBalancer, 0)
+	opt := make([]*iam.ServerCertificateMetadata, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elbv2.DescribeLoadBalancersWithContext(ctx, input)
+		o, err := c.svc.iam.ListServerCertificatesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.LoadBalancers == nil {
+		if o.ServerCertificateMetadataList == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &elbv2.DescribeLoadBalancersInput{}
+			input = &iam.ListServerCertificatesInput{}
 		}
-		input.Marker = o.NextMarker
-		hasNextToken = o.NextMarker != nil
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.LoadBalancers...)
+		opt = append(opt, o.ServerCertificateMetadataList...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLoadBalancersV2Tags(ctx context.Context, input *elbv2.DescribeTagsInput) ([]*elbv2.TagDescription, error) {
-	if c.svc.elbv2 == nil {
-		c.svc.elbv2 = elbv2.New(c.svc.session)
+func (c *connector) GetSSHPublicKeys(ctx context.Context, input *iam.ListSSHPublicKeysInput) ([]*iam.SSHPublicKeyMetadata, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
 	}
 
-	opt := make([]*elbv2.TagDescription, 0)
+	opt := make([]*iam.SSHPublicKeyMetadata, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elbv2.DescribeTagsWithContext(ctx, input)
+		o, err := c.svc.iam.ListSSHPublicKeysWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TagDescriptions == nil {
+		if o.SSHPublicKeys == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &iam.ListSSHPublicKeysInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.TagDescriptions...)
+		opt = append(opt, o.SSHPublicKeys...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLoadBalancersV2TargetGroupAttributes(ctx context.Context, input *elbv2.DescribeTargetGroupAttributesInput) ([]*elbv2.TargetGroupAttribute, error) {
-	if c.svc.elbv2 == nil {
-		c.svc.elbv2 = elbv2.New(c.svc.session)
+func (c *connector) GetUserPolicies(ctx context.Context, input *iam.ListUserPoliciesInput) ([]*string, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
 	}
 
-	opt := make([]*elbv2.TargetGroupAttribute, 0)
+	opt := make([]*string, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elbv2.DescribeTargetGroupAttributesWithContext(ctx, input)
+		o, err := c.svc.iam.ListUserPoliciesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Attributes == nil {
+		if o.PolicyNames == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &iam.ListUserPoliciesInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.Attributes...)
+		opt = append(opt, o.PolicyNames...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLoadBalancersV2TargetGroups(ctx context.Context, input *elbv2.DescribeTargetGroupsInput) ([]*elbv2.TargetGroup, error) {
-	if c.svc.elbv2 == nil {
-		c.svc.elbv2 = elbv2.New(c.svc.session)
+func (c *connector) GetUsers(ctx context.Context, input *iam.ListUsersInput) ([]*iam.User, error) {
+	if c.svc.iam == nil {
+		c.svc.iam = iam.New(c.svc.globalSession)
 	}
 
-	opt := make([]*elbv2.TargetGroup, 0)
+	opt := make([]*iam.User, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elbv2.DescribeTargetGroupsWithContext(ctx, input)
+		o, err := c.svc.iam.ListUsersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TargetGroups == nil {
+		if o.Users == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &elbv2.DescribeTargetGroupsInput{}
+			input = &iam.ListUsersInput{}
 		}
-		input.Marker = o.NextMarker
-		hasNextToken = o.NextMarker != nil
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.TargetGroups...)
+		opt = append(opt, o.Users...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLoadBalancersV2TargetHealth(ctx context.Context, input *elbv2.DescribeTargetHealthInput) ([]*elbv2.TargetHealthDescription, error) {
-	if c.svc.elbv2 == nil {
-		c.svc.elbv2 = elbv2.New(c.svc.session)
+func (c *connector) GetMSKClusters(ctx context.Context, input *kafka.ListClustersInput) ([]*kafka.ClusterInfo, error) {
+	if c.svc.kafka == nil {
+		c.svc.kafka = kafka.New(c.svc.session)
 	}
 
-	opt := make([]*elbv2.TargetHealthDescription, 0)
+	opt := make([]*kafka.ClusterInfo, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elbv2.DescribeTargetHealthWithContext(ctx, input)
+		o, err := c.svc.kafka.ListClustersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TargetHealthDescriptions == nil {
+		if o.ClusterInfoList == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &kafka.ListClustersInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.TargetHealthDescriptions...)
+		opt = append(opt, o.ClusterInfoList...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLoadBalancersV2Rules(ctx context.Context, input *elbv2.DescribeRulesInput) ([]*elbv2.Rule, error) {
-	if c.svc.elbv2 == nil {
-		c.svc.elbv2 = elbv2.New(c.svc.session)
+func (c *connector) GetMSKConfigurations(ctx context.Context, input *kafka.ListConfigurationsInput) ([]*kafka.Configuration, error) {
+	if c.svc.kafka == nil {
+		c.svc.kafka = kafka.New(c.svc.session)
 	}
 
-	opt := make([]*elbv2.Rule, 0)
+	opt := make([]*kafka.Configuration, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.elbv2.DescribeRulesWithContext(ctx, input)
+		o, err := c.svc.kafka.ListConfigurationsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Rules == nil {
+		if o.Configurations == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &elbv2.DescribeRulesInput{}
+			input = &kafka.ListConfigurationsInput{}
 		}
-		input.Marker = o.NextMarker
-		hasNextToken = o.NextMarker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Rules...)
+		opt = append(opt, o.Configurations...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetEMRClusters(ctx context.Context, input *emr.ListClustersInput) ([]*emr.ClusterSummary, error) {
-	if c.svc.emr == nil {
-		c.svc.emr = emr.New(c.svc.session)
+func (c *connector) GetKinesisStreams(ctx context.Context, input *kinesis.ListStreamsInput) ([]*string, error) {
+	if c.svc.kinesis == nil {
+		c.svc.kinesis = kinesis.New(c.svc.session)
 	}
 
-	opt := make([]*emr.ClusterSummary, 0)
+	opt := make([]*string, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.emr.ListClustersWithContext(ctx, input)
+		o, err := c.svc.kinesis.ListStreamsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Clusters == nil {
+		if o.StreamNames == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &emr.ListClustersInput{}
-		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		hasNextToken = false
 
-		opt = append(opt, o.Clusters...)
+		opt = append(opt, o.StreamNames...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetFSXFileSystems(ctx context.Context, input *fsx.DescribeFileSystemsInput) ([]*fsx.FileSystem, error) {
-	if c.svc.fsx == nil {
-		c.svc.fsx = fsx.New(c.svc.session)
+func (c *connector) GetLambdaFunctions(ctx context.Context, input *lambda.ListFunctionsInput) ([]*lambda.FunctionConfiguration, error) {
+	if c.svc.lambda == nil {
+		c.svc.lambda = lambda.New(c.svc.session)
 	}
 
-	opt := make([]*fsx.FileSystem, 0)
+	opt := make([]*lambda.FunctionConfiguration, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.fsx.DescribeFileSystemsWithContext(ctx, input)
+		o, err := c.svc.lambda.ListFunctionsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.FileSystems == nil {
+		if o.Functions == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &fsx.DescribeFileSystemsInput{}
+			input = &lambda.ListFunctionsInput{}
 		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
 
-		opt = append(opt, o.FileSystems...)
+		opt = append(opt, o.Functions...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetGlueDatabases(ctx context.Context, input *glue.GetDatabasesInput) ([]*glue.Database, error) {
-	if c.svc.glue == nil {
-		c.svc.glue = glue.New(c.svc.session)
+func (c *connector) GetLambdaEventSourceMappings(ctx context.Context, input *lambda.ListEventSourceMappingsInput) ([]*lambda.EventSourceMappingConfiguration, error) {
+	if c.svc.lambda == nil {
+		c.svc.lambda = lambda.New(c.svc.session)
 	}
 
-	opt := make([]*glue.Database, 0)
+	opt := make([]*lambda.EventSourceMappingConfiguration, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.glue.GetDatabasesWithContext(ctx, input)
+		o, err := c.svc.lambda.ListEventSourceMappingsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.DatabaseList == nil {
+		if o.EventSourceMappings == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &glue.GetDatabasesInput{}
+			input = &lambda.ListEventSourceMappingsInput{}
 		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
 
-		opt = append(opt, o.DatabaseList...)
+		opt = append(opt, o.EventSourceMappings...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetGlueTables(ctx context.Context, input *glue.GetTablesInput) ([]*glue.TableData, error) {
-	if c.svc.glue == nil {
-		c.svc.glue = glue.New(c.svc.session)
+func (c *connector) GetLambdaFunctionCode(ctx context.Context, input *lambda.GetFunctionInput) (*lambda.FunctionCodeLocation, error) {
+	if c.svc.lambda == nil {
+		c.svc.lambda = lambda.New(c.svc.session)
 	}
 
-	opt := make([]*glue.TableData, 0)
+	var opt *lambda.FunctionCodeLocation
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.glue.GetTablesWithContext(ctx, input)
+		o, err := c.svc.lambda.GetFunctionWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TableList == nil {
+		if o.Code == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &glue.GetTablesInput{}
-		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		hasNextToken = false
 
-		opt = append(opt, o.TableList...)
+		opt = o.Code
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetAccessKeys(ctx context.Context, input *iam.ListAccessKeysInput) ([]*iam.AccessKeyMetadata, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetLambdaFunctionURLConfigs(ctx context.Context, input *lambda.ListFunctionUrlConfigsInput) ([]*lambda.FunctionUrlConfig, error) {
+	if c.svc.lambda == nil {
+		c.svc.lambda = lambda.New(c.svc.session)
 	}
 
-	opt := make([]*iam.AccessKeyMetadata, 0)
+	opt := make([]*lambda.FunctionUrlConfig, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListAccessKeysWithContext(ctx, input)
+		o, err := c.svc.lambda.ListFunctionUrlConfigsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.AccessKeyMetadata == nil {
+		if o.FunctionUrlConfigs == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListAccessKeysInput{}
+			input = &lambda.ListFunctionUrlConfigsInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
 
-		opt = append(opt, o.AccessKeyMetadata...)
+		opt = append(opt, o.FunctionUrlConfigs...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetAccountAliases(ctx context.Context, input *iam.ListAccountAliasesInput) ([]*string, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetLambdaLayers(ctx context.Context, input *lambda.ListLayersInput) ([]*lambda.LayersListItem, error) {
+	if c.svc.lambda == nil {
+		c.svc.lambda = lambda.New(c.svc.session)
 	}
 
-	opt := make([]*string, 0)
+	opt := make([]*lambda.LayersListItem, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListAccountAliasesWithContext(ctx, input)
+		o, err := c.svc.lambda.ListLayersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.AccountAliases == nil {
+		if o.Layers == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListAccountAliasesInput{}
+			input = &lambda.ListLayersInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
 
-		opt = append(opt, o.AccountAliases...)
+		opt = append(opt, o.Layers...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetAccountPasswordPolicy(ctx context.Context, input *iam.GetAccountPasswordPolicyInput) (*iam.PasswordPolicy, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetLambdaLayerVersions(ctx context.Context, input *lambda.ListLayerVersionsInput) ([]*lambda.LayerVersionsListItem, error) {
+	if c.svc.lambda == nil {
+		c.svc.lambda = lambda.New(c.svc.session)
 	}
 
-	var opt *iam.PasswordPolicy
+	opt := make([]*lambda.LayerVersionsListItem, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.GetAccountPasswordPolicyWithContext(ctx, input)
+		o, err := c.svc.lambda.ListLayerVersionsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.PasswordPolicy == nil {
+		if o.LayerVersions == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &lambda.ListLayerVersionsInput{}
+		}
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
 
-		opt = o.PasswordPolicy
+		opt = append(opt, o.LayerVersions...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetAttachedGroupPolicies(ctx context.Context, input *iam.ListAttachedGroupPoliciesInput) ([]*iam.AttachedPolicy, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetLambdaLayerVersionContent(ctx context.Context, input *lambda.GetLayerVersionByArnInput) (*lambda.LayerVersionContentOutput, error) {
+	if c.svc.lambda == nil {
+		c.svc.lambda = lambda.New(c.svc.session)
 	}
 
-	opt := make([]*iam.AttachedPolicy, 0)
+	var opt *lambda.LayerVersionContentOutput
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListAttachedGroupPoliciesWithContext(ctx, input)
+		o, err := c.svc.lambda.GetLayerVersionByArnWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.AttachedPolicies == nil {
+		if o.Content == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &iam.ListAttachedGroupPoliciesInput{}
-		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		hasNextToken = false
 
-		opt = append(opt, o.AttachedPolicies...)
+		opt = o.Content
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetAttachedRolePolicies(ctx context.Context, input *iam.ListAttachedRolePoliciesInput) ([]*iam.AttachedPolicy, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetLambdaPolicy(ctx context.Context, input *lambda.GetPolicyInput) (*string, error) {
+	if c.svc.lambda == nil {
+		c.svc.lambda = lambda.New(c.svc.session)
 	}
 
-	opt := make([]*iam.AttachedPolicy, 0)
+	var opt *string
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListAttachedRolePoliciesWithContext(ctx, input)
+		o, err := c.svc.lambda.GetPolicyWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.AttachedPolicies == nil {
+		if o.Policy == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &iam.ListAttachedRolePoliciesInput{}
-		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		hasNextToken = false
 
-		opt = append(opt, o.AttachedPolicies...)
+		opt = o.Policy
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetAttachedUserPolicies(ctx context.Context, input *iam.ListAttachedUserPoliciesInput) ([]*iam.AttachedPolicy, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetLightsailInstances(ctx context.Context, input *lightsail.GetInstancesInput) ([]*lightsail.Instance, error) {
+	if c.svc.lightsail == nil {
+		c.svc.lightsail = lightsail.New(c.svc.session)
 	}
 
-	opt := make([]*iam.AttachedPolicy, 0)
+	opt := make([]*lightsail.Instance, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListAttachedUserPoliciesWithContext(ctx, input)
+		o, err := c.svc.lightsail.GetInstancesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.AttachedPolicies == nil {
+		if o.Instances == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListAttachedUserPoliciesInput{}
+			input = &lightsail.GetInstancesInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.PageToken = o.NextPageToken
+		hasNextToken = o.NextPageToken != nil
 
-		opt = append(opt, o.AttachedPolicies...)
+		opt = append(opt, o.Instances...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetGroupUsers(ctx context.Context, input *iam.GetGroupInput) ([]*iam.User, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetMediastoreContainers(ctx context.Context, input *mediastore.ListContainersInput) ([]*mediastore.Container, error) {
+	if c.svc.mediastore == nil {
+		c.svc.mediastore = mediastore.New(c.svc.session)
 	}
 
-	opt := make([]*iam.User, 0)
+	opt := make([]*mediastore.Container, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.GetGroupWithContext(ctx, input)
+		o, err := c.svc.mediastore.ListContainersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Users == nil {
+		if o.Containers == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.GetGroupInput{}
+			input = &mediastore.ListContainersInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Users...)
+		opt = append(opt, o.Containers...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetGroupPolicies(ctx context.Context, input *iam.ListGroupPoliciesInput) ([]*string, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetMemoryDBClusters(ctx context.Context, input *memorydb.DescribeClustersInput) ([]*memorydb.Cluster, error) {
+	if c.svc.memorydb == nil {
+		c.svc.memorydb = memorydb.New(c.svc.session)
 	}
 
-	opt := make([]*string, 0)
+	opt := make([]*memorydb.Cluster, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListGroupPoliciesWithContext(ctx, input)
+		o, err := c.svc.memorydb.DescribeClustersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.PolicyNames == nil {
+		if o.Clusters == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListGroupPoliciesInput{}
+			input = &memorydb.DescribeClustersInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.PolicyNames...)
+		opt = append(opt, o.Clusters...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetGroups(ctx context.Context, input *iam.ListGroupsInput) ([]*iam.Group, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetMQBrokers(ctx context.Context, input *mq.ListBrokersInput) ([]*mq.BrokerSummary, error) {
+	if c.svc.mq == nil {
+		c.svc.mq = mq.New(c.svc.session)
 	}
 
-	opt := make([]*iam.Group, 0)
+	opt := make([]*mq.BrokerSummary, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListGroupsWithContext(ctx, input)
+		o, err := c.svc.mq.ListBrokersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Groups == nil {
+		if o.BrokerSummaries == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListGroupsInput{}
+			input = &mq.ListBrokersInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Groups...)
+		opt = append(opt, o.BrokerSummaries...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetGroupsForUser(ctx context.Context, input *iam.ListGroupsForUserInput) ([]*iam.Group, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetMQConfigurations(ctx context.Context, input *mq.ListConfigurationsInput) ([]*mq.Configuration, error) {
+	if c.svc.mq == nil {
+		c.svc.mq = mq.New(c.svc.session)
 	}
 
-	opt := make([]*iam.Group, 0)
+	opt := make([]*mq.Configuration, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListGroupsForUserWithContext(ctx, input)
+		o, err := c.svc.mq.ListConfigurationsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Groups == nil {
+		if o.Configurations == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListGroupsForUserInput{}
+			input = &mq.ListConfigurationsInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Groups...)
+		opt = append(opt, o.Configurations...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetInstanceProfiles(ctx context.Context, input *iam.ListInstanceProfilesInput) ([]*iam.InstanceProfile, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetMWAAEnvironments(ctx context.Context, input *mwaa.ListEnvironmentsInput) ([]*string, error) {
+	if c.svc.mwaa == nil {
+		c.svc.mwaa = mwaa.New(c.svc.session)
 	}
 
-	opt := make([]*iam.InstanceProfile, 0)
+	opt := make([]*string, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListInstanceProfilesWithContext(ctx, input)
+		o, err := c.svc.mwaa.ListEnvironmentsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.InstanceProfiles == nil {
+		if o.Environments == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListInstanceProfilesInput{}
+			input = &mwaa.ListEnvironmentsInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.InstanceProfiles...)
+		opt = append(opt, o.Environments...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetOpenIDConnectProviders(ctx context.Context, input *iam.ListOpenIDConnectProvidersInput) ([]*iam.OpenIDConnectProviderListEntry, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetNeptuneDBClusters(ctx context.Context, input *neptune.DescribeDBClustersInput) ([]*neptune.DBCluster, error) {
+	if c.svc.neptune == nil {
+		c.svc.neptune = neptune.New(c.svc.session)
 	}
 
-	opt := make([]*iam.OpenIDConnectProviderListEntry, 0)
+	opt := make([]*neptune.DBCluster, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListOpenIDConnectProvidersWithContext(ctx, input)
+		o, err := c.svc.neptune.DescribeDBClustersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.OpenIDConnectProviderList == nil {
+		if o.DBClusters == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &neptune.DescribeDBClustersInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.OpenIDConnectProviderList...)
+		opt = append(opt, o.DBClusters...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetPolicies(ctx context.Context, input *iam.ListPoliciesInput) ([]*iam.Policy, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetRDSDBClusters(ctx context.Context, input *rds.DescribeDBClustersInput) ([]*rds.DBCluster, error) {
+	if c.svc.rds == nil {
+		c.svc.rds = rds.New(c.svc.session)
 	}
 
-	opt := make([]*iam.Policy, 0)
+	opt := make([]*rds.DBCluster, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListPoliciesWithContext(ctx, input)
+		o, err := c.svc.rds.DescribeDBClustersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Policies == nil {
+		if o.DBClusters == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListPoliciesInput{}
+			input = &rds.DescribeDBClustersInput{}
 		}
 		input.Marker = o.Marker
 		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.Policies...)
+		opt = append(opt, o.DBClusters...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetRolePolicies(ctx context.Context, input *iam.ListRolePoliciesInput) ([]*string, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetDBInstances(ctx context.Context, input *rds.DescribeDBInstancesInput) ([]*rds.DBInstance, error) {
+	if c.svc.rds == nil {
+		c.svc.rds = rds.New(c.svc.session)
 	}
 
-	opt := make([]*string, 0)
+	opt := make([]*rds.DBInstance, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListRolePoliciesWithContext(ctx, input)
+		o, err := c.svc.rds.DescribeDBInstancesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.PolicyNames == nil {
+		if o.DBInstances == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListRolePoliciesInput{}
+			input = &rds.DescribeDBInstancesInput{}
 		}
 		input.Marker = o.Marker
 		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.PolicyNames...)
+		opt = append(opt, o.DBInstances...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetRoles(ctx context.Context, input *iam.ListRolesInput) ([]*iam.Role, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetDBParameterGroups(ctx context.Context, input *rds.DescribeDBParameterGroupsInput) ([]*rds.DBParameterGroup, error) {
+	if c.svc.rds == nil {
+		c.svc.rds = rds.New(c.svc.session)
 	}
 
-	opt := make([]*iam.Role, 0)
+	opt := make([]*rds.DBParameterGroup, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListRolesWithContext(ctx, input)
+		o, err := c.svc.rds.DescribeDBParameterGroupsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Roles == nil {
+		if o.DBParameterGroups == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListRolesInput{}
+			input = &rds.DescribeDBParameterGroupsInput{}
 		}
 		input.Marker = o.Marker
 		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.Roles...)
+		opt = append(opt, o.DBParameterGroups...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetSAMLProviders(ctx context.Context, input *iam.ListSAMLProvidersInput) ([]*iam.SAMLProviderListEntry, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetDBSubnetGroups(ctx context.Context, input *rds.DescribeDBSubnetGroupsInput) ([]*rds.DBSubnetGroup, error) {
+	if c.svc.rds == nil {
+		c.svc.rds = rds.New(c.svc.session)
 	}
 
-	opt := make([]*iam.SAMLProviderListEntry, 0)
+	opt := make([]*rds.DBSubnetGroup, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListSAMLProvidersWithContext(ctx, input)
+		o, err := c.svc.rds.DescribeDBSubnetGroupsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.SAMLProviderList == nil {
+		if o.DBSubnetGroups == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &rds.DescribeDBSubnetGroupsInput{}
+		}
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.SAMLProviderList...)
+		opt = append(opt, o.DBSubnetGroups...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetServerCertificates(ctx context.Context, input *iam.ListServerCertificatesInput) ([]*iam.ServerCertificateMetadata, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetRDSGlobalClusters(ctx context.Context, input *rds.DescribeGlobalClustersInput) ([]*rds.GlobalCluster, error) {
+	if c.svc.rds == nil {
+		c.svc.rds = rds.New(c.svc.session)
 	}
 
-	opt := make([]*iam.ServerCertificateMetadata, 0)
+	opt := make([]*rds.GlobalCluster, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListServerCertificatesWithContext(ctx, input)
+		o, err := c.svc.rds.DescribeGlobalClustersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ServerCertificateMetadataList == nil {
+		if o.GlobalClusters == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListServerCertificatesInput{}
+			input = &rds.DescribeGlobalClustersInput{}
 		}
 		input.Marker = o.Marker
 		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.ServerCertificateMetadataList...)
+		opt = append(opt, o.GlobalClusters...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetSSHPublicKeys(ctx context.Context, input *iam.ListSSHPublicKeysInput) ([]*iam.SSHPublicKeyMetadata, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetDBInstancesTags(ctx context.Context, input *rds.ListTagsForResourceInput) ([]*rds.Tag, error) {
+	if c.svc.rds == nil {
+		c.svc.rds = rds.New(c.svc.session)
 	}
 
-	opt := make([]*iam.SSHPublicKeyMetadata, 0)
+	opt := make([]*rds.Tag, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListSSHPublicKeysWithContext(ctx, input)
+		o, err := c.svc.rds.ListTagsForResourceWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.SSHPublicKeys == nil {
+		if o.TagList == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &iam.ListSSHPublicKeysInput{}
-		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		hasNextToken = false
 
-		opt = append(opt, o.SSHPublicKeys...)
+		opt = append(opt, o.TagList...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetUserPolicies(ctx context.Context, input *iam.ListUserPoliciesInput) ([]*string, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetRedshiftClusters(ctx context.Context, input *redshift.DescribeClustersInput) ([]*redshift.Cluster, error) {
+	if c.svc.redshift == nil {
+		c.svc.redshift = redshift.New(c.svc.session)
 	}
 
-	opt := make([]*string, 0)
+	opt := make([]*redshift.Cluster, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListUserPoliciesWithContext(ctx, input)
+		o, err := c.svc.redshift.DescribeClustersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.PolicyNames == nil {
+		if o.Clusters == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListUserPoliciesInput{}
+			input = &redshift.DescribeClustersInput{}
 		}
 		input.Marker = o.Marker
 		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.PolicyNames...)
+		opt = append(opt, o.Clusters...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetUsers(ctx context.Context, input *iam.ListUsersInput) ([]*iam.User, error) {
-	if c.svc.iam == nil {
-		c.svc.iam = iam.New(c.svc.session)
+func (c *connector) GetQueryLoggingConfigs(ctx context.Context, input *route53.ListQueryLoggingConfigsInput) ([]*route53.QueryLoggingConfig, error) {
+	if c.svc.route53 == nil {
+		c.svc.route53 = route53.New(c.svc.globalSession)
 	}
 
-	opt := make([]*iam.User, 0)
+	opt := make([]*route53.QueryLoggingConfig, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.iam.ListUsersWithContext(ctx, input)
+		o, err := c.svc.route53.ListQueryLoggingConfigsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Users == nil {
+		if o.QueryLoggingConfigs == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &iam.ListUsersInput{}
+			input = &route53.ListQueryLoggingConfigsInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Users...)
+		opt = append(opt, o.QueryLoggingConfigs...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetKinesisStreams(ctx context.Context, input *kinesis.ListStreamsInput) ([]*string, error) {
-	if c.svc.kinesis == nil {
-		c.svc.kinesis = kinesis.New(c.svc.session)
+func (c *connector) GetHealthChecks(ctx context.Context, input *route53.ListHealthChecksInput) ([]*route53.HealthCheck, error) {
+	if c.svc.route53 == nil {
+		c.svc.route53 = route53.New(c.svc.globalSession)
 	}
 
-	opt := make([]*string, 0)
+	opt := make([]*route53.HealthCheck, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.kinesis.ListStreamsWithContext(ctx, input)
+		o, err := c.svc.route53.ListHealthChecksWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.StreamNames == nil {
+		if o.HealthChecks == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
-
-		opt = append(opt, o.StreamNames...)
+		if input == nil {
+			input = &route53.ListHealthChecksInput{}
+		}
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
+
+		opt = append(opt, o.HealthChecks...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLambdaFunctions(ctx context.Context, input *lambda.ListFunctionsInput) ([]*lambda.FunctionConfiguration, error) {
-	if c.svc.lambda == nil {
-		c.svc.lambda = lambda.New(c.svc.session)
+func (c *connector) GetHostedZones(ctx context.Context, input *route53.ListHostedZonesInput) ([]*route53.HostedZone, error) {
+	if c.svc.route53 == nil {
+		c.svc.route53 = route53.New(c.svc.globalSession)
 	}
 
-	opt := make([]*lambda.FunctionConfiguration, 0)
+	opt := make([]*route53.HostedZone, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.lambda.ListFunctionsWithContext(ctx, input)
+		o, err := c.svc.route53.ListHostedZonesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Functions == nil {
+		if o.HostedZones == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &lambda.ListFunctionsInput{}
+			input = &route53.ListHostedZonesInput{}
 		}
 		input.Marker = o.NextMarker
 		hasNextToken = o.NextMarker != nil
 
-		opt = append(opt, o.Functions...)
+		opt = append(opt, o.HostedZones...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetLightsailInstances(ctx context.Context, input *lightsail.GetInstancesInput) ([]*lightsail.Instance, error) {
-	if c.svc.lightsail == nil {
-		c.svc.lightsail = lightsail.New(c.svc.session)
+func (c *connector) GetHostedZoneVPCs(ctx context.Context, input *route53.GetHostedZoneInput) ([]*route53.VPC, error) {
+	if c.svc.route53 == nil {
+		c.svc.route53 = route53.New(c.svc.globalSession)
 	}
 
-	opt := make([]*lightsail.Instance, 0)
+	opt := make([]*route53.VPC, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.lightsail.GetInstancesWithContext(ctx, input)
+		o, err := c.svc.route53.GetHostedZoneWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Instances == nil {
+		if o.VPCs == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &lightsail.GetInstancesInput{}
-		}
-		input.PageToken = o.NextPageToken
-		hasNextToken = o.NextPageToken != nil
+		hasNextToken = false
 
-		opt = append(opt, o.Instances...)
+		opt = append(opt, o.VPCs...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetMediastoreContainers(ctx context.Context, input *mediastore.ListContainersInput) ([]*mediastore.Container, error) {
-	if c.svc.mediastore == nil {
-		c.svc.mediastore = mediastore.New(c.svc.session)
+func (c *connector) GetReusableDelegationSets(ctx context.Context, input *route53.ListReusableDelegationSetsInput) ([]*route53.DelegationSet, error) {
+	if c.svc.route53 == nil {
+		c.svc.route53 = route53.New(c.svc.globalSession)
 	}
 
-	opt := make([]*mediastore.Container, 0)
+	opt := make([]*route53.DelegationSet, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.mediastore.ListContainersWithContext(ctx, input)
+		o, err := c.svc.route53.ListReusableDelegationSetsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Containers == nil {
+		if o.DelegationSets == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &mediastore.ListContainersInput{}
+			input = &route53.ListReusableDelegationSetsInput{}
 		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
 
-		opt = append(opt, o.Containers...)
+		opt = append(opt, o.DelegationSets...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetMQBrokers(ctx context.Context, input *mq.ListBrokersInput) ([]*mq.BrokerSummary, error) {
-	if c.svc.mq == nil {
-		c.svc.mq = mq.New(c.svc.session)
+func (c *connector) GetVPCAssociationAuthorizations(ctx context.Context, input *route53.ListVPCAssociationAuthorizationsInput) ([]*route53.VPC, error) {
+	if c.svc.route53 == nil {
+		c.svc.route53 = route53.New(c.svc.globalSession)
 	}
 
-	opt := make([]*mq.BrokerSummary, 0)
+	opt := make([]*route53.VPC, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.mq.ListBrokersWithContext(ctx, input)
+		o, err := c.svc.route53.ListVPCAssociationAuthorizationsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.BrokerSummaries == nil {
+		if o.VPCs == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &mq.ListBrokersInput{}
+			input = &route53.ListVPCAssociationAuthorizationsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.BrokerSummaries...)
+		opt = append(opt, o.VPCs...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetNeptuneDBClusters(ctx context.Context, input *neptune.DescribeDBClustersInput) ([]*neptune.DBCluster, error) {
-	if c.svc.neptune == nil {
-		c.svc.neptune = neptune.New(c.svc.session)
+func (c *connector) GetResolverEndpoints(ctx context.Context, input *route53resolver.ListResolverEndpointsInput) ([]*route53resolver.ResolverEndpoint, error) {
+	if c.svc.route53resolver == nil {
+		c.svc.route53resolver = route53resolver.New(c.svc.session)
 	}
 
-	opt := make([]*neptune.DBCluster, 0)
+	opt := make([]*route53resolver.ResolverEndpoint, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.neptune.DescribeDBClustersWithContext(ctx, input)
+		o, err := c.svc.route53resolver.ListResolverEndpointsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.DBClusters == nil {
+		if o.ResolverEndpoints == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &neptune.DescribeDBClustersInput{}
+			input = &route53resolver.ListResolverEndpointsInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.DBClusters...)
+		opt = append(opt, o.ResolverEndpoints...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetRDSDBClusters(ctx context.Context, input *rds.DescribeDBClustersInput) ([]*rds.DBCluster, error) {
-	if c.svc.rds == nil {
-		c.svc.rds = rds.New(c.svc.session)
+func (c *connector) GetResolverRuleAssociations(ctx context.Context, input *route53resolver.ListResolverRuleAssociationsInput) ([]*route53resolver.ResolverRuleAssociation, error) {
+	if c.svc.route53resolver == nil {
+		c.svc.route53resolver = route53resolver.New(c.svc.session)
 	}
 
-	opt := make([]*rds.DBCluster, 0)
+	opt := make([]*route53resolver.ResolverRuleAssociation, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.rds.DescribeDBClustersWithContext(ctx, input)
+		o, err := c.svc.route53resolver.ListResolverRuleAssociationsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.DBClusters == nil {
+		if o.ResolverRuleAssociations == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &rds.DescribeDBClustersInput{}
+			input = &route53resolver.ListResolverRuleAssociationsInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.DBClusters...)
+		opt = append(opt, o.ResolverRuleAssociations...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetDBInstances(ctx context.Context, input *rds.DescribeDBInstancesInput) ([]*rds.DBInstance, error) {
-	if c.svc.rds == nil {
-		c.svc.rds = rds.New(c.svc.session)
+func (c *connector) GetResolverRules(ctx context.Context, input *route53resolver.ListResolverRulesInput) ([]*route53resolver.ResolverRule, error) {
+	if c.svc.route53resolver == nil {
+		c.svc.route53resolver = route53resolver.New(c.svc.session)
 	}
 
-	opt := make([]*rds.DBInstance, 0)
+	opt := make([]*route53resolver.ResolverRule, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.rds.DescribeDBInstancesWithContext(ctx, input)
+		o, err := c.svc.route53resolver.ListResolverRulesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.DBInstances == nil {
+		if o.ResolverRules == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &rds.DescribeDBInstancesInput{}
+			input = &route53resolver.ListResolverRulesInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.DBInstances...)
+		opt = append(opt, o.ResolverRules...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetDBParameterGroups(ctx context.Context, input *rds.DescribeDBParameterGroupsInput) ([]*rds.DBParameterGroup, error) {
-	if c.svc.rds == nil {
-		c.svc.rds = rds.New(c.svc.session)
+func (c *connector) GetBucketTags(ctx context.Context, input *s3.GetBucketTaggingInput) ([]*s3.Tag, error) {
+	if c.svc.s3 == nil {
+		c.svc.s3 = s3.New(c.svc.session)
 	}
 
-	opt := make([]*rds.DBParameterGroup, 0)
+	opt := make([]*s3.Tag, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.rds.DescribeDBParameterGroupsWithContext(ctx, input)
+		o, err := c.svc.s3.GetBucketTaggingWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.DBParameterGroups == nil {
+		if o.TagSet == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &rds.DescribeDBParameterGroupsInput{}
-		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		hasNextToken = false
 
-		opt = append(opt, o.DBParameterGroups...)
+		opt = append(opt, o.TagSet...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetDBSubnetGroups(ctx context.Context, input *rds.DescribeDBSubnetGroupsInput) ([]*rds.DBSubnetGroup, error) {
-	if c.svc.rds == nil {
-		c.svc.rds = rds.New(c.svc.session)
+func (c *connector) ListObjects(ctx context.Context, input *s3.ListObjectsInput) ([]*s3.Object, error) {
+	if c.svc.s3 == nil {
+		c.svc.s3 = s3.New(c.svc.session)
 	}
 
-	opt := make([]*rds.DBSubnetGroup, 0)
+	opt := make([]*s3.Object, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.rds.DescribeDBSubnetGroupsWithContext(ctx, input)
+		o, err := c.svc.s3.ListObjectsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.DBSubnetGroups == nil {
+		if o.Contents == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &rds.DescribeDBSubnetGroupsInput{}
+			input = &s3.ListObjectsInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.Marker = o.NextMarker
+		hasNextToken = o.NextMarker != nil
 
-		opt = append(opt, o.DBSubnetGroups...)
+		opt = append(opt, o.Contents...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetRDSGlobalClusters(ctx context.Context, input *rds.DescribeGlobalClustersInput) ([]*rds.GlobalCluster, error) {
-	if c.svc.rds == nil {
-		c.svc.rds = rds.New(c.svc.session)
+func (c *connector) GetObjectsTags(ctx context.Context, input *s3.GetObjectTaggingInput) ([]*s3.Tag, error) {
+	if c.svc.s3 == nil {
+		c.svc.s3 = s3.New(c.svc.session)
 	}
 
-	opt := make([]*rds.GlobalCluster, 0)
+	opt := make([]*s3.Tag, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.rds.DescribeGlobalClustersWithContext(ctx, input)
+		o, err := c.svc.s3.GetObjectTaggingWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.GlobalClusters == nil {
+		if o.TagSet == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &rds.DescribeGlobalClustersInput{}
-		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		hasNextToken = false
 
-		opt = append(opt, o.GlobalClusters...)
+		opt = append(opt, o.TagSet...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetDBInstancesTags(ctx context.Context, input *rds.ListTagsForResourceInput) ([]*rds.Tag, error) {
-	if c.svc.rds == nil {
-		c.svc.rds = rds.New(c.svc.session)
+func (c *connector) GetSecretsManagerSecrets(ctx context.Context, input *secretsmanager.ListSecretsInput) ([]*secretsmanager.SecretListEntry, error) {
+	if c.svc.secretsmanager == nil {
+		c.svc.secretsmanager = secretsmanager.New(c.svc.session)
 	}
 
-	opt := make([]*rds.Tag, 0)
+	opt := make([]*secretsmanager.SecretListEntry, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.rds.ListTagsForResourceWithContext(ctx, input)
+		o, err := c.svc.secretsmanager.ListSecretsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TagList == nil {
+		if o.SecretList == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &secretsmanager.ListSecretsInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.TagList...)
+		opt = append(opt, o.SecretList...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetRedshiftClusters(ctx context.Context, input *redshift.DescribeClustersInput) ([]*redshift.Cluster, error) {
-	if c.svc.redshift == nil {
-		c.svc.redshift = redshift.New(c.svc.session)
+func (c *connector) GetServiceDiscoveryNamespaces(ctx context.Context, input *servicediscovery.ListNamespacesInput) ([]*servicediscovery.NamespaceSummary, error) {
+	if c.svc.servicediscovery == nil {
+		c.svc.servicediscovery = servicediscovery.New(c.svc.session)
 	}
 
-	opt := make([]*redshift.Cluster, 0)
+	opt := make([]*servicediscovery.NamespaceSummary, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.redshift.DescribeClustersWithContext(ctx, input)
+		o, err := c.svc.servicediscovery.ListNamespacesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Clusters == nil {
+		if o.Namespaces == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &redshift.DescribeClustersInput{}
+			input = &servicediscovery.ListNamespacesInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Clusters...)
+		opt = append(opt, o.Namespaces...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetQueryLoggingConfigs(ctx context.Context, input *route53.ListQueryLoggingConfigsInput) ([]*route53.QueryLoggingConfig, error) {
-	if c.svc.route53 == nil {
-		c.svc.route53 = route53.New(c.svc.session)
+func (c *connector) GetServiceDiscoveryServices(ctx context.Context, input *servicediscovery.ListServicesInput) ([]*servicediscovery.ServiceSummary, error) {
+	if c.svc.servicediscovery == nil {
+		c.svc.servicediscovery = servicediscovery.New(c.svc.session)
 	}
 
-	opt := make([]*route53.QueryLoggingConfig, 0)
+	opt := make([]*servicediscovery.ServiceSummary, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.route53.ListQueryLoggingConfigsWithContext(ctx, input)
+		o, err := c.svc.servicediscovery.ListServicesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.QueryLoggingConfigs == nil {
+		if o.Services == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &route53.ListQueryLoggingConfigsInput{}
+			input = &servicediscovery.ListServicesInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.QueryLoggingConfigs...)
+		opt = append(opt, o.Services...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetHealthChecks(ctx context.Context, input *route53.ListHealthChecksInput) ([]*route53.HealthCheck, error) {
-	if c.svc.route53 == nil {
-		c.svc.route53 = route53.New(c.svc.session)
+func (c *connector) GetActiveReceiptRuleSet(ctx context.Context, input *ses.DescribeActiveReceiptRuleSetInput) (*string, error) {
+	if c.svc.ses == nil {
+		c.svc.ses = ses.New(c.svc.session)
 	}
 
-	opt := make([]*route53.HealthCheck, 0)
+	var opt *string
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.route53.ListHealthChecksWithContext(ctx, input)
+		o, err := c.svc.ses.DescribeActiveReceiptRuleSetWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.HealthChecks == nil {
+		if o.Metadata == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &route53.ListHealthChecksInput{}
-		}
-		input.Marker = o.NextMarker
-		hasNextToken = o.NextMarker != nil
+		hasNextToken = false
 
-		opt = append(opt, o.HealthChecks...)
+		opt = o.Metadata.Name
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetHostedZones(ctx context.Context, input *route53.ListHostedZonesInput) ([]*route53.HostedZone, error) {
-	if c.svc.route53 == nil {
-		c.svc.route53 = route53.New(c.svc.session)
+func (c *connector) GetActiveReceiptRulesSet(ctx context.Context, input *ses.DescribeActiveReceiptRuleSetInput) ([]*ses.ReceiptRule, error) {
+	if c.svc.ses == nil {
+		c.svc.ses = ses.New(c.svc.session)
 	}
 
-	opt := make([]*route53.HostedZone, 0)
+	opt := make([]*ses.ReceiptRule, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.route53.ListHostedZonesWithContext(ctx, input)
+		o, err := c.svc.ses.DescribeActiveReceiptRuleSetWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.HostedZones == nil {
+		if o.Rules == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &route53.ListHostedZonesInput{}
-		}
-		input.Marker = o.NextMarker
-		hasNextToken = o.NextMarker != nil
+		hasNextToken = false
 
-		opt = append(opt, o.HostedZones...)
+		opt = append(opt, o.Rules...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetResourceRecordSets(ctx context.Context, input *route53.ListResourceRecordSetsInput) ([]*route53.ResourceRecordSet, error) {
-	if c.svc.route53 == nil {
-		c.svc.route53 = route53.New(c.svc.session)
+func (c *connector) GetConfigurationSets(ctx context.Context, input *ses.ListConfigurationSetsInput) ([]*ses.ConfigurationSet, error) {
+	if c.svc.ses == nil {
+		c.svc.ses = ses.New(c.svc.session)
 	}
 
-	opt := make([]*route53.ResourceRecordSet, 0)
+	opt := make([]*ses.ConfigurationSet, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.route53.ListResourceRecordSetsWithContext(ctx, input)
+		o, err := c.svc.ses.ListConfigurationSetsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ResourceRecordSets == nil {
+		if o.ConfigurationSets == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &route53.ListResourceRecordSetsInput{}
+			input = &ses.ListConfigurationSetsInput{}
 		}
-		input.StartRecordName = o.NextRecordName
-		hasNextToken = o.NextRecordName != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.ResourceRecordSets...)
+		opt = append(opt, o.ConfigurationSets...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetReusableDelegationSets(ctx context.Context, input *route53.ListReusableDelegationSetsInput) ([]*route53.DelegationSet, error) {
-	if c.svc.route53 == nil {
-		c.svc.route53 = route53.New(c.svc.session)
+func (c *connector) GetIdentities(ctx context.Context, input *ses.ListIdentitiesInput) ([]*string, error) {
+	if c.svc.ses == nil {
+		c.svc.ses = ses.New(c.svc.session)
 	}
 
-	opt := make([]*route53.DelegationSet, 0)
+	opt := make([]*string, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.route53.ListReusableDelegationSetsWithContext(ctx, input)
+		o, err := c.svc.ses.ListIdentitiesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.DelegationSets == nil {
+		if o.Identities == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &route53.ListReusableDelegationSetsInput{}
+			input = &ses.ListIdentitiesInput{}
 		}
-		input.Marker = o.NextMarker
-		hasNextToken = o.NextMarker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.DelegationSets...)
+		opt = append(opt, o.Identities...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetVPCAssociationAuthorizations(ctx context.Context, input *route53.ListVPCAssociationAuthorizationsInput) ([]*route53.VPC, error) {
-	if c.svc.route53 == nil {
-		c.svc.route53 = route53.New(c.svc.session)
+func (c *connector) GetIdentityNotificationAttributes(ctx context.Context, input *ses.GetIdentityNotificationAttributesInput) (map[string]*ses.IdentityNotificationAttributes, error) {
+	if c.svc.ses == nil {
+		c.svc.ses = ses.New(c.svc.session)
 	}
 
-	opt := make([]*route53.VPC, 0)
+	opt := make(map[string]*ses.IdentityNotificationAttributes, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.route53.ListVPCAssociationAuthorizationsWithContext(ctx, input)
+		o, err := c.svc.ses.GetIdentityNotificationAttributesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.VPCs == nil {
+		if o.NotificationAttributes == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &route53.ListVPCAssociationAuthorizationsInput{}
-		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		hasNextToken = false
 
-		opt = append(opt, o.VPCs...)
+		opt = o.NotificationAttributes
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetResolverEndpoints(ctx context.Context, input *route53resolver.ListResolverEndpointsInput) ([]*route53resolver.ResolverEndpoint, error) {
-	if c.svc.route53resolver == nil {
-		c.svc.route53resolver = route53resolver.New(c.svc.session)
+func (c *connector) GetReceiptFilters(ctx context.Context, input *ses.ListReceiptFiltersInput) ([]*ses.ReceiptFilter, error) {
+	if c.svc.ses == nil {
+		c.svc.ses = ses.New(c.svc.session)
 	}
 
-	opt := make([]*route53resolver.ResolverEndpoint, 0)
+	opt := make([]*ses.ReceiptFilter, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.route53resolver.ListResolverEndpointsWithContext(ctx, input)
+		o, err := c.svc.ses.ListReceiptFiltersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ResolverEndpoints == nil {
+		if o.Filters == nil {
 			hasNextToken = false
 			continue
 		}
 
-		if input == nil {
-			input = &route53resolver.ListResolverEndpointsInput{}
-		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		hasNextToken = false
 
-		opt = append(opt, o.ResolverEndpoints...)
+		opt = append(opt, o.Filters...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetResolverRuleAssociations(ctx context.Context, input *route53resolver.ListResolverRuleAssociationsInput) ([]*route53resolver.ResolverRuleAssociation, error) {
-	if c.svc.route53resolver == nil {
-		c.svc.route53resolver = route53resolver.New(c.svc.session)
+func (c *connector) GetTemplates(ctx context.Context, input *ses.ListTemplatesInput) ([]*ses.TemplateMetadata, error) {
+	if c.svc.ses == nil {
+		c.svc.ses = ses.New(c.svc.session)
 	}
 
-	opt := make([]*route53resolver.ResolverRuleAssociation, 0)
+	opt := make([]*ses.TemplateMetadata, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.route53resolver.ListResolverRuleAssociationsWithContext(ctx, input)
+		o, err := c.svc.ses.ListTemplatesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ResolverRuleAssociations == nil {
+		if o.TemplatesMetadata == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &route53resolver.ListResolverRuleAssociationsInput{}
+			input = &ses.ListTemplatesInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.ResolverRuleAssociations...)
+		opt = append(opt, o.TemplatesMetadata...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetResolverRules(ctx context.Context, input *route53resolver.ListResolverRulesInput) ([]*route53resolver.ResolverRule, error) {
-	if c.svc.route53resolver == nil {
-		c.svc.route53resolver = route53resolver.New(c.svc.session)
+func (c *connector) GetShieldProtections(ctx context.Context, input *shield.ListProtectionsInput) ([]*shield.Protection, error) {
+	if c.svc.shield == nil {
+		c.svc.shield = shield.New(c.svc.globalSession)
 	}
 
-	opt := make([]*route53resolver.ResolverRule, 0)
+	opt := make([]*shield.Protection, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.route53resolver.ListResolverRulesWithContext(ctx, input)
+		o, err := c.svc.shield.ListProtectionsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ResolverRules == nil {
+		if o.Protections == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &route53resolver.ListResolverRulesInput{}
+			input = &shield.ListProtectionsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.ResolverRules...)
+		opt = append(opt, o.Protections...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetBucketTags(ctx context.Context, input *s3.GetBucketTaggingInput) ([]*s3.Tag, error) {
-	if c.svc.s3 == nil {
-		c.svc.s3 = s3.New(c.svc.session)
+func (c *connector) GetSQSQueues(ctx context.Context, input *sqs.ListQueuesInput) ([]*string, error) {
+	if c.svc.sqs == nil {
+		c.svc.sqs = sqs.New(c.svc.session)
 	}
 
-	opt := make([]*s3.Tag, 0)
+	opt := make([]*string, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.s3.GetBucketTaggingWithContext(ctx, input)
+		o, err := c.svc.sqs.ListQueuesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TagSet == nil {
+		if o.QueueUrls == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &sqs.ListQueuesInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.TagSet...)
+		opt = append(opt, o.QueueUrls...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) ListObjects(ctx context.Context, input *s3.ListObjectsInput) ([]*s3.Object, error) {
-	if c.svc.s3 == nil {
-		c.svc.s3 = s3.New(c.svc.session)
+func (c *connector) GetSSMParameters(ctx context.Context, input *ssm.DescribeParametersInput) ([]*ssm.ParameterMetadata, error) {
+	if c.svc.ssm == nil {
+		c.svc.ssm = ssm.New(c.svc.session)
 	}
 
-	opt := make([]*s3.Object, 0)
+	opt := make([]*ssm.ParameterMetadata, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.s3.ListObjectsWithContext(ctx, input)
+		o, err := c.svc.ssm.DescribeParametersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Contents == nil {
+		if o.Parameters == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &s3.ListObjectsInput{}
+			input = &ssm.DescribeParametersInput{}
 		}
-		input.Marker = o.NextMarker
-		hasNextToken = o.NextMarker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Contents...)
+		opt = append(opt, o.Parameters...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetObjectsTags(ctx context.Context, input *s3.GetObjectTaggingInput) ([]*s3.Tag, error) {
-	if c.svc.s3 == nil {
-		c.svc.s3 = s3.New(c.svc.session)
+func (c *connector) GetSSOAdminInstances(ctx context.Context, input *ssoadmin.ListInstancesInput) ([]*ssoadmin.InstanceMetadata, error) {
+	if c.svc.ssoadmin == nil {
+		c.svc.ssoadmin = ssoadmin.New(c.svc.session)
 	}
 
-	opt := make([]*s3.Tag, 0)
+	opt := make([]*ssoadmin.InstanceMetadata, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.s3.GetObjectTaggingWithContext(ctx, input)
+		o, err := c.svc.ssoadmin.ListInstancesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TagSet == nil {
+		if o.Instances == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &ssoadmin.ListInstancesInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.TagSet...)
+		opt = append(opt, o.Instances...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetActiveReceiptRuleSet(ctx context.Context, input *ses.DescribeActiveReceiptRuleSetInput) (*string, error) {
-	if c.svc.ses == nil {
-		c.svc.ses = ses.New(c.svc.session)
+func (c *connector) GetSSOAdminPermissionSets(ctx context.Context, input *ssoadmin.ListPermissionSetsInput) ([]*string, error) {
+	if c.svc.ssoadmin == nil {
+		c.svc.ssoadmin = ssoadmin.New(c.svc.session)
 	}
 
-	var opt *string
+	opt := make([]*string, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ses.DescribeActiveReceiptRuleSetWithContext(ctx, input)
+		o, err := c.svc.ssoadmin.ListPermissionSetsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Metadata == nil {
+		if o.PermissionSets == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &ssoadmin.ListPermissionSetsInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = o.Metadata.Name
+		opt = append(opt, o.PermissionSets...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetActiveReceiptRulesSet(ctx context.Context, input *ses.DescribeActiveReceiptRuleSetInput) ([]*ses.ReceiptRule, error) {
-	if c.svc.ses == nil {
-		c.svc.ses = ses.New(c.svc.session)
+func (c *connector) GetSSOAdminManagedPoliciesInPermissionSet(ctx context.Context, input *ssoadmin.ListManagedPoliciesInPermissionSetInput) ([]*ssoadmin.AttachedManagedPolicy, error) {
+	if c.svc.ssoadmin == nil {
+		c.svc.ssoadmin = ssoadmin.New(c.svc.session)
 	}
 
-	opt := make([]*ses.ReceiptRule, 0)
+	opt := make([]*ssoadmin.AttachedManagedPolicy, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ses.DescribeActiveReceiptRuleSetWithContext(ctx, input)
+		o, err := c.svc.ssoadmin.ListManagedPoliciesInPermissionSetWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Rules == nil {
+		if o.AttachedManagedPolicies == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &ssoadmin.ListManagedPoliciesInPermissionSetInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Rules...)
+		opt = append(opt, o.AttachedManagedPolicies...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetConfigurationSets(ctx context.Context, input *ses.ListConfigurationSetsInput) ([]*ses.ConfigurationSet, error) {
-	if c.svc.ses == nil {
-		c.svc.ses = ses.New(c.svc.session)
+func (c *connector) GetSSOAdminAccountAssignments(ctx context.Context, input *ssoadmin.ListAccountAssignmentsInput) ([]*ssoadmin.AccountAssignment, error) {
+	if c.svc.ssoadmin == nil {
+		c.svc.ssoadmin = ssoadmin.New(c.svc.session)
 	}
 
-	opt := make([]*ses.ConfigurationSet, 0)
+	opt := make([]*ssoadmin.AccountAssignment, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ses.ListConfigurationSetsWithContext(ctx, input)
+		o, err := c.svc.ssoadmin.ListAccountAssignmentsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.ConfigurationSets == nil {
+		if o.AccountAssignments == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ses.ListConfigurationSetsInput{}
+			input = &ssoadmin.ListAccountAssignmentsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.ConfigurationSets...)
+		opt = append(opt, o.AccountAssignments...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetIdentities(ctx context.Context, input *ses.ListIdentitiesInput) ([]*string, error) {
-	if c.svc.ses == nil {
-		c.svc.ses = ses.New(c.svc.session)
+func (c *connector) GetStorageGatewayGateways(ctx context.Context, input *storagegateway.ListGatewaysInput) ([]*storagegateway.GatewayInfo, error) {
+	if c.svc.storagegateway == nil {
+		c.svc.storagegateway = storagegateway.New(c.svc.session)
 	}
 
-	opt := make([]*string, 0)
+	opt := make([]*storagegateway.GatewayInfo, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ses.ListIdentitiesWithContext(ctx, input)
+		o, err := c.svc.storagegateway.ListGatewaysWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Identities == nil {
+		if o.Gateways == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ses.ListIdentitiesInput{}
+			input = &storagegateway.ListGatewaysInput{}
 		}
-		input.NextToken = o.NextToken
-		hasNextToken = o.NextToken != nil
+		input.Marker = o.Marker
+		hasNextToken = o.Marker != nil
 
-		opt = append(opt, o.Identities...)
+		opt = append(opt, o.Gateways...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetIdentityNotificationAttributes(ctx context.Context, input *ses.GetIdentityNotificationAttributesInput) (map[string]*ses.IdentityNotificationAttributes, error) {
-	if c.svc.ses == nil {
-		c.svc.ses = ses.New(c.svc.session)
+func (c *connector) GetSyntheticsCanaries(ctx context.Context, input *synthetics.DescribeCanariesInput) ([]*synthetics.Canary, error) {
+	if c.svc.synthetics == nil {
+		c.svc.synthetics = synthetics.New(c.svc.session)
 	}
 
-	opt := make(map[string]*ses.IdentityNotificationAttributes, 0)
+	opt := make([]*synthetics.Canary, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ses.GetIdentityNotificationAttributesWithContext(ctx, input)
+		o, err := c.svc.synthetics.DescribeCanariesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.NotificationAttributes == nil {
+		if o.Canaries == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &synthetics.DescribeCanariesInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = o.NotificationAttributes
+		opt = append(opt, o.Canaries...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetReceiptFilters(ctx context.Context, input *ses.ListReceiptFiltersInput) ([]*ses.ReceiptFilter, error) {
-	if c.svc.ses == nil {
-		c.svc.ses = ses.New(c.svc.session)
+func (c *connector) GetTransferServers(ctx context.Context, input *transfer.ListServersInput) ([]*transfer.ListedServer, error) {
+	if c.svc.transfer == nil {
+		c.svc.transfer = transfer.New(c.svc.session)
 	}
 
-	opt := make([]*ses.ReceiptFilter, 0)
+	opt := make([]*transfer.ListedServer, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ses.ListReceiptFiltersWithContext(ctx, input)
+		o, err := c.svc.transfer.ListServersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Filters == nil {
+		if o.Servers == nil {
 			hasNextToken = false
 			continue
 		}
 
-		hasNextToken = false
+		if input == nil {
+			input = &transfer.ListServersInput{}
+		}
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Filters...)
+		opt = append(opt, o.Servers...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetTemplates(ctx context.Context, input *ses.ListTemplatesInput) ([]*ses.TemplateMetadata, error) {
-	if c.svc.ses == nil {
-		c.svc.ses = ses.New(c.svc.session)
+func (c *connector) GetTransferUsers(ctx context.Context, input *transfer.ListUsersInput) ([]*transfer.ListedUser, error) {
+	if c.svc.transfer == nil {
+		c.svc.transfer = transfer.New(c.svc.session)
 	}
 
-	opt := make([]*ses.TemplateMetadata, 0)
+	opt := make([]*transfer.ListedUser, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.ses.ListTemplatesWithContext(ctx, input)
+		o, err := c.svc.transfer.ListUsersWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.TemplatesMetadata == nil {
+		if o.Users == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &ses.ListTemplatesInput{}
+			input = &transfer.ListUsersInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.TemplatesMetadata...)
+		opt = append(opt, o.Users...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetSQSQueues(ctx context.Context, input *sqs.ListQueuesInput) ([]*string, error) {
-	if c.svc.sqs == nil {
-		c.svc.sqs = sqs.New(c.svc.session)
+func (c *connector) GetXRayGroups(ctx context.Context, input *xray.GetGroupsInput) ([]*xray.GroupSummary, error) {
+	if c.svc.xray == nil {
+		c.svc.xray = xray.New(c.svc.session)
 	}
 
-	opt := make([]*string, 0)
+	opt := make([]*xray.GroupSummary, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.sqs.ListQueuesWithContext(ctx, input)
+		o, err := c.svc.xray.GetGroupsWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.QueueUrls == nil {
+		if o.Groups == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &sqs.ListQueuesInput{}
+			input = &xray.GetGroupsInput{}
 		}
 		input.NextToken = o.NextToken
 		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.QueueUrls...)
+		opt = append(opt, o.Groups...)
 
 	}
 
 	return opt, nil
 }
 
-func (c *connector) GetStorageGatewayGateways(ctx context.Context, input *storagegateway.ListGatewaysInput) ([]*storagegateway.GatewayInfo, error) {
-	if c.svc.storagegateway == nil {
-		c.svc.storagegateway = storagegateway.New(c.svc.session)
+func (c *connector) GetXRaySamplingRules(ctx context.Context, input *xray.GetSamplingRulesInput) ([]*xray.SamplingRuleRecord, error) {
+	if c.svc.xray == nil {
+		c.svc.xray = xray.New(c.svc.session)
 	}
 
-	opt := make([]*storagegateway.GatewayInfo, 0)
+	opt := make([]*xray.SamplingRuleRecord, 0)
 
 	hasNextToken := true
 	for hasNextToken {
-		o, err := c.svc.storagegateway.ListGatewaysWithContext(ctx, input)
+		o, err := c.svc.xray.GetSamplingRulesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
-		if o.Gateways == nil {
+		if o.SamplingRuleRecords == nil {
 			hasNextToken = false
 			continue
 		}
 
 		if input == nil {
-			input = &storagegateway.ListGatewaysInput{}
+			input = &xray.GetSamplingRulesInput{}
 		}
-		input.Marker = o.Marker
-		hasNextToken = o.Marker != nil
+		input.NextToken = o.NextToken
+		hasNextToken = o.NextToken != nil
 
-		opt = append(opt, o.Gateways...)
+		opt = append(opt, o.SamplingRuleRecords...)
 
 	}
 
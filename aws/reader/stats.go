@@ -0,0 +1,123 @@
+package reader
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// APIStats are the statistics of the calls done
+// to an operation of an AWS API, each page is a call
+type APIStats struct {
+	Service   string
+	Operation string
+
+	Calls int
+
+	// Retries are all the retries done by the SDK,
+	// which include the ones of the Throttles
+	Retries int
+
+	// Throttles are the attempts that
+	// have been throttled by AWS
+	Throttles int
+
+	// Duration is the total duration of the
+	// calls, with the time spent on the retries
+	Duration time.Duration
+
+	// MaxDuration is the duration of the slowest call
+	MaxDuration time.Duration
+}
+
+// apiStats collects the APIStats of the
+// requests done with the sessions
+type apiStats struct {
+	mu  sync.Mutex
+	ops map[string]*APIStats
+}
+
+func newAPIStats() *apiStats {
+	return &apiStats{
+		ops: make(map[string]*APIStats),
+	}
+}
+
+// register adds the handlers that collect the
+// APIStats to all the requests of the sess
+func (s *apiStats) register(sess *session.Session) {
+	sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "terracognita.APIStatsCompleteAttemptHandler",
+		Fn: func(r *request.Request) {
+			if r.Error == nil || !r.IsErrorThrottle() {
+				return
+			}
+
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			s.get(r).Throttles++
+		},
+	})
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terracognita.APIStatsCompleteHandler",
+		Fn: func(r *request.Request) {
+			d := time.Since(r.Time)
+
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			st := s.get(r)
+			st.Calls++
+			st.Retries += r.RetryCount
+			st.Duration += d
+			if d > st.MaxDuration {
+				st.MaxDuration = d
+			}
+		},
+	})
+}
+
+// get returns the APIStats of the operation of the r,
+// it has to be called with the lock acquired
+func (s *apiStats) get(r *request.Request) *APIStats {
+	k := r.ClientInfo.ServiceName + "." + r.Operation.Name
+	st, ok := s.ops[k]
+	if !ok {
+		st = &APIStats{
+			Service:   r.ClientInfo.ServiceName,
+			Operation: r.Operation.Name,
+		}
+		s.ops[k] = st
+	}
+
+	return st
+}
+
+// list returns a copy of the APIStats
+// sorted by service and operation
+func (s *apiStats) list() []APIStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]APIStats, 0, len(s.ops))
+	for _, st := range s.ops {
+		res = append(res, *st)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Service != res[j].Service {
+			return res[i].Service < res[j].Service
+		}
+		return res[i].Operation < res[j].Operation
+	})
+
+	return res
+}
+
+func (c *connector) GetAPIStats() []APIStats {
+	return c.stats.list()
+}
@@ -0,0 +1,104 @@
+package reader
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+)
+
+// wafv2Client returns the WAFV2 client for the scope, the CLOUDFRONT
+// scope is only available on the global region so it uses a
+// different client than the REGIONAL one
+func (c *connector) wafv2Client(scope *string) wafv2iface.WAFV2API {
+	if aws.StringValue(scope) == wafv2.ScopeCloudfront {
+		if c.svc.wafv2CloudFront == nil {
+			c.svc.wafv2CloudFront = wafv2.New(c.svc.globalSession)
+		}
+		return c.svc.wafv2CloudFront
+	}
+
+	if c.svc.wafv2 == nil {
+		c.svc.wafv2 = wafv2.New(c.svc.session)
+	}
+	return c.svc.wafv2
+}
+
+// GetWAFV2IPSets has a custom implementation as the client
+// used depends on the Scope of the input
+func (c *connector) GetWAFV2IPSets(ctx context.Context, input *wafv2.ListIPSetsInput) ([]*wafv2.IPSetSummary, error) {
+	if input == nil {
+		input = &wafv2.ListIPSetsInput{}
+	}
+	svc := c.wafv2Client(input.Scope)
+
+	opt := make([]*wafv2.IPSetSummary, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := svc.ListIPSetsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		opt = append(opt, o.IPSets...)
+
+		input.NextMarker = o.NextMarker
+		hasNextToken = o.NextMarker != nil && len(o.IPSets) != 0
+	}
+
+	return opt, nil
+}
+
+// GetWAFV2RuleGroups has a custom implementation as the client
+// used depends on the Scope of the input
+func (c *connector) GetWAFV2RuleGroups(ctx context.Context, input *wafv2.ListRuleGroupsInput) ([]*wafv2.RuleGroupSummary, error) {
+	if input == nil {
+		input = &wafv2.ListRuleGroupsInput{}
+	}
+	svc := c.wafv2Client(input.Scope)
+
+	opt := make([]*wafv2.RuleGroupSummary, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := svc.ListRuleGroupsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		opt = append(opt, o.RuleGroups...)
+
+		input.NextMarker = o.NextMarker
+		hasNextToken = o.NextMarker != nil && len(o.RuleGroups) != 0
+	}
+
+	return opt, nil
+}
+
+// GetWAFV2WebACLs has a custom implementation as the client
+// used depends on the Scope of the input
+func (c *connector) GetWAFV2WebACLs(ctx context.Context, input *wafv2.ListWebACLsInput) ([]*wafv2.WebACLSummary, error) {
+	if input == nil {
+		input = &wafv2.ListWebACLsInput{}
+	}
+	svc := c.wafv2Client(input.Scope)
+
+	opt := make([]*wafv2.WebACLSummary, 0)
+
+	hasNextToken := true
+	for hasNextToken {
+		o, err := svc.ListWebACLsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		opt = append(opt, o.WebACLs...)
+
+		input.NextMarker = o.NextMarker
+		hasNextToken = o.NextMarker != nil && len(o.WebACLs) != 0
+	}
+
+	return opt, nil
+}
@@ -0,0 +1,88 @@
+package aws
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// managedAccountID is the AccountID used on the
+// ARNs of the resources managed by AWS like
+// the IAM Policies
+const managedAccountID = "aws"
+
+// ExternalReference checks if the v is an ARN that points to another
+// account, another region or to a global service. It also identifies
+// the VPCs referenced by peering connections
+func (a *aws) ExternalReference(rt, attr, v string) (provider.ExternalReference, bool) {
+	if (rt == VPCPeeringConnection.String() || rt == VPCPeeringConnectionAccepter.String()) && attr == "peer_vpc_id" {
+		return provider.ExternalReference{Kind: provider.ReferencePeering}, true
+	}
+
+	if !arn.IsARN(v) {
+		return provider.ExternalReference{}, false
+	}
+
+	parn, err := arn.Parse(v)
+	if err != nil {
+		return provider.ExternalReference{}, false
+	}
+
+	// An entity on another partition is always
+	// on another account
+	if parn.Partition != a.awsr.GetPartition() {
+		return provider.ExternalReference{Kind: provider.ReferenceCrossAccount}, true
+	}
+
+	if parn.AccountID != "" && parn.AccountID != managedAccountID && parn.AccountID != a.awsr.GetAccountID() {
+		return provider.ExternalReference{Kind: provider.ReferenceCrossAccount}, true
+	}
+
+	if parn.Region == "" {
+		ref := provider.ExternalReference{Kind: provider.ReferenceGlobal}
+		setDataSource(&ref, parn, v)
+		return ref, true
+	}
+
+	if parn.Region != a.Region() {
+		return provider.ExternalReference{Kind: provider.ReferenceCrossRegion}, true
+	}
+
+	return provider.ExternalReference{}, false
+}
+
+// setDataSource sets the data source that can be used to reference the
+// global entity on the ref. Only the ones that can be fetched with the
+// same provider configuration are set
+func setDataSource(ref *provider.ExternalReference, parn arn.ARN, v string) {
+	switch parn.Service {
+	case "iam":
+		rs := strings.Split(parn.Resource, "/")
+		name := rs[len(rs)-1]
+		switch rs[0] {
+		case "role":
+			ref.DataSource = "aws_iam_role"
+			ref.DataSourceConfig = map[string]interface{}{"name": name}
+		case "instance-profile":
+			ref.DataSource = "aws_iam_instance_profile"
+			ref.DataSourceConfig = map[string]interface{}{"name": name}
+		case "policy":
+			ref.DataSource = "aws_iam_policy"
+			ref.DataSourceConfig = map[string]interface{}{"arn": v}
+		default:
+			return
+		}
+	case "s3":
+		// Only buckets are supported, the objects
+		// have the format 'bucket/key'
+		if strings.Contains(parn.Resource, "/") {
+			return
+		}
+		ref.DataSource = "aws_s3_bucket"
+		ref.DataSourceConfig = map[string]interface{}{"bucket": parn.Resource}
+	default:
+		return
+	}
+	ref.DataSourceAttribute = "arn"
+}
@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// multiRegion is a Provider that reads from multiple regions,
+// one aws Provider for each one of them, so all of them are
+// imported on the same output. The first one is the default
+// region, the global services are only read on it and the
+// rest are written as aliased providers
+type multiRegion struct {
+	providers []*aws
+}
+
+// aliasRe matches the valid names of the aliases
+var aliasRe = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// NewMultiRegionProvider returns an AWS Provider that imports all
+// the regions of the providers, which have to be initialized with
+// NewProvider. The first one is the default region and the rest are
+// aliased with the aliases, by region, or with the name of the region
+// if not defined. If only one is defined it's returned
+func NewMultiRegionProvider(providers []provider.Provider, aliases map[string]string) (provider.Provider, error) {
+	if len(providers) == 0 {
+		return nil, errors.New("at least one region is required")
+	}
+
+	regions := make(map[string]struct{}, len(providers))
+	names := make(map[string]string, len(providers))
+	aps := make([]*aws, 0, len(providers))
+	for i, p := range providers {
+		a, ok := p.(*aws)
+		if !ok {
+			return nil, errors.Errorf("the provider %s is not an AWS Provider", p.String())
+		}
+		if _, ok := regions[a.Region()]; ok {
+			return nil, errors.Errorf("the region %s is defined more than once", a.Region())
+		}
+		regions[a.Region()] = struct{}{}
+		aps = append(aps, a)
+
+		if i == 0 {
+			if _, ok := aliases[a.Region()]; ok {
+				return nil, errors.Errorf("the default region %s can not have an alias", a.Region())
+			}
+			continue
+		}
+
+		alias, ok := aliases[a.Region()]
+		if !ok {
+			alias = regionAlias(a.Region())
+		} else if !aliasRe.MatchString(alias) {
+			return nil, errors.Errorf("invalid alias %q for the region %s, it has to start with a letter or '_' and contain only letters, digits, '_' and '-'", alias, a.Region())
+		}
+		if r, ok := names[alias]; ok {
+			return nil, errors.Errorf("the alias %q is used by the regions %s and %s", alias, r, a.Region())
+		}
+		names[alias] = a.Region()
+		a.alias = alias
+	}
+
+	for r := range aliases {
+		if _, ok := regions[r]; !ok {
+			return nil, errors.Errorf("the region %s of the aliases is not imported", r)
+		}
+	}
+
+	if len(aps) == 1 {
+		return aps[0], nil
+	}
+
+	return &multiRegion{
+		providers: aps,
+	}, nil
+}
+
+// regionAlias returns the default alias of the provider of the region,
+// the name of the region with '_' instead of '-' (ex: eu_west_1)
+func regionAlias(region string) string {
+	return strings.ReplaceAll(region, "-", "_")
+}
+
+// defaultRegion returns the aws Provider of the default region of the
+// p, it's used by the features that only work on one region
+func defaultRegion(p provider.Provider) (*aws, bool) {
+	switch v := p.(type) {
+	case *aws:
+		return v, true
+	case *multiRegion:
+		return v.providers[0], true
+	default:
+		return nil, false
+	}
+}
+
+func (m *multiRegion) HasResourceType(t string) bool         { return m.providers[0].HasResourceType(t) }
+func (m *multiRegion) Region() string                        { return m.providers[0].Region() }
+func (m *multiRegion) String() string                        { return m.providers[0].String() }
+func (m *multiRegion) TagKey() string                        { return m.providers[0].TagKey() }
+func (m *multiRegion) Source() string                        { return m.providers[0].Source() }
+func (m *multiRegion) Version() string                       { return TFProviderVersion }
+func (m *multiRegion) Configuration() map[string]interface{} { return m.providers[0].Configuration() }
+func (m *multiRegion) ResourceTypes() []string               { return m.providers[0].ResourceTypes() }
+func (m *multiRegion) TFClient() interface{}                 { return m.providers[0].TFClient() }
+func (m *multiRegion) TFProvider() *schema.Provider          { return m.providers[0].TFProvider() }
+
+// Resources returns the Resources of the type t, the global ones are only read
+// on the default region and the regional ones on all of them. The regions that
+// return an errcode.ErrProviderAPI are skipped unless all of them fail with it
+func (m *multiRegion) Resources(ctx context.Context, t string, f *filter.Filter) ([]provider.Resource, error) {
+	rt, err := ResourceTypeString(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := globalResourceTypes[rt]; ok {
+		return m.providers[0].Resources(ctx, t, f)
+	}
+
+	var (
+		resources []provider.Resource
+		apiErr    error
+		read      bool
+	)
+	for _, p := range m.providers {
+		res, err := p.Resources(ctx, t, f)
+		if err != nil {
+			if errors.Is(err, errcode.ErrProviderAPI) {
+				apiErr = err
+				continue
+			}
+			return nil, errors.Wrapf(err, "on region %s", p.Region())
+		}
+		read = true
+		resources = append(resources, res...)
+	}
+
+	if !read && apiErr != nil {
+		return nil, apiErr
+	}
+
+	return resources, nil
+}
+
+// Alias returns empty as the default region is not aliased
+func (m *multiRegion) Alias() string { return "" }
+
+// Aliases returns the region of each one of the aliased providers
+func (m *multiRegion) Aliases() map[string]map[string]interface{} {
+	aliases := make(map[string]map[string]interface{}, len(m.providers)-1)
+	for _, p := range m.providers[1:] {
+		aliases[p.alias] = map[string]interface{}{
+			"region": p.Region(),
+		}
+	}
+	return aliases
+}
+
+// APIStats returns the statistics of the calls done
+// to the AWS APIs on all the regions
+func (m *multiRegion) APIStats() []provider.APIStats {
+	var stats []provider.APIStats
+	for _, p := range m.providers {
+		stats = append(stats, p.APIStats()...)
+	}
+	return stats
+}
+
+// BrokenTypes returns the resource types with known
+// problems with the TFProviderVersion
+func (m *multiRegion) BrokenTypes() map[string]string { return m.providers[0].BrokenTypes() }
+
+// InterpolationMatchers returns the Matchers of the aws Provider
+func (m *multiRegion) InterpolationMatchers() []provider.Matcher {
+	return m.providers[0].InterpolationMatchers()
+}
+
+// ExternalReference checks if the v is external like on the aws Provider,
+// but the ARNs of the regions imported are not cross-region
+func (m *multiRegion) ExternalReference(rt, attr, v string) (provider.ExternalReference, bool) {
+	ref, ok := m.providers[0].ExternalReference(rt, attr, v)
+	if !ok || ref.Kind != provider.ReferenceCrossRegion {
+		return ref, ok
+	}
+
+	parn, err := arn.Parse(v)
+	if err != nil {
+		return ref, ok
+	}
+	for _, p := range m.providers {
+		if p.Region() == parn.Region {
+			return provider.ExternalReference{}, false
+		}
+	}
+
+	return ref, ok
+}
+
+// Parameter returns the value of the parameter name on the default region
+func (m *multiRegion) Parameter(ctx context.Context, name string) (string, error) {
+	return m.providers[0].Parameter(ctx, name)
+}
+
+// ResourceOwner returns the owner of the r with
+// the aws Provider of the region it was read from
+func (m *multiRegion) ResourceOwner(ctx context.Context, r provider.Resource) (string, string, error) {
+	if a, ok := r.Provider().(*aws); ok {
+		return a.ResourceOwner(ctx, r)
+	}
+	return m.providers[0].ResourceOwner(ctx, r)
+}
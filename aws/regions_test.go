@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/aws/reader"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// regionReader is a reader.Reader
+// that only knows its region
+type regionReader struct {
+	reader.Reader
+	region string
+}
+
+func (r regionReader) GetRegion() string { return r.region }
+
+func newRegionProvider(region string) *aws {
+	return &aws{awsr: regionReader{region: region}}
+}
+
+func TestNewMultiRegionProvider(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		use1 := newRegionProvider("us-east-1")
+		euw1 := newRegionProvider("eu-west-1")
+		euc1 := newRegionProvider("eu-central-1")
+
+		p, err := NewMultiRegionProvider([]provider.Provider{use1, euw1, euc1}, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, "us-east-1", p.Region())
+		assert.Equal(t, "", use1.Alias())
+		assert.Equal(t, "eu_west_1", euw1.Alias())
+		assert.Equal(t, "eu_central_1", euc1.Alias())
+
+		al, ok := p.(provider.Aliaser)
+		require.True(t, ok)
+		assert.Equal(t, "", al.Alias())
+		assert.Equal(t, map[string]map[string]interface{}{
+			"eu_west_1":    map[string]interface{}{"region": "eu-west-1"},
+			"eu_central_1": map[string]interface{}{"region": "eu-central-1"},
+		}, al.Aliases())
+	})
+
+	t.Run("SuccessWithAliases", func(t *testing.T) {
+		use1 := newRegionProvider("us-east-1")
+		euw1 := newRegionProvider("eu-west-1")
+		euc1 := newRegionProvider("eu-central-1")
+
+		_, err := NewMultiRegionProvider([]provider.Provider{use1, euw1, euc1}, map[string]string{"eu-west-1": "prod_euw1"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "prod_euw1", euw1.Alias())
+		assert.Equal(t, "eu_central_1", euc1.Alias())
+	})
+
+	t.Run("ErrAliases", func(t *testing.T) {
+		for n, aliases := range map[string]map[string]string{
+			"DefaultRegion": map[string]string{"us-east-1": "use1"},
+			"Invalid":       map[string]string{"eu-west-1": "1euw"},
+			"Duplicated":    map[string]string{"eu-west-1": "eu", "eu-central-1": "eu"},
+			"NotImported":   map[string]string{"ap-south-1": "aps1"},
+		} {
+			t.Run(n, func(t *testing.T) {
+				_, err := NewMultiRegionProvider([]provider.Provider{newRegionProvider("us-east-1"), newRegionProvider("eu-west-1"), newRegionProvider("eu-central-1")}, aliases)
+				assert.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("SingleRegion", func(t *testing.T) {
+		use1 := newRegionProvider("us-east-1")
+
+		p, err := NewMultiRegionProvider([]provider.Provider{use1}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, use1, p)
+		assert.Nil(t, use1.Aliases())
+	})
+
+	t.Run("ErrDuplicatedRegion", func(t *testing.T) {
+		_, err := NewMultiRegionProvider([]provider.Provider{newRegionProvider("us-east-1"), newRegionProvider("us-east-1")}, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("ErrNoRegions", func(t *testing.T) {
+		_, err := NewMultiRegionProvider(nil, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestRegionWAFV2Scopes(t *testing.T) {
+	assert.Equal(t, []string{wafv2.ScopeRegional, wafv2.ScopeCloudfront}, regionWAFV2Scopes(&aws{}))
+	assert.Equal(t, []string{wafv2.ScopeRegional}, regionWAFV2Scopes(&aws{alias: "eu_west_1"}))
+}
+
+func TestGlobalResourceTypes(t *testing.T) {
+	// All the global types have to be
+	// read, so they must have a function
+	for rt := range globalResourceTypes {
+		_, ok := resources[rt]
+		assert.True(t, ok, rt.String())
+	}
+}
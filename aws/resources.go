@@ -2,13 +2,20 @@ package aws
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	awsSDK "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -22,12 +29,18 @@ import (
 	"github.com/aws/aws-sdk-go/service/mediastore"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/cycloidio/terracognita/filter"
 	"github.com/cycloidio/terracognita/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
 )
 
 // ResourceType is the type used to define all the Resources
@@ -57,9 +70,17 @@ const (
 	ALBTargetGroup
 	ALBTargetGroupAttachment
 	APIGatewayDeployment
+	APIGatewayMethod
 	APIGatewayResource
 	APIGatewayRestAPI
 	APIGatewayStage
+	APIGatewayV2API       // apigatewayv2_api
+	APIGatewayV2Route     // apigatewayv2_route
+	APIGatewayV2Stage     // apigatewayv2_stage
+	AppmeshMesh           // appmesh_mesh
+	AppmeshVirtualNode    // appmesh_virtual_node
+	AppmeshVirtualRouter  // appmesh_virtual_router
+	AppmeshVirtualService // appmesh_virtual_service
 	//AthenaDatabase // conflict with GlueDatabase
 	//AthenaTable // conflict with GlueTable
 	AthenaWorkgroup
@@ -67,7 +88,9 @@ const (
 	AutoscalingPolicy
 	AutoscalingSchedule
 	BatchJobDefinition
+	CloudfrontCachePolicy
 	CloudfrontDistribution
+	CloudfrontFunction
 	CloudfrontOriginAccessIdentity
 	CloudfrontPublicKey
 	CloudwatchMetricAlarm
@@ -83,6 +106,8 @@ const (
 	EBSVolume
 	ECSCluster
 	ECSService
+	EC2CapacityReservation
+	EC2Fleet
 	EC2TransitGateway
 	EC2TransitGatewayVPCAttachment
 	EC2TransitGatewayRouteTable
@@ -98,7 +123,9 @@ const (
 	EIP
 	EKSCluster
 	ElasticacheCluster
+	ElasticacheParameterGroup
 	ElasticacheReplicationGroup
+	ElasticacheSubnetGroup
 	ElasticBeanstalkApplication
 	ElasticsearchDomain
 	ElasticsearchDomainPolicy
@@ -133,8 +160,13 @@ const (
 	IAMUserSSHKey
 	InternetGateway
 	KeyPair
+	KinesisFirehoseDeliveryStream
 	KinesisStream
+	LambdaEventSourceMapping
 	LambdaFunction
+	LambdaFunctionURL
+	LambdaLayerVersion
+	LambdaPermission
 	LaunchConfiguration
 	LaunchTemplate
 	LB
@@ -146,7 +178,12 @@ const (
 	LBTargetGroupAttachment
 	LightsailInstance
 	MediaStoreContainer
+	MemoryDBCluster // memorydb_cluster
 	MQBroker
+	MQConfiguration
+	MSKCluster
+	MSKConfiguration
+	MWAAEnvironment
 	NatGateway
 	NeptuneCluster
 	RDSCluster
@@ -163,7 +200,13 @@ const (
 	RouteTable
 	S3Bucket
 	//S3BucketObject
+	SecretsmanagerSecret
 	SecurityGroup
+	SecurityGroupRule
+	ServiceDiscoveryHTTPNamespace
+	ServiceDiscoveryPrivateDNSNamespace
+	ServiceDiscoveryPublicDNSNamespace
+	ServiceDiscoveryService
 	SESActiveReceiptRuleSet
 	SESConfigurationSet
 	SESDomainDKIM
@@ -176,14 +219,29 @@ const (
 	SESReceiptRule
 	SESReceiptRuleSet
 	SESTemplate
+	ShieldProtection
+	SpotFleetRequest
 	SQSQueue
+	SSMParameter
+	SSOAdminAccountAssignment       // ssoadmin_account_assignment
+	SSOAdminManagedPolicyAttachment // ssoadmin_managed_policy_attachment
+	SSOAdminPermissionSet           // ssoadmin_permission_set
 	StoragegatewayGateway
 	Subnet
+	SyntheticsCanary
+	TransferServer
+	TransferUser
 	VolumeAttachment
 	VPC
 	VPCEndpoint
 	VPCPeeringConnection
+	VPCPeeringConnectionAccepter
 	VPNGateway
+	WAFV2IPSet       // wafv2_ip_set
+	WAFV2RuleGroup   // wafv2_rule_group
+	WAFV2WebACL      // wafv2_web_acl
+	XRayGroup        // xray_group
+	XRaySamplingRule // xray_sampling_rule
 )
 
 type rtFn func(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error)
@@ -198,15 +256,25 @@ var (
 		ALBTargetGroupAttachment: albTargetGroupAttachments,
 		//AMI:      ami,
 		APIGatewayDeployment:           apiGatewayDeployments,
+		APIGatewayMethod:               apiGatewayMethods,
 		APIGatewayResource:             apiGatewayResources,
 		APIGatewayRestAPI:              apiGatewayRestApis,
 		APIGatewayStage:                apiGatewayStages,
+		APIGatewayV2API:                cacheAPIGatewayV2APIs,
+		APIGatewayV2Route:              apiGatewayV2Routes,
+		APIGatewayV2Stage:              apiGatewayV2Stages,
+		AppmeshMesh:                    cacheAppmeshMeshes,
+		AppmeshVirtualNode:             appmeshVirtualNodes,
+		AppmeshVirtualRouter:           appmeshVirtualRouters,
+		AppmeshVirtualService:          appmeshVirtualServices,
 		AthenaWorkgroup:                athenaWorkgroups,
 		AutoscalingGroup:               autoscalingGroups,
 		AutoscalingPolicy:              autoscalingPolicies,
 		AutoscalingSchedule:            autoscalingSchedules,
 		BatchJobDefinition:             batchJobDefinitions,
+		CloudfrontCachePolicy:          cloudfrontCachePolicies,
 		CloudfrontDistribution:         cloudfrontDistributions,
+		CloudfrontFunction:             cloudfrontFunctions,
 		CloudfrontOriginAccessIdentity: cloudfrontOriginAccessIdentities,
 		CloudfrontPublicKey:            cloudfrontPublicKeys,
 		CloudwatchMetricAlarm:          cloudwatchMetricAlarms,
@@ -223,6 +291,8 @@ var (
 		EBSVolume:                                  ebsVolumes,
 		ECSCluster:                                 cacheECSClusters,
 		ECSService:                                 ecsServices,
+		EC2CapacityReservation:                     ec2CapacityReservations,
+		EC2Fleet:                                   ec2Fleets,
 		EC2TransitGateway:                          ec2TransitGateways,
 		EC2TransitGatewayVPCAttachment:             ec2TransitGatewayVPCAttachment,
 		EC2TransitGatewayRouteTable:                cacheTransitGatewayRouteTables,
@@ -238,7 +308,9 @@ var (
 		EIP:                                        eips,
 		EKSCluster:                                 eksClusters,
 		ElasticacheCluster:                         elasticacheClusters,
+		ElasticacheParameterGroup:                  elasticacheParameterGroups,
 		ElasticacheReplicationGroup:                elasticacheReplicationGroups,
+		ElasticacheSubnetGroup:                     elasticacheSubnetGroups,
 		ElasticBeanstalkApplication:                elasticBeanstalkApplications,
 		ElasticsearchDomain:                        elasticsearchDomains,
 		ElasticsearchDomainPolicy:                  elasticsearchDomains,
@@ -270,8 +342,13 @@ var (
 		Instance:                                   instances,
 		InternetGateway:                            internetGateways,
 		KeyPair:                                    keyPairs,
+		KinesisFirehoseDeliveryStream:              kinesisFirehoseDeliveryStreams,
 		KinesisStream:                              kinesisStreams,
-		LambdaFunction:                             lambdaFunctions,
+		LambdaEventSourceMapping:                   lambdaEventSourceMappings,
+		LambdaFunction:                             cacheLambdaFunctions,
+		LambdaFunctionURL:                          lambdaFunctionURLs,
+		LambdaLayerVersion:                         lambdaLayerVersions,
+		LambdaPermission:                           lambdaPermissions,
 		LaunchConfiguration:                        launchConfigurations,
 		LaunchTemplate:                             launchTemplates,
 		LB:                                         cacheLoadBalancersV2,
@@ -283,7 +360,12 @@ var (
 		LBTargetGroupAttachment:                    albTargetGroupAttachments,
 		LightsailInstance:                          lightsailInstances,
 		MediaStoreContainer:                        mediaStoreContainers,
+		MemoryDBCluster:                            memoryDBClusters,
 		MQBroker:                                   mqBrokers,
+		MQConfiguration:                            mqConfigurations,
+		MSKCluster:                                 mskClusters,
+		MSKConfiguration:                           mskConfigurations,
+		MWAAEnvironment:                            mwaaEnvironments,
 		NatGateway:                                 natGateways,
 		NeptuneCluster:                             neptuneClusters,
 		RDSCluster:                                 rdsClusters,
@@ -299,33 +381,120 @@ var (
 		Route53Zone:                                cacheRoute53Zones,
 		RouteTable:                                 routeTables,
 		//S3BucketObject:      s3_bucket_objects,
-		S3Bucket:                     s3Buckets,
-		SecurityGroup:                securityGroups,
-		SESActiveReceiptRuleSet:      sesActiveReceiptRuleSets,
-		SESConfigurationSet:          sesConfigurationSets,
-		SESDomainDKIM:                sesDomainGeneral,
-		SESDomainIdentity:            cacheSESDomainIdentities,
-		SESDomainMailFrom:            sesDomainGeneral,
-		SESIdentityNotificationTopic: sesIdentityNotificationTopics,
-		SESReceiptFilter:             sesReceiptFilters,
-		SESReceiptRule:               sesReceiptRules,
-		SESReceiptRuleSet:            sesReceiptRuleSets,
-		SESTemplate:                  sesTemplates,
-		SQSQueue:                     sqsQueues,
-		StoragegatewayGateway:        storagegatewayGateways,
-		Subnet:                       subnets,
-		VolumeAttachment:             volumeAttachments,
-		VPCPeeringConnection:         vpcPeeringConnections,
-		VPC:                          vpcs,
-		VPCEndpoint:                  vpcEndpoints,
-		VPNGateway:                   vpnGateways,
+		S3Bucket:                            s3Buckets,
+		SecretsmanagerSecret:                secretsmanagerSecrets,
+		SecurityGroup:                       securityGroups,
+		SecurityGroupRule:                   securityGroupRules,
+		ServiceDiscoveryHTTPNamespace:       serviceDiscoveryHTTPNamespaces,
+		ServiceDiscoveryPrivateDNSNamespace: serviceDiscoveryPrivateDNSNamespaces,
+		ServiceDiscoveryPublicDNSNamespace:  serviceDiscoveryPublicDNSNamespaces,
+		ServiceDiscoveryService:             serviceDiscoveryServices,
+		SESActiveReceiptRuleSet:             sesActiveReceiptRuleSets,
+		SESConfigurationSet:                 sesConfigurationSets,
+		SESDomainDKIM:                       sesDomainGeneral,
+		SESDomainIdentity:                   cacheSESDomainIdentities,
+		SESDomainMailFrom:                   sesDomainGeneral,
+		SESIdentityNotificationTopic:        sesIdentityNotificationTopics,
+		SESReceiptFilter:                    sesReceiptFilters,
+		SESReceiptRule:                      sesReceiptRules,
+		SESReceiptRuleSet:                   sesReceiptRuleSets,
+		SESTemplate:                         sesTemplates,
+		ShieldProtection:                    shieldProtections,
+		SpotFleetRequest:                    spotFleetRequests,
+		SQSQueue:                            sqsQueues,
+		SSMParameter:                        ssmParameters,
+		SSOAdminAccountAssignment:           ssoadminAccountAssignments,
+		SSOAdminManagedPolicyAttachment:     ssoadminManagedPolicyAttachments,
+		SSOAdminPermissionSet:               cacheSSOAdminPermissionSets,
+		StoragegatewayGateway:               storagegatewayGateways,
+		Subnet:                              subnets,
+		SyntheticsCanary:                    syntheticsCanaries,
+		TransferServer:                      cacheTransferServers,
+		TransferUser:                        transferUsers,
+		VolumeAttachment:                    volumeAttachments,
+		VPCPeeringConnection:                vpcPeeringConnections,
+		VPCPeeringConnectionAccepter:        vpcPeeringConnections,
+		VPC:                                 vpcs,
+		VPCEndpoint:                         vpcEndpoints,
+		VPNGateway:                          vpnGateways,
+		WAFV2IPSet:                          wafv2IPSets,
+		WAFV2RuleGroup:                      wafv2RuleGroups,
+		WAFV2WebACL:                         wafv2WebACLs,
+		XRayGroup:                           xrayGroups,
+		XRaySamplingRule:                    xraySamplingRules,
+	}
+
+	// wafv2Scopes are all the Scopes of the WAFV2 resources, the
+	// CLOUDFRONT ones are global so they are always imported
+	wafv2Scopes = []string{wafv2.ScopeRegional, wafv2.ScopeCloudfront}
+
+	// globalResourceTypes are the types read from the global services,
+	// the reader Functions with IsGlobal, so when importing multiple
+	// regions they are only read once on the default one
+	globalResourceTypes = map[ResourceType]struct{}{
+		CloudfrontCachePolicy:          struct{}{},
+		CloudfrontDistribution:         struct{}{},
+		CloudfrontFunction:             struct{}{},
+		CloudfrontOriginAccessIdentity: struct{}{},
+		CloudfrontPublicKey:            struct{}{},
+		IAMAccessKey:                   struct{}{},
+		IAMAccountAlias:                struct{}{},
+		IAMAccountPasswordPolicy:       struct{}{},
+		IAMGroup:                       struct{}{},
+		IAMGroupMembership:             struct{}{},
+		IAMGroupPolicy:                 struct{}{},
+		IAMGroupPolicyAttachment:       struct{}{},
+		IAMInstanceProfile:             struct{}{},
+		IAMOpenidConnectProvider:       struct{}{},
+		IAMPolicy:                      struct{}{},
+		IAMRole:                        struct{}{},
+		IAMRolePolicy:                  struct{}{},
+		IAMRolePolicyAttachment:        struct{}{},
+		IAMSAMLProvider:                struct{}{},
+		IAMServerCertificate:           struct{}{},
+		IAMUser:                        struct{}{},
+		IAMUserGroupMembership:         struct{}{},
+		IAMUserPolicy:                  struct{}{},
+		IAMUserPolicyAttachment:        struct{}{},
+		IAMUserSSHKey:                  struct{}{},
+		Route53DelegationSet:           struct{}{},
+		Route53HealthCheck:             struct{}{},
+		Route53QueryLog:                struct{}{},
+		Route53Record:                  struct{}{},
+		Route53Zone:                    struct{}{},
+		Route53ZoneAssociation:         struct{}{},
+		ShieldProtection:               struct{}{},
+	}
+
+	// vpcPeeringConnectionActiveStatus are the status of the VPC Peering Connections
+	// that can be imported, the rejected, failed or deleted ones are still
+	// returned by AWS for a while after it
+	vpcPeeringConnectionActiveStatus = map[string]bool{
+		ec2.VpcPeeringConnectionStateReasonCodeActive:            true,
+		ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance: true,
+		ec2.VpcPeeringConnectionStateReasonCodeProvisioning:      true,
 	}
 )
 
+// xraySamplingRuleDefault is the name of the
+// Sampling Rule that AWS creates on each account
+const xraySamplingRuleDefault = "Default"
+
 func initializeResource(a *aws, ID, t string) (provider.Resource, error) {
 	return provider.NewResource(ID, t, a), nil
 }
 
+// isCreatedIn checks if the creation time t of a resource is inside
+// of the created window of the filters, when the resource has
+// no creation time it's not filtered
+func isCreatedIn(filters *filter.Filter, t *time.Time) bool {
+	if t == nil {
+		return true
+	}
+
+	return filters.IsCreatedIn(*t)
+}
+
 func albListenerCertificates(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	// if both defined, keep only aws_alb_listener_certificate
 	if filters.IsIncluded("aws_alb_listener_certificate", "aws_lb_listener_certificate") && (!filters.IsExcluded("aws_alb_listener_certificate") && resourceType == "aws_lb_listener_certificate") {
@@ -470,6 +639,10 @@ func albs(ctx context.Context, a *aws, resourceType string, filters *filter.Filt
 
 	resources := make([]provider.Resource, 0)
 	for _, v := range lbs {
+		if !isCreatedIn(filters, v.CreatedTime) {
+			continue
+		}
+
 		r, err := initializeResource(a, *v.LoadBalancerArn, resourceType)
 		if err != nil {
 			return nil, err
@@ -650,6 +823,39 @@ func apiGatewayDeployments(ctx context.Context, a *aws, resourceType string, fil
 	return resources, nil
 }
 
+func apiGatewayMethods(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+
+	apiGatewayRestApis, err := getAPIGatewayRestApis(ctx, a, APIGatewayRestAPI.String(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, rapi := range apiGatewayRestApis {
+
+		var input = &apigateway.GetResourcesInput{
+			RestApiId: awsSDK.String(rapi),
+			Limit:     awsSDK.Int64(500),
+		}
+
+		apiGatewayMethods, err := a.awsr.GetAPIGatewayMethods(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		// The keys are already RESOURCE-ID/HTTP-METHOD
+		for k := range apiGatewayMethods {
+			r, err := initializeResource(a, fmt.Sprintf("%s/%s", rapi, k), resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
 func apiGatewayResources(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 
 	apiGatewayRestApis, err := getAPIGatewayRestApis(ctx, a, APIGatewayRestAPI.String(), filters)
@@ -737,6 +943,188 @@ func apiGatewayStages(ctx context.Context, a *aws, resourceType string, filters
 	return resources, nil
 }
 
+func apiGatewayV2APIs(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	apiGatewayV2APIs, err := a.awsr.GetAPIGatewayV2APIs(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range apiGatewayV2APIs {
+		r, err := initializeResource(a, *i.ApiId, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func apiGatewayV2Routes(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	apiGatewayV2APIs, err := getAPIGatewayV2APIs(ctx, a, APIGatewayV2API.String(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, api := range apiGatewayV2APIs {
+		var input = &apigatewayv2.GetRoutesInput{
+			ApiId: awsSDK.String(api),
+		}
+
+		apiGatewayV2Routes, err := a.awsr.GetAPIGatewayV2Routes(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range apiGatewayV2Routes {
+			r, err := initializeResource(a, fmt.Sprintf("%s/%s", api, *i.RouteId), resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func apiGatewayV2Stages(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	apiGatewayV2APIs, err := getAPIGatewayV2APIs(ctx, a, APIGatewayV2API.String(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, api := range apiGatewayV2APIs {
+		var input = &apigatewayv2.GetStagesInput{
+			ApiId: awsSDK.String(api),
+		}
+
+		apiGatewayV2Stages, err := a.awsr.GetAPIGatewayV2Stages(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range apiGatewayV2Stages {
+			r, err := initializeResource(a, fmt.Sprintf("%s/%s", api, *i.StageName), resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func appmeshMeshes(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	meshes, err := a.awsr.GetAppMeshMeshes(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, m := range meshes {
+		// The Meshes shared by other accounts are
+		// imported with the credentials of the owner
+		if awsSDK.StringValue(m.MeshOwner) != a.awsr.GetAccountID() {
+			continue
+		}
+
+		r, err := initializeResource(a, *m.MeshName, resourceType)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func appmeshVirtualNodes(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	meshes, err := getAppmeshMeshes(ctx, a, AppmeshMesh.String(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, m := range meshes {
+		nodes, err := a.awsr.GetAppMeshVirtualNodes(ctx, &appmesh.ListVirtualNodesInput{
+			MeshName: awsSDK.String(m),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, n := range nodes {
+			r, err := initializeResource(a, fmt.Sprintf("%s/%s", m, *n.VirtualNodeName), resourceType)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+func appmeshVirtualRouters(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	meshes, err := getAppmeshMeshes(ctx, a, AppmeshMesh.String(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, m := range meshes {
+		routers, err := a.awsr.GetAppMeshVirtualRouters(ctx, &appmesh.ListVirtualRoutersInput{
+			MeshName: awsSDK.String(m),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, vr := range routers {
+			r, err := initializeResource(a, fmt.Sprintf("%s/%s", m, *vr.VirtualRouterName), resourceType)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+func appmeshVirtualServices(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	meshes, err := getAppmeshMeshes(ctx, a, AppmeshMesh.String(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, m := range meshes {
+		services, err := a.awsr.GetAppMeshVirtualServices(ctx, &appmesh.ListVirtualServicesInput{
+			MeshName: awsSDK.String(m),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, vs := range services {
+			r, err := initializeResource(a, fmt.Sprintf("%s/%s", m, *vs.VirtualServiceName), resourceType)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
 func athenaWorkgroups(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 
 	athenaWorkGroups, err := a.awsr.GetAthenaWorkGroups(ctx, nil)
@@ -774,6 +1162,9 @@ func autoscalingGroups(ctx context.Context, a *aws, resourceType string, filters
 
 	resources := make([]provider.Resource, 0)
 	for _, i := range autoscalingGroups {
+		if !isCreatedIn(filters, i.CreatedTime) {
+			continue
+		}
 
 		r, err := initializeResource(a, *i.AutoScalingGroupName, resourceType)
 		if err != nil {
@@ -853,6 +1244,54 @@ func batchJobDefinitions(ctx context.Context, a *aws, resourceType string, filte
 	return resources, nil
 }
 
+func cloudfrontCachePolicies(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	// The managed policies are owned by AWS
+	// so only the custom ones are imported
+	policies, err := a.awsr.GetCloudFrontCachePolicies(ctx, &cloudfront.ListCachePoliciesInput{
+		Type: awsSDK.String(cloudfront.CachePolicyTypeCustom),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range policies {
+		r, err := initializeResource(a, *i.CachePolicy.Id, resourceType)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func cloudfrontFunctions(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	functions, err := a.awsr.GetCloudFrontFunctions(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The same function is returned for each one
+	// of the stages (DEVELOPMENT and LIVE)
+	names := make(map[string]struct{})
+	resources := make([]provider.Resource, 0)
+	for _, i := range functions {
+		if _, ok := names[*i.Name]; ok {
+			continue
+		}
+		names[*i.Name] = struct{}{}
+
+		r, err := initializeResource(a, *i.Name, resourceType)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
 func cloudfrontDistributions(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	distributions, err := a.awsr.GetCloudFrontDistributions(ctx, nil)
 	if err != nil {
@@ -956,6 +1395,10 @@ func dbInstances(ctx context.Context, a *aws, resourceType string, filters *filt
 
 	resources := make([]provider.Resource, 0)
 	for _, v := range dbs {
+		if !isCreatedIn(filters, v.InstanceCreateTime) {
+			continue
+		}
+
 		r, err := initializeResource(a, *v.DBInstanceIdentifier, resourceType)
 		if err != nil {
 			return nil, err
@@ -1142,6 +1585,9 @@ func ebsVolumes(ctx context.Context, a *aws, resourceType string, filters *filte
 
 	resources := make([]provider.Resource, 0)
 	for _, v := range volumes {
+		if !isCreatedIn(filters, v.CreateTime) {
+			continue
+		}
 
 		// if aws_instance defined, attached volume are done by ebs_block_device block.
 		if (len(v.Attachments) != 0) && (filters.IsIncluded("aws_instance") && !filters.IsExcluded("aws_instance")) {
@@ -1258,8 +1704,71 @@ func ecsServices(ctx context.Context, a *aws, resourceType string, filters *filt
 	return resources, nil
 }
 
+func ec2CapacityReservations(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	capacityReservations, err := a.awsr.GetCapacityReservations(ctx, &ec2.DescribeCapacityReservationsInput{
+		Filters: append(toEC2Filters(filters), &ec2.Filter{
+			// The expired, cancelled and failed ones
+			// can not be managed anymore
+			Name:   awsSDK.String("state"),
+			Values: awsSDK.StringSlice([]string{ec2.CapacityReservationStateActive, ec2.CapacityReservationStatePending}),
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+
+	for _, i := range capacityReservations {
+
+		r, err := initializeResource(a, *i.CapacityReservationId, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func ec2Fleets(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	fleets, err := a.awsr.GetFleets(ctx, &ec2.DescribeFleetsInput{
+		Filters: append(toEC2Filters(filters), &ec2.Filter{
+			// The deleted ones are still
+			// returned for some time
+			Name:   awsSDK.String("fleet-state"),
+			Values: awsSDK.StringSlice([]string{ec2.FleetStateCodeSubmitted, ec2.FleetStateCodeActive, ec2.FleetStateCodeModifying}),
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+
+	for _, i := range fleets {
+		// The instant Fleets are a one time request
+		// that can not be imported
+		if awsSDK.StringValue(i.Type) == ec2.FleetTypeInstant {
+			continue
+		}
+
+		r, err := initializeResource(a, *i.FleetId, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
 func ec2TransitGateways(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	transitGateways, err := a.awsr.GetTransitGateways(ctx, nil)
+	transitGateways, err := a.awsr.GetTransitGateways(ctx, &ec2.DescribeTransitGatewaysInput{
+		Filters: toEC2Filters(filters),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1280,7 +1789,9 @@ func ec2TransitGateways(ctx context.Context, a *aws, resourceType string, filter
 }
 
 func ec2TransitGatewayVPCAttachment(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	transitGatewayVPCAttachment, err := a.awsr.GetTransitGatewayVpcAttachments(ctx, nil)
+	transitGatewayVPCAttachment, err := a.awsr.GetTransitGatewayVpcAttachments(ctx, &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: toEC2Filters(filters),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1301,7 +1812,9 @@ func ec2TransitGatewayVPCAttachment(ctx context.Context, a *aws, resourceType st
 }
 
 func ec2TransitGatewayRouteTable(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	transitGatewayRouteTables, err := a.awsr.GetTransitGatewayRouteTables(ctx, nil)
+	transitGatewayRouteTables, err := a.awsr.GetTransitGatewayRouteTables(ctx, &ec2.DescribeTransitGatewayRouteTablesInput{
+		Filters: toEC2Filters(filters),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1564,6 +2077,12 @@ func elasticacheClusters(ctx context.Context, a *aws, resourceType string, filte
 
 	resources := make([]provider.Resource, 0)
 	for _, v := range cacheClusters {
+		// The members of a Replication Group are
+		// managed by the aws_elasticache_replication_group
+		if v.ReplicationGroupId != nil {
+			continue
+		}
+
 		r, err := initializeResource(a, *v.CacheClusterId, resourceType)
 		if err != nil {
 			return nil, err
@@ -1574,15 +2093,62 @@ func elasticacheClusters(ctx context.Context, a *aws, resourceType string, filte
 	return resources, nil
 }
 
-func elasticacheReplicationGroups(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	elasticacheReplicationGroups, err := a.awsr.GetElastiCacheReplicationGroups(ctx, nil)
+func elasticacheParameterGroups(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	parameterGroups, err := a.awsr.GetElastiCacheParameterGroups(ctx, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	resources := make([]provider.Resource, 0)
-	for _, i := range elasticacheReplicationGroups {
-		r, err := initializeResource(a, *i.ReplicationGroupId, resourceType)
+	for _, i := range parameterGroups {
+		// The default Parameter Groups are
+		// created by AWS and can not be modified
+		if strings.HasPrefix(*i.CacheParameterGroupName, "default.") {
+			continue
+		}
+
+		r, err := initializeResource(a, *i.CacheParameterGroupName, resourceType)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func elasticacheReplicationGroups(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	elasticacheReplicationGroups, err := a.awsr.GetElastiCacheReplicationGroups(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range elasticacheReplicationGroups {
+		r, err := initializeResource(a, *i.ReplicationGroupId, resourceType)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func elasticacheSubnetGroups(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	subnetGroups, err := a.awsr.GetElastiCacheSubnetGroups(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range subnetGroups {
+		// The default Subnet Group is created by AWS
+		if *i.CacheSubnetGroupName == "default" {
+			continue
+		}
+
+		r, err := initializeResource(a, *i.CacheSubnetGroupName, resourceType)
 		if err != nil {
 			return nil, err
 		}
@@ -1688,6 +2254,10 @@ func elbs(ctx context.Context, a *aws, resourceType string, filters *filter.Filt
 
 	resources := make([]provider.Resource, 0)
 	for _, v := range lbs {
+		if !isCreatedIn(filters, v.CreatedTime) {
+			continue
+		}
+
 		r, err := initializeResource(a, *v.LoadBalancerName, resourceType)
 		if err != nil {
 			return nil, err
@@ -1960,6 +2530,10 @@ func iamGroups(ctx context.Context, a *aws, resourceType string, filters *filter
 
 	resources := make([]provider.Resource, 0)
 	for _, i := range groups {
+		if !isCreatedIn(filters, i.CreateDate) {
+			continue
+		}
+
 		r, err := initializeResource(a, *i.GroupName, resourceType)
 		if err != nil {
 			return nil, err
@@ -2017,6 +2591,10 @@ func iamPolicies(ctx context.Context, a *aws, resourceType string, filters *filt
 
 	resources := make([]provider.Resource, 0)
 	for _, i := range policies {
+		if !isCreatedIn(filters, i.CreateDate) {
+			continue
+		}
+
 		r, err := initializeResource(a, *i.Arn, resourceType)
 		if err != nil {
 			return nil, err
@@ -2091,6 +2669,10 @@ func iamRoles(ctx context.Context, a *aws, resourceType string, filters *filter.
 
 	resources := make([]provider.Resource, 0)
 	for _, i := range roles {
+		if !isCreatedIn(filters, i.CreateDate) {
+			continue
+		}
+
 		r, err := initializeResource(a, *i.RoleName, resourceType)
 		if err != nil {
 			return nil, err
@@ -2244,6 +2826,10 @@ func iamUsers(ctx context.Context, a *aws, resourceType string, filters *filter.
 
 	resources := make([]provider.Resource, 0)
 	for _, i := range users {
+		if !isCreatedIn(filters, i.CreateDate) {
+			continue
+		}
+
 		r, err := initializeResource(a, *i.UserName, resourceType)
 		if err != nil {
 			return nil, err
@@ -2296,6 +2882,10 @@ func instances(ctx context.Context, a *aws, resourceType string, filters *filter
 
 	resources := make([]provider.Resource, 0)
 	for _, i := range instances {
+		if !isCreatedIn(filters, i.LaunchTime) {
+			continue
+		}
+
 		r, err := initializeResource(a, *i.InstanceId, resourceType)
 		if err != nil {
 			return nil, err
@@ -2351,6 +2941,35 @@ func keyPairs(ctx context.Context, a *aws, resourceType string, filters *filter.
 	return resources, nil
 }
 
+func kinesisFirehoseDeliveryStreams(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	deliveryStreams, err := a.awsr.GetKinesisFirehoseDeliveryStreams(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range deliveryStreams {
+		// The ListDeliveryStreams only returns the names
+		// and the import ID of TF is the ARN
+		id := arn.ARN{
+			Partition: a.awsr.GetPartition(),
+			Service:   "firehose",
+			Region:    a.Region(),
+			AccountID: a.awsr.GetAccountID(),
+			Resource:  fmt.Sprintf("deliverystream/%s", *i),
+		}.String()
+
+		r, err := initializeResource(a, id, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
 func kinesisStreams(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	kinesisStreams, err := a.awsr.GetKinesisStreams(ctx, nil)
 	if err != nil {
@@ -2393,6 +3012,140 @@ func lambdaFunctions(ctx context.Context, a *aws, resourceType string, filters *
 	return resources, nil
 }
 
+func lambdaEventSourceMappings(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	mappings, err := a.awsr.GetLambdaEventSourceMappings(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range mappings {
+		r, err := initializeResource(a, *i.UUID, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func lambdaFunctionURLs(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	functionNames, err := getLambdaFunctionNames(ctx, a, LambdaFunction.String(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, fn := range functionNames {
+		urls, err := a.awsr.GetLambdaFunctionURLConfigs(ctx, &lambda.ListFunctionUrlConfigsInput{
+			FunctionName: awsSDK.String(fn),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range urls {
+			// The ID is the function name and the qualifier
+			// (alias) if the URL is not for the $LATEST
+			id := fn
+			if parn, err := arn.Parse(*i.FunctionArn); err == nil {
+				if rs := strings.Split(parn.Resource, ":"); len(rs) == 3 {
+					id = fmt.Sprintf("%s/%s", fn, rs[2])
+				}
+			}
+
+			r, err := initializeResource(a, id, resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+func lambdaLayerVersions(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	layers, err := a.awsr.GetLambdaLayers(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, l := range layers {
+		versions, err := a.awsr.GetLambdaLayerVersions(ctx, &lambda.ListLayerVersionsInput{
+			LayerName: l.LayerName,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range versions {
+			r, err := initializeResource(a, *i.LayerVersionArn, resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+// lambdaPolicy is the part of the Policy
+// of a Function needed to import the permissions
+type lambdaPolicy struct {
+	Statement []struct {
+		Sid string
+	}
+}
+
+func lambdaPermissions(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	functionNames, err := getLambdaFunctionNames(ctx, a, LambdaFunction.String(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, fn := range functionNames {
+		policy, err := a.awsr.GetLambdaPolicy(ctx, &lambda.GetPolicyInput{
+			FunctionName: awsSDK.String(fn),
+		})
+		if err != nil {
+			// The Functions without permissions
+			// do not have a Policy
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == lambda.ErrCodeResourceNotFoundException {
+				continue
+			}
+			return nil, err
+		}
+		if policy == nil {
+			continue
+		}
+
+		var lp lambdaPolicy
+		err = json.Unmarshal([]byte(*policy), &lp)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid policy of the function %q", fn)
+		}
+
+		for _, s := range lp.Statement {
+			r, err := initializeResource(a, fmt.Sprintf("%s/%s", fn, s.Sid), resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
 func launchConfigurations(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	var input = &autoscaling.DescribeLaunchConfigurationsInput{
 		MaxRecords: awsSDK.Int64(100),
@@ -2404,6 +3157,10 @@ func launchConfigurations(ctx context.Context, a *aws, resourceType string, filt
 
 	resources := make([]provider.Resource, 0)
 	for _, i := range launchConfigurations {
+		if !isCreatedIn(filters, i.CreatedTime) {
+			continue
+		}
+
 		r, err := initializeResource(a, *i.LaunchConfigurationName, resourceType)
 		if err != nil {
 			return nil, err
@@ -2428,6 +3185,9 @@ func launchTemplates(ctx context.Context, a *aws, resourceType string, filters *
 
 	resources := make([]provider.Resource, 0)
 	for _, i := range launchTemplates {
+		if !isCreatedIn(filters, i.CreateTime) {
+			continue
+		}
 
 		r, err := initializeResource(a, *i.LaunchTemplateId, resourceType)
 		if err != nil {
@@ -2543,6 +3303,25 @@ func mediaStoreContainers(ctx context.Context, a *aws, resourceType string, filt
 	return resources, nil
 }
 
+func memoryDBClusters(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	clusters, err := a.awsr.GetMemoryDBClusters(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range clusters {
+		r, err := initializeResource(a, *i.Name, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
 func mqBrokers(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	mqBrokers, err := a.awsr.GetMQBrokers(ctx, nil)
 	if err != nil {
@@ -2562,6 +3341,82 @@ func mqBrokers(ctx context.Context, a *aws, resourceType string, filters *filter
 	return resources, nil
 }
 
+func mqConfigurations(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	mqConfigurations, err := a.awsr.GetMQConfigurations(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range mqConfigurations {
+		r, err := initializeResource(a, *i.Id, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func mskClusters(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	clusters, err := a.awsr.GetMSKClusters(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range clusters {
+		r, err := initializeResource(a, *i.ClusterArn, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func mskConfigurations(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	configurations, err := a.awsr.GetMSKConfigurations(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range configurations {
+		r, err := initializeResource(a, *i.Arn, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func mwaaEnvironments(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	mwaaEnvironments, err := a.awsr.GetMWAAEnvironments(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range mwaaEnvironments {
+		r, err := initializeResource(a, *i, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
 func natGateways(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	var input = &ec2.DescribeNatGatewaysInput{
 		Filter: toEC2Filters(filters),
@@ -2574,6 +3429,10 @@ func natGateways(ctx context.Context, a *aws, resourceType string, filters *filt
 
 	resources := make([]provider.Resource, 0)
 	for _, i := range natGateways {
+		if !isCreatedIn(filters, i.CreateTime) {
+			continue
+		}
+
 		r, err := initializeResource(a, *i.NatGatewayId, resourceType)
 		if err != nil {
 			return nil, err
@@ -2612,6 +3471,10 @@ func rdsClusters(ctx context.Context, a *aws, resourceType string, filters *filt
 
 	resources := make([]provider.Resource, 0)
 	for _, i := range rdsClusters {
+		if !isCreatedIn(filters, i.ClusterCreateTime) {
+			continue
+		}
+
 		r, err := initializeResource(a, *i.DBClusterIdentifier, resourceType)
 		if err != nil {
 			return nil, err
@@ -2737,7 +3600,11 @@ func route53Records(ctx context.Context, a *aws, resourceType string, filters *f
 		}
 
 		for _, i := range r53Records {
-			id := []string{z, strings.ToLower(*i.Name), *i.Type}
+			// The names are returned as FQDN and with the
+			// wildcard escaped, but TF expects them without
+			// the trailing dot and with the wildcard
+			name := strings.TrimSuffix(strings.ReplaceAll(strings.ToLower(*i.Name), `\052`, "*"), ".")
+			id := []string{z, name, *i.Type}
 			if i.SetIdentifier != nil {
 				id = append(id, *i.SetIdentifier)
 			}
@@ -2824,7 +3691,9 @@ func route53Zones(ctx context.Context, a *aws, resourceType string, filters *fil
 
 	resources := make([]provider.Resource, 0)
 	for _, i := range r53Zones {
-		r, err := initializeResource(a, *i.Id, resourceType)
+		// The IDs are returned with the format '/hostedzone/ID'
+		// and TF only expects the ID
+		r, err := initializeResource(a, strings.TrimPrefix(*i.Id, "/hostedzone/"), resourceType)
 		if err != nil {
 			return nil, err
 		}
@@ -2860,6 +3729,10 @@ func s3Buckets(ctx context.Context, a *aws, resourceType string, filters *filter
 
 	resources := make([]provider.Resource, 0)
 	for _, v := range buckets {
+		if !isCreatedIn(filters, v.CreationDate) {
+			continue
+		}
+
 		r, err := initializeResource(a, *v.Name, resourceType)
 		if err != nil {
 			return nil, err
@@ -2870,12 +3743,35 @@ func s3Buckets(ctx context.Context, a *aws, resourceType string, filters *filter
 	return resources, nil
 }
 
-func securityGroups(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	var input = &ec2.DescribeSecurityGroupsInput{
-		Filters: toEC2Filters(filters),
-	}
-
-	sgs, err := a.awsr.GetSecurityGroups(ctx, input)
+func secretsmanagerSecrets(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	secrets, err := a.awsr.GetSecretsManagerSecrets(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range secrets {
+		if !isCreatedIn(filters, i.CreatedDate) {
+			continue
+		}
+
+		r, err := initializeResource(a, *i.ARN, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func securityGroups(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	var input = &ec2.DescribeSecurityGroupsInput{
+		Filters: toEC2Filters(filters),
+	}
+
+	sgs, err := a.awsr.GetSecurityGroups(ctx, input)
 	if err != nil {
 		return nil, err
 	}
@@ -2892,6 +3788,124 @@ func securityGroups(ctx context.Context, a *aws, resourceType string, filters *f
 	return resources, nil
 }
 
+// securityGroupRules returns the rules of the Security Groups
+// only if they are imported with SecurityGroupRulesSeparate
+func securityGroupRules(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if a.securityGroupRules != SecurityGroupRulesSeparate {
+		return nil, nil
+	}
+
+	sgs, err := a.awsr.GetSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{
+		Filters: toEC2Filters(filters),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, v := range sgs {
+		ids := make([]string, 0)
+		for _, p := range v.IpPermissions {
+			ids = append(ids, securityGroupRuleIDs(*v.GroupId, "ingress", p)...)
+		}
+		for _, p := range v.IpPermissionsEgress {
+			ids = append(ids, securityGroupRuleIDs(*v.GroupId, "egress", p)...)
+		}
+
+		for _, id := range ids {
+			r, err := initializeResource(a, id, resourceType)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+func serviceDiscoveryHTTPNamespaces(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	return serviceDiscoveryNamespaces(ctx, a, resourceType, servicediscovery.NamespaceTypeHttp)
+}
+
+func serviceDiscoveryPrivateDNSNamespaces(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	return serviceDiscoveryNamespaces(ctx, a, resourceType, servicediscovery.NamespaceTypeDnsPrivate)
+}
+
+func serviceDiscoveryPublicDNSNamespaces(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	return serviceDiscoveryNamespaces(ctx, a, resourceType, servicediscovery.NamespaceTypeDnsPublic)
+}
+
+// serviceDiscoveryNamespaces returns the Service Discovery Namespaces of the
+// namespaceType, as each one of them is a different resource type
+func serviceDiscoveryNamespaces(ctx context.Context, a *aws, resourceType, namespaceType string) ([]provider.Resource, error) {
+	namespaces, err := a.awsr.GetServiceDiscoveryNamespaces(ctx, &servicediscovery.ListNamespacesInput{
+		Filters: []*servicediscovery.NamespaceFilter{
+			{
+				Name:      awsSDK.String(servicediscovery.NamespaceFilterNameType),
+				Values:    []*string{awsSDK.String(namespaceType)},
+				Condition: awsSDK.String(servicediscovery.FilterConditionEq),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, ns := range namespaces {
+		id := *ns.Id
+		if namespaceType == servicediscovery.NamespaceTypeDnsPrivate {
+			// The private DNS Namespaces are imported with
+			// the format 'NAMESPACE_ID:VPC_ID', the VPC is the one
+			// associated to the Route53 Hosted Zone of the Namespace
+			if ns.Properties == nil || ns.Properties.DnsProperties == nil || ns.Properties.DnsProperties.HostedZoneId == nil {
+				continue
+			}
+			vpcs, err := a.awsr.GetHostedZoneVPCs(ctx, &route53.GetHostedZoneInput{
+				Id: ns.Properties.DnsProperties.HostedZoneId,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if len(vpcs) == 0 {
+				continue
+			}
+			id = fmt.Sprintf("%s:%s", id, *vpcs[0].VPCId)
+		}
+
+		r, err := initializeResource(a, id, resourceType)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func serviceDiscoveryServices(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	services, err := a.awsr.GetServiceDiscoveryServices(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, svc := range services {
+		if !isCreatedIn(filters, svc.CreateDate) {
+			continue
+		}
+
+		r, err := initializeResource(a, *svc.Id, resourceType)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
 func sesActiveReceiptRuleSets(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	sesActiveReceiptRuleSets, err := a.awsr.GetActiveReceiptRuleSet(ctx, nil)
 	if err != nil {
@@ -3092,6 +4106,68 @@ func sesTemplates(ctx context.Context, a *aws, resourceType string, filters *fil
 	return resources, nil
 }
 
+func spotFleetRequests(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	// The DescribeSpotFleetRequests has no filters
+	// so the tags are filtered when reading them
+	spotFleetRequests, err := a.awsr.GetSpotFleetRequests(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+
+	for _, i := range spotFleetRequests {
+		// The cancelled ones are still
+		// returned for some time
+		if !isActiveSpotFleetRequest(awsSDK.StringValue(i.SpotFleetRequestState)) {
+			continue
+		}
+
+		r, err := initializeResource(a, *i.SpotFleetRequestId, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// isActiveSpotFleetRequest checks if the state of
+// a Spot Fleet Request is not one of the cancelled
+func isActiveSpotFleetRequest(state string) bool {
+	switch state {
+	case ec2.BatchStateSubmitted, ec2.BatchStateActive, ec2.BatchStateModifying:
+		return true
+	}
+	return false
+}
+
+func shieldProtections(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	protections, err := a.awsr.GetShieldProtections(ctx, nil)
+	if err != nil {
+		// If the account has no Protections
+		// the NotFound error is returned
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == shield.ErrCodeResourceNotFoundException {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range protections {
+		r, err := initializeResource(a, *i.Id, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
 func sqsQueues(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	var input = &sqs.ListQueuesInput{
 		MaxResults: awsSDK.Int64(1000),
@@ -3115,6 +4191,133 @@ func sqsQueues(ctx context.Context, a *aws, resourceType string, filters *filter
 	return resources, nil
 }
 
+func ssmParameters(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	parameters, err := a.awsr.GetSSMParameters(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range parameters {
+		r, err := initializeResource(a, *i.Name, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func ssoadminPermissionSets(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	instances, err := a.awsr.GetSSOAdminInstances(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range instances {
+		// All the SSO Admin API calls are scoped
+		// to the Instance of the Identity Center
+		permissionSets, err := a.awsr.GetSSOAdminPermissionSets(ctx, &ssoadmin.ListPermissionSetsInput{
+			InstanceArn: i.InstanceArn,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ps := range permissionSets {
+			r, err := initializeResource(a, fmt.Sprintf("%s,%s", *ps, *i.InstanceArn), resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+func ssoadminManagedPolicyAttachments(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	permissionSets, err := getSSOAdminPermissionSets(ctx, a, SSOAdminPermissionSet.String(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, ps := range permissionSets {
+		// The ID of the Permission Set is 'PERMISSION_SET_ARN,INSTANCE_ARN'
+		psArn, instanceArn := splitSSOAdminPermissionSetID(ps)
+
+		policies, err := a.awsr.GetSSOAdminManagedPoliciesInPermissionSet(ctx, &ssoadmin.ListManagedPoliciesInPermissionSetInput{
+			InstanceArn:      awsSDK.String(instanceArn),
+			PermissionSetArn: awsSDK.String(psArn),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range policies {
+			r, err := initializeResource(a, fmt.Sprintf("%s,%s", *p.Arn, ps), resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+func ssoadminAccountAssignments(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	permissionSets, err := getSSOAdminPermissionSets(ctx, a, SSOAdminPermissionSet.String(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, ps := range permissionSets {
+		psArn, instanceArn := splitSSOAdminPermissionSetID(ps)
+
+		// Only the assignments to the account of
+		// the credentials are imported, as the rest
+		// are imported with the credentials of each account
+		assignments, err := a.awsr.GetSSOAdminAccountAssignments(ctx, &ssoadmin.ListAccountAssignmentsInput{
+			AccountId:        awsSDK.String(a.awsr.GetAccountID()),
+			InstanceArn:      awsSDK.String(instanceArn),
+			PermissionSetArn: awsSDK.String(psArn),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, as := range assignments {
+			r, err := initializeResource(a, fmt.Sprintf("%s,%s,%s,%s,%s", *as.PrincipalId, *as.PrincipalType, *as.AccountId, ssoadmin.TargetTypeAwsAccount, ps), resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+// splitSSOAdminPermissionSetID splits the ID of the aws_ssoadmin_permission_set
+// into the ARN of the Permission Set and the ARN of the Instance
+func splitSSOAdminPermissionSetID(id string) (string, string) {
+	parts := strings.SplitN(id, ",", 2)
+	if len(parts) != 2 {
+		return id, ""
+	}
+
+	return parts[0], parts[1]
+}
+
 func storagegatewayGateways(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	storagegatewayGateways, err := a.awsr.GetStorageGatewayGateways(ctx, nil)
 	if err != nil {
@@ -3156,6 +4359,72 @@ func subnets(ctx context.Context, a *aws, resourceType string, filters *filter.F
 	return resources, nil
 }
 
+func syntheticsCanaries(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	canaries, err := a.awsr.GetSyntheticsCanaries(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range canaries {
+		r, err := initializeResource(a, *i.Name, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func transferServers(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	transferServers, err := a.awsr.GetTransferServers(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range transferServers {
+		r, err := initializeResource(a, *i.ServerId, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func transferUsers(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	transferServers, err := getTransferServers(ctx, a, TransferServer.String(), filters)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, server := range transferServers {
+		transferUsers, err := a.awsr.GetTransferUsers(ctx, &transfer.ListUsersInput{
+			ServerId: awsSDK.String(server),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range transferUsers {
+			r, err := initializeResource(a, fmt.Sprintf("%s/%s", server, *i.UserName), resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
 func volumeAttachments(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	// if aws_instance defined, attachment are done by ebs_block_device block.
 	if filters.IsIncluded("aws_instance") && !filters.IsExcluded("aws_instance") {
@@ -3195,8 +4464,24 @@ func vpcPeeringConnections(ctx context.Context, a *aws, resourceType string, fil
 		return nil, err
 	}
 
+	accountID := a.awsr.GetAccountID()
 	resources := make([]provider.Resource, 0)
 	for _, i := range vpcPeeringConnections {
+		if i.Status != nil && !vpcPeeringConnectionActiveStatus[awsSDK.StringValue(i.Status.Code)] {
+			continue
+		}
+
+		// The aws_vpc_peering_connection is the side that requested the peering,
+		// which also accepts it when both VPCs are on this account and region,
+		// and the aws_vpc_peering_connection_accepter is the side that accepted
+		// a peering requested from another account or region
+		requester := isVPCPeeringConnectionSide(i.RequesterVpcInfo, accountID, a.Region())
+		accepter := isVPCPeeringConnectionSide(i.AccepterVpcInfo, accountID, a.Region())
+		if (resourceType == VPCPeeringConnection.String() && !requester) ||
+			(resourceType == VPCPeeringConnectionAccepter.String() && (!accepter || requester)) {
+			continue
+		}
+
 		r, err := initializeResource(a, *i.VpcPeeringConnectionId, resourceType)
 		if err != nil {
 			return nil, err
@@ -3208,6 +4493,16 @@ func vpcPeeringConnections(ctx context.Context, a *aws, resourceType string, fil
 	return resources, nil
 }
 
+// isVPCPeeringConnectionSide checks if the side of the peering
+// connection v is on the accountID and region
+func isVPCPeeringConnectionSide(v *ec2.VpcPeeringConnectionVpcInfo, accountID, region string) bool {
+	if v == nil {
+		return false
+	}
+
+	return awsSDK.StringValue(v.OwnerId) == accountID && awsSDK.StringValue(v.Region) == region
+}
+
 func vpcs(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	var input = &ec2.DescribeVpcsInput{
 		Filters: toEC2Filters(filters),
@@ -3275,9 +4570,134 @@ func vpnGateways(ctx context.Context, a *aws, resourceType string, filters *filt
 	return resources, nil
 }
 
+// regionWAFV2Scopes returns the wafv2Scopes to read on the region
+// of the a, the CLOUDFRONT one is only read on the default region
+func regionWAFV2Scopes(a *aws) []string {
+	if a.alias != "" {
+		return []string{wafv2.ScopeRegional}
+	}
+	return wafv2Scopes
+}
+
+func wafv2IPSets(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+	for _, scope := range regionWAFV2Scopes(a) {
+		ipSets, err := a.awsr.GetWAFV2IPSets(ctx, &wafv2.ListIPSetsInput{
+			Scope: awsSDK.String(scope),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range ipSets {
+			r, err := initializeResource(a, fmt.Sprintf("%s/%s/%s", *i.Id, *i.Name, scope), resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+func wafv2RuleGroups(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+	for _, scope := range regionWAFV2Scopes(a) {
+		ruleGroups, err := a.awsr.GetWAFV2RuleGroups(ctx, &wafv2.ListRuleGroupsInput{
+			Scope: awsSDK.String(scope),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range ruleGroups {
+			r, err := initializeResource(a, fmt.Sprintf("%s/%s/%s", *i.Id, *i.Name, scope), resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+func wafv2WebACLs(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	resources := make([]provider.Resource, 0)
+	for _, scope := range regionWAFV2Scopes(a) {
+		webACLs, err := a.awsr.GetWAFV2WebACLs(ctx, &wafv2.ListWebACLsInput{
+			Scope: awsSDK.String(scope),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range webACLs {
+			r, err := initializeResource(a, fmt.Sprintf("%s/%s/%s", *i.Id, *i.Name, scope), resourceType)
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
+
+func xrayGroups(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	groups, err := a.awsr.GetXRayGroups(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range groups {
+		r, err := initializeResource(a, *i.GroupARN, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func xraySamplingRules(ctx context.Context, a *aws, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	samplingRules, err := a.awsr.GetXRaySamplingRules(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, i := range samplingRules {
+		// The Default rule is created by AWS
+		// and it can not be created or deleted
+		if i.SamplingRule == nil || awsSDK.StringValue(i.SamplingRule.RuleName) == xraySamplingRuleDefault {
+			continue
+		}
+
+		r, err := initializeResource(a, *i.SamplingRule.RuleName, resourceType)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// toEC2Filters returns the Tags of the filters as ec2.Filter, if
+// the keys are normalized they are filtered when the resources are read,
+// as the filters of the API are case sensitive
 func toEC2Filters(filters *filter.Filter) []*ec2.Filter {
 	tags := filters.Tags
-	if len(tags) == 0 {
+	if len(tags) == 0 || !filters.IsTagsCaseSensitive() {
 		return nil
 	}
 	filtersEc2 := make([]*ec2.Filter, 0, len(tags))
@@ -3291,7 +4711,7 @@ func toEC2Filters(filters *filter.Filter) []*ec2.Filter {
 
 func toRedshiftTag(filters *filter.Filter) ([]*string, []*string) {
 	tags := filters.Tags
-	if len(tags) == 0 {
+	if len(tags) == 0 || !filters.IsTagsCaseSensitive() {
 		return nil, nil
 	}
 	filtersRedshiftTagKey := make([]*string, 0, len(tags))
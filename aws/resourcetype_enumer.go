@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _ResourceTypeName = "aws_instanceaws_albaws_alb_listeneraws_alb_listener_certificateaws_alb_listener_ruleaws_alb_target_groupaws_alb_target_group_attachmentaws_api_gateway_deploymentaws_api_gateway_resourceaws_api_gateway_rest_apiaws_api_gateway_stageaws_athena_workgroupaws_autoscaling_groupaws_autoscaling_policyaws_autoscaling_scheduleaws_batch_job_definitionaws_cloudfront_distributionaws_cloudfront_origin_access_identityaws_cloudfront_public_keyaws_cloudwatch_metric_alarmaws_dax_clusteraws_db_instanceaws_db_parameter_groupaws_db_subnet_groupaws_directory_service_directoryaws_dms_replication_instanceaws_dx_gatewayaws_dynamodb_global_tableaws_dynamodb_tableaws_ebs_volumeaws_ecs_clusteraws_ecs_serviceaws_ec2_transit_gatewayaws_ec2_transit_gateway_vpc_attachmentaws_ec2_transit_gateway_route_tableaws_ec2_transit_gateway_multicast_domainaws_ec2_transit_gateway_peering_attachmentaws_ec2_transit_gateway_peering_attachment_accepteraws_ec2_transit_gateway_prefix_list_referenceaws_ec2_transit_gateway_routeaws_ec2_transit_gateway_route_table_associationaws_ec2_transit_gateway_route_table_propagationaws_ec2_transit_gateway_vpc_attachment_accepteraws_efs_file_systemaws_eipaws_eks_clusteraws_elasticache_clusteraws_elasticache_replication_groupaws_elastic_beanstalk_applicationaws_elasticsearch_domainaws_elasticsearch_domain_policyaws_elbaws_emr_clusteraws_fsx_lustre_file_systemaws_glue_catalog_databaseaws_glue_catalog_tableaws_iam_access_keyaws_iam_account_aliasaws_iam_account_password_policyaws_iam_groupaws_iam_group_membershipaws_iam_group_policyaws_iam_group_policy_attachmentaws_iam_instance_profileaws_iam_openid_connect_provideraws_iam_policyaws_iam_roleaws_iam_role_policyaws_iam_role_policy_attachmentaws_iam_saml_provideraws_iam_server_certificateaws_iam_useraws_iam_user_group_membershipaws_iam_user_policyaws_iam_user_policy_attachmentaws_iam_user_ssh_keyaws_internet_gatewayaws_key_pairaws_kinesis_streamaws_lambda_functionaws_launch_configurationaws_launch_templateaws_lbaws_lb_cookie_stickiness_policyaws_lb_listeneraws_lb_listener_certificateaws_lb_listener_ruleaws_lb_target_groupaws_lb_target_group_attachmentaws_lightsail_instanceaws_media_store_containeraws_mq_brokeraws_nat_gatewayaws_neptune_clusteraws_rds_clusteraws_rds_global_clusteraws_redshift_clusteraws_route53_delegation_setaws_route53_health_checkaws_route53_query_logaws_route53_recordaws_route53_resolver_endpointaws_route53_resolver_rule_associationaws_route53_zoneaws_route53_zone_associationaws_route_tableaws_s3_bucketaws_security_groupaws_ses_active_receipt_rule_setaws_ses_configuration_setaws_ses_domain_dkimaws_ses_domain_identityaws_ses_domain_mail_fromaws_ses_identity_notification_topicaws_ses_receipt_filteraws_ses_receipt_ruleaws_ses_receipt_rule_setaws_ses_templateaws_sqs_queueaws_storagegateway_gatewayaws_subnetaws_volume_attachmentaws_vpcaws_vpc_endpointaws_vpc_peering_connectionaws_vpn_gateway"
+const _ResourceTypeName = "aws_instanceaws_albaws_alb_listeneraws_alb_listener_certificateaws_alb_listener_ruleaws_alb_target_groupaws_alb_target_group_attachmentaws_api_gateway_deploymentaws_api_gateway_methodaws_api_gateway_resourceaws_api_gateway_rest_apiaws_api_gateway_stageaws_apigatewayv2_apiaws_apigatewayv2_routeaws_apigatewayv2_stageaws_appmesh_meshaws_appmesh_virtual_nodeaws_appmesh_virtual_routeraws_appmesh_virtual_serviceaws_athena_workgroupaws_autoscaling_groupaws_autoscaling_policyaws_autoscaling_scheduleaws_batch_job_definitionaws_cloudfront_cache_policyaws_cloudfront_distributionaws_cloudfront_functionaws_cloudfront_origin_access_identityaws_cloudfront_public_keyaws_cloudwatch_metric_alarmaws_dax_clusteraws_db_instanceaws_db_parameter_groupaws_db_subnet_groupaws_directory_service_directoryaws_dms_replication_instanceaws_dx_gatewayaws_dynamodb_global_tableaws_dynamodb_tableaws_ebs_volumeaws_ecs_clusteraws_ecs_serviceaws_ec2_capacity_reservationaws_ec2_fleetaws_ec2_transit_gatewayaws_ec2_transit_gateway_vpc_attachmentaws_ec2_transit_gateway_route_tableaws_ec2_transit_gateway_multicast_domainaws_ec2_transit_gateway_peering_attachmentaws_ec2_transit_gateway_peering_attachment_accepteraws_ec2_transit_gateway_prefix_list_referenceaws_ec2_transit_gateway_routeaws_ec2_transit_gateway_route_table_associationaws_ec2_transit_gateway_route_table_propagationaws_ec2_transit_gateway_vpc_attachment_accepteraws_efs_file_systemaws_eipaws_eks_clusteraws_elasticache_clusteraws_elasticache_parameter_groupaws_elasticache_replication_groupaws_elasticache_subnet_groupaws_elastic_beanstalk_applicationaws_elasticsearch_domainaws_elasticsearch_domain_policyaws_elbaws_emr_clusteraws_fsx_lustre_file_systemaws_glue_catalog_databaseaws_glue_catalog_tableaws_iam_access_keyaws_iam_account_aliasaws_iam_account_password_policyaws_iam_groupaws_iam_group_membershipaws_iam_group_policyaws_iam_group_policy_attachmentaws_iam_instance_profileaws_iam_openid_connect_provideraws_iam_policyaws_iam_roleaws_iam_role_policyaws_iam_role_policy_attachmentaws_iam_saml_provideraws_iam_server_certificateaws_iam_useraws_iam_user_group_membershipaws_iam_user_policyaws_iam_user_policy_attachmentaws_iam_user_ssh_keyaws_internet_gatewayaws_key_pairaws_kinesis_firehose_delivery_streamaws_kinesis_streamaws_lambda_event_source_mappingaws_lambda_functionaws_lambda_function_urlaws_lambda_layer_versionaws_lambda_permissionaws_launch_configurationaws_launch_templateaws_lbaws_lb_cookie_stickiness_policyaws_lb_listeneraws_lb_listener_certificateaws_lb_listener_ruleaws_lb_target_groupaws_lb_target_group_attachmentaws_lightsail_instanceaws_media_store_containeraws_memorydb_clusteraws_mq_brokeraws_mq_configurationaws_msk_clusteraws_msk_configurationaws_mwaa_environmentaws_nat_gatewayaws_neptune_clusteraws_rds_clusteraws_rds_global_clusteraws_redshift_clusteraws_route53_delegation_setaws_route53_health_checkaws_route53_query_logaws_route53_recordaws_route53_resolver_endpointaws_route53_resolver_rule_associationaws_route53_zoneaws_route53_zone_associationaws_route_tableaws_s3_bucketaws_secretsmanager_secretaws_security_groupaws_security_group_ruleaws_service_discovery_http_namespaceaws_service_discovery_private_dns_namespaceaws_service_discovery_public_dns_namespaceaws_service_discovery_serviceaws_ses_active_receipt_rule_setaws_ses_configuration_setaws_ses_domain_dkimaws_ses_domain_identityaws_ses_domain_mail_fromaws_ses_identity_notification_topicaws_ses_receipt_filteraws_ses_receipt_ruleaws_ses_receipt_rule_setaws_ses_templateaws_shield_protectionaws_spot_fleet_requestaws_sqs_queueaws_ssm_parameteraws_ssoadmin_account_assignmentaws_ssoadmin_managed_policy_attachmentaws_ssoadmin_permission_setaws_storagegateway_gatewayaws_subnetaws_synthetics_canaryaws_transfer_serveraws_transfer_useraws_volume_attachmentaws_vpcaws_vpc_endpointaws_vpc_peering_connectionaws_vpc_peering_connection_accepteraws_vpn_gatewayaws_wafv2_ip_setaws_wafv2_rule_groupaws_wafv2_web_aclaws_xray_groupaws_xray_sampling_rule"
 
-var _ResourceTypeIndex = [...]uint16{0, 12, 19, 35, 63, 84, 104, 135, 161, 185, 209, 230, 250, 271, 293, 317, 341, 368, 405, 430, 457, 472, 487, 509, 528, 559, 587, 601, 626, 644, 658, 673, 688, 711, 749, 784, 824, 866, 917, 962, 991, 1038, 1085, 1132, 1151, 1158, 1173, 1196, 1229, 1262, 1286, 1317, 1324, 1339, 1365, 1390, 1412, 1430, 1451, 1482, 1495, 1519, 1539, 1570, 1594, 1625, 1639, 1651, 1670, 1700, 1721, 1747, 1759, 1788, 1807, 1837, 1857, 1877, 1889, 1907, 1926, 1950, 1969, 1975, 2006, 2021, 2048, 2068, 2087, 2117, 2139, 2164, 2177, 2192, 2211, 2226, 2248, 2268, 2294, 2318, 2339, 2357, 2386, 2423, 2439, 2467, 2482, 2495, 2513, 2544, 2569, 2588, 2611, 2635, 2670, 2692, 2712, 2736, 2752, 2765, 2791, 2801, 2822, 2829, 2845, 2871, 2886}
+var _ResourceTypeIndex = [...]uint16{0, 12, 19, 35, 63, 84, 104, 135, 161, 183, 207, 231, 252, 272, 294, 316, 332, 356, 382, 409, 429, 450, 472, 496, 520, 547, 574, 597, 634, 659, 686, 701, 716, 738, 757, 788, 816, 830, 855, 873, 887, 902, 917, 945, 958, 981, 1019, 1054, 1094, 1136, 1187, 1232, 1261, 1308, 1355, 1402, 1421, 1428, 1443, 1466, 1497, 1530, 1558, 1591, 1615, 1646, 1653, 1668, 1694, 1719, 1741, 1759, 1780, 1811, 1824, 1848, 1868, 1899, 1923, 1954, 1968, 1980, 1999, 2029, 2050, 2076, 2088, 2117, 2136, 2166, 2186, 2206, 2218, 2254, 2272, 2303, 2322, 2345, 2369, 2390, 2414, 2433, 2439, 2470, 2485, 2512, 2532, 2551, 2581, 2603, 2628, 2648, 2661, 2681, 2696, 2717, 2737, 2752, 2771, 2786, 2808, 2828, 2854, 2878, 2899, 2917, 2946, 2983, 2999, 3027, 3042, 3055, 3080, 3098, 3121, 3157, 3200, 3242, 3271, 3302, 3327, 3346, 3369, 3393, 3428, 3450, 3470, 3494, 3510, 3531, 3553, 3566, 3583, 3614, 3652, 3679, 3705, 3715, 3736, 3755, 3772, 3793, 3800, 3816, 3842, 3877, 3892, 3908, 3928, 3945, 3959, 3981}
 
-const _ResourceTypeLowerName = "aws_instanceaws_albaws_alb_listeneraws_alb_listener_certificateaws_alb_listener_ruleaws_alb_target_groupaws_alb_target_group_attachmentaws_api_gateway_deploymentaws_api_gateway_resourceaws_api_gateway_rest_apiaws_api_gateway_stageaws_athena_workgroupaws_autoscaling_groupaws_autoscaling_policyaws_autoscaling_scheduleaws_batch_job_definitionaws_cloudfront_distributionaws_cloudfront_origin_access_identityaws_cloudfront_public_keyaws_cloudwatch_metric_alarmaws_dax_clusteraws_db_instanceaws_db_parameter_groupaws_db_subnet_groupaws_directory_service_directoryaws_dms_replication_instanceaws_dx_gatewayaws_dynamodb_global_tableaws_dynamodb_tableaws_ebs_volumeaws_ecs_clusteraws_ecs_serviceaws_ec2_transit_gatewayaws_ec2_transit_gateway_vpc_attachmentaws_ec2_transit_gateway_route_tableaws_ec2_transit_gateway_multicast_domainaws_ec2_transit_gateway_peering_attachmentaws_ec2_transit_gateway_peering_attachment_accepteraws_ec2_transit_gateway_prefix_list_referenceaws_ec2_transit_gateway_routeaws_ec2_transit_gateway_route_table_associationaws_ec2_transit_gateway_route_table_propagationaws_ec2_transit_gateway_vpc_attachment_accepteraws_efs_file_systemaws_eipaws_eks_clusteraws_elasticache_clusteraws_elasticache_replication_groupaws_elastic_beanstalk_applicationaws_elasticsearch_domainaws_elasticsearch_domain_policyaws_elbaws_emr_clusteraws_fsx_lustre_file_systemaws_glue_catalog_databaseaws_glue_catalog_tableaws_iam_access_keyaws_iam_account_aliasaws_iam_account_password_policyaws_iam_groupaws_iam_group_membershipaws_iam_group_policyaws_iam_group_policy_attachmentaws_iam_instance_profileaws_iam_openid_connect_provideraws_iam_policyaws_iam_roleaws_iam_role_policyaws_iam_role_policy_attachmentaws_iam_saml_provideraws_iam_server_certificateaws_iam_useraws_iam_user_group_membershipaws_iam_user_policyaws_iam_user_policy_attachmentaws_iam_user_ssh_keyaws_internet_gatewayaws_key_pairaws_kinesis_streamaws_lambda_functionaws_launch_configurationaws_launch_templateaws_lbaws_lb_cookie_stickiness_policyaws_lb_listeneraws_lb_listener_certificateaws_lb_listener_ruleaws_lb_target_groupaws_lb_target_group_attachmentaws_lightsail_instanceaws_media_store_containeraws_mq_brokeraws_nat_gatewayaws_neptune_clusteraws_rds_clusteraws_rds_global_clusteraws_redshift_clusteraws_route53_delegation_setaws_route53_health_checkaws_route53_query_logaws_route53_recordaws_route53_resolver_endpointaws_route53_resolver_rule_associationaws_route53_zoneaws_route53_zone_associationaws_route_tableaws_s3_bucketaws_security_groupaws_ses_active_receipt_rule_setaws_ses_configuration_setaws_ses_domain_dkimaws_ses_domain_identityaws_ses_domain_mail_fromaws_ses_identity_notification_topicaws_ses_receipt_filteraws_ses_receipt_ruleaws_ses_receipt_rule_setaws_ses_templateaws_sqs_queueaws_storagegateway_gatewayaws_subnetaws_volume_attachmentaws_vpcaws_vpc_endpointaws_vpc_peering_connectionaws_vpn_gateway"
+const _ResourceTypeLowerName = "aws_instanceaws_albaws_alb_listeneraws_alb_listener_certificateaws_alb_listener_ruleaws_alb_target_groupaws_alb_target_group_attachmentaws_api_gateway_deploymentaws_api_gateway_methodaws_api_gateway_resourceaws_api_gateway_rest_apiaws_api_gateway_stageaws_apigatewayv2_apiaws_apigatewayv2_routeaws_apigatewayv2_stageaws_appmesh_meshaws_appmesh_virtual_nodeaws_appmesh_virtual_routeraws_appmesh_virtual_serviceaws_athena_workgroupaws_autoscaling_groupaws_autoscaling_policyaws_autoscaling_scheduleaws_batch_job_definitionaws_cloudfront_cache_policyaws_cloudfront_distributionaws_cloudfront_functionaws_cloudfront_origin_access_identityaws_cloudfront_public_keyaws_cloudwatch_metric_alarmaws_dax_clusteraws_db_instanceaws_db_parameter_groupaws_db_subnet_groupaws_directory_service_directoryaws_dms_replication_instanceaws_dx_gatewayaws_dynamodb_global_tableaws_dynamodb_tableaws_ebs_volumeaws_ecs_clusteraws_ecs_serviceaws_ec2_capacity_reservationaws_ec2_fleetaws_ec2_transit_gatewayaws_ec2_transit_gateway_vpc_attachmentaws_ec2_transit_gateway_route_tableaws_ec2_transit_gateway_multicast_domainaws_ec2_transit_gateway_peering_attachmentaws_ec2_transit_gateway_peering_attachment_accepteraws_ec2_transit_gateway_prefix_list_referenceaws_ec2_transit_gateway_routeaws_ec2_transit_gateway_route_table_associationaws_ec2_transit_gateway_route_table_propagationaws_ec2_transit_gateway_vpc_attachment_accepteraws_efs_file_systemaws_eipaws_eks_clusteraws_elasticache_clusteraws_elasticache_parameter_groupaws_elasticache_replication_groupaws_elasticache_subnet_groupaws_elastic_beanstalk_applicationaws_elasticsearch_domainaws_elasticsearch_domain_policyaws_elbaws_emr_clusteraws_fsx_lustre_file_systemaws_glue_catalog_databaseaws_glue_catalog_tableaws_iam_access_keyaws_iam_account_aliasaws_iam_account_password_policyaws_iam_groupaws_iam_group_membershipaws_iam_group_policyaws_iam_group_policy_attachmentaws_iam_instance_profileaws_iam_openid_connect_provideraws_iam_policyaws_iam_roleaws_iam_role_policyaws_iam_role_policy_attachmentaws_iam_saml_provideraws_iam_server_certificateaws_iam_useraws_iam_user_group_membershipaws_iam_user_policyaws_iam_user_policy_attachmentaws_iam_user_ssh_keyaws_internet_gatewayaws_key_pairaws_kinesis_firehose_delivery_streamaws_kinesis_streamaws_lambda_event_source_mappingaws_lambda_functionaws_lambda_function_urlaws_lambda_layer_versionaws_lambda_permissionaws_launch_configurationaws_launch_templateaws_lbaws_lb_cookie_stickiness_policyaws_lb_listeneraws_lb_listener_certificateaws_lb_listener_ruleaws_lb_target_groupaws_lb_target_group_attachmentaws_lightsail_instanceaws_media_store_containeraws_memorydb_clusteraws_mq_brokeraws_mq_configurationaws_msk_clusteraws_msk_configurationaws_mwaa_environmentaws_nat_gatewayaws_neptune_clusteraws_rds_clusteraws_rds_global_clusteraws_redshift_clusteraws_route53_delegation_setaws_route53_health_checkaws_route53_query_logaws_route53_recordaws_route53_resolver_endpointaws_route53_resolver_rule_associationaws_route53_zoneaws_route53_zone_associationaws_route_tableaws_s3_bucketaws_secretsmanager_secretaws_security_groupaws_security_group_ruleaws_service_discovery_http_namespaceaws_service_discovery_private_dns_namespaceaws_service_discovery_public_dns_namespaceaws_service_discovery_serviceaws_ses_active_receipt_rule_setaws_ses_configuration_setaws_ses_domain_dkimaws_ses_domain_identityaws_ses_domain_mail_fromaws_ses_identity_notification_topicaws_ses_receipt_filteraws_ses_receipt_ruleaws_ses_receipt_rule_setaws_ses_templateaws_shield_protectionaws_spot_fleet_requestaws_sqs_queueaws_ssm_parameteraws_ssoadmin_account_assignmentaws_ssoadmin_managed_policy_attachmentaws_ssoadmin_permission_setaws_storagegateway_gatewayaws_subnetaws_synthetics_canaryaws_transfer_serveraws_transfer_useraws_volume_attachmentaws_vpcaws_vpc_endpointaws_vpc_peering_connectionaws_vpc_peering_connection_accepteraws_vpn_gatewayaws_wafv2_ip_setaws_wafv2_rule_groupaws_wafv2_web_aclaws_xray_groupaws_xray_sampling_rule"
 
 func (i ResourceType) String() string {
 	i -= 1
@@ -33,127 +33,172 @@ func _ResourceTypeNoOp() {
 	_ = x[ALBTargetGroup-(6)]
 	_ = x[ALBTargetGroupAttachment-(7)]
 	_ = x[APIGatewayDeployment-(8)]
-	_ = x[APIGatewayResource-(9)]
-	_ = x[APIGatewayRestAPI-(10)]
-	_ = x[APIGatewayStage-(11)]
-	_ = x[AthenaWorkgroup-(12)]
-	_ = x[AutoscalingGroup-(13)]
-	_ = x[AutoscalingPolicy-(14)]
-	_ = x[AutoscalingSchedule-(15)]
-	_ = x[BatchJobDefinition-(16)]
-	_ = x[CloudfrontDistribution-(17)]
-	_ = x[CloudfrontOriginAccessIdentity-(18)]
-	_ = x[CloudfrontPublicKey-(19)]
-	_ = x[CloudwatchMetricAlarm-(20)]
-	_ = x[DaxCluster-(21)]
-	_ = x[DBInstance-(22)]
-	_ = x[DBParameterGroup-(23)]
-	_ = x[DBSubnetGroup-(24)]
-	_ = x[DirectoryServiceDirectory-(25)]
-	_ = x[DmsReplicationInstance-(26)]
-	_ = x[DXGateway-(27)]
-	_ = x[DynamodbGlobalTable-(28)]
-	_ = x[DynamodbTable-(29)]
-	_ = x[EBSVolume-(30)]
-	_ = x[ECSCluster-(31)]
-	_ = x[ECSService-(32)]
-	_ = x[EC2TransitGateway-(33)]
-	_ = x[EC2TransitGatewayVPCAttachment-(34)]
-	_ = x[EC2TransitGatewayRouteTable-(35)]
-	_ = x[EC2TransitGatewayMulticastDomain-(36)]
-	_ = x[EC2TransitGatewayPeeringAttachment-(37)]
-	_ = x[EC2TransitGatewayPeeringAttachmentAccepter-(38)]
-	_ = x[EC2TransitGatewayPrefixListReference-(39)]
-	_ = x[EC2TransitGatewayRoute-(40)]
-	_ = x[EC2TransitGatewayRouteTableAssociation-(41)]
-	_ = x[EC2TransitGatewayRouteTablePropagation-(42)]
-	_ = x[EC2TransitGatewayVPCAttachmentAccepter-(43)]
-	_ = x[EFSFileSystem-(44)]
-	_ = x[EIP-(45)]
-	_ = x[EKSCluster-(46)]
-	_ = x[ElasticacheCluster-(47)]
-	_ = x[ElasticacheReplicationGroup-(48)]
-	_ = x[ElasticBeanstalkApplication-(49)]
-	_ = x[ElasticsearchDomain-(50)]
-	_ = x[ElasticsearchDomainPolicy-(51)]
-	_ = x[ELB-(52)]
-	_ = x[EMRCluster-(53)]
-	_ = x[FsxLustreFileSystem-(54)]
-	_ = x[GlueCatalogDatabase-(55)]
-	_ = x[GlueCatalogTable-(56)]
-	_ = x[IAMAccessKey-(57)]
-	_ = x[IAMAccountAlias-(58)]
-	_ = x[IAMAccountPasswordPolicy-(59)]
-	_ = x[IAMGroup-(60)]
-	_ = x[IAMGroupMembership-(61)]
-	_ = x[IAMGroupPolicy-(62)]
-	_ = x[IAMGroupPolicyAttachment-(63)]
-	_ = x[IAMInstanceProfile-(64)]
-	_ = x[IAMOpenidConnectProvider-(65)]
-	_ = x[IAMPolicy-(66)]
-	_ = x[IAMRole-(67)]
-	_ = x[IAMRolePolicy-(68)]
-	_ = x[IAMRolePolicyAttachment-(69)]
-	_ = x[IAMSAMLProvider-(70)]
-	_ = x[IAMServerCertificate-(71)]
-	_ = x[IAMUser-(72)]
-	_ = x[IAMUserGroupMembership-(73)]
-	_ = x[IAMUserPolicy-(74)]
-	_ = x[IAMUserPolicyAttachment-(75)]
-	_ = x[IAMUserSSHKey-(76)]
-	_ = x[InternetGateway-(77)]
-	_ = x[KeyPair-(78)]
-	_ = x[KinesisStream-(79)]
-	_ = x[LambdaFunction-(80)]
-	_ = x[LaunchConfiguration-(81)]
-	_ = x[LaunchTemplate-(82)]
-	_ = x[LB-(83)]
-	_ = x[LBCookieStickinessPolicy-(84)]
-	_ = x[LBListener-(85)]
-	_ = x[LBListenerCertificate-(86)]
-	_ = x[LBListenerRule-(87)]
-	_ = x[LBTargetGroup-(88)]
-	_ = x[LBTargetGroupAttachment-(89)]
-	_ = x[LightsailInstance-(90)]
-	_ = x[MediaStoreContainer-(91)]
-	_ = x[MQBroker-(92)]
-	_ = x[NatGateway-(93)]
-	_ = x[NeptuneCluster-(94)]
-	_ = x[RDSCluster-(95)]
-	_ = x[RDSGlobalCluster-(96)]
-	_ = x[RedshiftCluster-(97)]
-	_ = x[Route53DelegationSet-(98)]
-	_ = x[Route53HealthCheck-(99)]
-	_ = x[Route53QueryLog-(100)]
-	_ = x[Route53Record-(101)]
-	_ = x[Route53ResolverEndpoint-(102)]
-	_ = x[Route53ResolverRuleAssociation-(103)]
-	_ = x[Route53Zone-(104)]
-	_ = x[Route53ZoneAssociation-(105)]
-	_ = x[RouteTable-(106)]
-	_ = x[S3Bucket-(107)]
-	_ = x[SecurityGroup-(108)]
-	_ = x[SESActiveReceiptRuleSet-(109)]
-	_ = x[SESConfigurationSet-(110)]
-	_ = x[SESDomainDKIM-(111)]
-	_ = x[SESDomainIdentity-(112)]
-	_ = x[SESDomainMailFrom-(113)]
-	_ = x[SESIdentityNotificationTopic-(114)]
-	_ = x[SESReceiptFilter-(115)]
-	_ = x[SESReceiptRule-(116)]
-	_ = x[SESReceiptRuleSet-(117)]
-	_ = x[SESTemplate-(118)]
-	_ = x[SQSQueue-(119)]
-	_ = x[StoragegatewayGateway-(120)]
-	_ = x[Subnet-(121)]
-	_ = x[VolumeAttachment-(122)]
-	_ = x[VPC-(123)]
-	_ = x[VPCEndpoint-(124)]
-	_ = x[VPCPeeringConnection-(125)]
-	_ = x[VPNGateway-(126)]
+	_ = x[APIGatewayMethod-(9)]
+	_ = x[APIGatewayResource-(10)]
+	_ = x[APIGatewayRestAPI-(11)]
+	_ = x[APIGatewayStage-(12)]
+	_ = x[APIGatewayV2API-(13)]
+	_ = x[APIGatewayV2Route-(14)]
+	_ = x[APIGatewayV2Stage-(15)]
+	_ = x[AppmeshMesh-(16)]
+	_ = x[AppmeshVirtualNode-(17)]
+	_ = x[AppmeshVirtualRouter-(18)]
+	_ = x[AppmeshVirtualService-(19)]
+	_ = x[AthenaWorkgroup-(20)]
+	_ = x[AutoscalingGroup-(21)]
+	_ = x[AutoscalingPolicy-(22)]
+	_ = x[AutoscalingSchedule-(23)]
+	_ = x[BatchJobDefinition-(24)]
+	_ = x[CloudfrontCachePolicy-(25)]
+	_ = x[CloudfrontDistribution-(26)]
+	_ = x[CloudfrontFunction-(27)]
+	_ = x[CloudfrontOriginAccessIdentity-(28)]
+	_ = x[CloudfrontPublicKey-(29)]
+	_ = x[CloudwatchMetricAlarm-(30)]
+	_ = x[DaxCluster-(31)]
+	_ = x[DBInstance-(32)]
+	_ = x[DBParameterGroup-(33)]
+	_ = x[DBSubnetGroup-(34)]
+	_ = x[DirectoryServiceDirectory-(35)]
+	_ = x[DmsReplicationInstance-(36)]
+	_ = x[DXGateway-(37)]
+	_ = x[DynamodbGlobalTable-(38)]
+	_ = x[DynamodbTable-(39)]
+	_ = x[EBSVolume-(40)]
+	_ = x[ECSCluster-(41)]
+	_ = x[ECSService-(42)]
+	_ = x[EC2CapacityReservation-(43)]
+	_ = x[EC2Fleet-(44)]
+	_ = x[EC2TransitGateway-(45)]
+	_ = x[EC2TransitGatewayVPCAttachment-(46)]
+	_ = x[EC2TransitGatewayRouteTable-(47)]
+	_ = x[EC2TransitGatewayMulticastDomain-(48)]
+	_ = x[EC2TransitGatewayPeeringAttachment-(49)]
+	_ = x[EC2TransitGatewayPeeringAttachmentAccepter-(50)]
+	_ = x[EC2TransitGatewayPrefixListReference-(51)]
+	_ = x[EC2TransitGatewayRoute-(52)]
+	_ = x[EC2TransitGatewayRouteTableAssociation-(53)]
+	_ = x[EC2TransitGatewayRouteTablePropagation-(54)]
+	_ = x[EC2TransitGatewayVPCAttachmentAccepter-(55)]
+	_ = x[EFSFileSystem-(56)]
+	_ = x[EIP-(57)]
+	_ = x[EKSCluster-(58)]
+	_ = x[ElasticacheCluster-(59)]
+	_ = x[ElasticacheParameterGroup-(60)]
+	_ = x[ElasticacheReplicationGroup-(61)]
+	_ = x[ElasticacheSubnetGroup-(62)]
+	_ = x[ElasticBeanstalkApplication-(63)]
+	_ = x[ElasticsearchDomain-(64)]
+	_ = x[ElasticsearchDomainPolicy-(65)]
+	_ = x[ELB-(66)]
+	_ = x[EMRCluster-(67)]
+	_ = x[FsxLustreFileSystem-(68)]
+	_ = x[GlueCatalogDatabase-(69)]
+	_ = x[GlueCatalogTable-(70)]
+	_ = x[IAMAccessKey-(71)]
+	_ = x[IAMAccountAlias-(72)]
+	_ = x[IAMAccountPasswordPolicy-(73)]
+	_ = x[IAMGroup-(74)]
+	_ = x[IAMGroupMembership-(75)]
+	_ = x[IAMGroupPolicy-(76)]
+	_ = x[IAMGroupPolicyAttachment-(77)]
+	_ = x[IAMInstanceProfile-(78)]
+	_ = x[IAMOpenidConnectProvider-(79)]
+	_ = x[IAMPolicy-(80)]
+	_ = x[IAMRole-(81)]
+	_ = x[IAMRolePolicy-(82)]
+	_ = x[IAMRolePolicyAttachment-(83)]
+	_ = x[IAMSAMLProvider-(84)]
+	_ = x[IAMServerCertificate-(85)]
+	_ = x[IAMUser-(86)]
+	_ = x[IAMUserGroupMembership-(87)]
+	_ = x[IAMUserPolicy-(88)]
+	_ = x[IAMUserPolicyAttachment-(89)]
+	_ = x[IAMUserSSHKey-(90)]
+	_ = x[InternetGateway-(91)]
+	_ = x[KeyPair-(92)]
+	_ = x[KinesisFirehoseDeliveryStream-(93)]
+	_ = x[KinesisStream-(94)]
+	_ = x[LambdaEventSourceMapping-(95)]
+	_ = x[LambdaFunction-(96)]
+	_ = x[LambdaFunctionURL-(97)]
+	_ = x[LambdaLayerVersion-(98)]
+	_ = x[LambdaPermission-(99)]
+	_ = x[LaunchConfiguration-(100)]
+	_ = x[LaunchTemplate-(101)]
+	_ = x[LB-(102)]
+	_ = x[LBCookieStickinessPolicy-(103)]
+	_ = x[LBListener-(104)]
+	_ = x[LBListenerCertificate-(105)]
+	_ = x[LBListenerRule-(106)]
+	_ = x[LBTargetGroup-(107)]
+	_ = x[LBTargetGroupAttachment-(108)]
+	_ = x[LightsailInstance-(109)]
+	_ = x[MediaStoreContainer-(110)]
+	_ = x[MemoryDBCluster-(111)]
+	_ = x[MQBroker-(112)]
+	_ = x[MQConfiguration-(113)]
+	_ = x[MSKCluster-(114)]
+	_ = x[MSKConfiguration-(115)]
+	_ = x[MWAAEnvironment-(116)]
+	_ = x[NatGateway-(117)]
+	_ = x[NeptuneCluster-(118)]
+	_ = x[RDSCluster-(119)]
+	_ = x[RDSGlobalCluster-(120)]
+	_ = x[RedshiftCluster-(121)]
+	_ = x[Route53DelegationSet-(122)]
+	_ = x[Route53HealthCheck-(123)]
+	_ = x[Route53QueryLog-(124)]
+	_ = x[Route53Record-(125)]
+	_ = x[Route53ResolverEndpoint-(126)]
+	_ = x[Route53ResolverRuleAssociation-(127)]
+	_ = x[Route53Zone-(128)]
+	_ = x[Route53ZoneAssociation-(129)]
+	_ = x[RouteTable-(130)]
+	_ = x[S3Bucket-(131)]
+	_ = x[SecretsmanagerSecret-(132)]
+	_ = x[SecurityGroup-(133)]
+	_ = x[SecurityGroupRule-(134)]
+	_ = x[ServiceDiscoveryHTTPNamespace-(135)]
+	_ = x[ServiceDiscoveryPrivateDNSNamespace-(136)]
+	_ = x[ServiceDiscoveryPublicDNSNamespace-(137)]
+	_ = x[ServiceDiscoveryService-(138)]
+	_ = x[SESActiveReceiptRuleSet-(139)]
+	_ = x[SESConfigurationSet-(140)]
+	_ = x[SESDomainDKIM-(141)]
+	_ = x[SESDomainIdentity-(142)]
+	_ = x[SESDomainMailFrom-(143)]
+	_ = x[SESIdentityNotificationTopic-(144)]
+	_ = x[SESReceiptFilter-(145)]
+	_ = x[SESReceiptRule-(146)]
+	_ = x[SESReceiptRuleSet-(147)]
+	_ = x[SESTemplate-(148)]
+	_ = x[ShieldProtection-(149)]
+	_ = x[SpotFleetRequest-(150)]
+	_ = x[SQSQueue-(151)]
+	_ = x[SSMParameter-(152)]
+	_ = x[SSOAdminAccountAssignment-(153)]
+	_ = x[SSOAdminManagedPolicyAttachment-(154)]
+	_ = x[SSOAdminPermissionSet-(155)]
+	_ = x[StoragegatewayGateway-(156)]
+	_ = x[Subnet-(157)]
+	_ = x[SyntheticsCanary-(158)]
+	_ = x[TransferServer-(159)]
+	_ = x[TransferUser-(160)]
+	_ = x[VolumeAttachment-(161)]
+	_ = x[VPC-(162)]
+	_ = x[VPCEndpoint-(163)]
+	_ = x[VPCPeeringConnection-(164)]
+	_ = x[VPCPeeringConnectionAccepter-(165)]
+	_ = x[VPNGateway-(166)]
+	_ = x[WAFV2IPSet-(167)]
+	_ = x[WAFV2RuleGroup-(168)]
+	_ = x[WAFV2WebACL-(169)]
+	_ = x[XRayGroup-(170)]
+	_ = x[XRaySamplingRule-(171)]
 }
 
-var _ResourceTypeValues = []ResourceType{Instance, ALB, ALBListener, ALBListenerCertificate, ALBListenerRule, ALBTargetGroup, ALBTargetGroupAttachment, APIGatewayDeployment, APIGatewayResource, APIGatewayRestAPI, APIGatewayStage, AthenaWorkgroup, AutoscalingGroup, AutoscalingPolicy, AutoscalingSchedule, BatchJobDefinition, CloudfrontDistribution, CloudfrontOriginAccessIdentity, CloudfrontPublicKey, CloudwatchMetricAlarm, DaxCluster, DBInstance, DBParameterGroup, DBSubnetGroup, DirectoryServiceDirectory, DmsReplicationInstance, DXGateway, DynamodbGlobalTable, DynamodbTable, EBSVolume, ECSCluster, ECSService, EC2TransitGateway, EC2TransitGatewayVPCAttachment, EC2TransitGatewayRouteTable, EC2TransitGatewayMulticastDomain, EC2TransitGatewayPeeringAttachment, EC2TransitGatewayPeeringAttachmentAccepter, EC2TransitGatewayPrefixListReference, EC2TransitGatewayRoute, EC2TransitGatewayRouteTableAssociation, EC2TransitGatewayRouteTablePropagation, EC2TransitGatewayVPCAttachmentAccepter, EFSFileSystem, EIP, EKSCluster, ElasticacheCluster, ElasticacheReplicationGroup, ElasticBeanstalkApplication, ElasticsearchDomain, ElasticsearchDomainPolicy, ELB, EMRCluster, FsxLustreFileSystem, GlueCatalogDatabase, GlueCatalogTable, IAMAccessKey, IAMAccountAlias, IAMAccountPasswordPolicy, IAMGroup, IAMGroupMembership, IAMGroupPolicy, IAMGroupPolicyAttachment, IAMInstanceProfile, IAMOpenidConnectProvider, IAMPolicy, IAMRole, IAMRolePolicy, IAMRolePolicyAttachment, IAMSAMLProvider, IAMServerCertificate, IAMUser, IAMUserGroupMembership, IAMUserPolicy, IAMUserPolicyAttachment, IAMUserSSHKey, InternetGateway, KeyPair, KinesisStream, LambdaFunction, LaunchConfiguration, LaunchTemplate, LB, LBCookieStickinessPolicy, LBListener, LBListenerCertificate, LBListenerRule, LBTargetGroup, LBTargetGroupAttachment, LightsailInstance, MediaStoreContainer, MQBroker, NatGateway, NeptuneCluster, RDSCluster, RDSGlobalCluster, RedshiftCluster, Route53DelegationSet, Route53HealthCheck, Route53QueryLog, Route53Record, Route53ResolverEndpoint, Route53ResolverRuleAssociation, Route53Zone, Route53ZoneAssociation, RouteTable, S3Bucket, SecurityGroup, SESActiveReceiptRuleSet, SESConfigurationSet, SESDomainDKIM, SESDomainIdentity, SESDomainMailFrom, SESIdentityNotificationTopic, SESReceiptFilter, SESReceiptRule, SESReceiptRuleSet, SESTemplate, SQSQueue, StoragegatewayGateway, Subnet, VolumeAttachment, VPC, VPCEndpoint, VPCPeeringConnection, VPNGateway}
+var _ResourceTypeValues = []ResourceType{Instance, ALB, ALBListener, ALBListenerCertificate, ALBListenerRule, ALBTargetGroup, ALBTargetGroupAttachment, APIGatewayDeployment, APIGatewayMethod, APIGatewayResource, APIGatewayRestAPI, APIGatewayStage, APIGatewayV2API, APIGatewayV2Route, APIGatewayV2Stage, AppmeshMesh, AppmeshVirtualNode, AppmeshVirtualRouter, AppmeshVirtualService, AthenaWorkgroup, AutoscalingGroup, AutoscalingPolicy, AutoscalingSchedule, BatchJobDefinition, CloudfrontCachePolicy, CloudfrontDistribution, CloudfrontFunction, CloudfrontOriginAccessIdentity, CloudfrontPublicKey, CloudwatchMetricAlarm, DaxCluster, DBInstance, DBParameterGroup, DBSubnetGroup, DirectoryServiceDirectory, DmsReplicationInstance, DXGateway, DynamodbGlobalTable, DynamodbTable, EBSVolume, ECSCluster, ECSService, EC2CapacityReservation, EC2Fleet, EC2TransitGateway, EC2TransitGatewayVPCAttachment, EC2TransitGatewayRouteTable, EC2TransitGatewayMulticastDomain, EC2TransitGatewayPeeringAttachment, EC2TransitGatewayPeeringAttachmentAccepter, EC2TransitGatewayPrefixListReference, EC2TransitGatewayRoute, EC2TransitGatewayRouteTableAssociation, EC2TransitGatewayRouteTablePropagation, EC2TransitGatewayVPCAttachmentAccepter, EFSFileSystem, EIP, EKSCluster, ElasticacheCluster, ElasticacheParameterGroup, ElasticacheReplicationGroup, ElasticacheSubnetGroup, ElasticBeanstalkApplication, ElasticsearchDomain, ElasticsearchDomainPolicy, ELB, EMRCluster, FsxLustreFileSystem, GlueCatalogDatabase, GlueCatalogTable, IAMAccessKey, IAMAccountAlias, IAMAccountPasswordPolicy, IAMGroup, IAMGroupMembership, IAMGroupPolicy, IAMGroupPolicyAttachment, IAMInstanceProfile, IAMOpenidConnectProvider, IAMPolicy, IAMRole, IAMRolePolicy, IAMRolePolicyAttachment, IAMSAMLProvider, IAMServerCertificate, IAMUser, IAMUserGroupMembership, IAMUserPolicy, IAMUserPolicyAttachment, IAMUserSSHKey, InternetGateway, KeyPair, KinesisFirehoseDeliveryStream, KinesisStream, LambdaEventSourceMapping, LambdaFunction, LambdaFunctionURL, LambdaLayerVersion, LambdaPermission, LaunchConfiguration, LaunchTemplate, LB, LBCookieStickinessPolicy, LBListener, LBListenerCertificate, LBListenerRule, LBTargetGroup, LBTargetGroupAttachment, LightsailInstance, MediaStoreContainer, MemoryDBCluster, MQBroker, MQConfiguration, MSKCluster, MSKConfiguration, MWAAEnvironment, NatGateway, NeptuneCluster, RDSCluster, RDSGlobalCluster, RedshiftCluster, Route53DelegationSet, Route53HealthCheck, Route53QueryLog, Route53Record, Route53ResolverEndpoint, Route53ResolverRuleAssociation, Route53Zone, Route53ZoneAssociation, RouteTable, S3Bucket, SecretsmanagerSecret, SecurityGroup, SecurityGroupRule, ServiceDiscoveryHTTPNamespace, ServiceDiscoveryPrivateDNSNamespace, ServiceDiscoveryPublicDNSNamespace, ServiceDiscoveryService, SESActiveReceiptRuleSet, SESConfigurationSet, SESDomainDKIM, SESDomainIdentity, SESDomainMailFrom, SESIdentityNotificationTopic, SESReceiptFilter, SESReceiptRule, SESReceiptRuleSet, SESTemplate, ShieldProtection, SpotFleetRequest, SQSQueue, SSMParameter, SSOAdminAccountAssignment, SSOAdminManagedPolicyAttachment, SSOAdminPermissionSet, StoragegatewayGateway, Subnet, SyntheticsCanary, TransferServer, TransferUser, VolumeAttachment, VPC, VPCEndpoint, VPCPeeringConnection, VPCPeeringConnectionAccepter, VPNGateway, WAFV2IPSet, WAFV2RuleGroup, WAFV2WebACL, XRayGroup, XRaySamplingRule}
 
 var _ResourceTypeNameToValueMap = map[string]ResourceType{
 	_ResourceTypeName[0:12]:           Instance,
@@ -172,242 +217,332 @@ var _ResourceTypeNameToValueMap = map[string]ResourceType{
 	_ResourceTypeLowerName[104:135]:   ALBTargetGroupAttachment,
 	_ResourceTypeName[135:161]:        APIGatewayDeployment,
 	_ResourceTypeLowerName[135:161]:   APIGatewayDeployment,
-	_ResourceTypeName[161:185]:        APIGatewayResource,
-	_ResourceTypeLowerName[161:185]:   APIGatewayResource,
-	_ResourceTypeName[185:209]:        APIGatewayRestAPI,
-	_ResourceTypeLowerName[185:209]:   APIGatewayRestAPI,
-	_ResourceTypeName[209:230]:        APIGatewayStage,
-	_ResourceTypeLowerName[209:230]:   APIGatewayStage,
-	_ResourceTypeName[230:250]:        AthenaWorkgroup,
-	_ResourceTypeLowerName[230:250]:   AthenaWorkgroup,
-	_ResourceTypeName[250:271]:        AutoscalingGroup,
-	_ResourceTypeLowerName[250:271]:   AutoscalingGroup,
-	_ResourceTypeName[271:293]:        AutoscalingPolicy,
-	_ResourceTypeLowerName[271:293]:   AutoscalingPolicy,
-	_ResourceTypeName[293:317]:        AutoscalingSchedule,
-	_ResourceTypeLowerName[293:317]:   AutoscalingSchedule,
-	_ResourceTypeName[317:341]:        BatchJobDefinition,
-	_ResourceTypeLowerName[317:341]:   BatchJobDefinition,
-	_ResourceTypeName[341:368]:        CloudfrontDistribution,
-	_ResourceTypeLowerName[341:368]:   CloudfrontDistribution,
-	_ResourceTypeName[368:405]:        CloudfrontOriginAccessIdentity,
-	_ResourceTypeLowerName[368:405]:   CloudfrontOriginAccessIdentity,
-	_ResourceTypeName[405:430]:        CloudfrontPublicKey,
-	_ResourceTypeLowerName[405:430]:   CloudfrontPublicKey,
-	_ResourceTypeName[430:457]:        CloudwatchMetricAlarm,
-	_ResourceTypeLowerName[430:457]:   CloudwatchMetricAlarm,
-	_ResourceTypeName[457:472]:        DaxCluster,
-	_ResourceTypeLowerName[457:472]:   DaxCluster,
-	_ResourceTypeName[472:487]:        DBInstance,
-	_ResourceTypeLowerName[472:487]:   DBInstance,
-	_ResourceTypeName[487:509]:        DBParameterGroup,
-	_ResourceTypeLowerName[487:509]:   DBParameterGroup,
-	_ResourceTypeName[509:528]:        DBSubnetGroup,
-	_ResourceTypeLowerName[509:528]:   DBSubnetGroup,
-	_ResourceTypeName[528:559]:        DirectoryServiceDirectory,
-	_ResourceTypeLowerName[528:559]:   DirectoryServiceDirectory,
-	_ResourceTypeName[559:587]:        DmsReplicationInstance,
-	_ResourceTypeLowerName[559:587]:   DmsReplicationInstance,
-	_ResourceTypeName[587:601]:        DXGateway,
-	_ResourceTypeLowerName[587:601]:   DXGateway,
-	_ResourceTypeName[601:626]:        DynamodbGlobalTable,
-	_ResourceTypeLowerName[601:626]:   DynamodbGlobalTable,
-	_ResourceTypeName[626:644]:        DynamodbTable,
-	_ResourceTypeLowerName[626:644]:   DynamodbTable,
-	_ResourceTypeName[644:658]:        EBSVolume,
-	_ResourceTypeLowerName[644:658]:   EBSVolume,
-	_ResourceTypeName[658:673]:        ECSCluster,
-	_ResourceTypeLowerName[658:673]:   ECSCluster,
-	_ResourceTypeName[673:688]:        ECSService,
-	_ResourceTypeLowerName[673:688]:   ECSService,
-	_ResourceTypeName[688:711]:        EC2TransitGateway,
-	_ResourceTypeLowerName[688:711]:   EC2TransitGateway,
-	_ResourceTypeName[711:749]:        EC2TransitGatewayVPCAttachment,
-	_ResourceTypeLowerName[711:749]:   EC2TransitGatewayVPCAttachment,
-	_ResourceTypeName[749:784]:        EC2TransitGatewayRouteTable,
-	_ResourceTypeLowerName[749:784]:   EC2TransitGatewayRouteTable,
-	_ResourceTypeName[784:824]:        EC2TransitGatewayMulticastDomain,
-	_ResourceTypeLowerName[784:824]:   EC2TransitGatewayMulticastDomain,
-	_ResourceTypeName[824:866]:        EC2TransitGatewayPeeringAttachment,
-	_ResourceTypeLowerName[824:866]:   EC2TransitGatewayPeeringAttachment,
-	_ResourceTypeName[866:917]:        EC2TransitGatewayPeeringAttachmentAccepter,
-	_ResourceTypeLowerName[866:917]:   EC2TransitGatewayPeeringAttachmentAccepter,
-	_ResourceTypeName[917:962]:        EC2TransitGatewayPrefixListReference,
-	_ResourceTypeLowerName[917:962]:   EC2TransitGatewayPrefixListReference,
-	_ResourceTypeName[962:991]:        EC2TransitGatewayRoute,
-	_ResourceTypeLowerName[962:991]:   EC2TransitGatewayRoute,
-	_ResourceTypeName[991:1038]:       EC2TransitGatewayRouteTableAssociation,
-	_ResourceTypeLowerName[991:1038]:  EC2TransitGatewayRouteTableAssociation,
-	_ResourceTypeName[1038:1085]:      EC2TransitGatewayRouteTablePropagation,
-	_ResourceTypeLowerName[1038:1085]: EC2TransitGatewayRouteTablePropagation,
-	_ResourceTypeName[1085:1132]:      EC2TransitGatewayVPCAttachmentAccepter,
-	_ResourceTypeLowerName[1085:1132]: EC2TransitGatewayVPCAttachmentAccepter,
-	_ResourceTypeName[1132:1151]:      EFSFileSystem,
-	_ResourceTypeLowerName[1132:1151]: EFSFileSystem,
-	_ResourceTypeName[1151:1158]:      EIP,
-	_ResourceTypeLowerName[1151:1158]: EIP,
-	_ResourceTypeName[1158:1173]:      EKSCluster,
-	_ResourceTypeLowerName[1158:1173]: EKSCluster,
-	_ResourceTypeName[1173:1196]:      ElasticacheCluster,
-	_ResourceTypeLowerName[1173:1196]: ElasticacheCluster,
-	_ResourceTypeName[1196:1229]:      ElasticacheReplicationGroup,
-	_ResourceTypeLowerName[1196:1229]: ElasticacheReplicationGroup,
-	_ResourceTypeName[1229:1262]:      ElasticBeanstalkApplication,
-	_ResourceTypeLowerName[1229:1262]: ElasticBeanstalkApplication,
-	_ResourceTypeName[1262:1286]:      ElasticsearchDomain,
-	_ResourceTypeLowerName[1262:1286]: ElasticsearchDomain,
-	_ResourceTypeName[1286:1317]:      ElasticsearchDomainPolicy,
-	_ResourceTypeLowerName[1286:1317]: ElasticsearchDomainPolicy,
-	_ResourceTypeName[1317:1324]:      ELB,
-	_ResourceTypeLowerName[1317:1324]: ELB,
-	_ResourceTypeName[1324:1339]:      EMRCluster,
-	_ResourceTypeLowerName[1324:1339]: EMRCluster,
-	_ResourceTypeName[1339:1365]:      FsxLustreFileSystem,
-	_ResourceTypeLowerName[1339:1365]: FsxLustreFileSystem,
-	_ResourceTypeName[1365:1390]:      GlueCatalogDatabase,
-	_ResourceTypeLowerName[1365:1390]: GlueCatalogDatabase,
-	_ResourceTypeName[1390:1412]:      GlueCatalogTable,
-	_ResourceTypeLowerName[1390:1412]: GlueCatalogTable,
-	_ResourceTypeName[1412:1430]:      IAMAccessKey,
-	_ResourceTypeLowerName[1412:1430]: IAMAccessKey,
-	_ResourceTypeName[1430:1451]:      IAMAccountAlias,
-	_ResourceTypeLowerName[1430:1451]: IAMAccountAlias,
-	_ResourceTypeName[1451:1482]:      IAMAccountPasswordPolicy,
-	_ResourceTypeLowerName[1451:1482]: IAMAccountPasswordPolicy,
-	_ResourceTypeName[1482:1495]:      IAMGroup,
-	_ResourceTypeLowerName[1482:1495]: IAMGroup,
-	_ResourceTypeName[1495:1519]:      IAMGroupMembership,
-	_ResourceTypeLowerName[1495:1519]: IAMGroupMembership,
-	_ResourceTypeName[1519:1539]:      IAMGroupPolicy,
-	_ResourceTypeLowerName[1519:1539]: IAMGroupPolicy,
-	_ResourceTypeName[1539:1570]:      IAMGroupPolicyAttachment,
-	_ResourceTypeLowerName[1539:1570]: IAMGroupPolicyAttachment,
-	_ResourceTypeName[1570:1594]:      IAMInstanceProfile,
-	_ResourceTypeLowerName[1570:1594]: IAMInstanceProfile,
-	_ResourceTypeName[1594:1625]:      IAMOpenidConnectProvider,
-	_ResourceTypeLowerName[1594:1625]: IAMOpenidConnectProvider,
-	_ResourceTypeName[1625:1639]:      IAMPolicy,
-	_ResourceTypeLowerName[1625:1639]: IAMPolicy,
-	_ResourceTypeName[1639:1651]:      IAMRole,
-	_ResourceTypeLowerName[1639:1651]: IAMRole,
-	_ResourceTypeName[1651:1670]:      IAMRolePolicy,
-	_ResourceTypeLowerName[1651:1670]: IAMRolePolicy,
-	_ResourceTypeName[1670:1700]:      IAMRolePolicyAttachment,
-	_ResourceTypeLowerName[1670:1700]: IAMRolePolicyAttachment,
-	_ResourceTypeName[1700:1721]:      IAMSAMLProvider,
-	_ResourceTypeLowerName[1700:1721]: IAMSAMLProvider,
-	_ResourceTypeName[1721:1747]:      IAMServerCertificate,
-	_ResourceTypeLowerName[1721:1747]: IAMServerCertificate,
-	_ResourceTypeName[1747:1759]:      IAMUser,
-	_ResourceTypeLowerName[1747:1759]: IAMUser,
-	_ResourceTypeName[1759:1788]:      IAMUserGroupMembership,
-	_ResourceTypeLowerName[1759:1788]: IAMUserGroupMembership,
-	_ResourceTypeName[1788:1807]:      IAMUserPolicy,
-	_ResourceTypeLowerName[1788:1807]: IAMUserPolicy,
-	_ResourceTypeName[1807:1837]:      IAMUserPolicyAttachment,
-	_ResourceTypeLowerName[1807:1837]: IAMUserPolicyAttachment,
-	_ResourceTypeName[1837:1857]:      IAMUserSSHKey,
-	_ResourceTypeLowerName[1837:1857]: IAMUserSSHKey,
-	_ResourceTypeName[1857:1877]:      InternetGateway,
-	_ResourceTypeLowerName[1857:1877]: InternetGateway,
-	_ResourceTypeName[1877:1889]:      KeyPair,
-	_ResourceTypeLowerName[1877:1889]: KeyPair,
-	_ResourceTypeName[1889:1907]:      KinesisStream,
-	_ResourceTypeLowerName[1889:1907]: KinesisStream,
-	_ResourceTypeName[1907:1926]:      LambdaFunction,
-	_ResourceTypeLowerName[1907:1926]: LambdaFunction,
-	_ResourceTypeName[1926:1950]:      LaunchConfiguration,
-	_ResourceTypeLowerName[1926:1950]: LaunchConfiguration,
-	_ResourceTypeName[1950:1969]:      LaunchTemplate,
-	_ResourceTypeLowerName[1950:1969]: LaunchTemplate,
-	_ResourceTypeName[1969:1975]:      LB,
-	_ResourceTypeLowerName[1969:1975]: LB,
-	_ResourceTypeName[1975:2006]:      LBCookieStickinessPolicy,
-	_ResourceTypeLowerName[1975:2006]: LBCookieStickinessPolicy,
-	_ResourceTypeName[2006:2021]:      LBListener,
-	_ResourceTypeLowerName[2006:2021]: LBListener,
-	_ResourceTypeName[2021:2048]:      LBListenerCertificate,
-	_ResourceTypeLowerName[2021:2048]: LBListenerCertificate,
-	_ResourceTypeName[2048:2068]:      LBListenerRule,
-	_ResourceTypeLowerName[2048:2068]: LBListenerRule,
-	_ResourceTypeName[2068:2087]:      LBTargetGroup,
-	_ResourceTypeLowerName[2068:2087]: LBTargetGroup,
-	_ResourceTypeName[2087:2117]:      LBTargetGroupAttachment,
-	_ResourceTypeLowerName[2087:2117]: LBTargetGroupAttachment,
-	_ResourceTypeName[2117:2139]:      LightsailInstance,
-	_ResourceTypeLowerName[2117:2139]: LightsailInstance,
-	_ResourceTypeName[2139:2164]:      MediaStoreContainer,
-	_ResourceTypeLowerName[2139:2164]: MediaStoreContainer,
-	_ResourceTypeName[2164:2177]:      MQBroker,
-	_ResourceTypeLowerName[2164:2177]: MQBroker,
-	_ResourceTypeName[2177:2192]:      NatGateway,
-	_ResourceTypeLowerName[2177:2192]: NatGateway,
-	_ResourceTypeName[2192:2211]:      NeptuneCluster,
-	_ResourceTypeLowerName[2192:2211]: NeptuneCluster,
-	_ResourceTypeName[2211:2226]:      RDSCluster,
-	_ResourceTypeLowerName[2211:2226]: RDSCluster,
-	_ResourceTypeName[2226:2248]:      RDSGlobalCluster,
-	_ResourceTypeLowerName[2226:2248]: RDSGlobalCluster,
-	_ResourceTypeName[2248:2268]:      RedshiftCluster,
-	_ResourceTypeLowerName[2248:2268]: RedshiftCluster,
-	_ResourceTypeName[2268:2294]:      Route53DelegationSet,
-	_ResourceTypeLowerName[2268:2294]: Route53DelegationSet,
-	_ResourceTypeName[2294:2318]:      Route53HealthCheck,
-	_ResourceTypeLowerName[2294:2318]: Route53HealthCheck,
-	_ResourceTypeName[2318:2339]:      Route53QueryLog,
-	_ResourceTypeLowerName[2318:2339]: Route53QueryLog,
-	_ResourceTypeName[2339:2357]:      Route53Record,
-	_ResourceTypeLowerName[2339:2357]: Route53Record,
-	_ResourceTypeName[2357:2386]:      Route53ResolverEndpoint,
-	_ResourceTypeLowerName[2357:2386]: Route53ResolverEndpoint,
-	_ResourceTypeName[2386:2423]:      Route53ResolverRuleAssociation,
-	_ResourceTypeLowerName[2386:2423]: Route53ResolverRuleAssociation,
-	_ResourceTypeName[2423:2439]:      Route53Zone,
-	_ResourceTypeLowerName[2423:2439]: Route53Zone,
-	_ResourceTypeName[2439:2467]:      Route53ZoneAssociation,
-	_ResourceTypeLowerName[2439:2467]: Route53ZoneAssociation,
-	_ResourceTypeName[2467:2482]:      RouteTable,
-	_ResourceTypeLowerName[2467:2482]: RouteTable,
-	_ResourceTypeName[2482:2495]:      S3Bucket,
-	_ResourceTypeLowerName[2482:2495]: S3Bucket,
-	_ResourceTypeName[2495:2513]:      SecurityGroup,
-	_ResourceTypeLowerName[2495:2513]: SecurityGroup,
-	_ResourceTypeName[2513:2544]:      SESActiveReceiptRuleSet,
-	_ResourceTypeLowerName[2513:2544]: SESActiveReceiptRuleSet,
-	_ResourceTypeName[2544:2569]:      SESConfigurationSet,
-	_ResourceTypeLowerName[2544:2569]: SESConfigurationSet,
-	_ResourceTypeName[2569:2588]:      SESDomainDKIM,
-	_ResourceTypeLowerName[2569:2588]: SESDomainDKIM,
-	_ResourceTypeName[2588:2611]:      SESDomainIdentity,
-	_ResourceTypeLowerName[2588:2611]: SESDomainIdentity,
-	_ResourceTypeName[2611:2635]:      SESDomainMailFrom,
-	_ResourceTypeLowerName[2611:2635]: SESDomainMailFrom,
-	_ResourceTypeName[2635:2670]:      SESIdentityNotificationTopic,
-	_ResourceTypeLowerName[2635:2670]: SESIdentityNotificationTopic,
-	_ResourceTypeName[2670:2692]:      SESReceiptFilter,
-	_ResourceTypeLowerName[2670:2692]: SESReceiptFilter,
-	_ResourceTypeName[2692:2712]:      SESReceiptRule,
-	_ResourceTypeLowerName[2692:2712]: SESReceiptRule,
-	_ResourceTypeName[2712:2736]:      SESReceiptRuleSet,
-	_ResourceTypeLowerName[2712:2736]: SESReceiptRuleSet,
-	_ResourceTypeName[2736:2752]:      SESTemplate,
-	_ResourceTypeLowerName[2736:2752]: SESTemplate,
-	_ResourceTypeName[2752:2765]:      SQSQueue,
-	_ResourceTypeLowerName[2752:2765]: SQSQueue,
-	_ResourceTypeName[2765:2791]:      StoragegatewayGateway,
-	_ResourceTypeLowerName[2765:2791]: StoragegatewayGateway,
-	_ResourceTypeName[2791:2801]:      Subnet,
-	_ResourceTypeLowerName[2791:2801]: Subnet,
-	_ResourceTypeName[2801:2822]:      VolumeAttachment,
-	_ResourceTypeLowerName[2801:2822]: VolumeAttachment,
-	_ResourceTypeName[2822:2829]:      VPC,
-	_ResourceTypeLowerName[2822:2829]: VPC,
-	_ResourceTypeName[2829:2845]:      VPCEndpoint,
-	_ResourceTypeLowerName[2829:2845]: VPCEndpoint,
-	_ResourceTypeName[2845:2871]:      VPCPeeringConnection,
-	_ResourceTypeLowerName[2845:2871]: VPCPeeringConnection,
-	_ResourceTypeName[2871:2886]:      VPNGateway,
-	_ResourceTypeLowerName[2871:2886]: VPNGateway,
+	_ResourceTypeName[161:183]:        APIGatewayMethod,
+	_ResourceTypeLowerName[161:183]:   APIGatewayMethod,
+	_ResourceTypeName[183:207]:        APIGatewayResource,
+	_ResourceTypeLowerName[183:207]:   APIGatewayResource,
+	_ResourceTypeName[207:231]:        APIGatewayRestAPI,
+	_ResourceTypeLowerName[207:231]:   APIGatewayRestAPI,
+	_ResourceTypeName[231:252]:        APIGatewayStage,
+	_ResourceTypeLowerName[231:252]:   APIGatewayStage,
+	_ResourceTypeName[252:272]:        APIGatewayV2API,
+	_ResourceTypeLowerName[252:272]:   APIGatewayV2API,
+	_ResourceTypeName[272:294]:        APIGatewayV2Route,
+	_ResourceTypeLowerName[272:294]:   APIGatewayV2Route,
+	_ResourceTypeName[294:316]:        APIGatewayV2Stage,
+	_ResourceTypeLowerName[294:316]:   APIGatewayV2Stage,
+	_ResourceTypeName[316:332]:        AppmeshMesh,
+	_ResourceTypeLowerName[316:332]:   AppmeshMesh,
+	_ResourceTypeName[332:356]:        AppmeshVirtualNode,
+	_ResourceTypeLowerName[332:356]:   AppmeshVirtualNode,
+	_ResourceTypeName[356:382]:        AppmeshVirtualRouter,
+	_ResourceTypeLowerName[356:382]:   AppmeshVirtualRouter,
+	_ResourceTypeName[382:409]:        AppmeshVirtualService,
+	_ResourceTypeLowerName[382:409]:   AppmeshVirtualService,
+	_ResourceTypeName[409:429]:        AthenaWorkgroup,
+	_ResourceTypeLowerName[409:429]:   AthenaWorkgroup,
+	_ResourceTypeName[429:450]:        AutoscalingGroup,
+	_ResourceTypeLowerName[429:450]:   AutoscalingGroup,
+	_ResourceTypeName[450:472]:        AutoscalingPolicy,
+	_ResourceTypeLowerName[450:472]:   AutoscalingPolicy,
+	_ResourceTypeName[472:496]:        AutoscalingSchedule,
+	_ResourceTypeLowerName[472:496]:   AutoscalingSchedule,
+	_ResourceTypeName[496:520]:        BatchJobDefinition,
+	_ResourceTypeLowerName[496:520]:   BatchJobDefinition,
+	_ResourceTypeName[520:547]:        CloudfrontCachePolicy,
+	_ResourceTypeLowerName[520:547]:   CloudfrontCachePolicy,
+	_ResourceTypeName[547:574]:        CloudfrontDistribution,
+	_ResourceTypeLowerName[547:574]:   CloudfrontDistribution,
+	_ResourceTypeName[574:597]:        CloudfrontFunction,
+	_ResourceTypeLowerName[574:597]:   CloudfrontFunction,
+	_ResourceTypeName[597:634]:        CloudfrontOriginAccessIdentity,
+	_ResourceTypeLowerName[597:634]:   CloudfrontOriginAccessIdentity,
+	_ResourceTypeName[634:659]:        CloudfrontPublicKey,
+	_ResourceTypeLowerName[634:659]:   CloudfrontPublicKey,
+	_ResourceTypeName[659:686]:        CloudwatchMetricAlarm,
+	_ResourceTypeLowerName[659:686]:   CloudwatchMetricAlarm,
+	_ResourceTypeName[686:701]:        DaxCluster,
+	_ResourceTypeLowerName[686:701]:   DaxCluster,
+	_ResourceTypeName[701:716]:        DBInstance,
+	_ResourceTypeLowerName[701:716]:   DBInstance,
+	_ResourceTypeName[716:738]:        DBParameterGroup,
+	_ResourceTypeLowerName[716:738]:   DBParameterGroup,
+	_ResourceTypeName[738:757]:        DBSubnetGroup,
+	_ResourceTypeLowerName[738:757]:   DBSubnetGroup,
+	_ResourceTypeName[757:788]:        DirectoryServiceDirectory,
+	_ResourceTypeLowerName[757:788]:   DirectoryServiceDirectory,
+	_ResourceTypeName[788:816]:        DmsReplicationInstance,
+	_ResourceTypeLowerName[788:816]:   DmsReplicationInstance,
+	_ResourceTypeName[816:830]:        DXGateway,
+	_ResourceTypeLowerName[816:830]:   DXGateway,
+	_ResourceTypeName[830:855]:        DynamodbGlobalTable,
+	_ResourceTypeLowerName[830:855]:   DynamodbGlobalTable,
+	_ResourceTypeName[855:873]:        DynamodbTable,
+	_ResourceTypeLowerName[855:873]:   DynamodbTable,
+	_ResourceTypeName[873:887]:        EBSVolume,
+	_ResourceTypeLowerName[873:887]:   EBSVolume,
+	_ResourceTypeName[887:902]:        ECSCluster,
+	_ResourceTypeLowerName[887:902]:   ECSCluster,
+	_ResourceTypeName[902:917]:        ECSService,
+	_ResourceTypeLowerName[902:917]:   ECSService,
+	_ResourceTypeName[917:945]:        EC2CapacityReservation,
+	_ResourceTypeLowerName[917:945]:   EC2CapacityReservation,
+	_ResourceTypeName[945:958]:        EC2Fleet,
+	_ResourceTypeLowerName[945:958]:   EC2Fleet,
+	_ResourceTypeName[958:981]:        EC2TransitGateway,
+	_ResourceTypeLowerName[958:981]:   EC2TransitGateway,
+	_ResourceTypeName[981:1019]:       EC2TransitGatewayVPCAttachment,
+	_ResourceTypeLowerName[981:1019]:  EC2TransitGatewayVPCAttachment,
+	_ResourceTypeName[1019:1054]:      EC2TransitGatewayRouteTable,
+	_ResourceTypeLowerName[1019:1054]: EC2TransitGatewayRouteTable,
+	_ResourceTypeName[1054:1094]:      EC2TransitGatewayMulticastDomain,
+	_ResourceTypeLowerName[1054:1094]: EC2TransitGatewayMulticastDomain,
+	_ResourceTypeName[1094:1136]:      EC2TransitGatewayPeeringAttachment,
+	_ResourceTypeLowerName[1094:1136]: EC2TransitGatewayPeeringAttachment,
+	_ResourceTypeName[1136:1187]:      EC2TransitGatewayPeeringAttachmentAccepter,
+	_ResourceTypeLowerName[1136:1187]: EC2TransitGatewayPeeringAttachmentAccepter,
+	_ResourceTypeName[1187:1232]:      EC2TransitGatewayPrefixListReference,
+	_ResourceTypeLowerName[1187:1232]: EC2TransitGatewayPrefixListReference,
+	_ResourceTypeName[1232:1261]:      EC2TransitGatewayRoute,
+	_ResourceTypeLowerName[1232:1261]: EC2TransitGatewayRoute,
+	_ResourceTypeName[1261:1308]:      EC2TransitGatewayRouteTableAssociation,
+	_ResourceTypeLowerName[1261:1308]: EC2TransitGatewayRouteTableAssociation,
+	_ResourceTypeName[1308:1355]:      EC2TransitGatewayRouteTablePropagation,
+	_ResourceTypeLowerName[1308:1355]: EC2TransitGatewayRouteTablePropagation,
+	_ResourceTypeName[1355:1402]:      EC2TransitGatewayVPCAttachmentAccepter,
+	_ResourceTypeLowerName[1355:1402]: EC2TransitGatewayVPCAttachmentAccepter,
+	_ResourceTypeName[1402:1421]:      EFSFileSystem,
+	_ResourceTypeLowerName[1402:1421]: EFSFileSystem,
+	_ResourceTypeName[1421:1428]:      EIP,
+	_ResourceTypeLowerName[1421:1428]: EIP,
+	_ResourceTypeName[1428:1443]:      EKSCluster,
+	_ResourceTypeLowerName[1428:1443]: EKSCluster,
+	_ResourceTypeName[1443:1466]:      ElasticacheCluster,
+	_ResourceTypeLowerName[1443:1466]: ElasticacheCluster,
+	_ResourceTypeName[1466:1497]:      ElasticacheParameterGroup,
+	_ResourceTypeLowerName[1466:1497]: ElasticacheParameterGroup,
+	_ResourceTypeName[1497:1530]:      ElasticacheReplicationGroup,
+	_ResourceTypeLowerName[1497:1530]: ElasticacheReplicationGroup,
+	_ResourceTypeName[1530:1558]:      ElasticacheSubnetGroup,
+	_ResourceTypeLowerName[1530:1558]: ElasticacheSubnetGroup,
+	_ResourceTypeName[1558:1591]:      ElasticBeanstalkApplication,
+	_ResourceTypeLowerName[1558:1591]: ElasticBeanstalkApplication,
+	_ResourceTypeName[1591:1615]:      ElasticsearchDomain,
+	_ResourceTypeLowerName[1591:1615]: ElasticsearchDomain,
+	_ResourceTypeName[1615:1646]:      ElasticsearchDomainPolicy,
+	_ResourceTypeLowerName[1615:1646]: ElasticsearchDomainPolicy,
+	_ResourceTypeName[1646:1653]:      ELB,
+	_ResourceTypeLowerName[1646:1653]: ELB,
+	_ResourceTypeName[1653:1668]:      EMRCluster,
+	_ResourceTypeLowerName[1653:1668]: EMRCluster,
+	_ResourceTypeName[1668:1694]:      FsxLustreFileSystem,
+	_ResourceTypeLowerName[1668:1694]: FsxLustreFileSystem,
+	_ResourceTypeName[1694:1719]:      GlueCatalogDatabase,
+	_ResourceTypeLowerName[1694:1719]: GlueCatalogDatabase,
+	_ResourceTypeName[1719:1741]:      GlueCatalogTable,
+	_ResourceTypeLowerName[1719:1741]: GlueCatalogTable,
+	_ResourceTypeName[1741:1759]:      IAMAccessKey,
+	_ResourceTypeLowerName[1741:1759]: IAMAccessKey,
+	_ResourceTypeName[1759:1780]:      IAMAccountAlias,
+	_ResourceTypeLowerName[1759:1780]: IAMAccountAlias,
+	_ResourceTypeName[1780:1811]:      IAMAccountPasswordPolicy,
+	_ResourceTypeLowerName[1780:1811]: IAMAccountPasswordPolicy,
+	_ResourceTypeName[1811:1824]:      IAMGroup,
+	_ResourceTypeLowerName[1811:1824]: IAMGroup,
+	_ResourceTypeName[1824:1848]:      IAMGroupMembership,
+	_ResourceTypeLowerName[1824:1848]: IAMGroupMembership,
+	_ResourceTypeName[1848:1868]:      IAMGroupPolicy,
+	_ResourceTypeLowerName[1848:1868]: IAMGroupPolicy,
+	_ResourceTypeName[1868:1899]:      IAMGroupPolicyAttachment,
+	_ResourceTypeLowerName[1868:1899]: IAMGroupPolicyAttachment,
+	_ResourceTypeName[1899:1923]:      IAMInstanceProfile,
+	_ResourceTypeLowerName[1899:1923]: IAMInstanceProfile,
+	_ResourceTypeName[1923:1954]:      IAMOpenidConnectProvider,
+	_ResourceTypeLowerName[1923:1954]: IAMOpenidConnectProvider,
+	_ResourceTypeName[1954:1968]:      IAMPolicy,
+	_ResourceTypeLowerName[1954:1968]: IAMPolicy,
+	_ResourceTypeName[1968:1980]:      IAMRole,
+	_ResourceTypeLowerName[1968:1980]: IAMRole,
+	_ResourceTypeName[1980:1999]:      IAMRolePolicy,
+	_ResourceTypeLowerName[1980:1999]: IAMRolePolicy,
+	_ResourceTypeName[1999:2029]:      IAMRolePolicyAttachment,
+	_ResourceTypeLowerName[1999:2029]: IAMRolePolicyAttachment,
+	_ResourceTypeName[2029:2050]:      IAMSAMLProvider,
+	_ResourceTypeLowerName[2029:2050]: IAMSAMLProvider,
+	_ResourceTypeName[2050:2076]:      IAMServerCertificate,
+	_ResourceTypeLowerName[2050:2076]: IAMServerCertificate,
+	_ResourceTypeName[2076:2088]:      IAMUser,
+	_ResourceTypeLowerName[2076:2088]: IAMUser,
+	_ResourceTypeName[2088:2117]:      IAMUserGroupMembership,
+	_ResourceTypeLowerName[2088:2117]: IAMUserGroupMembership,
+	_ResourceTypeName[2117:2136]:      IAMUserPolicy,
+	_ResourceTypeLowerName[2117:2136]: IAMUserPolicy,
+	_ResourceTypeName[2136:2166]:      IAMUserPolicyAttachment,
+	_ResourceTypeLowerName[2136:2166]: IAMUserPolicyAttachment,
+	_ResourceTypeName[2166:2186]:      IAMUserSSHKey,
+	_ResourceTypeLowerName[2166:2186]: IAMUserSSHKey,
+	_ResourceTypeName[2186:2206]:      InternetGateway,
+	_ResourceTypeLowerName[2186:2206]: InternetGateway,
+	_ResourceTypeName[2206:2218]:      KeyPair,
+	_ResourceTypeLowerName[2206:2218]: KeyPair,
+	_ResourceTypeName[2218:2254]:      KinesisFirehoseDeliveryStream,
+	_ResourceTypeLowerName[2218:2254]: KinesisFirehoseDeliveryStream,
+	_ResourceTypeName[2254:2272]:      KinesisStream,
+	_ResourceTypeLowerName[2254:2272]: KinesisStream,
+	_ResourceTypeName[2272:2303]:      LambdaEventSourceMapping,
+	_ResourceTypeLowerName[2272:2303]: LambdaEventSourceMapping,
+	_ResourceTypeName[2303:2322]:      LambdaFunction,
+	_ResourceTypeLowerName[2303:2322]: LambdaFunction,
+	_ResourceTypeName[2322:2345]:      LambdaFunctionURL,
+	_ResourceTypeLowerName[2322:2345]: LambdaFunctionURL,
+	_ResourceTypeName[2345:2369]:      LambdaLayerVersion,
+	_ResourceTypeLowerName[2345:2369]: LambdaLayerVersion,
+	_ResourceTypeName[2369:2390]:      LambdaPermission,
+	_ResourceTypeLowerName[2369:2390]: LambdaPermission,
+	_ResourceTypeName[2390:2414]:      LaunchConfiguration,
+	_ResourceTypeLowerName[2390:2414]: LaunchConfiguration,
+	_ResourceTypeName[2414:2433]:      LaunchTemplate,
+	_ResourceTypeLowerName[2414:2433]: LaunchTemplate,
+	_ResourceTypeName[2433:2439]:      LB,
+	_ResourceTypeLowerName[2433:2439]: LB,
+	_ResourceTypeName[2439:2470]:      LBCookieStickinessPolicy,
+	_ResourceTypeLowerName[2439:2470]: LBCookieStickinessPolicy,
+	_ResourceTypeName[2470:2485]:      LBListener,
+	_ResourceTypeLowerName[2470:2485]: LBListener,
+	_ResourceTypeName[2485:2512]:      LBListenerCertificate,
+	_ResourceTypeLowerName[2485:2512]: LBListenerCertificate,
+	_ResourceTypeName[2512:2532]:      LBListenerRule,
+	_ResourceTypeLowerName[2512:2532]: LBListenerRule,
+	_ResourceTypeName[2532:2551]:      LBTargetGroup,
+	_ResourceTypeLowerName[2532:2551]: LBTargetGroup,
+	_ResourceTypeName[2551:2581]:      LBTargetGroupAttachment,
+	_ResourceTypeLowerName[2551:2581]: LBTargetGroupAttachment,
+	_ResourceTypeName[2581:2603]:      LightsailInstance,
+	_ResourceTypeLowerName[2581:2603]: LightsailInstance,
+	_ResourceTypeName[2603:2628]:      MediaStoreContainer,
+	_ResourceTypeLowerName[2603:2628]: MediaStoreContainer,
+	_ResourceTypeName[2628:2648]:      MemoryDBCluster,
+	_ResourceTypeLowerName[2628:2648]: MemoryDBCluster,
+	_ResourceTypeName[2648:2661]:      MQBroker,
+	_ResourceTypeLowerName[2648:2661]: MQBroker,
+	_ResourceTypeName[2661:2681]:      MQConfiguration,
+	_ResourceTypeLowerName[2661:2681]: MQConfiguration,
+	_ResourceTypeName[2681:2696]:      MSKCluster,
+	_ResourceTypeLowerName[2681:2696]: MSKCluster,
+	_ResourceTypeName[2696:2717]:      MSKConfiguration,
+	_ResourceTypeLowerName[2696:2717]: MSKConfiguration,
+	_ResourceTypeName[2717:2737]:      MWAAEnvironment,
+	_ResourceTypeLowerName[2717:2737]: MWAAEnvironment,
+	_ResourceTypeName[2737:2752]:      NatGateway,
+	_ResourceTypeLowerName[2737:2752]: NatGateway,
+	_ResourceTypeName[2752:2771]:      NeptuneCluster,
+	_ResourceTypeLowerName[2752:2771]: NeptuneCluster,
+	_ResourceTypeName[2771:2786]:      RDSCluster,
+	_ResourceTypeLowerName[2771:2786]: RDSCluster,
+	_ResourceTypeName[2786:2808]:      RDSGlobalCluster,
+	_ResourceTypeLowerName[2786:2808]: RDSGlobalCluster,
+	_ResourceTypeName[2808:2828]:      RedshiftCluster,
+	_ResourceTypeLowerName[2808:2828]: RedshiftCluster,
+	_ResourceTypeName[2828:2854]:      Route53DelegationSet,
+	_ResourceTypeLowerName[2828:2854]: Route53DelegationSet,
+	_ResourceTypeName[2854:2878]:      Route53HealthCheck,
+	_ResourceTypeLowerName[2854:2878]: Route53HealthCheck,
+	_ResourceTypeName[2878:2899]:      Route53QueryLog,
+	_ResourceTypeLowerName[2878:2899]: Route53QueryLog,
+	_ResourceTypeName[2899:2917]:      Route53Record,
+	_ResourceTypeLowerName[2899:2917]: Route53Record,
+	_ResourceTypeName[2917:2946]:      Route53ResolverEndpoint,
+	_ResourceTypeLowerName[2917:2946]: Route53ResolverEndpoint,
+	_ResourceTypeName[2946:2983]:      Route53ResolverRuleAssociation,
+	_ResourceTypeLowerName[2946:2983]: Route53ResolverRuleAssociation,
+	_ResourceTypeName[2983:2999]:      Route53Zone,
+	_ResourceTypeLowerName[2983:2999]: Route53Zone,
+	_ResourceTypeName[2999:3027]:      Route53ZoneAssociation,
+	_ResourceTypeLowerName[2999:3027]: Route53ZoneAssociation,
+	_ResourceTypeName[3027:3042]:      RouteTable,
+	_ResourceTypeLowerName[3027:3042]: RouteTable,
+	_ResourceTypeName[3042:3055]:      S3Bucket,
+	_ResourceTypeLowerName[3042:3055]: S3Bucket,
+	_ResourceTypeName[3055:3080]:      SecretsmanagerSecret,
+	_ResourceTypeLowerName[3055:3080]: SecretsmanagerSecret,
+	_ResourceTypeName[3080:3098]:      SecurityGroup,
+	_ResourceTypeLowerName[3080:3098]: SecurityGroup,
+	_ResourceTypeName[3098:3121]:      SecurityGroupRule,
+	_ResourceTypeLowerName[3098:3121]: SecurityGroupRule,
+	_ResourceTypeName[3121:3157]:      ServiceDiscoveryHTTPNamespace,
+	_ResourceTypeLowerName[3121:3157]: ServiceDiscoveryHTTPNamespace,
+	_ResourceTypeName[3157:3200]:      ServiceDiscoveryPrivateDNSNamespace,
+	_ResourceTypeLowerName[3157:3200]: ServiceDiscoveryPrivateDNSNamespace,
+	_ResourceTypeName[3200:3242]:      ServiceDiscoveryPublicDNSNamespace,
+	_ResourceTypeLowerName[3200:3242]: ServiceDiscoveryPublicDNSNamespace,
+	_ResourceTypeName[3242:3271]:      ServiceDiscoveryService,
+	_ResourceTypeLowerName[3242:3271]: ServiceDiscoveryService,
+	_ResourceTypeName[3271:3302]:      SESActiveReceiptRuleSet,
+	_ResourceTypeLowerName[3271:3302]: SESActiveReceiptRuleSet,
+	_ResourceTypeName[3302:3327]:      SESConfigurationSet,
+	_ResourceTypeLowerName[3302:3327]: SESConfigurationSet,
+	_ResourceTypeName[3327:3346]:      SESDomainDKIM,
+	_ResourceTypeLowerName[3327:3346]: SESDomainDKIM,
+	_ResourceTypeName[3346:3369]:      SESDomainIdentity,
+	_ResourceTypeLowerName[3346:3369]: SESDomainIdentity,
+	_ResourceTypeName[3369:3393]:      SESDomainMailFrom,
+	_ResourceTypeLowerName[3369:3393]: SESDomainMailFrom,
+	_ResourceTypeName[3393:3428]:      SESIdentityNotificationTopic,
+	_ResourceTypeLowerName[3393:3428]: SESIdentityNotificationTopic,
+	_ResourceTypeName[3428:3450]:      SESReceiptFilter,
+	_ResourceTypeLowerName[3428:3450]: SESReceiptFilter,
+	_ResourceTypeName[3450:3470]:      SESReceiptRule,
+	_ResourceTypeLowerName[3450:3470]: SESReceiptRule,
+	_ResourceTypeName[3470:3494]:      SESReceiptRuleSet,
+	_ResourceTypeLowerName[3470:3494]: SESReceiptRuleSet,
+	_ResourceTypeName[3494:3510]:      SESTemplate,
+	_ResourceTypeLowerName[3494:3510]: SESTemplate,
+	_ResourceTypeName[3510:3531]:      ShieldProtection,
+	_ResourceTypeLowerName[3510:3531]: ShieldProtection,
+	_ResourceTypeName[3531:3553]:      SpotFleetRequest,
+	_ResourceTypeLowerName[3531:3553]: SpotFleetRequest,
+	_ResourceTypeName[3553:3566]:      SQSQueue,
+	_ResourceTypeLowerName[3553:3566]: SQSQueue,
+	_ResourceTypeName[3566:3583]:      SSMParameter,
+	_ResourceTypeLowerName[3566:3583]: SSMParameter,
+	_ResourceTypeName[3583:3614]:      SSOAdminAccountAssignment,
+	_ResourceTypeLowerName[3583:3614]: SSOAdminAccountAssignment,
+	_ResourceTypeName[3614:3652]:      SSOAdminManagedPolicyAttachment,
+	_ResourceTypeLowerName[3614:3652]: SSOAdminManagedPolicyAttachment,
+	_ResourceTypeName[3652:3679]:      SSOAdminPermissionSet,
+	_ResourceTypeLowerName[3652:3679]: SSOAdminPermissionSet,
+	_ResourceTypeName[3679:3705]:      StoragegatewayGateway,
+	_ResourceTypeLowerName[3679:3705]: StoragegatewayGateway,
+	_ResourceTypeName[3705:3715]:      Subnet,
+	_ResourceTypeLowerName[3705:3715]: Subnet,
+	_ResourceTypeName[3715:3736]:      SyntheticsCanary,
+	_ResourceTypeLowerName[3715:3736]: SyntheticsCanary,
+	_ResourceTypeName[3736:3755]:      TransferServer,
+	_ResourceTypeLowerName[3736:3755]: TransferServer,
+	_ResourceTypeName[3755:3772]:      TransferUser,
+	_ResourceTypeLowerName[3755:3772]: TransferUser,
+	_ResourceTypeName[3772:3793]:      VolumeAttachment,
+	_ResourceTypeLowerName[3772:3793]: VolumeAttachment,
+	_ResourceTypeName[3793:3800]:      VPC,
+	_ResourceTypeLowerName[3793:3800]: VPC,
+	_ResourceTypeName[3800:3816]:      VPCEndpoint,
+	_ResourceTypeLowerName[3800:3816]: VPCEndpoint,
+	_ResourceTypeName[3816:3842]:      VPCPeeringConnection,
+	_ResourceTypeLowerName[3816:3842]: VPCPeeringConnection,
+	_ResourceTypeName[3842:3877]:      VPCPeeringConnectionAccepter,
+	_ResourceTypeLowerName[3842:3877]: VPCPeeringConnectionAccepter,
+	_ResourceTypeName[3877:3892]:      VPNGateway,
+	_ResourceTypeLowerName[3877:3892]: VPNGateway,
+	_ResourceTypeName[3892:3908]:      WAFV2IPSet,
+	_ResourceTypeLowerName[3892:3908]: WAFV2IPSet,
+	_ResourceTypeName[3908:3928]:      WAFV2RuleGroup,
+	_ResourceTypeLowerName[3908:3928]: WAFV2RuleGroup,
+	_ResourceTypeName[3928:3945]:      WAFV2WebACL,
+	_ResourceTypeLowerName[3928:3945]: WAFV2WebACL,
+	_ResourceTypeName[3945:3959]:      XRayGroup,
+	_ResourceTypeLowerName[3945:3959]: XRayGroup,
+	_ResourceTypeName[3959:3981]:      XRaySamplingRule,
+	_ResourceTypeLowerName[3959:3981]: XRaySamplingRule,
 }
 
 var _ResourceTypeNames = []string{
@@ -419,124 +554,169 @@ var _ResourceTypeNames = []string{
 	_ResourceTypeName[84:104],
 	_ResourceTypeName[104:135],
 	_ResourceTypeName[135:161],
-	_ResourceTypeName[161:185],
-	_ResourceTypeName[185:209],
-	_ResourceTypeName[209:230],
-	_ResourceTypeName[230:250],
-	_ResourceTypeName[250:271],
-	_ResourceTypeName[271:293],
-	_ResourceTypeName[293:317],
-	_ResourceTypeName[317:341],
-	_ResourceTypeName[341:368],
-	_ResourceTypeName[368:405],
-	_ResourceTypeName[405:430],
-	_ResourceTypeName[430:457],
-	_ResourceTypeName[457:472],
-	_ResourceTypeName[472:487],
-	_ResourceTypeName[487:509],
-	_ResourceTypeName[509:528],
-	_ResourceTypeName[528:559],
-	_ResourceTypeName[559:587],
-	_ResourceTypeName[587:601],
-	_ResourceTypeName[601:626],
-	_ResourceTypeName[626:644],
-	_ResourceTypeName[644:658],
-	_ResourceTypeName[658:673],
-	_ResourceTypeName[673:688],
-	_ResourceTypeName[688:711],
-	_ResourceTypeName[711:749],
-	_ResourceTypeName[749:784],
-	_ResourceTypeName[784:824],
-	_ResourceTypeName[824:866],
-	_ResourceTypeName[866:917],
-	_ResourceTypeName[917:962],
-	_ResourceTypeName[962:991],
-	_ResourceTypeName[991:1038],
-	_ResourceTypeName[1038:1085],
-	_ResourceTypeName[1085:1132],
-	_ResourceTypeName[1132:1151],
-	_ResourceTypeName[1151:1158],
-	_ResourceTypeName[1158:1173],
-	_ResourceTypeName[1173:1196],
-	_ResourceTypeName[1196:1229],
-	_ResourceTypeName[1229:1262],
-	_ResourceTypeName[1262:1286],
-	_ResourceTypeName[1286:1317],
-	_ResourceTypeName[1317:1324],
-	_ResourceTypeName[1324:1339],
-	_ResourceTypeName[1339:1365],
-	_ResourceTypeName[1365:1390],
-	_ResourceTypeName[1390:1412],
-	_ResourceTypeName[1412:1430],
-	_ResourceTypeName[1430:1451],
-	_ResourceTypeName[1451:1482],
-	_ResourceTypeName[1482:1495],
-	_ResourceTypeName[1495:1519],
-	_ResourceTypeName[1519:1539],
-	_ResourceTypeName[1539:1570],
-	_ResourceTypeName[1570:1594],
-	_ResourceTypeName[1594:1625],
-	_ResourceTypeName[1625:1639],
-	_ResourceTypeName[1639:1651],
-	_ResourceTypeName[1651:1670],
-	_ResourceTypeName[1670:1700],
-	_ResourceTypeName[1700:1721],
-	_ResourceTypeName[1721:1747],
-	_ResourceTypeName[1747:1759],
-	_ResourceTypeName[1759:1788],
-	_ResourceTypeName[1788:1807],
-	_ResourceTypeName[1807:1837],
-	_ResourceTypeName[1837:1857],
-	_ResourceTypeName[1857:1877],
-	_ResourceTypeName[1877:1889],
-	_ResourceTypeName[1889:1907],
-	_ResourceTypeName[1907:1926],
-	_ResourceTypeName[1926:1950],
-	_ResourceTypeName[1950:1969],
-	_ResourceTypeName[1969:1975],
-	_ResourceTypeName[1975:2006],
-	_ResourceTypeName[2006:2021],
-	_ResourceTypeName[2021:2048],
-	_ResourceTypeName[2048:2068],
-	_ResourceTypeName[2068:2087],
-	_ResourceTypeName[2087:2117],
-	_ResourceTypeName[2117:2139],
-	_ResourceTypeName[2139:2164],
-	_ResourceTypeName[2164:2177],
-	_ResourceTypeName[2177:2192],
-	_ResourceTypeName[2192:2211],
-	_ResourceTypeName[2211:2226],
-	_ResourceTypeName[2226:2248],
-	_ResourceTypeName[2248:2268],
-	_ResourceTypeName[2268:2294],
-	_ResourceTypeName[2294:2318],
-	_ResourceTypeName[2318:2339],
-	_ResourceTypeName[2339:2357],
-	_ResourceTypeName[2357:2386],
-	_ResourceTypeName[2386:2423],
-	_ResourceTypeName[2423:2439],
-	_ResourceTypeName[2439:2467],
-	_ResourceTypeName[2467:2482],
-	_ResourceTypeName[2482:2495],
-	_ResourceTypeName[2495:2513],
-	_ResourceTypeName[2513:2544],
-	_ResourceTypeName[2544:2569],
-	_ResourceTypeName[2569:2588],
-	_ResourceTypeName[2588:2611],
-	_ResourceTypeName[2611:2635],
-	_ResourceTypeName[2635:2670],
-	_ResourceTypeName[2670:2692],
-	_ResourceTypeName[2692:2712],
-	_ResourceTypeName[2712:2736],
-	_ResourceTypeName[2736:2752],
-	_ResourceTypeName[2752:2765],
-	_ResourceTypeName[2765:2791],
-	_ResourceTypeName[2791:2801],
-	_ResourceTypeName[2801:2822],
-	_ResourceTypeName[2822:2829],
-	_ResourceTypeName[2829:2845],
-	_ResourceTypeName[2845:2871],
-	_ResourceTypeName[2871:2886],
+	_ResourceTypeName[161:183],
+	_ResourceTypeName[183:207],
+	_ResourceTypeName[207:231],
+	_ResourceTypeName[231:252],
+	_ResourceTypeName[252:272],
+	_ResourceTypeName[272:294],
+	_ResourceTypeName[294:316],
+	_ResourceTypeName[316:332],
+	_ResourceTypeName[332:356],
+	_ResourceTypeName[356:382],
+	_ResourceTypeName[382:409],
+	_ResourceTypeName[409:429],
+	_ResourceTypeName[429:450],
+	_ResourceTypeName[450:472],
+	_ResourceTypeName[472:496],
+	_ResourceTypeName[496:520],
+	_ResourceTypeName[520:547],
+	_ResourceTypeName[547:574],
+	_ResourceTypeName[574:597],
+	_ResourceTypeName[597:634],
+	_ResourceTypeName[634:659],
+	_ResourceTypeName[659:686],
+	_ResourceTypeName[686:701],
+	_ResourceTypeName[701:716],
+	_ResourceTypeName[716:738],
+	_ResourceTypeName[738:757],
+	_ResourceTypeName[757:788],
+	_ResourceTypeName[788:816],
+	_ResourceTypeName[816:830],
+	_ResourceTypeName[830:855],
+	_ResourceTypeName[855:873],
+	_ResourceTypeName[873:887],
+	_ResourceTypeName[887:902],
+	_ResourceTypeName[902:917],
+	_ResourceTypeName[917:945],
+	_ResourceTypeName[945:958],
+	_ResourceTypeName[958:981],
+	_ResourceTypeName[981:1019],
+	_ResourceTypeName[1019:1054],
+	_ResourceTypeName[1054:1094],
+	_ResourceTypeName[1094:1136],
+	_ResourceTypeName[1136:1187],
+	_ResourceTypeName[1187:1232],
+	_ResourceTypeName[1232:1261],
+	_ResourceTypeName[1261:1308],
+	_ResourceTypeName[1308:1355],
+	_ResourceTypeName[1355:1402],
+	_ResourceTypeName[1402:1421],
+	_ResourceTypeName[1421:1428],
+	_ResourceTypeName[1428:1443],
+	_ResourceTypeName[1443:1466],
+	_ResourceTypeName[1466:1497],
+	_ResourceTypeName[1497:1530],
+	_ResourceTypeName[1530:1558],
+	_ResourceTypeName[1558:1591],
+	_ResourceTypeName[1591:1615],
+	_ResourceTypeName[1615:1646],
+	_ResourceTypeName[1646:1653],
+	_ResourceTypeName[1653:1668],
+	_ResourceTypeName[1668:1694],
+	_ResourceTypeName[1694:1719],
+	_ResourceTypeName[1719:1741],
+	_ResourceTypeName[1741:1759],
+	_ResourceTypeName[1759:1780],
+	_ResourceTypeName[1780:1811],
+	_ResourceTypeName[1811:1824],
+	_ResourceTypeName[1824:1848],
+	_ResourceTypeName[1848:1868],
+	_ResourceTypeName[1868:1899],
+	_ResourceTypeName[1899:1923],
+	_ResourceTypeName[1923:1954],
+	_ResourceTypeName[1954:1968],
+	_ResourceTypeName[1968:1980],
+	_ResourceTypeName[1980:1999],
+	_ResourceTypeName[1999:2029],
+	_ResourceTypeName[2029:2050],
+	_ResourceTypeName[2050:2076],
+	_ResourceTypeName[2076:2088],
+	_ResourceTypeName[2088:2117],
+	_ResourceTypeName[2117:2136],
+	_ResourceTypeName[2136:2166],
+	_ResourceTypeName[2166:2186],
+	_ResourceTypeName[2186:2206],
+	_ResourceTypeName[2206:2218],
+	_ResourceTypeName[2218:2254],
+	_ResourceTypeName[2254:2272],
+	_ResourceTypeName[2272:2303],
+	_ResourceTypeName[2303:2322],
+	_ResourceTypeName[2322:2345],
+	_ResourceTypeName[2345:2369],
+	_ResourceTypeName[2369:2390],
+	_ResourceTypeName[2390:2414],
+	_ResourceTypeName[2414:2433],
+	_ResourceTypeName[2433:2439],
+	_ResourceTypeName[2439:2470],
+	_ResourceTypeName[2470:2485],
+	_ResourceTypeName[2485:2512],
+	_ResourceTypeName[2512:2532],
+	_ResourceTypeName[2532:2551],
+	_ResourceTypeName[2551:2581],
+	_ResourceTypeName[2581:2603],
+	_ResourceTypeName[2603:2628],
+	_ResourceTypeName[2628:2648],
+	_ResourceTypeName[2648:2661],
+	_ResourceTypeName[2661:2681],
+	_ResourceTypeName[2681:2696],
+	_ResourceTypeName[2696:2717],
+	_ResourceTypeName[2717:2737],
+	_ResourceTypeName[2737:2752],
+	_ResourceTypeName[2752:2771],
+	_ResourceTypeName[2771:2786],
+	_ResourceTypeName[2786:2808],
+	_ResourceTypeName[2808:2828],
+	_ResourceTypeName[2828:2854],
+	_ResourceTypeName[2854:2878],
+	_ResourceTypeName[2878:2899],
+	_ResourceTypeName[2899:2917],
+	_ResourceTypeName[2917:2946],
+	_ResourceTypeName[2946:2983],
+	_ResourceTypeName[2983:2999],
+	_ResourceTypeName[2999:3027],
+	_ResourceTypeName[3027:3042],
+	_ResourceTypeName[3042:3055],
+	_ResourceTypeName[3055:3080],
+	_ResourceTypeName[3080:3098],
+	_ResourceTypeName[3098:3121],
+	_ResourceTypeName[3121:3157],
+	_ResourceTypeName[3157:3200],
+	_ResourceTypeName[3200:3242],
+	_ResourceTypeName[3242:3271],
+	_ResourceTypeName[3271:3302],
+	_ResourceTypeName[3302:3327],
+	_ResourceTypeName[3327:3346],
+	_ResourceTypeName[3346:3369],
+	_ResourceTypeName[3369:3393],
+	_ResourceTypeName[3393:3428],
+	_ResourceTypeName[3428:3450],
+	_ResourceTypeName[3450:3470],
+	_ResourceTypeName[3470:3494],
+	_ResourceTypeName[3494:3510],
+	_ResourceTypeName[3510:3531],
+	_ResourceTypeName[3531:3553],
+	_ResourceTypeName[3553:3566],
+	_ResourceTypeName[3566:3583],
+	_ResourceTypeName[3583:3614],
+	_ResourceTypeName[3614:3652],
+	_ResourceTypeName[3652:3679],
+	_ResourceTypeName[3679:3705],
+	_ResourceTypeName[3705:3715],
+	_ResourceTypeName[3715:3736],
+	_ResourceTypeName[3736:3755],
+	_ResourceTypeName[3755:3772],
+	_ResourceTypeName[3772:3793],
+	_ResourceTypeName[3793:3800],
+	_ResourceTypeName[3800:3816],
+	_ResourceTypeName[3816:3842],
+	_ResourceTypeName[3842:3877],
+	_ResourceTypeName[3877:3892],
+	_ResourceTypeName[3892:3908],
+	_ResourceTypeName[3908:3928],
+	_ResourceTypeName[3928:3945],
+	_ResourceTypeName[3945:3959],
+	_ResourceTypeName[3959:3981],
 }
 
 // ResourceTypeString retrieves an enum value from the enum constants string name.
@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	awsSDK "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// List of all the supported SecurityGroupRules styles
+const (
+	// SecurityGroupRulesInline imports the rules inline
+	// on the 'ingress' and 'egress' of the aws_security_group
+	SecurityGroupRulesInline = "inline"
+
+	// SecurityGroupRulesSeparate imports the aws_security_group
+	// without rules and each rule as an aws_security_group_rule
+	SecurityGroupRulesSeparate = "separate"
+)
+
+// SecurityGroupRules are all the supported styles to import the rules
+// of the Security Groups
+var SecurityGroupRules = []string{SecurityGroupRulesInline, SecurityGroupRulesSeparate}
+
+func isValidSecurityGroupRules(s string) bool {
+	for _, sgr := range SecurityGroupRules {
+		if s == sgr {
+			return true
+		}
+	}
+	return false
+}
+
+// securityGroupRuleIDs returns the import IDs of the aws_security_group_rule of the
+// perm of the Security Group sgID, with the format 'SGID_TYPE_PROTOCOL_FROM_TO_SOURCE'.
+// All the CIDRs and Prefix Lists are on the same rule and each Security Group
+// referenced is a different one, as it's how the TF Provider reads them
+func securityGroupRuleIDs(sgID, ruleType string, perm *ec2.IpPermission) []string {
+	// The ports are not set when the
+	// protocol is all (-1)
+	var from, to int64
+	if perm.FromPort != nil {
+		from = *perm.FromPort
+	}
+	if perm.ToPort != nil {
+		to = *perm.ToPort
+	}
+	prefix := fmt.Sprintf("%s_%s_%s_%d_%d", sgID, ruleType, awsSDK.StringValue(perm.IpProtocol), from, to)
+
+	ids := make([]string, 0)
+
+	sources := make([]string, 0)
+	for _, r := range perm.IpRanges {
+		sources = append(sources, awsSDK.StringValue(r.CidrIp))
+	}
+	for _, r := range perm.Ipv6Ranges {
+		sources = append(sources, awsSDK.StringValue(r.CidrIpv6))
+	}
+	for _, pl := range perm.PrefixListIds {
+		sources = append(sources, awsSDK.StringValue(pl.PrefixListId))
+	}
+	if len(sources) != 0 {
+		ids = append(ids, fmt.Sprintf("%s_%s", prefix, strings.Join(sources, "_")))
+	}
+
+	for _, p := range perm.UserIdGroupPairs {
+		source := awsSDK.StringValue(p.GroupId)
+		if source == sgID {
+			source = "self"
+		}
+		ids = append(ids, fmt.Sprintf("%s_%s", prefix, source))
+	}
+
+	return ids
+}
+
+// configureSecurityGroup removes the inline rules of the cfg of the
+// aws_security_group when they are imported as aws_security_group_rule,
+// as both can not be used at the same time
+func (a *aws) configureSecurityGroup(r provider.Resource, cfg map[string]interface{}) {
+	if a.securityGroupRules != SecurityGroupRulesSeparate {
+		return
+	}
+
+	delete(cfg, "ingress")
+	delete(cfg, "egress")
+}
@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"testing"
+
+	awsSDK "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityGroupRuleIDs(t *testing.T) {
+	t.Run("CIDRs", func(t *testing.T) {
+		ids := securityGroupRuleIDs("sg-1", "ingress", &ec2.IpPermission{
+			IpProtocol: awsSDK.String("tcp"),
+			FromPort:   awsSDK.Int64(80),
+			ToPort:     awsSDK.Int64(443),
+			IpRanges: []*ec2.IpRange{
+				{CidrIp: awsSDK.String("10.0.0.0/16")},
+				{CidrIp: awsSDK.String("10.1.0.0/16")},
+			},
+			Ipv6Ranges: []*ec2.Ipv6Range{
+				{CidrIpv6: awsSDK.String("::/0")},
+			},
+			PrefixListIds: []*ec2.PrefixListId{
+				{PrefixListId: awsSDK.String("pl-1")},
+			},
+		})
+		assert.Equal(t, []string{"sg-1_ingress_tcp_80_443_10.0.0.0/16_10.1.0.0/16_::/0_pl-1"}, ids)
+	})
+
+	t.Run("SecurityGroups", func(t *testing.T) {
+		ids := securityGroupRuleIDs("sg-1", "ingress", &ec2.IpPermission{
+			IpProtocol: awsSDK.String("udp"),
+			FromPort:   awsSDK.Int64(53),
+			ToPort:     awsSDK.Int64(53),
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{
+				{GroupId: awsSDK.String("sg-1")},
+				{GroupId: awsSDK.String("sg-2")},
+			},
+		})
+		assert.Equal(t, []string{"sg-1_ingress_udp_53_53_self", "sg-1_ingress_udp_53_53_sg-2"}, ids)
+	})
+
+	t.Run("AllProtocols", func(t *testing.T) {
+		ids := securityGroupRuleIDs("sg-1", "egress", &ec2.IpPermission{
+			IpProtocol: awsSDK.String("-1"),
+			IpRanges: []*ec2.IpRange{
+				{CidrIp: awsSDK.String("0.0.0.0/0")},
+			},
+		})
+		assert.Equal(t, []string{"sg-1_egress_-1_0_0_0.0.0.0/0"}, ids)
+	})
+}
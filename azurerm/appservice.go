@@ -0,0 +1,77 @@
+package azurerm
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web"
+	"github.com/pkg/errors"
+)
+
+// appSettingsTypes are the resource types
+// that have the 'app_settings' attribute
+var appSettingsTypes = map[string]struct{}{
+	LinuxWebApp.String():        struct{}{},
+	WindowsWebApp.String():      struct{}{},
+	LinuxWebAppSlot.String():    struct{}{},
+	WindowsWebAppSlot.String():  struct{}{},
+	LinuxFunctionApp.String():   struct{}{},
+	WindowsFunctionApp.String(): struct{}{},
+}
+
+// secretAppSettingRe matches the keys of the app settings
+// that are usually secrets (ex: DB_PASSWORD, API_KEY)
+var secretAppSettingRe = regexp.MustCompile(`(?i)(password|passwd|pwd|secret|token|key|connection_?string|credential)`)
+
+// keyVaultReferencePrefix is the prefix of the app settings that reference
+// a Key Vault secret, which are not secrets as they only have the reference
+const keyVaultReferencePrefix = "@Microsoft.KeyVault("
+
+// listSites returns the Sites of the Resource Group of the ar, the
+// Function Apps if functionApp or the Web Apps if not
+func listSites(ctx context.Context, ar *AzureReader, functionApp bool) ([]web.Site, error) {
+	// The Sites are listed for all the subscription
+	sites, err := ar.ListWebApps(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list sites")
+	}
+
+	rsites := make([]web.Site, 0, len(sites))
+	for _, s := range sites {
+		if s.ID == nil || !strings.EqualFold(resourceGroupFromID(*s.ID), ar.GetResourceGroupName()) {
+			continue
+		}
+		if isFunctionApp(s) != functionApp {
+			continue
+		}
+		rsites = append(rsites, s)
+	}
+
+	return rsites, nil
+}
+
+// isFunctionApp returns true if the s is a Function App, which
+// has 'functionapp' on the kind (ex: 'functionapp,linux')
+func isFunctionApp(s web.Site) bool {
+	return s.Kind != nil && strings.Contains(strings.ToLower(*s.Kind), "functionapp")
+}
+
+// isLinuxSite returns true if the s runs on Linux, which is when
+// it's reserved https://azure.github.io/AppService/2021/08/31/Kind-property-overview.html
+func isLinuxSite(s web.Site) bool {
+	return s.SiteProperties != nil && s.SiteProperties.Reserved != nil && *s.SiteProperties.Reserved
+}
+
+// IsSecret returns true for the 'app_settings' of the Web and Function
+// Apps with keys that are usually secrets, unless they are a reference
+// to a Key Vault secret
+func (a *azurerm) IsSecret(rt, attr, key, value string) bool {
+	if _, ok := appSettingsTypes[rt]; !ok || attr != "app_settings" {
+		return false
+	}
+	if strings.HasPrefix(value, keyVaultReferencePrefix) {
+		return false
+	}
+	return secretAppSettingRe.MatchString(key)
+}
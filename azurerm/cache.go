@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cycloidio/terracognita/errcode"
 	"github.com/cycloidio/terracognita/filter"
@@ -9,8 +10,14 @@ import (
 	"github.com/pkg/errors"
 )
 
+// cacheKey returns the key of the cache of the rt on the Resource
+// Group of the ar, as each Resource Group has different Resources
+func cacheKey(ar *AzureReader, rt string) string {
+	return fmt.Sprintf("%s/%s", ar.GetResourceGroupName(), rt)
+}
+
 // Quick sum-up of cached resources:
-//   Network -> virtual_networks, security_group, route_tables, virtual_hub, load_balancer
+//   Network -> virtual_networks, security_group, route_tables, virtual_hub, load_balancer, express_route_circuit
 //   Compute -> virtual_machines, virtual_machine_scale_sets
 //   Logic -> logic_app_worfklows
 //   Container-registry -> container_registry
@@ -23,11 +30,12 @@ import (
 //   Application Insights -> application_insights
 //   Log analytics -> log_analytics_workspace
 //   App service -> web_app, service_plans, static_sites
+//   Batch -> batch_accounts
 
 //Network
 
 func cacheVirtualNetworks(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -38,7 +46,7 @@ func cacheVirtualNetworks(ctx context.Context, a *azurerm, ar *AzureReader, rt s
 			return nil, errors.Wrap(err, "unable to get virtual networks")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -60,7 +68,7 @@ func getVirtualNetworkNames(ctx context.Context, a *azurerm, ar *AzureReader, rt
 }
 
 func cacheSecurityGroups(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -71,7 +79,7 @@ func cacheSecurityGroups(ctx context.Context, a *azurerm, ar *AzureReader, rt st
 			return nil, errors.Wrap(err, "unable to get Security Groups")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -93,7 +101,7 @@ func getSecurityGroups(ctx context.Context, a *azurerm, ar *AzureReader, rt stri
 }
 
 func cacheRouteTables(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -104,7 +112,7 @@ func cacheRouteTables(ctx context.Context, a *azurerm, ar *AzureReader, rt strin
 			return nil, errors.Wrap(err, "unable to get routeTables")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -127,7 +135,7 @@ func getRouteTables(ctx context.Context, a *azurerm, ar *AzureReader, rt string,
 }
 
 func cacheVirtualHubs(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -138,7 +146,7 @@ func cacheVirtualHubs(ctx context.Context, a *azurerm, ar *AzureReader, rt strin
 			return nil, errors.Wrap(err, "unable to get virtualHubs")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -160,8 +168,43 @@ func getVirtualHub(ctx context.Context, a *azurerm, ar *AzureReader, rt string,
 	return names, nil
 }
 
+func cacheExpressRouteCircuits(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := a.cache.Get(cacheKey(ar, rt))
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = expressRouteCircuits(ctx, a, ar, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get express route circuits")
+		}
+
+		err = a.cache.Set(cacheKey(ar, rt), rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+func getExpressRouteCircuits(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheExpressRouteCircuits(ctx, a, ar, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(rs))
+	for _, i := range rs {
+		names = append(names, i.Data().Get("name").(string))
+	}
+
+	return names, nil
+}
+
 func cacheLbs(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -172,7 +215,7 @@ func cacheLbs(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filte
 			return nil, errors.Wrap(err, "unable to get load balancers")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -199,7 +242,7 @@ func getLbs(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters
 func cacheVirtualMachines(ctx context.Context, a *azurerm, ar *AzureReader, rtList []string, filters *filter.Filter) ([]provider.Resource, error) {
 	var resources []provider.Resource
 	for _, rt := range rtList {
-		rs, err := a.cache.Get(rt)
+		rs, err := a.cache.Get(cacheKey(ar, rt))
 		if err != nil {
 			if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 				return nil, errors.WithStack(err)
@@ -210,7 +253,7 @@ func cacheVirtualMachines(ctx context.Context, a *azurerm, ar *AzureReader, rtLi
 				return nil, errors.Wrap(err, "unable to get virtual machines")
 			}
 
-			err = a.cache.Set(rt, rs)
+			err = a.cache.Set(cacheKey(ar, rt), rs)
 			if err != nil {
 				return nil, err
 			}
@@ -237,7 +280,7 @@ func cacheVirtualMachineScaleSets(ctx context.Context, a *azurerm, ar *AzureRead
 	var resources []provider.Resource
 
 	for _, rt := range rtList {
-		rs, err := a.cache.Get(rt)
+		rs, err := a.cache.Get(cacheKey(ar, rt))
 		if err != nil {
 			if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 				return nil, errors.WithStack(err)
@@ -247,7 +290,7 @@ func cacheVirtualMachineScaleSets(ctx context.Context, a *azurerm, ar *AzureRead
 				return nil, errors.Wrap(err, "unable to get virtual machines scale sets")
 			}
 
-			err = a.cache.Set(rt, rs)
+			err = a.cache.Set(cacheKey(ar, rt), rs)
 			if err != nil {
 				return nil, err
 			}
@@ -272,7 +315,7 @@ func getVirtualMachineScaleSetNames(ctx context.Context, a *azurerm, ar *AzureRe
 // Logic
 
 func cacheWorkflows(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -283,7 +326,7 @@ func cacheWorkflows(ctx context.Context, a *azurerm, ar *AzureReader, rt string,
 			return nil, errors.Wrap(err, "unable to get workflows")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -308,7 +351,7 @@ func getWorkflowNames(ctx context.Context, a *azurerm, ar *AzureReader, rt strin
 // Container registry
 
 func cacheContainerRegistries(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -319,7 +362,7 @@ func cacheContainerRegistries(ctx context.Context, a *azurerm, ar *AzureReader,
 			return nil, errors.Wrap(err, "unable to get ContainerRegistries")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -344,7 +387,7 @@ func getContainerRegistries(ctx context.Context, a *azurerm, ar *AzureReader, rt
 // Container service (k8s)
 
 func cacheKubernetesClusters(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -355,7 +398,7 @@ func cacheKubernetesClusters(ctx context.Context, a *azurerm, ar *AzureReader, r
 			return nil, errors.Wrap(err, "unable to get KubernetesCluster")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -380,7 +423,7 @@ func getKubernetesClusters(ctx context.Context, a *azurerm, ar *AzureReader, rt
 //Storage
 
 func cacheStorageAccounts(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -391,7 +434,7 @@ func cacheStorageAccounts(ctx context.Context, a *azurerm, ar *AzureReader, rt s
 			return nil, errors.Wrap(err, "unable to get storageAccounts")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -416,7 +459,7 @@ func getStorageAccounts(ctx context.Context, a *azurerm, ar *AzureReader, rt str
 // Database
 
 func cacheMariadbServers(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -427,7 +470,7 @@ func cacheMariadbServers(ctx context.Context, a *azurerm, ar *AzureReader, rt st
 			return nil, errors.Wrap(err, "unable to get MariaDB Servers")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -450,7 +493,7 @@ func getMariadbServers(ctx context.Context, a *azurerm, ar *AzureReader, rt stri
 }
 
 func cacheMysqlServers(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -461,7 +504,7 @@ func cacheMysqlServers(ctx context.Context, a *azurerm, ar *AzureReader, rt stri
 			return nil, errors.Wrap(err, "unable to get MySQL Servers")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -484,7 +527,7 @@ func getMysqlServers(ctx context.Context, a *azurerm, ar *AzureReader, rt string
 }
 
 func cachePostgresqlServers(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -495,7 +538,7 @@ func cachePostgresqlServers(ctx context.Context, a *azurerm, ar *AzureReader, rt
 			return nil, errors.Wrap(err, "unable to get PostgreSQL Servers")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -518,7 +561,7 @@ func getPostgresqlServers(ctx context.Context, a *azurerm, ar *AzureReader, rt s
 }
 
 func cacheMsSQLServers(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -529,7 +572,7 @@ func cacheMsSQLServers(ctx context.Context, a *azurerm, ar *AzureReader, rt stri
 			return nil, errors.Wrap(err, "unable to get SQL Servers")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -553,7 +596,7 @@ func getMsSQLServers(ctx context.Context, a *azurerm, ar *AzureReader, rt string
 
 // Redis
 func cacheRedisCaches(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -564,7 +607,7 @@ func cacheRedisCaches(ctx context.Context, a *azurerm, ar *AzureReader, rt strin
 			return nil, errors.Wrap(err, "unable to get Redis Caches")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -590,7 +633,7 @@ func getRedisCaches(ctx context.Context, a *azurerm, ar *AzureReader, rt string,
 // DNS
 
 func cacheDNSZones(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -601,7 +644,7 @@ func cacheDNSZones(ctx context.Context, a *azurerm, ar *AzureReader, rt string,
 			return nil, errors.Wrap(err, "unable to get DNS Zones")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -627,7 +670,7 @@ func getDNSZones(ctx context.Context, a *azurerm, ar *AzureReader, rt string, fi
 // Private DNS
 
 func cachePrivateDNSZones(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -638,7 +681,7 @@ func cachePrivateDNSZones(ctx context.Context, a *azurerm, ar *AzureReader, rt s
 			return nil, errors.Wrap(err, "unable to get Private DNS Zones")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -663,7 +706,7 @@ func getPrivateDNSZones(ctx context.Context, a *azurerm, ar *AzureReader, rt str
 
 //Application Insights
 func cacheApplicationInsights(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -674,7 +717,7 @@ func cacheApplicationInsights(ctx context.Context, a *azurerm, ar *AzureReader,
 			return nil, errors.Wrap(err, "unable to get application insigths")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -699,7 +742,7 @@ func getApplicationInsightsComponents(ctx context.Context, a *azurerm, ar *Azure
 
 // Log Analytics
 func cachelogAnalyticsWorkspaces(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -710,7 +753,7 @@ func cachelogAnalyticsWorkspaces(ctx context.Context, a *azurerm, ar *AzureReade
 			return nil, errors.Wrap(err, "unable to get log analytics workspace")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -737,7 +780,7 @@ func getLogAnalyticsWorkspaces(ctx context.Context, a *azurerm, ar *AzureReader,
 func cacheWebApps(ctx context.Context, a *azurerm, ar *AzureReader, rtList []string, filters *filter.Filter) ([]provider.Resource, error) {
 	var resources []provider.Resource
 	for _, rt := range rtList {
-		rs, err := a.cache.Get(rt)
+		rs, err := a.cache.Get(cacheKey(ar, rt))
 		if err != nil {
 			if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 				return nil, errors.WithStack(err)
@@ -748,7 +791,7 @@ func cacheWebApps(ctx context.Context, a *azurerm, ar *AzureReader, rtList []str
 				return nil, errors.Wrap(err, "unable to get app service web apps")
 			}
 
-			err = a.cache.Set(rt, rs)
+			err = a.cache.Set(cacheKey(ar, rt), rs)
 			if err != nil {
 				return nil, err
 			}
@@ -775,7 +818,7 @@ func getWebApps(ctx context.Context, a *azurerm, ar *AzureReader, rt []string, f
 // app service service plan
 
 func cacheServicePlans(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -786,7 +829,7 @@ func cacheServicePlans(ctx context.Context, a *azurerm, ar *AzureReader, rt stri
 			return nil, errors.Wrap(err, "unable to get app service service plans")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -812,7 +855,7 @@ func getServicePlans(ctx context.Context, a *azurerm, ar *AzureReader, rt string
 // app service static sites
 
 func cacheStaticSites(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
-	rs, err := a.cache.Get(rt)
+	rs, err := a.cache.Get(cacheKey(ar, rt))
 	if err != nil {
 		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
 			return nil, errors.WithStack(err)
@@ -823,7 +866,7 @@ func cacheStaticSites(ctx context.Context, a *azurerm, ar *AzureReader, rt strin
 			return nil, errors.Wrap(err, "unable to get app service static sites")
 		}
 
-		err = a.cache.Set(rt, rs)
+		err = a.cache.Set(cacheKey(ar, rt), rs)
 		if err != nil {
 			return nil, err
 		}
@@ -845,3 +888,40 @@ func getStaticSites(ctx context.Context, a *azurerm, ar *AzureReader, rt string,
 
 	return names, nil
 }
+
+// Batch
+
+func cacheBatchAccounts(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := a.cache.Get(cacheKey(ar, rt))
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+
+		rs, err = batchAccounts(ctx, a, ar, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get batch accounts")
+		}
+
+		err = a.cache.Set(cacheKey(ar, rt), rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+func getBatchAccounts(ctx context.Context, a *azurerm, ar *AzureReader, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheBatchAccounts(ctx, a, ar, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(rs))
+	for _, i := range rs {
+		names = append(names, i.Data().Get("name").(string))
+	}
+
+	return names, nil
+}
@@ -34,6 +34,9 @@ var azureAPIs = []AzureAPI{
 	{PackageIdentifier: "newActivityLogAlertsClient", API: "insights", OtherPath: "monitor/mgmt", APIVersion: "2020-10-01"},                    // used for monitor resources
 	{PackageIdentifier: "monitor", API: "insights", OtherPath: "monitor/mgmt", APIVersion: "2021-07-01-preview", IsPreview: true},              // used for monitor resources
 	{API: "web", APIVersion: "2021-03-01"},
+	{API: "batch", APIVersion: "2021-06-01"},
+	{API: "hdinsight", APIVersion: "2018-06-01"},
+	{API: "databricks", APIVersion: "2018-04-01"},
 }
 
 var functions = []Function{
@@ -80,6 +83,7 @@ var functions = []Function{
 	{ResourceName: "AzureFirewall", API: "network", ResourceGroup: true},
 	{ResourceName: "LocalNetworkGateway", API: "network", ResourceGroup: true},
 	{ResourceName: "NatGateway", API: "network", ResourceGroup: true},
+	{ResourceName: "PrivateEndpoint", API: "network", ResourceGroup: true},
 	{ResourceName: "Profile", API: "network", ResourceGroup: true},
 	{ResourceName: "SecurityRule", API: "network", ResourceGroup: true, ExtraArgs: []Arg{
 		{
@@ -131,6 +135,15 @@ var functions = []Function{
 		},
 	}},
 	{ResourceName: "SecurityPartnerProvider", API: "network", ResourceGroup: false},
+	{ResourceName: "BastionHost", API: "network", AzureSDKListFunction: "ListByResourceGroup", ResourceGroup: true},
+	{ResourceName: "ExpressRouteCircuit", API: "network", ResourceGroup: true},
+	{ResourceName: "ExpressRouteCircuitPeering", API: "network", ResourceGroup: true, ExtraArgs: []Arg{
+		{
+			Name: "circuitName",
+			Type: "string",
+		},
+	}},
+	{ResourceName: "VirtualWAN", PluralName: "VirtualWans", API: "network", AzureSDKListFunction: "ListByResourceGroup", ResourceGroup: true},
 	{ResourceName: "LoadBalancer", API: "network", ResourceGroup: true},
 	{ResourceName: "BackendAddressPool", PluralName: "LoadBalancerBackendAddressPools", API: "network", ResourceGroup: true, ExtraArgs: []Arg{
 		{
@@ -509,6 +522,16 @@ var functions = []Function{
 			Type: "*int32",
 		},
 	}},
+	{ResourceName: "Secret", FunctionName: "ListKeyVaultSecrets", ResourceGroup: true, API: "keyvault", ExtraArgs: []Arg{
+		{
+			Name: "vaultName",
+			Type: "string",
+		},
+		{
+			Name: "top",
+			Type: "*int32",
+		},
+	}},
 	// app insights
 	{ResourceName: "ApplicationInsightsComponent", PluralName: "Components", API: "insights", ResourceGroup: false},
 	{ResourceName: "ApplicationInsightsComponentAPIKey", PluralName: "APIKeys", API: "insights", ResourceGroup: true, ReturnsList: true, ExtraArgs: []Arg{
@@ -597,6 +620,30 @@ var functions = []Function{
 			Type: "string",
 		},
 	}},
+	// batch
+	{ResourceName: "Account", API: "batch", IrregularClientName: "NewAccountClient", FunctionName: "ListBatchAccounts", AzureSDKListFunction: "ListByResourceGroup", ResourceGroup: true},
+	{ResourceName: "Pool", API: "batch", IrregularClientName: "NewPoolClient", FunctionName: "ListBatchPools", AzureSDKListFunction: "ListByBatchAccount", ResourceGroup: true, ExtraArgs: []Arg{
+		{
+			Name: "accountName",
+			Type: "string",
+		},
+		{
+			Name: "maxresults",
+			Type: "*int32",
+		},
+		{
+			Name: "selectParameter",
+			Type: "string",
+		},
+		{
+			Name: "filter",
+			Type: "string",
+		},
+	}},
+	// hdinsight
+	{ResourceName: "Cluster", API: "hdinsight", FunctionName: "ListHDInsightClusters", AzureSDKListFunction: "ListByResourceGroup", ResourceGroup: true},
+	// databricks
+	{ResourceName: "Workspace", API: "databricks", FunctionName: "ListDatabricksWorkspaces", AzureSDKListFunction: "ListByResourceGroup", ResourceGroup: true},
 }
 
 func main() {
@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resourcegraph/mgmt/2021-03-01/resourcegraph"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/tag"
+)
+
+// graphResourceTypes are the ARM types, as returned by the Resource Graph,
+// of the ResourceTypes that can be listed with it. Only the ones that are
+// a 1:1 of an ARM type are listed, the sub resources (ex: Subnets) and the
+// ones split by kind (ex: Virtual Machines) are always read with the ARM APIs
+var graphResourceTypes = map[ResourceType]string{
+	// Compute Resources
+	VirtualNetwork:  "microsoft.network/virtualnetworks",
+	AvailabilitySet: "microsoft.compute/availabilitysets",
+	ManagedDisk:     "microsoft.compute/disks",
+	Image:           "microsoft.compute/images",
+	// Network Resources
+	NetworkInterface:                "microsoft.network/networkinterfaces",
+	NetworkSecurityGroup:            "microsoft.network/networksecuritygroups",
+	ApplicationGateway:              "microsoft.network/applicationgateways",
+	ApplicationSecurityGroup:        "microsoft.network/applicationsecuritygroups",
+	NetworkDdosProtectionPlan:       "microsoft.network/ddosprotectionplans",
+	Firewall:                        "microsoft.network/azurefirewalls",
+	LocalNetworkGateway:             "microsoft.network/localnetworkgateways",
+	NatGateway:                      "microsoft.network/natgateways",
+	PrivateEndpoint:                 "microsoft.network/privateendpoints",
+	NetworkProfile:                  "microsoft.network/networkprofiles",
+	PublicIP:                        "microsoft.network/publicipaddresses",
+	PublicIPPrefix:                  "microsoft.network/publicipprefixes",
+	RouteTable:                      "microsoft.network/routetables",
+	VirtualNetworkGateway:           "microsoft.network/virtualnetworkgateways",
+	VirtualNetworkGatewayConnection: "microsoft.network/connections",
+	WebApplicationFirewallPolicy:    "microsoft.network/applicationgatewaywebapplicationfirewallpolicies",
+	VirtualHub:                      "microsoft.network/virtualhubs",
+	BastionHost:                     "microsoft.network/bastionhosts",
+	ExpressRouteCircuit:             "microsoft.network/expressroutecircuits",
+	VirtualWan:                      "microsoft.network/virtualwans",
+	// Load Balancer
+	Lb: "microsoft.network/loadbalancers",
+	// Logic Resources
+	LogicAppWorkflow: "microsoft.logic/workflows",
+	// Container Registry Resources
+	ContainerRegistry: "microsoft.containerregistry/registries",
+	// Container Service Resources
+	KubernetesCluster: "microsoft.containerservice/managedclusters",
+	// Storage Resources
+	StorageAccount: "microsoft.storage/storageaccounts",
+	// Database Resources
+	MariadbServer:    "microsoft.dbformariadb/servers",
+	MysqlServer:      "microsoft.dbformysql/servers",
+	PostgresqlServer: "microsoft.dbforpostgresql/servers",
+	MssqlServer:      "microsoft.sql/servers",
+	// Redis
+	RedisCache: "microsoft.cache/redis",
+	// Dns
+	DNSZone:        "microsoft.network/dnszones",
+	PrivateDNSZone: "microsoft.network/privatednszones",
+	// Vault
+	KeyVault: "microsoft.keyvault/vaults",
+	// Application Insigths
+	ApplicationInsights: "microsoft.insights/components",
+	// Log Analytics
+	LogAnalyticsWorkspace: "microsoft.operationalinsights/workspaces",
+	// Monitor
+	MonitorActionGroup: "microsoft.insights/actiongroups",
+	// App service
+	ServicePlan: "microsoft.web/serverfarms",
+	// Batch
+	BatchAccount: "microsoft.batch/batchaccounts",
+	// Databricks
+	DatabricksWorkspace: "microsoft.databricks/workspaces",
+}
+
+// graphResource is a resource returned by the Resource Graph query
+type graphResource struct {
+	ID   string
+	Name string
+}
+
+// graphQuery returns the Resource Graph query that lists the ID and
+// name of the resources of the armType on the resourceGroups tagged
+// with all the tags (if any)
+func graphQuery(armType string, resourceGroups []string, tags []tag.Tag) string {
+	rgs := make([]string, 0, len(resourceGroups))
+	for _, rg := range resourceGroups {
+		rgs = append(rgs, fmt.Sprintf("'%s'", rg))
+	}
+	var tconds string
+	for _, t := range tags {
+		tconds += fmt.Sprintf(" and tags[%s] == %s", quoteKQLString(t.Name), quoteKQLString(t.Value))
+	}
+	return fmt.Sprintf("Resources | where type =~ '%s' and resourceGroup in~ (%s)%s | project id, name | order by id asc", armType, strings.Join(rgs, ", "), tconds)
+}
+
+// quoteKQLString quotes the s to be used as a string
+// of a Resource Graph query, escaping the \ and the '
+func quoteKQLString(s string) string {
+	return fmt.Sprintf("'%s'", strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s))
+}
+
+// ListGraphResources returns the resources of the armType on all the resourceGroups
+// of the subscription with a Resource Graph query, instead of one list call of the
+// ARM APIs per Resource Group
+func (ar *AzureReader) ListGraphResources(ctx context.Context, armType string, resourceGroups []string, tags []tag.Tag) ([]graphResource, error) {
+	client := resourcegraph.New()
+	client.Authorizer = ar.authorizer
+
+	query := graphQuery(armType, resourceGroups, tags)
+	req := resourcegraph.QueryRequest{
+		Subscriptions: &[]string{ar.config.SubscriptionID},
+		Query:         &query,
+		Options: &resourcegraph.QueryRequestOptions{
+			ResultFormat: resourcegraph.ResultFormatObjectArray,
+		},
+	}
+
+	resources := make([]graphResource, 0)
+	for {
+		output, err := client.Resources(ctx, req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to query %s from Azure Resource Graph", armType)
+		}
+
+		rows, ok := output.Data.([]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid data format returned by Azure Resource Graph for %s", armType)
+		}
+		for _, row := range rows {
+			m, ok := row.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			id, _ := m["id"].(string)
+			name, _ := m["name"].(string)
+			if id == "" {
+				continue
+			}
+			resources = append(resources, graphResource{ID: id, Name: name})
+		}
+
+		if output.SkipToken == nil || *output.SkipToken == "" {
+			break
+		}
+		req.Options.SkipToken = output.SkipToken
+	}
+
+	return resources, nil
+}
+
+// graphResources returns the Resources of the resourceType listed with the
+// Resource Graph on all the Resource Groups of the readers of the Provider.
+// The Tags of the filters are also filtered by the query if the keys
+// are not normalized, as the keys of the tags are case sensitive on it
+func graphResources(ctx context.Context, a *azurerm, armType, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	rgs := make([]string, 0, len(a.azurerReaders))
+	for _, ar := range a.azurerReaders {
+		rgs = append(rgs, ar.GetResourceGroupName())
+	}
+
+	var tags []tag.Tag
+	if filters.IsTagsCaseSensitive() {
+		tags = filters.Tags
+	}
+
+	grs, err := a.azurerReaders[0].ListGraphResources(ctx, armType, rgs, tags)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list resources from the Resource Graph")
+	}
+	resources := make([]provider.Resource, 0, len(grs))
+	for _, gr := range grs {
+		r := provider.NewResource(gr.ID, resourceType, a)
+		// we set the name prior of reading it from the state
+		// as it is required to able to List resources depending on this one
+		if err := r.Data().Set("name", gr.Name); err != nil {
+			return nil, errors.Wrapf(err, "unable to set name data on the provider.Resource for '%s'", gr.Name)
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
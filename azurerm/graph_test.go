@@ -0,0 +1,29 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cycloidio/terracognita/tag"
+)
+
+func TestGraphQuery(t *testing.T) {
+	assert.Equal(t,
+		"Resources | where type =~ 'microsoft.network/virtualnetworks' and resourceGroup in~ ('rg-a', 'rg-b') | project id, name | order by id asc",
+		graphQuery("microsoft.network/virtualnetworks", []string{"rg-a", "rg-b"}, nil),
+	)
+	assert.Equal(t,
+		`Resources | where type =~ 'microsoft.keyvault/vaults' and resourceGroup in~ ('rg-a') and tags['env'] == 'prod' and tags['owner'] == 'o\'neil' | project id, name | order by id asc`,
+		graphQuery("microsoft.keyvault/vaults", []string{"rg-a"}, []tag.Tag{{Name: "env", Value: "prod"}, {Name: "owner", Value: "o'neil"}}),
+	)
+}
+
+func TestGraphResourceTypes(t *testing.T) {
+	// All the types listed with the Resource
+	// Graph must be implemented with the ARM APIs
+	for rt := range graphResourceTypes {
+		_, ok := resources[rt]
+		assert.True(t, ok, rt.String())
+	}
+}
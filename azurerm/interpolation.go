@@ -0,0 +1,18 @@
+package azurerm
+
+import (
+	"regexp"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// resourceIDMatcher matches the resources referenced by the ID path,
+// '/subscriptions/ID/resourceGroups/NAME/...', which is case insensitive
+// and returned with different cases depending on the API
+var resourceIDMatcher = provider.NewRegexpMatcher(regexp.MustCompile(`(?i)^(/subscriptions/[^/]+/resourcegroups/.+?)/?$`), true, 10)
+
+// InterpolationMatchers returns the Matchers used to resolve
+// the values to interpolate that have other formats
+func (a *azurerm) InterpolationMatchers() []provider.Matcher {
+	return []provider.Matcher{resourceIDMatcher}
+}
@@ -0,0 +1,27 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceIDMatcher(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Value string
+		NV    string
+		OK    bool
+	}{
+		{Name: "ID", Value: "/subscriptions/s1/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet", NV: "/subscriptions/s1/resourcegroups/rg/providers/microsoft.network/virtualnetworks/vnet", OK: true},
+		{Name: "OtherCase", Value: "/subscriptions/s1/resourcegroups/RG/providers/Microsoft.Network/virtualNetworks/vnet/", NV: "/subscriptions/s1/resourcegroups/rg/providers/microsoft.network/virtualnetworks/vnet", OK: true},
+		{Name: "Name", Value: "vnet", OK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			nv, ok := resourceIDMatcher.Match(tt.Value)
+			assert.Equal(t, tt.OK, ok)
+			assert.Equal(t, tt.NV, nv)
+		})
+	}
+}
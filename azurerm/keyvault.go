@@ -0,0 +1,25 @@
+package azurerm
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// ConfigureResource redacts the values of the Key Vault
+// Secrets so they are not written on the HCL nor on the State,
+// only the names and metadata are imported
+func (a *azurerm) ConfigureResource(r provider.Resource, cfg map[string]interface{}) error {
+	if r.Type() != KeyVaultSecret.String() {
+		return nil
+	}
+
+	// The HCL is written before the State so
+	// cleaning the Data also redacts the State
+	if err := r.Data().Set("value", ""); err != nil {
+		return errors.Wrapf(err, "unable to redact the value of the key vault secret %q", r.ID())
+	}
+	cfg["value"] = ""
+
+	return nil
+}
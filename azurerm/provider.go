@@ -26,22 +26,74 @@ var skippableCodes = map[string]struct{}{
 	"ParentResourceNotFound": struct{}{},
 }
 
+// TFProviderVersion is the version of the AzureRM Terraform
+// Provider used to import the resources
+const TFProviderVersion = "3.6.0"
+
 type azurerm struct {
 	tfAzureRMClient interface{}
 	tfProvider      *schema.Provider
 	azurerReaders   []*AzureReader
 
+	// resourceGraph defines if the graphResourceTypes
+	// are listed with the Azure Resource Graph
+	resourceGraph bool
+
+	// taggedResourceIDs are the IDs of the resources tagged
+	// with the filter tag of each Resource Group
+	taggedResourceIDs map[string]map[string]struct{}
+
 	configuraiton map[string]interface{}
 
 	cache cache.Cache
 }
 
-// NewProvider returns a AzureRM Provider
-func NewProvider(ctx context.Context, clientID, clientSecret, environment string, resourceGroupNames []string, subscriptionID, tenantID string) (provider.Provider, error) {
-	readers := make([]*AzureReader, 0, len(resourceGroupNames))
+// Options are the optional configuration of the AzureRM
+// Provider, the zero value is the default one
+type Options struct {
+	// ResourceGroupGlob filters the Resource Groups of the
+	// subscription to import when none is defined
+	ResourceGroupGlob string
+
+	// UseMSI makes the managed identity be used when
+	// there is no clientSecret, instead of the Azure CLI
+	UseMSI bool
+
+	// MSIEndpoint is the endpoint of the managed identity
+	MSIEndpoint string
+
+	// ResourceGraph lists the Resources with the
+	// Azure Resource Graph when possible
+	ResourceGraph bool
+}
+
+// NewProvider returns a AzureRM Provider, if no resourceGroupNames are
+// defined all the Resource Groups of the subscription matching the
+// opts.ResourceGroupGlob (if defined) are imported.
+// Without clientSecret the managed identity is used if opts.UseMSI, and if not
+// the account logged on the Azure CLI, which also defines the
+// subscriptionID and tenantID if they are empty
+func NewProvider(ctx context.Context, clientID, clientSecret, environment string, resourceGroupNames []string, subscriptionID, tenantID string, opts Options) (provider.Provider, error) {
 	log.Get().Log("func", "azurerm.NewProvider", "msg", "loading Azure reader")
+	cfg, auth, err := authorize(ctx, clientID, clientSecret, environment, subscriptionID, tenantID, opts.UseMSI, opts.MSIEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize AzureReader: %s", err)
+	}
+
+	if len(resourceGroupNames) == 0 {
+		log.Get().Log("func", "azurerm.NewProvider", "msg", "listing Resource Groups")
+		resourceGroupNames, err = listResourceGroupNames(ctx, cfg, auth, opts.ResourceGroupGlob)
+		if err != nil {
+			return nil, err
+		}
+		if len(resourceGroupNames) == 0 {
+			return nil, fmt.Errorf("no resource groups found on the subscription %s", cfg.SubscriptionID)
+		}
+	}
+
+	readers := make([]*AzureReader, 0, len(resourceGroupNames))
 	for _, rgn := range resourceGroupNames {
-		reader, err := NewAzureReader(ctx, clientID, clientSecret, environment, rgn, subscriptionID, tenantID)
+		reader, err := newAzureReader(ctx, cfg, auth, rgn)
 		if err != nil {
 			return nil, fmt.Errorf("could not initialize AzureReader: %s", err)
 		}
@@ -51,12 +103,16 @@ func NewProvider(ctx context.Context, clientID, clientSecret, environment string
 	log.Get().Log("func", "azurerm.NewProvider", "msg", "loading TF provider")
 	tfp := tfazurerm.AzureProvider()
 
+	// The subscription and tenant are the ones of the
+	// cfg as they can be defined by the Azure CLI
 	rawCfg := terraform.NewResourceConfigRaw(map[string]interface{}{
 		"client_id":       clientID,
 		"client_secret":   clientSecret,
 		"environment":     environment,
-		"subscription_id": subscriptionID,
-		"tenant_id":       tenantID,
+		"subscription_id": cfg.SubscriptionID,
+		"tenant_id":       cfg.TenantID,
+		"use_msi":         opts.UseMSI,
+		"msi_endpoint":    opts.MSIEndpoint,
 	})
 
 	log.Get().Log("func", "azurerm.NewProvider", "msg", "loading TF client")
@@ -68,10 +124,13 @@ func NewProvider(ctx context.Context, clientID, clientSecret, environment string
 		tfAzureRMClient: tfp.Meta(),
 		tfProvider:      tfp,
 		azurerReaders:   readers,
+		resourceGraph:   opts.ResourceGraph,
 		cache:           cache.New(),
 		configuraiton: map[string]interface{}{
 			"environment": environment,
 		},
+
+		taggedResourceIDs: make(map[string]map[string]struct{}),
 	}, nil
 }
 
@@ -84,6 +143,7 @@ func (a *azurerm) Region() string                        { return a.azurerReader
 func (a *azurerm) String() string                        { return "azurerm" }
 func (a *azurerm) TagKey() string                        { return "tags" }
 func (a *azurerm) Source() string                        { return "hashicorp/azurerm" }
+func (a *azurerm) Version() string                       { return TFProviderVersion }
 func (a *azurerm) Configuration() map[string]interface{} { return a.configuraiton }
 
 func (a *azurerm) ResourceTypes() []string {
@@ -101,6 +161,14 @@ func (a *azurerm) Resources(ctx context.Context, t string, f *filter.Filter) ([]
 		return nil, errors.Errorf("the resource %q it's not implemented", t)
 	}
 
+	if armType, ok := graphResourceTypes[rt]; ok && a.resourceGraph {
+		res, err := graphResources(ctx, a, armType, t, f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while reading from resource %q", t)
+		}
+		return res, nil
+	}
+
 	resources := make([]provider.Resource, 0, 0)
 	for _, ar := range a.azurerReaders {
 		nres, err := rfn(ctx, a, ar, t, f)
@@ -120,6 +188,11 @@ func (a *azurerm) Resources(ctx context.Context, t string, f *filter.Filter) ([]
 
 			return nil, errors.Wrapf(err, "error while reading from resource %q", t)
 		}
+
+		nres, err = filterTaggedResources(ctx, a, ar, rt, nres, f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while filtering by tags the resource %q", t)
+		}
 		resources = append(resources, nres...)
 	}
 
@@ -133,3 +206,9 @@ func (a *azurerm) TFClient() interface{} {
 func (a *azurerm) TFProvider() *schema.Provider {
 	return a.tfProvider
 }
+
+// ResourceOwner returns the owner of the r from the owner tags
+func (a *azurerm) ResourceOwner(ctx context.Context, r provider.Resource) (string, string, error) {
+	o, s := provider.OwnerFromTags(a, r)
+	return o, s, nil
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"context"
 	"fmt"
+	"path"
 
 	azureResourcesAPI "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
 	"github.com/Azure/go-autorest/autorest"
@@ -21,7 +22,21 @@ type AzureReader struct {
 }
 
 // NewAzureReader returns a AzureReader
-func NewAzureReader(ctx context.Context, clientID, clientSecret, environment, resourceGroupName, subscriptionID, tenantID string) (*AzureReader, error) {
+func NewAzureReader(ctx context.Context, clientID, clientSecret, environment, resourceGroupName, subscriptionID, tenantID string, useMSI bool, msiEndpoint string) (*AzureReader, error) {
+	cfg, auth, err := authorize(ctx, clientID, clientSecret, environment, subscriptionID, tenantID, useMSI, msiEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAzureReader(ctx, cfg, auth, resourceGroupName)
+}
+
+// authorize builds the configuration and the Authorizer
+// used by the AzureReaders to call the Azure APIs.
+// The credentials used are the first available of:
+// the client secret, the managed identity if useMSI
+// and the logged account of the Azure CLI
+func authorize(ctx context.Context, clientID, clientSecret, environment, subscriptionID, tenantID string, useMSI bool, msiEndpoint string) (*authentication.Config, autorest.Authorizer, error) {
 	// Config
 	cfgBuilder := &authentication.Builder{
 		ClientID:       clientID,
@@ -29,37 +44,46 @@ func NewAzureReader(ctx context.Context, clientID, clientSecret, environment, re
 		Environment:    environment,
 		SubscriptionID: subscriptionID,
 		TenantID:       tenantID,
+		MsiEndpoint:    msiEndpoint,
 
-		SupportsClientSecretAuth: true,
+		SupportsClientSecretAuth:       true,
+		SupportsManagedServiceIdentity: useMSI,
+		SupportsAzureCliToken:          true,
 	}
 
 	cfg, err := cfgBuilder.Build()
 	if err != nil {
-		return nil, fmt.Errorf("could not build 'azure/authentication.Config' because: %s", err)
+		return nil, nil, fmt.Errorf("could not build 'azure/authentication.Config' because: %s", err)
 	}
 
 	// Authorizer
 	env, err := authentication.DetermineEnvironment(cfg.Environment)
 	if err != nil {
-		return nil, fmt.Errorf("could not initialize 'azure.Environment.' because: %s", err)
+		return nil, nil, fmt.Errorf("could not initialize 'azure.Environment.' because: %s", err)
 	}
 
 	oauthConfig, err := cfg.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
 	if err != nil {
-		return nil, fmt.Errorf("could not initialize 'azure/authentication.OAuthConfig.' because: %s", err)
+		return nil, nil, fmt.Errorf("could not initialize 'azure/authentication.OAuthConfig.' because: %s", err)
 	}
 	// OAuthConfigForTenant returns a pointer, which can be nil.
 	if oauthConfig == nil {
-		return nil, fmt.Errorf("could not configure OAuthConfig for tenant %s", cfg.TenantID)
+		return nil, nil, fmt.Errorf("could not configure OAuthConfig for tenant %s", cfg.TenantID)
 	}
 
 	azureSender := sender.BuildSender("AzureRM")
 
 	auth, err := cfg.GetADALToken(ctx, azureSender, oauthConfig, env.ResourceManagerEndpoint)
 	if err != nil {
-		return nil, fmt.Errorf("could not initialize 'azure/autorest.Authorizer.' because: %s", err)
+		return nil, nil, fmt.Errorf("could not initialize 'azure/autorest.Authorizer.' because: %s", err)
 	}
 
+	return cfg, auth, nil
+}
+
+// newAzureReader returns a AzureReader of the resourceGroupName
+// with the already built cfg and auth
+func newAzureReader(ctx context.Context, cfg *authentication.Config, auth autorest.Authorizer, resourceGroupName string) (*AzureReader, error) {
 	// Resource Group
 	client := azureResourcesAPI.NewGroupsClient(cfg.SubscriptionID)
 	client.Authorizer = auth
@@ -75,6 +99,43 @@ func NewAzureReader(ctx context.Context, clientID, clientSecret, environment, re
 	}, nil
 }
 
+// listResourceGroupNames returns the names of all the Resource Groups of
+// the subscription, if the glob is defined only the ones matching it
+func listResourceGroupNames(ctx context.Context, cfg *authentication.Config, auth autorest.Authorizer, glob string) ([]string, error) {
+	client := azureResourcesAPI.NewGroupsClient(cfg.SubscriptionID)
+	client.Authorizer = auth
+
+	output, err := client.List(ctx, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not 'azure/resources.GroupsClient.List' the resource groups because: %s", err)
+	}
+
+	names := make([]string, 0)
+	for output.NotDone() {
+		for _, rg := range output.Values() {
+			if rg.Name == nil {
+				continue
+			}
+			if glob != "" {
+				ok, err := path.Match(glob, *rg.Name)
+				if err != nil {
+					return nil, fmt.Errorf("invalid resource group glob %q: %s", glob, err)
+				}
+				if !ok {
+					continue
+				}
+			}
+			names = append(names, *rg.Name)
+		}
+
+		if err := output.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("could not list the next page of resource groups because: %s", err)
+		}
+	}
+
+	return names, nil
+}
+
 // GetResourceGroup returns the current Resource Group resource
 func (ar *AzureReader) GetResourceGroup() azureResourcesAPI.Group {
 	return ar.resourceGroup
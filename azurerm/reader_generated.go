@@ -7,10 +7,13 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/Azure/azure-sdk-for-go/services/appinsights/mgmt/2020-02-02/insights"
+	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2021-06-01/batch"
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-12-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2019-05-01/containerregistry"
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2022-01-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/databricks/mgmt/2018-04-01/databricks"
 	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/Azure/azure-sdk-for-go/services/hdinsight/mgmt/2018-06-01/hdinsight"
 	"github.com/Azure/azure-sdk-for-go/services/logic/mgmt/2019-05-01/logic"
 	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2020-01-01/mariadb"
 	newActivityLogAlertsClient "github.com/Azure/azure-sdk-for-go/services/monitor/mgmt/2020-10-01/insights"
@@ -446,6 +449,31 @@ func (ar *AzureReader) ListNatGateways(ctx context.Context) ([]network.NatGatewa
 
 }
 
+// ListPrivateEndpoints returns a list of PrivateEndpoints within a subscription and a resource group
+func (ar *AzureReader) ListPrivateEndpoints(ctx context.Context) ([]network.PrivateEndpoint, error) {
+	client := network.NewPrivateEndpointsClient(ar.config.SubscriptionID)
+	client.Authorizer = ar.authorizer
+
+	output, err := client.List(ctx, ar.GetResourceGroupName())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list network.PrivateEndpoint from Azure APIs")
+	}
+
+	resources := make([]network.PrivateEndpoint, 0)
+	for output.NotDone() {
+
+		for _, res := range output.Values() {
+			resources = append(resources, res)
+		}
+
+		if err := output.NextWithContext(ctx); err != nil {
+			break
+		}
+	}
+	return resources, nil
+
+}
+
 // ListProfiles returns a list of Profiles within a subscription and a resource group
 func (ar *AzureReader) ListProfiles(ctx context.Context) ([]network.Profile, error) {
 	client := network.NewProfilesClient(ar.config.SubscriptionID)
@@ -846,6 +874,106 @@ func (ar *AzureReader) ListSecurityPartnerProviders(ctx context.Context) ([]netw
 
 }
 
+// ListBastionHosts returns a list of BastionHosts within a subscription and a resource group
+func (ar *AzureReader) ListBastionHosts(ctx context.Context) ([]network.BastionHost, error) {
+	client := network.NewBastionHostsClient(ar.config.SubscriptionID)
+	client.Authorizer = ar.authorizer
+
+	output, err := client.ListByResourceGroup(ctx, ar.GetResourceGroupName())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list network.BastionHost from Azure APIs")
+	}
+
+	resources := make([]network.BastionHost, 0)
+	for output.NotDone() {
+
+		for _, res := range output.Values() {
+			resources = append(resources, res)
+		}
+
+		if err := output.NextWithContext(ctx); err != nil {
+			break
+		}
+	}
+	return resources, nil
+
+}
+
+// ListExpressRouteCircuits returns a list of ExpressRouteCircuits within a subscription and a resource group
+func (ar *AzureReader) ListExpressRouteCircuits(ctx context.Context) ([]network.ExpressRouteCircuit, error) {
+	client := network.NewExpressRouteCircuitsClient(ar.config.SubscriptionID)
+	client.Authorizer = ar.authorizer
+
+	output, err := client.List(ctx, ar.GetResourceGroupName())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list network.ExpressRouteCircuit from Azure APIs")
+	}
+
+	resources := make([]network.ExpressRouteCircuit, 0)
+	for output.NotDone() {
+
+		for _, res := range output.Values() {
+			resources = append(resources, res)
+		}
+
+		if err := output.NextWithContext(ctx); err != nil {
+			break
+		}
+	}
+	return resources, nil
+
+}
+
+// ListExpressRouteCircuitPeerings returns a list of ExpressRouteCircuitPeerings within a subscription and a resource group
+func (ar *AzureReader) ListExpressRouteCircuitPeerings(ctx context.Context, circuitName string) ([]network.ExpressRouteCircuitPeering, error) {
+	client := network.NewExpressRouteCircuitPeeringsClient(ar.config.SubscriptionID)
+	client.Authorizer = ar.authorizer
+
+	output, err := client.List(ctx, ar.GetResourceGroupName(), circuitName)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list network.ExpressRouteCircuitPeering from Azure APIs")
+	}
+
+	resources := make([]network.ExpressRouteCircuitPeering, 0)
+	for output.NotDone() {
+
+		for _, res := range output.Values() {
+			resources = append(resources, res)
+		}
+
+		if err := output.NextWithContext(ctx); err != nil {
+			break
+		}
+	}
+	return resources, nil
+
+}
+
+// ListVirtualWans returns a list of VirtualWans within a subscription and a resource group
+func (ar *AzureReader) ListVirtualWans(ctx context.Context) ([]network.VirtualWAN, error) {
+	client := network.NewVirtualWansClient(ar.config.SubscriptionID)
+	client.Authorizer = ar.authorizer
+
+	output, err := client.ListByResourceGroup(ctx, ar.GetResourceGroupName())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list network.VirtualWAN from Azure APIs")
+	}
+
+	resources := make([]network.VirtualWAN, 0)
+	for output.NotDone() {
+
+		for _, res := range output.Values() {
+			resources = append(resources, res)
+		}
+
+		if err := output.NextWithContext(ctx); err != nil {
+			break
+		}
+	}
+	return resources, nil
+
+}
+
 // ListLoadBalancers returns a list of LoadBalancers within a subscription and a resource group
 func (ar *AzureReader) ListLoadBalancers(ctx context.Context) ([]network.LoadBalancer, error) {
 	client := network.NewLoadBalancersClient(ar.config.SubscriptionID)
@@ -1989,6 +2117,31 @@ func (ar *AzureReader) ListKeyVaults(ctx context.Context, top *int32) ([]keyvaul
 
 }
 
+// ListKeyVaultSecrets returns a list of Secrets within a subscription and a resource group
+func (ar *AzureReader) ListKeyVaultSecrets(ctx context.Context, vaultName string, top *int32) ([]keyvault.Secret, error) {
+	client := keyvault.NewSecretsClient(ar.config.SubscriptionID)
+	client.Authorizer = ar.authorizer
+
+	output, err := client.List(ctx, ar.GetResourceGroupName(), vaultName, top)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list keyvault.Secret from Azure APIs")
+	}
+
+	resources := make([]keyvault.Secret, 0)
+	for output.NotDone() {
+
+		for _, res := range output.Values() {
+			resources = append(resources, res)
+		}
+
+		if err := output.NextWithContext(ctx); err != nil {
+			break
+		}
+	}
+	return resources, nil
+
+}
+
 // ListINSIGHTSComponents returns a list of Components within a subscription
 func (ar *AzureReader) ListINSIGHTSComponents(ctx context.Context) ([]insights.ApplicationInsightsComponent, error) {
 	client := insights.NewComponentsClient(ar.config.SubscriptionID)
@@ -2386,3 +2539,103 @@ func (ar *AzureReader) ListHybridConnections(ctx context.Context, name string) (
 	return resources, nil
 
 }
+
+// ListBatchAccounts returns a list of Accounts within a subscription and a resource group
+func (ar *AzureReader) ListBatchAccounts(ctx context.Context) ([]batch.Account, error) {
+	client := batch.NewAccountClient(ar.config.SubscriptionID)
+	client.Authorizer = ar.authorizer
+
+	output, err := client.ListByResourceGroup(ctx, ar.GetResourceGroupName())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list batch.Account from Azure APIs")
+	}
+
+	resources := make([]batch.Account, 0)
+	for output.NotDone() {
+
+		for _, res := range output.Values() {
+			resources = append(resources, res)
+		}
+
+		if err := output.NextWithContext(ctx); err != nil {
+			break
+		}
+	}
+	return resources, nil
+
+}
+
+// ListBatchPools returns a list of Pools within a subscription and a resource group
+func (ar *AzureReader) ListBatchPools(ctx context.Context, accountName string, maxresults *int32, selectParameter string, filter string) ([]batch.Pool, error) {
+	client := batch.NewPoolClient(ar.config.SubscriptionID)
+	client.Authorizer = ar.authorizer
+
+	output, err := client.ListByBatchAccount(ctx, ar.GetResourceGroupName(), accountName, maxresults, selectParameter, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list batch.Pool from Azure APIs")
+	}
+
+	resources := make([]batch.Pool, 0)
+	for output.NotDone() {
+
+		for _, res := range output.Values() {
+			resources = append(resources, res)
+		}
+
+		if err := output.NextWithContext(ctx); err != nil {
+			break
+		}
+	}
+	return resources, nil
+
+}
+
+// ListHDInsightClusters returns a list of Clusters within a subscription and a resource group
+func (ar *AzureReader) ListHDInsightClusters(ctx context.Context) ([]hdinsight.Cluster, error) {
+	client := hdinsight.NewClustersClient(ar.config.SubscriptionID)
+	client.Authorizer = ar.authorizer
+
+	output, err := client.ListByResourceGroup(ctx, ar.GetResourceGroupName())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list hdinsight.Cluster from Azure APIs")
+	}
+
+	resources := make([]hdinsight.Cluster, 0)
+	for output.NotDone() {
+
+		for _, res := range output.Values() {
+			resources = append(resources, res)
+		}
+
+		if err := output.NextWithContext(ctx); err != nil {
+			break
+		}
+	}
+	return resources, nil
+
+}
+
+// ListDatabricksWorkspaces returns a list of Workspaces within a subscription and a resource group
+func (ar *AzureReader) ListDatabricksWorkspaces(ctx context.Context) ([]databricks.Workspace, error) {
+	client := databricks.NewWorkspacesClient(ar.config.SubscriptionID)
+	client.Authorizer = ar.authorizer
+
+	output, err := client.ListByResourceGroup(ctx, ar.GetResourceGroupName())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list databricks.Workspace from Azure APIs")
+	}
+
+	resources := make([]databricks.Workspace, 0)
+	for output.NotDone() {
+
+		for _, res := range output.Values() {
+			resources = append(resources, res)
+		}
+
+		if err := output.NextWithContext(ctx); err != nil {
+			break
+		}
+	}
+	return resources, nil
+
+}
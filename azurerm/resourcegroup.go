@@ -0,0 +1,36 @@
+package azurerm
+
+import (
+	"strings"
+
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/util"
+)
+
+// ResourceCategory prefixes the category of the r with the name of the
+// Resource Group it belongs to when more than one is imported, so each
+// Resource Group has its own files (ex: rg_front_compute.tf)
+func (a *azurerm) ResourceCategory(r provider.Resource, category string) string {
+	if len(a.azurerReaders) < 2 {
+		return category
+	}
+
+	rg := resourceGroupFromID(r.ID())
+	if rg == "" {
+		return category
+	}
+
+	return util.NormalizeName(rg) + "_" + category
+}
+
+// resourceGroupFromID returns the name of the Resource Group
+// of the Azure ID, or empty if the id does not have one
+func resourceGroupFromID(id string) string {
+	parts := strings.Split(id, "/")
+	for i, p := range parts {
+		if strings.EqualFold(p, "resourceGroups") && i+1 < len(parts) {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,25 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceGroupFromID(t *testing.T) {
+	tests := []struct {
+		Name string
+		ID   string
+		RG   string
+	}{
+		{Name: "ID", ID: "/subscriptions/s1/resourceGroups/rg-front/providers/Microsoft.Network/virtualNetworks/vnet", RG: "rg-front"},
+		{Name: "OtherCase", ID: "/subscriptions/s1/resourcegroups/RG/providers/Microsoft.Network/virtualNetworks/vnet", RG: "RG"},
+		{Name: "ResourceGroup", ID: "/subscriptions/s1/resourceGroups/rg", RG: "rg"},
+		{Name: "NoResourceGroup", ID: "https://vault.vault.azure.net/secrets/secret/1", RG: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.RG, resourceGroupFromID(tt.ID))
+		})
+	}
+}
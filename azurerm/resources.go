@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -39,6 +40,10 @@ const (
 	Firewall
 	LocalNetworkGateway
 	NatGateway
+	PrivateEndpoint
+	SubnetNatGatewayAssociation
+	SubnetNetworkSecurityGroupAssociation
+	SubnetRouteTableAssociation
 	NetworkProfile
 	NetworkSecurityRule
 	PublicIP
@@ -55,6 +60,10 @@ const (
 	VirtualHubIP
 	VirtualHubRouteTable
 	VirtualHubSecurityPartnerProvider
+	BastionHost
+	ExpressRouteCircuit
+	ExpressRouteCircuitPeering
+	VirtualWan
 	// Load Balancer
 	Lb
 	LbBackendAddressPool
@@ -140,6 +149,7 @@ const (
 	// Vault
 	KeyVault
 	KeyVaultAccessPolicy
+	KeyVaultSecret
 	// Application Insigths
 	ApplicationInsights
 	ApplicationInsightsAPIKey
@@ -159,6 +169,8 @@ const (
 	// App service
 	WindowsWebApp
 	LinuxWebApp
+	LinuxFunctionApp
+	WindowsFunctionApp
 	LinuxWebAppSlot
 	WindowsWebAppSlot
 	WebAppActiveSlot
@@ -167,6 +179,17 @@ const (
 	StaticSite
 	StaticSiteCustomDomain
 	WebAppHybridConnection
+	// Batch
+	BatchAccount
+	BatchPool
+	// HDInsight
+	HDInsightHadoopCluster           //hdinsight_hadoop_cluster
+	HDInsightHbaseCluster            //hdinsight_hbase_cluster
+	HDInsightInteractiveQueryCluster //hdinsight_interactive_query_cluster
+	HDInsightKafkaCluster            //hdinsight_kafka_cluster
+	HDInsightSparkCluster            //hdinsight_spark_cluster
+	// Databricks
+	DatabricksWorkspace
 )
 
 type rtFn func(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error)
@@ -187,31 +210,39 @@ var (
 		ManagedDisk:                     disks,
 		Image:                           images,
 		// Network Resources
-		Subnet:                            subnets,
-		NetworkInterface:                  networkInterfaces,
-		NetworkSecurityGroup:              networkSecurityGroups,
-		ApplicationGateway:                applicationGateways,
-		ApplicationSecurityGroup:          applicationSecurityGroups,
-		NetworkDdosProtectionPlan:         networkddosProtectionPlans,
-		Firewall:                          firewalls,
-		LocalNetworkGateway:               localNetworkGateways,
-		NatGateway:                        natGateways,
-		NetworkProfile:                    networkProfiles,
-		NetworkSecurityRule:               networkSecurityRules,
-		PublicIP:                          publicIP,
-		PublicIPPrefix:                    publicIPPrefixes,
-		Route:                             routes,
-		RouteTable:                        routeTables,
-		VirtualNetworkGateway:             virtualNetworkGateways,
-		VirtualNetworkGatewayConnection:   virtualNetworkGatewayConnections,
-		VirtualNetworkPeering:             virtualNetworkPeerings,
-		WebApplicationFirewallPolicy:      webApplicationFirewallPolicies,
-		VirtualHub:                        virtualHubs,
-		VirtualHubBgpConnection:           virtualHubBgpConnection,
-		VirtualHubConnection:              virtualHubConnection,
-		VirtualHubIP:                      virtualHubIP,
-		VirtualHubRouteTable:              virtualHubRouteTable,
-		VirtualHubSecurityPartnerProvider: virtualHubSecurityPartnerProvider,
+		Subnet:                                subnets,
+		NetworkInterface:                      networkInterfaces,
+		NetworkSecurityGroup:                  networkSecurityGroups,
+		ApplicationGateway:                    applicationGateways,
+		ApplicationSecurityGroup:              applicationSecurityGroups,
+		NetworkDdosProtectionPlan:             networkddosProtectionPlans,
+		Firewall:                              firewalls,
+		LocalNetworkGateway:                   localNetworkGateways,
+		NatGateway:                            natGateways,
+		PrivateEndpoint:                       privateEndpoints,
+		SubnetNatGatewayAssociation:           subnetAssociations,
+		SubnetNetworkSecurityGroupAssociation: subnetAssociations,
+		SubnetRouteTableAssociation:           subnetAssociations,
+		NetworkProfile:                        networkProfiles,
+		NetworkSecurityRule:                   networkSecurityRules,
+		PublicIP:                              publicIP,
+		PublicIPPrefix:                        publicIPPrefixes,
+		Route:                                 routes,
+		RouteTable:                            routeTables,
+		VirtualNetworkGateway:                 virtualNetworkGateways,
+		VirtualNetworkGatewayConnection:       virtualNetworkGatewayConnections,
+		VirtualNetworkPeering:                 virtualNetworkPeerings,
+		WebApplicationFirewallPolicy:          webApplicationFirewallPolicies,
+		VirtualHub:                            virtualHubs,
+		VirtualHubBgpConnection:               virtualHubBgpConnection,
+		VirtualHubConnection:                  virtualHubConnection,
+		VirtualHubIP:                          virtualHubIP,
+		VirtualHubRouteTable:                  virtualHubRouteTable,
+		VirtualHubSecurityPartnerProvider:     virtualHubSecurityPartnerProvider,
+		BastionHost:                           bastionHosts,
+		ExpressRouteCircuit:                   expressRouteCircuits,
+		ExpressRouteCircuitPeering:            expressRouteCircuitPeerings,
+		VirtualWan:                            virtualWans,
 		// Load Balancer
 		Lb:                   lbs,
 		LbBackendAddressPool: lbBackendAddressPools,
@@ -297,6 +328,7 @@ var (
 		// Vault
 		KeyVault:             keyVaults,
 		KeyVaultAccessPolicy: keyVaultProperties,
+		KeyVaultSecret:       keyVaultSecrets,
 		// Application Insigths
 		ApplicationInsights:              applicationInsights,
 		ApplicationInsightsAPIKey:        applicationInsightsAPIKeys,
@@ -316,6 +348,8 @@ var (
 		// App service
 		WindowsWebApp:          webApps,
 		LinuxWebApp:            webApps,
+		LinuxFunctionApp:       functionApps,
+		WindowsFunctionApp:     functionApps,
 		LinuxWebAppSlot:        linuxWebAppSlots,
 		WindowsWebAppSlot:      windowsWebAppSlots,
 		WebAppActiveSlot:       webAppActiveSlots,
@@ -324,9 +358,30 @@ var (
 		StaticSite:             staticSites,
 		StaticSiteCustomDomain: staticSiteCustomDomains,
 		WebAppHybridConnection: webAppHybridConnections,
+		// Batch
+		BatchAccount: batchAccounts,
+		BatchPool:    batchPools,
+		// HDInsight
+		HDInsightHadoopCluster:           hdInsightClusters,
+		HDInsightHbaseCluster:            hdInsightClusters,
+		HDInsightInteractiveQueryCluster: hdInsightClusters,
+		HDInsightKafkaCluster:            hdInsightClusters,
+		HDInsightSparkCluster:            hdInsightClusters,
+		// Databricks
+		DatabricksWorkspace: databricksWorkspaces,
 	}
 )
 
+// hdInsightClusterKinds has the kind of the HDInsight
+// Cluster that each resource type imports
+var hdInsightClusterKinds = map[ResourceType]string{
+	HDInsightHadoopCluster:           "hadoop",
+	HDInsightHbaseCluster:            "hbase",
+	HDInsightInteractiveQueryCluster: "interactivehive",
+	HDInsightKafkaCluster:            "kafka",
+	HDInsightSparkCluster:            "spark",
+}
+
 func resourceGroup(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	resourceGroup := ar.GetResourceGroup()
 	r := provider.NewResource(*resourceGroup.ID, resourceType, a)
@@ -536,6 +591,43 @@ func virtualNetworks(ctx context.Context, a *azurerm, ar *AzureReader, resourceT
 	return resources, nil
 }
 
+// subnetAssociations returns the associations of the resourceType of the
+// Subnets, which have the ID of the Subnet, as on the AzureRM v3 the NAT
+// Gateway, Security Group and Route Table are not defined on the Subnet
+func subnetAssociations(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	virtualNetworkNames, err := getVirtualNetworkNames(ctx, a, ar, VirtualNetwork.String(), filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list virtual networks from cache")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, virtualNetworkName := range virtualNetworkNames {
+		subnets, err := ar.ListSubnets(ctx, virtualNetworkName)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list subnets from reader")
+		}
+		for _, subnet := range subnets {
+			if subnet.SubnetPropertiesFormat == nil {
+				continue
+			}
+			var associated bool
+			switch resourceType {
+			case SubnetNatGatewayAssociation.String():
+				associated = subnet.NatGateway != nil
+			case SubnetNetworkSecurityGroupAssociation.String():
+				associated = subnet.NetworkSecurityGroup != nil
+			case SubnetRouteTableAssociation.String():
+				associated = subnet.RouteTable != nil
+			}
+			if !associated {
+				continue
+			}
+			r := provider.NewResource(*subnet.ID, resourceType, a)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
 func subnets(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	virtualNetworkNames, err := getVirtualNetworkNames(ctx, a, ar, VirtualNetwork.String(), filters)
 	if err != nil {
@@ -651,6 +743,19 @@ func localNetworkGateways(ctx context.Context, a *azurerm, ar *AzureReader, reso
 	return resources, nil
 }
 
+func privateEndpoints(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	privateEndpoints, err := ar.ListPrivateEndpoints(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list private endpoints from reader")
+	}
+	resources := make([]provider.Resource, 0, len(privateEndpoints))
+	for _, privateEndpoint := range privateEndpoints {
+		r := provider.NewResource(*privateEndpoint.ID, resourceType, a)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
 func natGateways(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	natGateways, err := ar.ListNatGateways(ctx)
 	if err != nil {
@@ -928,6 +1033,69 @@ func virtualHubSecurityPartnerProvider(ctx context.Context, a *azurerm, ar *Azur
 	return resources, nil
 }
 
+func bastionHosts(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	bastionHosts, err := ar.ListBastionHosts(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list bastion hosts from reader")
+	}
+	resources := make([]provider.Resource, 0, len(bastionHosts))
+	for _, bastionHost := range bastionHosts {
+		r := provider.NewResource(*bastionHost.ID, resourceType, a)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func expressRouteCircuits(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	expressRouteCircuits, err := ar.ListExpressRouteCircuits(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list express route circuits from reader")
+	}
+	resources := make([]provider.Resource, 0, len(expressRouteCircuits))
+	for _, expressRouteCircuit := range expressRouteCircuits {
+		r := provider.NewResource(*expressRouteCircuit.ID, resourceType, a)
+		// we set the name prior of reading it from the state
+		// as it is required to able to List resources depending on this one
+		if err := r.Data().Set("name", *expressRouteCircuit.Name); err != nil {
+			return nil, errors.Wrapf(err, "unable to set name data on the provider.Resource for the express route circuit '%s'", *expressRouteCircuit.Name)
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func expressRouteCircuitPeerings(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	expressRouteCircuitNames, err := getExpressRouteCircuits(ctx, a, ar, ExpressRouteCircuit.String(), filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list express route circuits from cache")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, expressRouteCircuitName := range expressRouteCircuitNames {
+		expressRouteCircuitPeerings, err := ar.ListExpressRouteCircuitPeerings(ctx, expressRouteCircuitName)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list express route circuit peerings from reader")
+		}
+		for _, expressRouteCircuitPeering := range expressRouteCircuitPeerings {
+			r := provider.NewResource(*expressRouteCircuitPeering.ID, resourceType, a)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+func virtualWans(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	virtualWans, err := ar.ListVirtualWans(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list virtual WANs from reader")
+	}
+	resources := make([]provider.Resource, 0, len(virtualWans))
+	for _, virtualWan := range virtualWans {
+		r := provider.NewResource(*virtualWan.ID, resourceType, a)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
 // Load Balancer
 func lbs(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	lbs, err := ar.ListLoadBalancers(ctx)
@@ -1972,10 +2140,19 @@ func keyVaultProperties(ctx context.Context, a *azurerm, ar *AzureReader, resour
 	}
 	resources := make([]provider.Resource, 0)
 	for _, keyVault := range keyVaults {
-		if vaultProps := keyVault.Properties; vaultProps == nil {
+		if vaultProps := keyVault.Properties; vaultProps != nil {
 			if resourceType == "azurerm_key_vault_access_policy" && vaultProps.AccessPolicies != nil {
 				for _, vaultAcessPolicy := range *vaultProps.AccessPolicies {
-					r := provider.NewResource(*vaultAcessPolicy.ObjectID, resourceType, a)
+					if vaultAcessPolicy.ObjectID == nil {
+						continue
+					}
+					// The import ID of the access policy is the ID of
+					// the Key Vault followed by the Object ID
+					id := fmt.Sprintf("%s/objectId/%s", *keyVault.ID, *vaultAcessPolicy.ObjectID)
+					if vaultAcessPolicy.ApplicationID != nil {
+						id = fmt.Sprintf("%s/applicationId/%s", id, vaultAcessPolicy.ApplicationID.String())
+					}
+					r := provider.NewResource(id, resourceType, a)
 					resources = append(resources, r)
 				}
 			}
@@ -1984,6 +2161,30 @@ func keyVaultProperties(ctx context.Context, a *azurerm, ar *AzureReader, resour
 	return resources, nil
 }
 
+func keyVaultSecrets(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	keyVaults, err := ar.ListKeyVaults(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list key vault from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, keyVault := range keyVaults {
+		// The management API only returns the metadata
+		// of the secrets, never the values
+		secrets, err := ar.ListKeyVaultSecrets(ctx, *keyVault.Name, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list key vault secrets from reader")
+		}
+		for _, secret := range secrets {
+			if secret.Properties == nil || secret.Properties.SecretURIWithVersion == nil {
+				continue
+			}
+			r := provider.NewResource(*secret.Properties.SecretURIWithVersion, resourceType, a)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
 // Application Insigths
 func applicationInsights(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	applicationInsights, err := ar.ListINSIGHTSComponents(ctx)
@@ -2189,7 +2390,7 @@ func monitorMetricAlerts(ctx context.Context, a *azurerm, ar *AzureReader, resou
 
 // App service
 func webApps(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	webApps, err := ar.ListWebApps(ctx)
+	webApps, err := listSites(ctx, ar, false)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list app service web apps from reader")
 	}
@@ -2198,7 +2399,7 @@ func webApps(ctx context.Context, a *azurerm, ar *AzureReader, resourceType stri
 
 		// https://azure.github.io/AppService/2021/08/31/Kind-property-overview.html
 		// is linux if reserved is set
-		if resourceType == "azurerm_windows_web_app" && *webApp.SiteProperties.Reserved == false || resourceType == "azurerm_linux_web_app" && *webApp.SiteProperties.Reserved == true {
+		if resourceType == "azurerm_windows_web_app" && !isLinuxSite(webApp) || resourceType == "azurerm_linux_web_app" && isLinuxSite(webApp) {
 			r := provider.NewResource(*webApp.ID, resourceType, a)
 			// we set the name prior of reading it from the state
 			// as it is required to able to List resources depending on this one
@@ -2211,6 +2412,24 @@ func webApps(ctx context.Context, a *azurerm, ar *AzureReader, resourceType stri
 	return resources, nil
 }
 
+func functionApps(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	functionApps, err := listSites(ctx, ar, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list app service function apps from reader")
+	}
+	resources := make([]provider.Resource, 0, len(functionApps))
+	for _, functionApp := range functionApps {
+		if resourceType == "azurerm_windows_function_app" && !isLinuxSite(functionApp) || resourceType == "azurerm_linux_function_app" && isLinuxSite(functionApp) {
+			r := provider.NewResource(*functionApp.ID, resourceType, a)
+			if err := r.Data().Set("name", *functionApp.Name); err != nil {
+				return nil, errors.Wrapf(err, "unable to set name data on the provider.Resource for the app service function app '%s'", *functionApp.Name)
+			}
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
 func servicePlans(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	// specify detailed = true to return all App Service plan properties, defaults to false, which returns a subset of the properties.
 	// Note! Retrieval of all properties may increase the API latency.
@@ -2222,6 +2441,10 @@ func servicePlans(ctx context.Context, a *azurerm, ar *AzureReader, resourceType
 	}
 	resources := make([]provider.Resource, 0, len(servicePlans))
 	for _, servicePlan := range servicePlans {
+		// The plans are listed for all the subscription
+		if !strings.EqualFold(resourceGroupFromID(*servicePlan.ID), ar.GetResourceGroupName()) {
+			continue
+		}
 
 		r := provider.NewResource(*servicePlan.ID, resourceType, a)
 		// we set the name prior of reading it from the state
@@ -2370,3 +2593,86 @@ func webAppActiveSlots(ctx context.Context, a *azurerm, ar *AzureReader, resourc
 	}
 	return resources, nil
 }
+
+// Batch Resources
+
+func batchAccounts(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	batchAccounts, err := ar.ListBatchAccounts(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list batch accounts from reader")
+	}
+	resources := make([]provider.Resource, 0, len(batchAccounts))
+	for _, batchAccount := range batchAccounts {
+		r := provider.NewResource(*batchAccount.ID, resourceType, a)
+		// we set the name prior of reading it from the state
+		// as it is required to able to List resources depending on this one
+		if err := r.Data().Set("name", *batchAccount.Name); err != nil {
+			return nil, errors.Wrapf(err, "unable to set name data on the provider.Resource for the batch account '%s'", *batchAccount.Name)
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func batchPools(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	batchAccountNames, err := getBatchAccounts(ctx, a, ar, BatchAccount.String(), filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list batch accounts from cache")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, batchAccountName := range batchAccountNames {
+		batchPools, err := ar.ListBatchPools(ctx, batchAccountName, nil, "", "")
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list batch pools from reader")
+		}
+		for _, batchPool := range batchPools {
+			r := provider.NewResource(*batchPool.ID, resourceType, a)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+// HDInsight Resources
+
+func hdInsightClusters(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	rt, err := ResourceTypeString(resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	hdInsightClusters, err := ar.ListHDInsightClusters(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list hdinsight clusters from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, hdInsightCluster := range hdInsightClusters {
+		// All the kinds of clusters are listed together
+		// so only the ones of the resourceType are kept
+		if hdInsightCluster.Properties == nil || hdInsightCluster.Properties.ClusterDefinition == nil || hdInsightCluster.Properties.ClusterDefinition.Kind == nil {
+			continue
+		}
+		if !strings.EqualFold(*hdInsightCluster.Properties.ClusterDefinition.Kind, hdInsightClusterKinds[rt]) {
+			continue
+		}
+
+		r := provider.NewResource(*hdInsightCluster.ID, resourceType, a)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// Databricks Resources
+
+func databricksWorkspaces(ctx context.Context, a *azurerm, ar *AzureReader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	databricksWorkspaces, err := ar.ListDatabricksWorkspaces(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list databricks workspaces from reader")
+	}
+	resources := make([]provider.Resource, 0, len(databricksWorkspaces))
+	for _, databricksWorkspace := range databricksWorkspaces {
+		r := provider.NewResource(*databricksWorkspace.ID, resourceType, a)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _ResourceTypeName = "azurerm_resource_groupazurerm_virtual_machineazurerm_windows_virtual_machineazurerm_linux_virtual_machineazurerm_virtual_machine_extensionazurerm_windows_virtual_machine_scale_setazurerm_linux_virtual_machine_scale_setazurerm_virtual_machine_scale_set_extensionazurerm_virtual_networkazurerm_availability_setazurerm_managed_diskazurerm_imageazurerm_subnetazurerm_network_interfaceazurerm_network_security_groupazurerm_application_gatewayazurerm_application_security_groupazurerm_network_ddos_protection_planazurerm_firewallazurerm_local_network_gatewayazurerm_nat_gatewayazurerm_network_profileazurerm_network_security_ruleazurerm_public_ipazurerm_public_ip_prefixazurerm_routeazurerm_route_tableazurerm_virtual_network_gatewayazurerm_virtual_network_gateway_connectionazurerm_virtual_network_peeringazurerm_web_application_firewall_policyazurerm_virtual_hubazurerm_virtual_hub_bgp_connectionazurerm_virtual_hub_connectionazurerm_virtual_hub_ipazurerm_virtual_hub_route_tableazurerm_virtual_hub_security_partner_providerazurerm_lbazurerm_lb_backend_address_poolazurerm_lb_ruleazurerm_lb_outbound_ruleazurerm_lb_nat_ruleazurerm_lb_nat_poolazurerm_lb_probeazurerm_virtual_desktop_host_poolazurerm_virtual_desktop_application_groupazurerm_logic_app_workflowazurerm_logic_app_trigger_customazurerm_logic_app_action_customazurerm_container_registryazurerm_container_registry_webhookazurerm_kubernetes_clusterazurerm_kubernetes_cluster_node_poolazurerm_storage_accountazurerm_storage_queueazurerm_storage_shareazurerm_storage_tableazurerm_storage_blobazurerm_mariadb_configurationazurerm_mariadb_databaseazurerm_mariadb_firewall_ruleazurerm_mariadb_serverazurerm_mariadb_virtual_network_ruleazurerm_mysql_configurationazurerm_mysql_databaseazurerm_mysql_firewall_ruleazurerm_mysql_serverazurerm_mysql_virtual_network_ruleazurerm_postgresql_configurationazurerm_postgresql_databaseazurerm_postgresql_firewall_ruleazurerm_postgresql_serverazurerm_postgresql_virtual_network_ruleazurerm_mssql_elasticpoolazurerm_mssql_databaseazurerm_mssql_firewall_ruleazurerm_mssql_serverazurerm_mssql_server_security_alert_policyazurerm_mssql_server_vulnerability_assessmentazurerm_mssql_virtual_machineazurerm_mssql_virtual_network_ruleazurerm_redis_cacheazurerm_redis_firewall_ruleazurerm_dns_zoneazurerm_dns_a_recordazurerm_dns_aaaa_recordazurerm_dns_caa_recordazurerm_dns_cname_recordazurerm_dns_mx_recordazurerm_dns_ns_recordazurerm_dns_ptr_recordazurerm_dns_srv_recordazurerm_dns_txt_recordazurerm_private_dns_zoneazurerm_private_dns_a_recordazurerm_private_dns_aaaa_recordazurerm_private_dns_cname_recordazurerm_private_dns_mx_recordazurerm_private_dns_ptr_recordazurerm_private_dns_srv_recordazurerm_private_dns_txt_recordazurerm_private_dns_zone_virtual_network_linkazurerm_policy_definitionazurerm_policy_remediationazurerm_policy_set_definitionazurerm_key_vaultazurerm_key_vault_access_policyazurerm_application_insightsazurerm_application_insights_api_keyazurerm_application_insights_analytics_itemazurerm_log_analytics_workspaceazurerm_log_analytics_linked_serviceazurerm_log_analytics_datasource_windows_performance_counterazurerm_log_analytics_datasource_windows_eventazurerm_monitor_action_groupazurerm_monitor_activity_log_alertazurerm_monitor_autoscale_settingazurerm_monitor_log_profileazurerm_monitor_metric_alertazurerm_windows_web_appazurerm_linux_web_appazurerm_linux_web_app_slotazurerm_windows_web_app_slotazurerm_web_app_active_slotazurerm_service_planazurerm_source_control_tokenazurerm_static_siteazurerm_static_site_custom_domainazurerm_web_app_hybrid_connection"
+const _ResourceTypeName = "azurerm_resource_groupazurerm_virtual_machineazurerm_windows_virtual_machineazurerm_linux_virtual_machineazurerm_virtual_machine_extensionazurerm_windows_virtual_machine_scale_setazurerm_linux_virtual_machine_scale_setazurerm_virtual_machine_scale_set_extensionazurerm_virtual_networkazurerm_availability_setazurerm_managed_diskazurerm_imageazurerm_subnetazurerm_network_interfaceazurerm_network_security_groupazurerm_application_gatewayazurerm_application_security_groupazurerm_network_ddos_protection_planazurerm_firewallazurerm_local_network_gatewayazurerm_nat_gatewayazurerm_private_endpointazurerm_subnet_nat_gateway_associationazurerm_subnet_network_security_group_associationazurerm_subnet_route_table_associationazurerm_network_profileazurerm_network_security_ruleazurerm_public_ipazurerm_public_ip_prefixazurerm_routeazurerm_route_tableazurerm_virtual_network_gatewayazurerm_virtual_network_gateway_connectionazurerm_virtual_network_peeringazurerm_web_application_firewall_policyazurerm_virtual_hubazurerm_virtual_hub_bgp_connectionazurerm_virtual_hub_connectionazurerm_virtual_hub_ipazurerm_virtual_hub_route_tableazurerm_virtual_hub_security_partner_providerazurerm_bastion_hostazurerm_express_route_circuitazurerm_express_route_circuit_peeringazurerm_virtual_wanazurerm_lbazurerm_lb_backend_address_poolazurerm_lb_ruleazurerm_lb_outbound_ruleazurerm_lb_nat_ruleazurerm_lb_nat_poolazurerm_lb_probeazurerm_virtual_desktop_host_poolazurerm_virtual_desktop_application_groupazurerm_logic_app_workflowazurerm_logic_app_trigger_customazurerm_logic_app_action_customazurerm_container_registryazurerm_container_registry_webhookazurerm_kubernetes_clusterazurerm_kubernetes_cluster_node_poolazurerm_storage_accountazurerm_storage_queueazurerm_storage_shareazurerm_storage_tableazurerm_storage_blobazurerm_mariadb_configurationazurerm_mariadb_databaseazurerm_mariadb_firewall_ruleazurerm_mariadb_serverazurerm_mariadb_virtual_network_ruleazurerm_mysql_configurationazurerm_mysql_databaseazurerm_mysql_firewall_ruleazurerm_mysql_serverazurerm_mysql_virtual_network_ruleazurerm_postgresql_configurationazurerm_postgresql_databaseazurerm_postgresql_firewall_ruleazurerm_postgresql_serverazurerm_postgresql_virtual_network_ruleazurerm_mssql_elasticpoolazurerm_mssql_databaseazurerm_mssql_firewall_ruleazurerm_mssql_serverazurerm_mssql_server_security_alert_policyazurerm_mssql_server_vulnerability_assessmentazurerm_mssql_virtual_machineazurerm_mssql_virtual_network_ruleazurerm_redis_cacheazurerm_redis_firewall_ruleazurerm_dns_zoneazurerm_dns_a_recordazurerm_dns_aaaa_recordazurerm_dns_caa_recordazurerm_dns_cname_recordazurerm_dns_mx_recordazurerm_dns_ns_recordazurerm_dns_ptr_recordazurerm_dns_srv_recordazurerm_dns_txt_recordazurerm_private_dns_zoneazurerm_private_dns_a_recordazurerm_private_dns_aaaa_recordazurerm_private_dns_cname_recordazurerm_private_dns_mx_recordazurerm_private_dns_ptr_recordazurerm_private_dns_srv_recordazurerm_private_dns_txt_recordazurerm_private_dns_zone_virtual_network_linkazurerm_policy_definitionazurerm_policy_remediationazurerm_policy_set_definitionazurerm_key_vaultazurerm_key_vault_access_policyazurerm_key_vault_secretazurerm_application_insightsazurerm_application_insights_api_keyazurerm_application_insights_analytics_itemazurerm_log_analytics_workspaceazurerm_log_analytics_linked_serviceazurerm_log_analytics_datasource_windows_performance_counterazurerm_log_analytics_datasource_windows_eventazurerm_monitor_action_groupazurerm_monitor_activity_log_alertazurerm_monitor_autoscale_settingazurerm_monitor_log_profileazurerm_monitor_metric_alertazurerm_windows_web_appazurerm_linux_web_appazurerm_linux_function_appazurerm_windows_function_appazurerm_linux_web_app_slotazurerm_windows_web_app_slotazurerm_web_app_active_slotazurerm_service_planazurerm_source_control_tokenazurerm_static_siteazurerm_static_site_custom_domainazurerm_web_app_hybrid_connectionazurerm_batch_accountazurerm_batch_poolazurerm_hdinsight_hadoop_clusterazurerm_hdinsight_hbase_clusterazurerm_hdinsight_interactive_query_clusterazurerm_hdinsight_kafka_clusterazurerm_hdinsight_spark_clusterazurerm_databricks_workspace"
 
-var _ResourceTypeIndex = [...]uint16{0, 22, 45, 76, 105, 138, 179, 218, 261, 284, 308, 328, 341, 355, 380, 410, 437, 471, 507, 523, 552, 571, 594, 623, 640, 664, 677, 696, 727, 769, 800, 839, 858, 892, 922, 944, 975, 1020, 1030, 1061, 1076, 1100, 1119, 1138, 1154, 1187, 1228, 1254, 1286, 1317, 1343, 1377, 1403, 1439, 1462, 1483, 1504, 1525, 1545, 1574, 1598, 1627, 1649, 1685, 1712, 1734, 1761, 1781, 1815, 1847, 1874, 1906, 1931, 1970, 1995, 2017, 2044, 2064, 2106, 2151, 2180, 2214, 2233, 2260, 2276, 2296, 2319, 2341, 2365, 2386, 2407, 2429, 2451, 2473, 2497, 2525, 2556, 2588, 2617, 2647, 2677, 2707, 2752, 2777, 2803, 2832, 2849, 2880, 2908, 2944, 2987, 3018, 3054, 3114, 3160, 3188, 3222, 3255, 3282, 3310, 3333, 3354, 3380, 3408, 3435, 3455, 3483, 3502, 3535, 3568}
+var _ResourceTypeIndex = [...]uint16{0, 22, 45, 76, 105, 138, 179, 218, 261, 284, 308, 328, 341, 355, 380, 410, 437, 471, 507, 523, 552, 571, 595, 633, 682, 720, 743, 772, 789, 813, 826, 845, 876, 918, 949, 988, 1007, 1041, 1071, 1093, 1124, 1169, 1189, 1218, 1255, 1274, 1284, 1315, 1330, 1354, 1373, 1392, 1408, 1441, 1482, 1508, 1540, 1571, 1597, 1631, 1657, 1693, 1716, 1737, 1758, 1779, 1799, 1828, 1852, 1881, 1903, 1939, 1966, 1988, 2015, 2035, 2069, 2101, 2128, 2160, 2185, 2224, 2249, 2271, 2298, 2318, 2360, 2405, 2434, 2468, 2487, 2514, 2530, 2550, 2573, 2595, 2619, 2640, 2661, 2683, 2705, 2727, 2751, 2779, 2810, 2842, 2871, 2901, 2931, 2961, 3006, 3031, 3057, 3086, 3103, 3134, 3158, 3186, 3222, 3265, 3296, 3332, 3392, 3438, 3466, 3500, 3533, 3560, 3588, 3611, 3632, 3658, 3686, 3712, 3740, 3767, 3787, 3815, 3834, 3867, 3900, 3921, 3939, 3971, 4002, 4045, 4076, 4107, 4135}
 
-const _ResourceTypeLowerName = "azurerm_resource_groupazurerm_virtual_machineazurerm_windows_virtual_machineazurerm_linux_virtual_machineazurerm_virtual_machine_extensionazurerm_windows_virtual_machine_scale_setazurerm_linux_virtual_machine_scale_setazurerm_virtual_machine_scale_set_extensionazurerm_virtual_networkazurerm_availability_setazurerm_managed_diskazurerm_imageazurerm_subnetazurerm_network_interfaceazurerm_network_security_groupazurerm_application_gatewayazurerm_application_security_groupazurerm_network_ddos_protection_planazurerm_firewallazurerm_local_network_gatewayazurerm_nat_gatewayazurerm_network_profileazurerm_network_security_ruleazurerm_public_ipazurerm_public_ip_prefixazurerm_routeazurerm_route_tableazurerm_virtual_network_gatewayazurerm_virtual_network_gateway_connectionazurerm_virtual_network_peeringazurerm_web_application_firewall_policyazurerm_virtual_hubazurerm_virtual_hub_bgp_connectionazurerm_virtual_hub_connectionazurerm_virtual_hub_ipazurerm_virtual_hub_route_tableazurerm_virtual_hub_security_partner_providerazurerm_lbazurerm_lb_backend_address_poolazurerm_lb_ruleazurerm_lb_outbound_ruleazurerm_lb_nat_ruleazurerm_lb_nat_poolazurerm_lb_probeazurerm_virtual_desktop_host_poolazurerm_virtual_desktop_application_groupazurerm_logic_app_workflowazurerm_logic_app_trigger_customazurerm_logic_app_action_customazurerm_container_registryazurerm_container_registry_webhookazurerm_kubernetes_clusterazurerm_kubernetes_cluster_node_poolazurerm_storage_accountazurerm_storage_queueazurerm_storage_shareazurerm_storage_tableazurerm_storage_blobazurerm_mariadb_configurationazurerm_mariadb_databaseazurerm_mariadb_firewall_ruleazurerm_mariadb_serverazurerm_mariadb_virtual_network_ruleazurerm_mysql_configurationazurerm_mysql_databaseazurerm_mysql_firewall_ruleazurerm_mysql_serverazurerm_mysql_virtual_network_ruleazurerm_postgresql_configurationazurerm_postgresql_databaseazurerm_postgresql_firewall_ruleazurerm_postgresql_serverazurerm_postgresql_virtual_network_ruleazurerm_mssql_elasticpoolazurerm_mssql_databaseazurerm_mssql_firewall_ruleazurerm_mssql_serverazurerm_mssql_server_security_alert_policyazurerm_mssql_server_vulnerability_assessmentazurerm_mssql_virtual_machineazurerm_mssql_virtual_network_ruleazurerm_redis_cacheazurerm_redis_firewall_ruleazurerm_dns_zoneazurerm_dns_a_recordazurerm_dns_aaaa_recordazurerm_dns_caa_recordazurerm_dns_cname_recordazurerm_dns_mx_recordazurerm_dns_ns_recordazurerm_dns_ptr_recordazurerm_dns_srv_recordazurerm_dns_txt_recordazurerm_private_dns_zoneazurerm_private_dns_a_recordazurerm_private_dns_aaaa_recordazurerm_private_dns_cname_recordazurerm_private_dns_mx_recordazurerm_private_dns_ptr_recordazurerm_private_dns_srv_recordazurerm_private_dns_txt_recordazurerm_private_dns_zone_virtual_network_linkazurerm_policy_definitionazurerm_policy_remediationazurerm_policy_set_definitionazurerm_key_vaultazurerm_key_vault_access_policyazurerm_application_insightsazurerm_application_insights_api_keyazurerm_application_insights_analytics_itemazurerm_log_analytics_workspaceazurerm_log_analytics_linked_serviceazurerm_log_analytics_datasource_windows_performance_counterazurerm_log_analytics_datasource_windows_eventazurerm_monitor_action_groupazurerm_monitor_activity_log_alertazurerm_monitor_autoscale_settingazurerm_monitor_log_profileazurerm_monitor_metric_alertazurerm_windows_web_appazurerm_linux_web_appazurerm_linux_web_app_slotazurerm_windows_web_app_slotazurerm_web_app_active_slotazurerm_service_planazurerm_source_control_tokenazurerm_static_siteazurerm_static_site_custom_domainazurerm_web_app_hybrid_connection"
+const _ResourceTypeLowerName = "azurerm_resource_groupazurerm_virtual_machineazurerm_windows_virtual_machineazurerm_linux_virtual_machineazurerm_virtual_machine_extensionazurerm_windows_virtual_machine_scale_setazurerm_linux_virtual_machine_scale_setazurerm_virtual_machine_scale_set_extensionazurerm_virtual_networkazurerm_availability_setazurerm_managed_diskazurerm_imageazurerm_subnetazurerm_network_interfaceazurerm_network_security_groupazurerm_application_gatewayazurerm_application_security_groupazurerm_network_ddos_protection_planazurerm_firewallazurerm_local_network_gatewayazurerm_nat_gatewayazurerm_private_endpointazurerm_subnet_nat_gateway_associationazurerm_subnet_network_security_group_associationazurerm_subnet_route_table_associationazurerm_network_profileazurerm_network_security_ruleazurerm_public_ipazurerm_public_ip_prefixazurerm_routeazurerm_route_tableazurerm_virtual_network_gatewayazurerm_virtual_network_gateway_connectionazurerm_virtual_network_peeringazurerm_web_application_firewall_policyazurerm_virtual_hubazurerm_virtual_hub_bgp_connectionazurerm_virtual_hub_connectionazurerm_virtual_hub_ipazurerm_virtual_hub_route_tableazurerm_virtual_hub_security_partner_providerazurerm_bastion_hostazurerm_express_route_circuitazurerm_express_route_circuit_peeringazurerm_virtual_wanazurerm_lbazurerm_lb_backend_address_poolazurerm_lb_ruleazurerm_lb_outbound_ruleazurerm_lb_nat_ruleazurerm_lb_nat_poolazurerm_lb_probeazurerm_virtual_desktop_host_poolazurerm_virtual_desktop_application_groupazurerm_logic_app_workflowazurerm_logic_app_trigger_customazurerm_logic_app_action_customazurerm_container_registryazurerm_container_registry_webhookazurerm_kubernetes_clusterazurerm_kubernetes_cluster_node_poolazurerm_storage_accountazurerm_storage_queueazurerm_storage_shareazurerm_storage_tableazurerm_storage_blobazurerm_mariadb_configurationazurerm_mariadb_databaseazurerm_mariadb_firewall_ruleazurerm_mariadb_serverazurerm_mariadb_virtual_network_ruleazurerm_mysql_configurationazurerm_mysql_databaseazurerm_mysql_firewall_ruleazurerm_mysql_serverazurerm_mysql_virtual_network_ruleazurerm_postgresql_configurationazurerm_postgresql_databaseazurerm_postgresql_firewall_ruleazurerm_postgresql_serverazurerm_postgresql_virtual_network_ruleazurerm_mssql_elasticpoolazurerm_mssql_databaseazurerm_mssql_firewall_ruleazurerm_mssql_serverazurerm_mssql_server_security_alert_policyazurerm_mssql_server_vulnerability_assessmentazurerm_mssql_virtual_machineazurerm_mssql_virtual_network_ruleazurerm_redis_cacheazurerm_redis_firewall_ruleazurerm_dns_zoneazurerm_dns_a_recordazurerm_dns_aaaa_recordazurerm_dns_caa_recordazurerm_dns_cname_recordazurerm_dns_mx_recordazurerm_dns_ns_recordazurerm_dns_ptr_recordazurerm_dns_srv_recordazurerm_dns_txt_recordazurerm_private_dns_zoneazurerm_private_dns_a_recordazurerm_private_dns_aaaa_recordazurerm_private_dns_cname_recordazurerm_private_dns_mx_recordazurerm_private_dns_ptr_recordazurerm_private_dns_srv_recordazurerm_private_dns_txt_recordazurerm_private_dns_zone_virtual_network_linkazurerm_policy_definitionazurerm_policy_remediationazurerm_policy_set_definitionazurerm_key_vaultazurerm_key_vault_access_policyazurerm_key_vault_secretazurerm_application_insightsazurerm_application_insights_api_keyazurerm_application_insights_analytics_itemazurerm_log_analytics_workspaceazurerm_log_analytics_linked_serviceazurerm_log_analytics_datasource_windows_performance_counterazurerm_log_analytics_datasource_windows_eventazurerm_monitor_action_groupazurerm_monitor_activity_log_alertazurerm_monitor_autoscale_settingazurerm_monitor_log_profileazurerm_monitor_metric_alertazurerm_windows_web_appazurerm_linux_web_appazurerm_linux_function_appazurerm_windows_function_appazurerm_linux_web_app_slotazurerm_windows_web_app_slotazurerm_web_app_active_slotazurerm_service_planazurerm_source_control_tokenazurerm_static_siteazurerm_static_site_custom_domainazurerm_web_app_hybrid_connectionazurerm_batch_accountazurerm_batch_poolazurerm_hdinsight_hadoop_clusterazurerm_hdinsight_hbase_clusterazurerm_hdinsight_interactive_query_clusterazurerm_hdinsight_kafka_clusterazurerm_hdinsight_spark_clusterazurerm_databricks_workspace"
 
 func (i ResourceType) String() string {
 	if i < 0 || i >= ResourceType(len(_ResourceTypeIndex)-1) {
@@ -45,117 +45,136 @@ func _ResourceTypeNoOp() {
 	_ = x[Firewall-(18)]
 	_ = x[LocalNetworkGateway-(19)]
 	_ = x[NatGateway-(20)]
-	_ = x[NetworkProfile-(21)]
-	_ = x[NetworkSecurityRule-(22)]
-	_ = x[PublicIP-(23)]
-	_ = x[PublicIPPrefix-(24)]
-	_ = x[Route-(25)]
-	_ = x[RouteTable-(26)]
-	_ = x[VirtualNetworkGateway-(27)]
-	_ = x[VirtualNetworkGatewayConnection-(28)]
-	_ = x[VirtualNetworkPeering-(29)]
-	_ = x[WebApplicationFirewallPolicy-(30)]
-	_ = x[VirtualHub-(31)]
-	_ = x[VirtualHubBgpConnection-(32)]
-	_ = x[VirtualHubConnection-(33)]
-	_ = x[VirtualHubIP-(34)]
-	_ = x[VirtualHubRouteTable-(35)]
-	_ = x[VirtualHubSecurityPartnerProvider-(36)]
-	_ = x[Lb-(37)]
-	_ = x[LbBackendAddressPool-(38)]
-	_ = x[LbRule-(39)]
-	_ = x[LbOutboundRule-(40)]
-	_ = x[LbNatRule-(41)]
-	_ = x[LbNatPool-(42)]
-	_ = x[LbProbe-(43)]
-	_ = x[VirtualDesktopHostPool-(44)]
-	_ = x[VirtualDesktopApplicationGroup-(45)]
-	_ = x[LogicAppWorkflow-(46)]
-	_ = x[LogicAppTriggerCustom-(47)]
-	_ = x[LogicAppActionCustom-(48)]
-	_ = x[ContainerRegistry-(49)]
-	_ = x[ContainerRegistryWebhook-(50)]
-	_ = x[KubernetesCluster-(51)]
-	_ = x[KubernetesClusterNodePool-(52)]
-	_ = x[StorageAccount-(53)]
-	_ = x[StorageQueue-(54)]
-	_ = x[StorageShare-(55)]
-	_ = x[StorageTable-(56)]
-	_ = x[StorageBlob-(57)]
-	_ = x[MariadbConfiguration-(58)]
-	_ = x[MariadbDatabase-(59)]
-	_ = x[MariadbFirewallRule-(60)]
-	_ = x[MariadbServer-(61)]
-	_ = x[MariadbVirtualNetworkRule-(62)]
-	_ = x[MysqlConfiguration-(63)]
-	_ = x[MysqlDatabase-(64)]
-	_ = x[MysqlFirewallRule-(65)]
-	_ = x[MysqlServer-(66)]
-	_ = x[MysqlVirtualNetworkRule-(67)]
-	_ = x[PostgresqlConfiguration-(68)]
-	_ = x[PostgresqlDatabase-(69)]
-	_ = x[PostgresqlFirewallRule-(70)]
-	_ = x[PostgresqlServer-(71)]
-	_ = x[PostgresqlVirtualNetworkRule-(72)]
-	_ = x[MssqlElasticpool-(73)]
-	_ = x[MssqlDatabase-(74)]
-	_ = x[MssqlFirewallRule-(75)]
-	_ = x[MssqlServer-(76)]
-	_ = x[MssqlServerSecurityAlertPolicy-(77)]
-	_ = x[MssqlServerVulnerabilityAssessment-(78)]
-	_ = x[MssqlVirtualMachine-(79)]
-	_ = x[MssqlVirtualNetworkRule-(80)]
-	_ = x[RedisCache-(81)]
-	_ = x[RedisFirewallRule-(82)]
-	_ = x[DNSZone-(83)]
-	_ = x[DNSARecord-(84)]
-	_ = x[DNSAaaaRecord-(85)]
-	_ = x[DNSCaaRecord-(86)]
-	_ = x[DNSCnameRecord-(87)]
-	_ = x[DNSMxRecord-(88)]
-	_ = x[DNSNsRecord-(89)]
-	_ = x[DNSPtrRecord-(90)]
-	_ = x[DNSSrvRecord-(91)]
-	_ = x[DNSTxtRecord-(92)]
-	_ = x[PrivateDNSZone-(93)]
-	_ = x[PrivateDNSARecord-(94)]
-	_ = x[PrivateDNSAaaaRecord-(95)]
-	_ = x[PrivateDNSCnameRecord-(96)]
-	_ = x[PrivateDNSMxRecord-(97)]
-	_ = x[PrivateDNSPtrRecord-(98)]
-	_ = x[PrivateDNSSrvRecord-(99)]
-	_ = x[PrivateDNSTxtRecord-(100)]
-	_ = x[PrivateDNSZoneVirtualNetworkLink-(101)]
-	_ = x[PolicyDefinition-(102)]
-	_ = x[PolicyRemediation-(103)]
-	_ = x[PolicySetDefinition-(104)]
-	_ = x[KeyVault-(105)]
-	_ = x[KeyVaultAccessPolicy-(106)]
-	_ = x[ApplicationInsights-(107)]
-	_ = x[ApplicationInsightsAPIKey-(108)]
-	_ = x[ApplicationInsightsAnalyticsItem-(109)]
-	_ = x[LogAnalyticsWorkspace-(110)]
-	_ = x[LogAnalyticsLinkedService-(111)]
-	_ = x[LogAnalyticsDatasourceWindowsPerformanceCounter-(112)]
-	_ = x[LogAnalyticsDatasourceWindowsEvent-(113)]
-	_ = x[MonitorActionGroup-(114)]
-	_ = x[MonitorActivityLogAlert-(115)]
-	_ = x[MonitorAutoscaleSetting-(116)]
-	_ = x[MonitorLogProfile-(117)]
-	_ = x[MonitorMetricAlert-(118)]
-	_ = x[WindowsWebApp-(119)]
-	_ = x[LinuxWebApp-(120)]
-	_ = x[LinuxWebAppSlot-(121)]
-	_ = x[WindowsWebAppSlot-(122)]
-	_ = x[WebAppActiveSlot-(123)]
-	_ = x[ServicePlan-(124)]
-	_ = x[SourceControlToken-(125)]
-	_ = x[StaticSite-(126)]
-	_ = x[StaticSiteCustomDomain-(127)]
-	_ = x[WebAppHybridConnection-(128)]
+	_ = x[PrivateEndpoint-(21)]
+	_ = x[SubnetNatGatewayAssociation-(22)]
+	_ = x[SubnetNetworkSecurityGroupAssociation-(23)]
+	_ = x[SubnetRouteTableAssociation-(24)]
+	_ = x[NetworkProfile-(25)]
+	_ = x[NetworkSecurityRule-(26)]
+	_ = x[PublicIP-(27)]
+	_ = x[PublicIPPrefix-(28)]
+	_ = x[Route-(29)]
+	_ = x[RouteTable-(30)]
+	_ = x[VirtualNetworkGateway-(31)]
+	_ = x[VirtualNetworkGatewayConnection-(32)]
+	_ = x[VirtualNetworkPeering-(33)]
+	_ = x[WebApplicationFirewallPolicy-(34)]
+	_ = x[VirtualHub-(35)]
+	_ = x[VirtualHubBgpConnection-(36)]
+	_ = x[VirtualHubConnection-(37)]
+	_ = x[VirtualHubIP-(38)]
+	_ = x[VirtualHubRouteTable-(39)]
+	_ = x[VirtualHubSecurityPartnerProvider-(40)]
+	_ = x[BastionHost-(41)]
+	_ = x[ExpressRouteCircuit-(42)]
+	_ = x[ExpressRouteCircuitPeering-(43)]
+	_ = x[VirtualWan-(44)]
+	_ = x[Lb-(45)]
+	_ = x[LbBackendAddressPool-(46)]
+	_ = x[LbRule-(47)]
+	_ = x[LbOutboundRule-(48)]
+	_ = x[LbNatRule-(49)]
+	_ = x[LbNatPool-(50)]
+	_ = x[LbProbe-(51)]
+	_ = x[VirtualDesktopHostPool-(52)]
+	_ = x[VirtualDesktopApplicationGroup-(53)]
+	_ = x[LogicAppWorkflow-(54)]
+	_ = x[LogicAppTriggerCustom-(55)]
+	_ = x[LogicAppActionCustom-(56)]
+	_ = x[ContainerRegistry-(57)]
+	_ = x[ContainerRegistryWebhook-(58)]
+	_ = x[KubernetesCluster-(59)]
+	_ = x[KubernetesClusterNodePool-(60)]
+	_ = x[StorageAccount-(61)]
+	_ = x[StorageQueue-(62)]
+	_ = x[StorageShare-(63)]
+	_ = x[StorageTable-(64)]
+	_ = x[StorageBlob-(65)]
+	_ = x[MariadbConfiguration-(66)]
+	_ = x[MariadbDatabase-(67)]
+	_ = x[MariadbFirewallRule-(68)]
+	_ = x[MariadbServer-(69)]
+	_ = x[MariadbVirtualNetworkRule-(70)]
+	_ = x[MysqlConfiguration-(71)]
+	_ = x[MysqlDatabase-(72)]
+	_ = x[MysqlFirewallRule-(73)]
+	_ = x[MysqlServer-(74)]
+	_ = x[MysqlVirtualNetworkRule-(75)]
+	_ = x[PostgresqlConfiguration-(76)]
+	_ = x[PostgresqlDatabase-(77)]
+	_ = x[PostgresqlFirewallRule-(78)]
+	_ = x[PostgresqlServer-(79)]
+	_ = x[PostgresqlVirtualNetworkRule-(80)]
+	_ = x[MssqlElasticpool-(81)]
+	_ = x[MssqlDatabase-(82)]
+	_ = x[MssqlFirewallRule-(83)]
+	_ = x[MssqlServer-(84)]
+	_ = x[MssqlServerSecurityAlertPolicy-(85)]
+	_ = x[MssqlServerVulnerabilityAssessment-(86)]
+	_ = x[MssqlVirtualMachine-(87)]
+	_ = x[MssqlVirtualNetworkRule-(88)]
+	_ = x[RedisCache-(89)]
+	_ = x[RedisFirewallRule-(90)]
+	_ = x[DNSZone-(91)]
+	_ = x[DNSARecord-(92)]
+	_ = x[DNSAaaaRecord-(93)]
+	_ = x[DNSCaaRecord-(94)]
+	_ = x[DNSCnameRecord-(95)]
+	_ = x[DNSMxRecord-(96)]
+	_ = x[DNSNsRecord-(97)]
+	_ = x[DNSPtrRecord-(98)]
+	_ = x[DNSSrvRecord-(99)]
+	_ = x[DNSTxtRecord-(100)]
+	_ = x[PrivateDNSZone-(101)]
+	_ = x[PrivateDNSARecord-(102)]
+	_ = x[PrivateDNSAaaaRecord-(103)]
+	_ = x[PrivateDNSCnameRecord-(104)]
+	_ = x[PrivateDNSMxRecord-(105)]
+	_ = x[PrivateDNSPtrRecord-(106)]
+	_ = x[PrivateDNSSrvRecord-(107)]
+	_ = x[PrivateDNSTxtRecord-(108)]
+	_ = x[PrivateDNSZoneVirtualNetworkLink-(109)]
+	_ = x[PolicyDefinition-(110)]
+	_ = x[PolicyRemediation-(111)]
+	_ = x[PolicySetDefinition-(112)]
+	_ = x[KeyVault-(113)]
+	_ = x[KeyVaultAccessPolicy-(114)]
+	_ = x[KeyVaultSecret-(115)]
+	_ = x[ApplicationInsights-(116)]
+	_ = x[ApplicationInsightsAPIKey-(117)]
+	_ = x[ApplicationInsightsAnalyticsItem-(118)]
+	_ = x[LogAnalyticsWorkspace-(119)]
+	_ = x[LogAnalyticsLinkedService-(120)]
+	_ = x[LogAnalyticsDatasourceWindowsPerformanceCounter-(121)]
+	_ = x[LogAnalyticsDatasourceWindowsEvent-(122)]
+	_ = x[MonitorActionGroup-(123)]
+	_ = x[MonitorActivityLogAlert-(124)]
+	_ = x[MonitorAutoscaleSetting-(125)]
+	_ = x[MonitorLogProfile-(126)]
+	_ = x[MonitorMetricAlert-(127)]
+	_ = x[WindowsWebApp-(128)]
+	_ = x[LinuxWebApp-(129)]
+	_ = x[LinuxFunctionApp-(130)]
+	_ = x[WindowsFunctionApp-(131)]
+	_ = x[LinuxWebAppSlot-(132)]
+	_ = x[WindowsWebAppSlot-(133)]
+	_ = x[WebAppActiveSlot-(134)]
+	_ = x[ServicePlan-(135)]
+	_ = x[SourceControlToken-(136)]
+	_ = x[StaticSite-(137)]
+	_ = x[StaticSiteCustomDomain-(138)]
+	_ = x[WebAppHybridConnection-(139)]
+	_ = x[BatchAccount-(140)]
+	_ = x[BatchPool-(141)]
+	_ = x[HDInsightHadoopCluster-(142)]
+	_ = x[HDInsightHbaseCluster-(143)]
+	_ = x[HDInsightInteractiveQueryCluster-(144)]
+	_ = x[HDInsightKafkaCluster-(145)]
+	_ = x[HDInsightSparkCluster-(146)]
+	_ = x[DatabricksWorkspace-(147)]
 }
 
-var _ResourceTypeValues = []ResourceType{ResourceGroup, VirtualMachine, WindowsVirtualMachine, LinuxVirtualMachine, VirtualMachineExtension, WindowsVirtualMachineScaleSet, LinuxVirtualMachineScaleSet, VirtualMachineScaleSetExtension, VirtualNetwork, AvailabilitySet, ManagedDisk, Image, Subnet, NetworkInterface, NetworkSecurityGroup, ApplicationGateway, ApplicationSecurityGroup, NetworkDdosProtectionPlan, Firewall, LocalNetworkGateway, NatGateway, NetworkProfile, NetworkSecurityRule, PublicIP, PublicIPPrefix, Route, RouteTable, VirtualNetworkGateway, VirtualNetworkGatewayConnection, VirtualNetworkPeering, WebApplicationFirewallPolicy, VirtualHub, VirtualHubBgpConnection, VirtualHubConnection, VirtualHubIP, VirtualHubRouteTable, VirtualHubSecurityPartnerProvider, Lb, LbBackendAddressPool, LbRule, LbOutboundRule, LbNatRule, LbNatPool, LbProbe, VirtualDesktopHostPool, VirtualDesktopApplicationGroup, LogicAppWorkflow, LogicAppTriggerCustom, LogicAppActionCustom, ContainerRegistry, ContainerRegistryWebhook, KubernetesCluster, KubernetesClusterNodePool, StorageAccount, StorageQueue, StorageShare, StorageTable, StorageBlob, MariadbConfiguration, MariadbDatabase, MariadbFirewallRule, MariadbServer, MariadbVirtualNetworkRule, MysqlConfiguration, MysqlDatabase, MysqlFirewallRule, MysqlServer, MysqlVirtualNetworkRule, PostgresqlConfiguration, PostgresqlDatabase, PostgresqlFirewallRule, PostgresqlServer, PostgresqlVirtualNetworkRule, MssqlElasticpool, MssqlDatabase, MssqlFirewallRule, MssqlServer, MssqlServerSecurityAlertPolicy, MssqlServerVulnerabilityAssessment, MssqlVirtualMachine, MssqlVirtualNetworkRule, RedisCache, RedisFirewallRule, DNSZone, DNSARecord, DNSAaaaRecord, DNSCaaRecord, DNSCnameRecord, DNSMxRecord, DNSNsRecord, DNSPtrRecord, DNSSrvRecord, DNSTxtRecord, PrivateDNSZone, PrivateDNSARecord, PrivateDNSAaaaRecord, PrivateDNSCnameRecord, PrivateDNSMxRecord, PrivateDNSPtrRecord, PrivateDNSSrvRecord, PrivateDNSTxtRecord, PrivateDNSZoneVirtualNetworkLink, PolicyDefinition, PolicyRemediation, PolicySetDefinition, KeyVault, KeyVaultAccessPolicy, ApplicationInsights, ApplicationInsightsAPIKey, ApplicationInsightsAnalyticsItem, LogAnalyticsWorkspace, LogAnalyticsLinkedService, LogAnalyticsDatasourceWindowsPerformanceCounter, LogAnalyticsDatasourceWindowsEvent, MonitorActionGroup, MonitorActivityLogAlert, MonitorAutoscaleSetting, MonitorLogProfile, MonitorMetricAlert, WindowsWebApp, LinuxWebApp, LinuxWebAppSlot, WindowsWebAppSlot, WebAppActiveSlot, ServicePlan, SourceControlToken, StaticSite, StaticSiteCustomDomain, WebAppHybridConnection}
+var _ResourceTypeValues = []ResourceType{ResourceGroup, VirtualMachine, WindowsVirtualMachine, LinuxVirtualMachine, VirtualMachineExtension, WindowsVirtualMachineScaleSet, LinuxVirtualMachineScaleSet, VirtualMachineScaleSetExtension, VirtualNetwork, AvailabilitySet, ManagedDisk, Image, Subnet, NetworkInterface, NetworkSecurityGroup, ApplicationGateway, ApplicationSecurityGroup, NetworkDdosProtectionPlan, Firewall, LocalNetworkGateway, NatGateway, PrivateEndpoint, SubnetNatGatewayAssociation, SubnetNetworkSecurityGroupAssociation, SubnetRouteTableAssociation, NetworkProfile, NetworkSecurityRule, PublicIP, PublicIPPrefix, Route, RouteTable, VirtualNetworkGateway, VirtualNetworkGatewayConnection, VirtualNetworkPeering, WebApplicationFirewallPolicy, VirtualHub, VirtualHubBgpConnection, VirtualHubConnection, VirtualHubIP, VirtualHubRouteTable, VirtualHubSecurityPartnerProvider, BastionHost, ExpressRouteCircuit, ExpressRouteCircuitPeering, VirtualWan, Lb, LbBackendAddressPool, LbRule, LbOutboundRule, LbNatRule, LbNatPool, LbProbe, VirtualDesktopHostPool, VirtualDesktopApplicationGroup, LogicAppWorkflow, LogicAppTriggerCustom, LogicAppActionCustom, ContainerRegistry, ContainerRegistryWebhook, KubernetesCluster, KubernetesClusterNodePool, StorageAccount, StorageQueue, StorageShare, StorageTable, StorageBlob, MariadbConfiguration, MariadbDatabase, MariadbFirewallRule, MariadbServer, MariadbVirtualNetworkRule, MysqlConfiguration, MysqlDatabase, MysqlFirewallRule, MysqlServer, MysqlVirtualNetworkRule, PostgresqlConfiguration, PostgresqlDatabase, PostgresqlFirewallRule, PostgresqlServer, PostgresqlVirtualNetworkRule, MssqlElasticpool, MssqlDatabase, MssqlFirewallRule, MssqlServer, MssqlServerSecurityAlertPolicy, MssqlServerVulnerabilityAssessment, MssqlVirtualMachine, MssqlVirtualNetworkRule, RedisCache, RedisFirewallRule, DNSZone, DNSARecord, DNSAaaaRecord, DNSCaaRecord, DNSCnameRecord, DNSMxRecord, DNSNsRecord, DNSPtrRecord, DNSSrvRecord, DNSTxtRecord, PrivateDNSZone, PrivateDNSARecord, PrivateDNSAaaaRecord, PrivateDNSCnameRecord, PrivateDNSMxRecord, PrivateDNSPtrRecord, PrivateDNSSrvRecord, PrivateDNSTxtRecord, PrivateDNSZoneVirtualNetworkLink, PolicyDefinition, PolicyRemediation, PolicySetDefinition, KeyVault, KeyVaultAccessPolicy, KeyVaultSecret, ApplicationInsights, ApplicationInsightsAPIKey, ApplicationInsightsAnalyticsItem, LogAnalyticsWorkspace, LogAnalyticsLinkedService, LogAnalyticsDatasourceWindowsPerformanceCounter, LogAnalyticsDatasourceWindowsEvent, MonitorActionGroup, MonitorActivityLogAlert, MonitorAutoscaleSetting, MonitorLogProfile, MonitorMetricAlert, WindowsWebApp, LinuxWebApp, LinuxFunctionApp, WindowsFunctionApp, LinuxWebAppSlot, WindowsWebAppSlot, WebAppActiveSlot, ServicePlan, SourceControlToken, StaticSite, StaticSiteCustomDomain, WebAppHybridConnection, BatchAccount, BatchPool, HDInsightHadoopCluster, HDInsightHbaseCluster, HDInsightInteractiveQueryCluster, HDInsightKafkaCluster, HDInsightSparkCluster, DatabricksWorkspace}
 
 var _ResourceTypeNameToValueMap = map[string]ResourceType{
 	_ResourceTypeName[0:22]:           ResourceGroup,
@@ -200,222 +219,260 @@ var _ResourceTypeNameToValueMap = map[string]ResourceType{
 	_ResourceTypeLowerName[523:552]:   LocalNetworkGateway,
 	_ResourceTypeName[552:571]:        NatGateway,
 	_ResourceTypeLowerName[552:571]:   NatGateway,
-	_ResourceTypeName[571:594]:        NetworkProfile,
-	_ResourceTypeLowerName[571:594]:   NetworkProfile,
-	_ResourceTypeName[594:623]:        NetworkSecurityRule,
-	_ResourceTypeLowerName[594:623]:   NetworkSecurityRule,
-	_ResourceTypeName[623:640]:        PublicIP,
-	_ResourceTypeLowerName[623:640]:   PublicIP,
-	_ResourceTypeName[640:664]:        PublicIPPrefix,
-	_ResourceTypeLowerName[640:664]:   PublicIPPrefix,
-	_ResourceTypeName[664:677]:        Route,
-	_ResourceTypeLowerName[664:677]:   Route,
-	_ResourceTypeName[677:696]:        RouteTable,
-	_ResourceTypeLowerName[677:696]:   RouteTable,
-	_ResourceTypeName[696:727]:        VirtualNetworkGateway,
-	_ResourceTypeLowerName[696:727]:   VirtualNetworkGateway,
-	_ResourceTypeName[727:769]:        VirtualNetworkGatewayConnection,
-	_ResourceTypeLowerName[727:769]:   VirtualNetworkGatewayConnection,
-	_ResourceTypeName[769:800]:        VirtualNetworkPeering,
-	_ResourceTypeLowerName[769:800]:   VirtualNetworkPeering,
-	_ResourceTypeName[800:839]:        WebApplicationFirewallPolicy,
-	_ResourceTypeLowerName[800:839]:   WebApplicationFirewallPolicy,
-	_ResourceTypeName[839:858]:        VirtualHub,
-	_ResourceTypeLowerName[839:858]:   VirtualHub,
-	_ResourceTypeName[858:892]:        VirtualHubBgpConnection,
-	_ResourceTypeLowerName[858:892]:   VirtualHubBgpConnection,
-	_ResourceTypeName[892:922]:        VirtualHubConnection,
-	_ResourceTypeLowerName[892:922]:   VirtualHubConnection,
-	_ResourceTypeName[922:944]:        VirtualHubIP,
-	_ResourceTypeLowerName[922:944]:   VirtualHubIP,
-	_ResourceTypeName[944:975]:        VirtualHubRouteTable,
-	_ResourceTypeLowerName[944:975]:   VirtualHubRouteTable,
-	_ResourceTypeName[975:1020]:       VirtualHubSecurityPartnerProvider,
-	_ResourceTypeLowerName[975:1020]:  VirtualHubSecurityPartnerProvider,
-	_ResourceTypeName[1020:1030]:      Lb,
-	_ResourceTypeLowerName[1020:1030]: Lb,
-	_ResourceTypeName[1030:1061]:      LbBackendAddressPool,
-	_ResourceTypeLowerName[1030:1061]: LbBackendAddressPool,
-	_ResourceTypeName[1061:1076]:      LbRule,
-	_ResourceTypeLowerName[1061:1076]: LbRule,
-	_ResourceTypeName[1076:1100]:      LbOutboundRule,
-	_ResourceTypeLowerName[1076:1100]: LbOutboundRule,
-	_ResourceTypeName[1100:1119]:      LbNatRule,
-	_ResourceTypeLowerName[1100:1119]: LbNatRule,
-	_ResourceTypeName[1119:1138]:      LbNatPool,
-	_ResourceTypeLowerName[1119:1138]: LbNatPool,
-	_ResourceTypeName[1138:1154]:      LbProbe,
-	_ResourceTypeLowerName[1138:1154]: LbProbe,
-	_ResourceTypeName[1154:1187]:      VirtualDesktopHostPool,
-	_ResourceTypeLowerName[1154:1187]: VirtualDesktopHostPool,
-	_ResourceTypeName[1187:1228]:      VirtualDesktopApplicationGroup,
-	_ResourceTypeLowerName[1187:1228]: VirtualDesktopApplicationGroup,
-	_ResourceTypeName[1228:1254]:      LogicAppWorkflow,
-	_ResourceTypeLowerName[1228:1254]: LogicAppWorkflow,
-	_ResourceTypeName[1254:1286]:      LogicAppTriggerCustom,
-	_ResourceTypeLowerName[1254:1286]: LogicAppTriggerCustom,
-	_ResourceTypeName[1286:1317]:      LogicAppActionCustom,
-	_ResourceTypeLowerName[1286:1317]: LogicAppActionCustom,
-	_ResourceTypeName[1317:1343]:      ContainerRegistry,
-	_ResourceTypeLowerName[1317:1343]: ContainerRegistry,
-	_ResourceTypeName[1343:1377]:      ContainerRegistryWebhook,
-	_ResourceTypeLowerName[1343:1377]: ContainerRegistryWebhook,
-	_ResourceTypeName[1377:1403]:      KubernetesCluster,
-	_ResourceTypeLowerName[1377:1403]: KubernetesCluster,
-	_ResourceTypeName[1403:1439]:      KubernetesClusterNodePool,
-	_ResourceTypeLowerName[1403:1439]: KubernetesClusterNodePool,
-	_ResourceTypeName[1439:1462]:      StorageAccount,
-	_ResourceTypeLowerName[1439:1462]: StorageAccount,
-	_ResourceTypeName[1462:1483]:      StorageQueue,
-	_ResourceTypeLowerName[1462:1483]: StorageQueue,
-	_ResourceTypeName[1483:1504]:      StorageShare,
-	_ResourceTypeLowerName[1483:1504]: StorageShare,
-	_ResourceTypeName[1504:1525]:      StorageTable,
-	_ResourceTypeLowerName[1504:1525]: StorageTable,
-	_ResourceTypeName[1525:1545]:      StorageBlob,
-	_ResourceTypeLowerName[1525:1545]: StorageBlob,
-	_ResourceTypeName[1545:1574]:      MariadbConfiguration,
-	_ResourceTypeLowerName[1545:1574]: MariadbConfiguration,
-	_ResourceTypeName[1574:1598]:      MariadbDatabase,
-	_ResourceTypeLowerName[1574:1598]: MariadbDatabase,
-	_ResourceTypeName[1598:1627]:      MariadbFirewallRule,
-	_ResourceTypeLowerName[1598:1627]: MariadbFirewallRule,
-	_ResourceTypeName[1627:1649]:      MariadbServer,
-	_ResourceTypeLowerName[1627:1649]: MariadbServer,
-	_ResourceTypeName[1649:1685]:      MariadbVirtualNetworkRule,
-	_ResourceTypeLowerName[1649:1685]: MariadbVirtualNetworkRule,
-	_ResourceTypeName[1685:1712]:      MysqlConfiguration,
-	_ResourceTypeLowerName[1685:1712]: MysqlConfiguration,
-	_ResourceTypeName[1712:1734]:      MysqlDatabase,
-	_ResourceTypeLowerName[1712:1734]: MysqlDatabase,
-	_ResourceTypeName[1734:1761]:      MysqlFirewallRule,
-	_ResourceTypeLowerName[1734:1761]: MysqlFirewallRule,
-	_ResourceTypeName[1761:1781]:      MysqlServer,
-	_ResourceTypeLowerName[1761:1781]: MysqlServer,
-	_ResourceTypeName[1781:1815]:      MysqlVirtualNetworkRule,
-	_ResourceTypeLowerName[1781:1815]: MysqlVirtualNetworkRule,
-	_ResourceTypeName[1815:1847]:      PostgresqlConfiguration,
-	_ResourceTypeLowerName[1815:1847]: PostgresqlConfiguration,
-	_ResourceTypeName[1847:1874]:      PostgresqlDatabase,
-	_ResourceTypeLowerName[1847:1874]: PostgresqlDatabase,
-	_ResourceTypeName[1874:1906]:      PostgresqlFirewallRule,
-	_ResourceTypeLowerName[1874:1906]: PostgresqlFirewallRule,
-	_ResourceTypeName[1906:1931]:      PostgresqlServer,
-	_ResourceTypeLowerName[1906:1931]: PostgresqlServer,
-	_ResourceTypeName[1931:1970]:      PostgresqlVirtualNetworkRule,
-	_ResourceTypeLowerName[1931:1970]: PostgresqlVirtualNetworkRule,
-	_ResourceTypeName[1970:1995]:      MssqlElasticpool,
-	_ResourceTypeLowerName[1970:1995]: MssqlElasticpool,
-	_ResourceTypeName[1995:2017]:      MssqlDatabase,
-	_ResourceTypeLowerName[1995:2017]: MssqlDatabase,
-	_ResourceTypeName[2017:2044]:      MssqlFirewallRule,
-	_ResourceTypeLowerName[2017:2044]: MssqlFirewallRule,
-	_ResourceTypeName[2044:2064]:      MssqlServer,
-	_ResourceTypeLowerName[2044:2064]: MssqlServer,
-	_ResourceTypeName[2064:2106]:      MssqlServerSecurityAlertPolicy,
-	_ResourceTypeLowerName[2064:2106]: MssqlServerSecurityAlertPolicy,
-	_ResourceTypeName[2106:2151]:      MssqlServerVulnerabilityAssessment,
-	_ResourceTypeLowerName[2106:2151]: MssqlServerVulnerabilityAssessment,
-	_ResourceTypeName[2151:2180]:      MssqlVirtualMachine,
-	_ResourceTypeLowerName[2151:2180]: MssqlVirtualMachine,
-	_ResourceTypeName[2180:2214]:      MssqlVirtualNetworkRule,
-	_ResourceTypeLowerName[2180:2214]: MssqlVirtualNetworkRule,
-	_ResourceTypeName[2214:2233]:      RedisCache,
-	_ResourceTypeLowerName[2214:2233]: RedisCache,
-	_ResourceTypeName[2233:2260]:      RedisFirewallRule,
-	_ResourceTypeLowerName[2233:2260]: RedisFirewallRule,
-	_ResourceTypeName[2260:2276]:      DNSZone,
-	_ResourceTypeLowerName[2260:2276]: DNSZone,
-	_ResourceTypeName[2276:2296]:      DNSARecord,
-	_ResourceTypeLowerName[2276:2296]: DNSARecord,
-	_ResourceTypeName[2296:2319]:      DNSAaaaRecord,
-	_ResourceTypeLowerName[2296:2319]: DNSAaaaRecord,
-	_ResourceTypeName[2319:2341]:      DNSCaaRecord,
-	_ResourceTypeLowerName[2319:2341]: DNSCaaRecord,
-	_ResourceTypeName[2341:2365]:      DNSCnameRecord,
-	_ResourceTypeLowerName[2341:2365]: DNSCnameRecord,
-	_ResourceTypeName[2365:2386]:      DNSMxRecord,
-	_ResourceTypeLowerName[2365:2386]: DNSMxRecord,
-	_ResourceTypeName[2386:2407]:      DNSNsRecord,
-	_ResourceTypeLowerName[2386:2407]: DNSNsRecord,
-	_ResourceTypeName[2407:2429]:      DNSPtrRecord,
-	_ResourceTypeLowerName[2407:2429]: DNSPtrRecord,
-	_ResourceTypeName[2429:2451]:      DNSSrvRecord,
-	_ResourceTypeLowerName[2429:2451]: DNSSrvRecord,
-	_ResourceTypeName[2451:2473]:      DNSTxtRecord,
-	_ResourceTypeLowerName[2451:2473]: DNSTxtRecord,
-	_ResourceTypeName[2473:2497]:      PrivateDNSZone,
-	_ResourceTypeLowerName[2473:2497]: PrivateDNSZone,
-	_ResourceTypeName[2497:2525]:      PrivateDNSARecord,
-	_ResourceTypeLowerName[2497:2525]: PrivateDNSARecord,
-	_ResourceTypeName[2525:2556]:      PrivateDNSAaaaRecord,
-	_ResourceTypeLowerName[2525:2556]: PrivateDNSAaaaRecord,
-	_ResourceTypeName[2556:2588]:      PrivateDNSCnameRecord,
-	_ResourceTypeLowerName[2556:2588]: PrivateDNSCnameRecord,
-	_ResourceTypeName[2588:2617]:      PrivateDNSMxRecord,
-	_ResourceTypeLowerName[2588:2617]: PrivateDNSMxRecord,
-	_ResourceTypeName[2617:2647]:      PrivateDNSPtrRecord,
-	_ResourceTypeLowerName[2617:2647]: PrivateDNSPtrRecord,
-	_ResourceTypeName[2647:2677]:      PrivateDNSSrvRecord,
-	_ResourceTypeLowerName[2647:2677]: PrivateDNSSrvRecord,
-	_ResourceTypeName[2677:2707]:      PrivateDNSTxtRecord,
-	_ResourceTypeLowerName[2677:2707]: PrivateDNSTxtRecord,
-	_ResourceTypeName[2707:2752]:      PrivateDNSZoneVirtualNetworkLink,
-	_ResourceTypeLowerName[2707:2752]: PrivateDNSZoneVirtualNetworkLink,
-	_ResourceTypeName[2752:2777]:      PolicyDefinition,
-	_ResourceTypeLowerName[2752:2777]: PolicyDefinition,
-	_ResourceTypeName[2777:2803]:      PolicyRemediation,
-	_ResourceTypeLowerName[2777:2803]: PolicyRemediation,
-	_ResourceTypeName[2803:2832]:      PolicySetDefinition,
-	_ResourceTypeLowerName[2803:2832]: PolicySetDefinition,
-	_ResourceTypeName[2832:2849]:      KeyVault,
-	_ResourceTypeLowerName[2832:2849]: KeyVault,
-	_ResourceTypeName[2849:2880]:      KeyVaultAccessPolicy,
-	_ResourceTypeLowerName[2849:2880]: KeyVaultAccessPolicy,
-	_ResourceTypeName[2880:2908]:      ApplicationInsights,
-	_ResourceTypeLowerName[2880:2908]: ApplicationInsights,
-	_ResourceTypeName[2908:2944]:      ApplicationInsightsAPIKey,
-	_ResourceTypeLowerName[2908:2944]: ApplicationInsightsAPIKey,
-	_ResourceTypeName[2944:2987]:      ApplicationInsightsAnalyticsItem,
-	_ResourceTypeLowerName[2944:2987]: ApplicationInsightsAnalyticsItem,
-	_ResourceTypeName[2987:3018]:      LogAnalyticsWorkspace,
-	_ResourceTypeLowerName[2987:3018]: LogAnalyticsWorkspace,
-	_ResourceTypeName[3018:3054]:      LogAnalyticsLinkedService,
-	_ResourceTypeLowerName[3018:3054]: LogAnalyticsLinkedService,
-	_ResourceTypeName[3054:3114]:      LogAnalyticsDatasourceWindowsPerformanceCounter,
-	_ResourceTypeLowerName[3054:3114]: LogAnalyticsDatasourceWindowsPerformanceCounter,
-	_ResourceTypeName[3114:3160]:      LogAnalyticsDatasourceWindowsEvent,
-	_ResourceTypeLowerName[3114:3160]: LogAnalyticsDatasourceWindowsEvent,
-	_ResourceTypeName[3160:3188]:      MonitorActionGroup,
-	_ResourceTypeLowerName[3160:3188]: MonitorActionGroup,
-	_ResourceTypeName[3188:3222]:      MonitorActivityLogAlert,
-	_ResourceTypeLowerName[3188:3222]: MonitorActivityLogAlert,
-	_ResourceTypeName[3222:3255]:      MonitorAutoscaleSetting,
-	_ResourceTypeLowerName[3222:3255]: MonitorAutoscaleSetting,
-	_ResourceTypeName[3255:3282]:      MonitorLogProfile,
-	_ResourceTypeLowerName[3255:3282]: MonitorLogProfile,
-	_ResourceTypeName[3282:3310]:      MonitorMetricAlert,
-	_ResourceTypeLowerName[3282:3310]: MonitorMetricAlert,
-	_ResourceTypeName[3310:3333]:      WindowsWebApp,
-	_ResourceTypeLowerName[3310:3333]: WindowsWebApp,
-	_ResourceTypeName[3333:3354]:      LinuxWebApp,
-	_ResourceTypeLowerName[3333:3354]: LinuxWebApp,
-	_ResourceTypeName[3354:3380]:      LinuxWebAppSlot,
-	_ResourceTypeLowerName[3354:3380]: LinuxWebAppSlot,
-	_ResourceTypeName[3380:3408]:      WindowsWebAppSlot,
-	_ResourceTypeLowerName[3380:3408]: WindowsWebAppSlot,
-	_ResourceTypeName[3408:3435]:      WebAppActiveSlot,
-	_ResourceTypeLowerName[3408:3435]: WebAppActiveSlot,
-	_ResourceTypeName[3435:3455]:      ServicePlan,
-	_ResourceTypeLowerName[3435:3455]: ServicePlan,
-	_ResourceTypeName[3455:3483]:      SourceControlToken,
-	_ResourceTypeLowerName[3455:3483]: SourceControlToken,
-	_ResourceTypeName[3483:3502]:      StaticSite,
-	_ResourceTypeLowerName[3483:3502]: StaticSite,
-	_ResourceTypeName[3502:3535]:      StaticSiteCustomDomain,
-	_ResourceTypeLowerName[3502:3535]: StaticSiteCustomDomain,
-	_ResourceTypeName[3535:3568]:      WebAppHybridConnection,
-	_ResourceTypeLowerName[3535:3568]: WebAppHybridConnection,
+	_ResourceTypeName[571:595]:        PrivateEndpoint,
+	_ResourceTypeLowerName[571:595]:   PrivateEndpoint,
+	_ResourceTypeName[595:633]:        SubnetNatGatewayAssociation,
+	_ResourceTypeLowerName[595:633]:   SubnetNatGatewayAssociation,
+	_ResourceTypeName[633:682]:        SubnetNetworkSecurityGroupAssociation,
+	_ResourceTypeLowerName[633:682]:   SubnetNetworkSecurityGroupAssociation,
+	_ResourceTypeName[682:720]:        SubnetRouteTableAssociation,
+	_ResourceTypeLowerName[682:720]:   SubnetRouteTableAssociation,
+	_ResourceTypeName[720:743]:        NetworkProfile,
+	_ResourceTypeLowerName[720:743]:   NetworkProfile,
+	_ResourceTypeName[743:772]:        NetworkSecurityRule,
+	_ResourceTypeLowerName[743:772]:   NetworkSecurityRule,
+	_ResourceTypeName[772:789]:        PublicIP,
+	_ResourceTypeLowerName[772:789]:   PublicIP,
+	_ResourceTypeName[789:813]:        PublicIPPrefix,
+	_ResourceTypeLowerName[789:813]:   PublicIPPrefix,
+	_ResourceTypeName[813:826]:        Route,
+	_ResourceTypeLowerName[813:826]:   Route,
+	_ResourceTypeName[826:845]:        RouteTable,
+	_ResourceTypeLowerName[826:845]:   RouteTable,
+	_ResourceTypeName[845:876]:        VirtualNetworkGateway,
+	_ResourceTypeLowerName[845:876]:   VirtualNetworkGateway,
+	_ResourceTypeName[876:918]:        VirtualNetworkGatewayConnection,
+	_ResourceTypeLowerName[876:918]:   VirtualNetworkGatewayConnection,
+	_ResourceTypeName[918:949]:        VirtualNetworkPeering,
+	_ResourceTypeLowerName[918:949]:   VirtualNetworkPeering,
+	_ResourceTypeName[949:988]:        WebApplicationFirewallPolicy,
+	_ResourceTypeLowerName[949:988]:   WebApplicationFirewallPolicy,
+	_ResourceTypeName[988:1007]:       VirtualHub,
+	_ResourceTypeLowerName[988:1007]:  VirtualHub,
+	_ResourceTypeName[1007:1041]:      VirtualHubBgpConnection,
+	_ResourceTypeLowerName[1007:1041]: VirtualHubBgpConnection,
+	_ResourceTypeName[1041:1071]:      VirtualHubConnection,
+	_ResourceTypeLowerName[1041:1071]: VirtualHubConnection,
+	_ResourceTypeName[1071:1093]:      VirtualHubIP,
+	_ResourceTypeLowerName[1071:1093]: VirtualHubIP,
+	_ResourceTypeName[1093:1124]:      VirtualHubRouteTable,
+	_ResourceTypeLowerName[1093:1124]: VirtualHubRouteTable,
+	_ResourceTypeName[1124:1169]:      VirtualHubSecurityPartnerProvider,
+	_ResourceTypeLowerName[1124:1169]: VirtualHubSecurityPartnerProvider,
+	_ResourceTypeName[1169:1189]:      BastionHost,
+	_ResourceTypeLowerName[1169:1189]: BastionHost,
+	_ResourceTypeName[1189:1218]:      ExpressRouteCircuit,
+	_ResourceTypeLowerName[1189:1218]: ExpressRouteCircuit,
+	_ResourceTypeName[1218:1255]:      ExpressRouteCircuitPeering,
+	_ResourceTypeLowerName[1218:1255]: ExpressRouteCircuitPeering,
+	_ResourceTypeName[1255:1274]:      VirtualWan,
+	_ResourceTypeLowerName[1255:1274]: VirtualWan,
+	_ResourceTypeName[1274:1284]:      Lb,
+	_ResourceTypeLowerName[1274:1284]: Lb,
+	_ResourceTypeName[1284:1315]:      LbBackendAddressPool,
+	_ResourceTypeLowerName[1284:1315]: LbBackendAddressPool,
+	_ResourceTypeName[1315:1330]:      LbRule,
+	_ResourceTypeLowerName[1315:1330]: LbRule,
+	_ResourceTypeName[1330:1354]:      LbOutboundRule,
+	_ResourceTypeLowerName[1330:1354]: LbOutboundRule,
+	_ResourceTypeName[1354:1373]:      LbNatRule,
+	_ResourceTypeLowerName[1354:1373]: LbNatRule,
+	_ResourceTypeName[1373:1392]:      LbNatPool,
+	_ResourceTypeLowerName[1373:1392]: LbNatPool,
+	_ResourceTypeName[1392:1408]:      LbProbe,
+	_ResourceTypeLowerName[1392:1408]: LbProbe,
+	_ResourceTypeName[1408:1441]:      VirtualDesktopHostPool,
+	_ResourceTypeLowerName[1408:1441]: VirtualDesktopHostPool,
+	_ResourceTypeName[1441:1482]:      VirtualDesktopApplicationGroup,
+	_ResourceTypeLowerName[1441:1482]: VirtualDesktopApplicationGroup,
+	_ResourceTypeName[1482:1508]:      LogicAppWorkflow,
+	_ResourceTypeLowerName[1482:1508]: LogicAppWorkflow,
+	_ResourceTypeName[1508:1540]:      LogicAppTriggerCustom,
+	_ResourceTypeLowerName[1508:1540]: LogicAppTriggerCustom,
+	_ResourceTypeName[1540:1571]:      LogicAppActionCustom,
+	_ResourceTypeLowerName[1540:1571]: LogicAppActionCustom,
+	_ResourceTypeName[1571:1597]:      ContainerRegistry,
+	_ResourceTypeLowerName[1571:1597]: ContainerRegistry,
+	_ResourceTypeName[1597:1631]:      ContainerRegistryWebhook,
+	_ResourceTypeLowerName[1597:1631]: ContainerRegistryWebhook,
+	_ResourceTypeName[1631:1657]:      KubernetesCluster,
+	_ResourceTypeLowerName[1631:1657]: KubernetesCluster,
+	_ResourceTypeName[1657:1693]:      KubernetesClusterNodePool,
+	_ResourceTypeLowerName[1657:1693]: KubernetesClusterNodePool,
+	_ResourceTypeName[1693:1716]:      StorageAccount,
+	_ResourceTypeLowerName[1693:1716]: StorageAccount,
+	_ResourceTypeName[1716:1737]:      StorageQueue,
+	_ResourceTypeLowerName[1716:1737]: StorageQueue,
+	_ResourceTypeName[1737:1758]:      StorageShare,
+	_ResourceTypeLowerName[1737:1758]: StorageShare,
+	_ResourceTypeName[1758:1779]:      StorageTable,
+	_ResourceTypeLowerName[1758:1779]: StorageTable,
+	_ResourceTypeName[1779:1799]:      StorageBlob,
+	_ResourceTypeLowerName[1779:1799]: StorageBlob,
+	_ResourceTypeName[1799:1828]:      MariadbConfiguration,
+	_ResourceTypeLowerName[1799:1828]: MariadbConfiguration,
+	_ResourceTypeName[1828:1852]:      MariadbDatabase,
+	_ResourceTypeLowerName[1828:1852]: MariadbDatabase,
+	_ResourceTypeName[1852:1881]:      MariadbFirewallRule,
+	_ResourceTypeLowerName[1852:1881]: MariadbFirewallRule,
+	_ResourceTypeName[1881:1903]:      MariadbServer,
+	_ResourceTypeLowerName[1881:1903]: MariadbServer,
+	_ResourceTypeName[1903:1939]:      MariadbVirtualNetworkRule,
+	_ResourceTypeLowerName[1903:1939]: MariadbVirtualNetworkRule,
+	_ResourceTypeName[1939:1966]:      MysqlConfiguration,
+	_ResourceTypeLowerName[1939:1966]: MysqlConfiguration,
+	_ResourceTypeName[1966:1988]:      MysqlDatabase,
+	_ResourceTypeLowerName[1966:1988]: MysqlDatabase,
+	_ResourceTypeName[1988:2015]:      MysqlFirewallRule,
+	_ResourceTypeLowerName[1988:2015]: MysqlFirewallRule,
+	_ResourceTypeName[2015:2035]:      MysqlServer,
+	_ResourceTypeLowerName[2015:2035]: MysqlServer,
+	_ResourceTypeName[2035:2069]:      MysqlVirtualNetworkRule,
+	_ResourceTypeLowerName[2035:2069]: MysqlVirtualNetworkRule,
+	_ResourceTypeName[2069:2101]:      PostgresqlConfiguration,
+	_ResourceTypeLowerName[2069:2101]: PostgresqlConfiguration,
+	_ResourceTypeName[2101:2128]:      PostgresqlDatabase,
+	_ResourceTypeLowerName[2101:2128]: PostgresqlDatabase,
+	_ResourceTypeName[2128:2160]:      PostgresqlFirewallRule,
+	_ResourceTypeLowerName[2128:2160]: PostgresqlFirewallRule,
+	_ResourceTypeName[2160:2185]:      PostgresqlServer,
+	_ResourceTypeLowerName[2160:2185]: PostgresqlServer,
+	_ResourceTypeName[2185:2224]:      PostgresqlVirtualNetworkRule,
+	_ResourceTypeLowerName[2185:2224]: PostgresqlVirtualNetworkRule,
+	_ResourceTypeName[2224:2249]:      MssqlElasticpool,
+	_ResourceTypeLowerName[2224:2249]: MssqlElasticpool,
+	_ResourceTypeName[2249:2271]:      MssqlDatabase,
+	_ResourceTypeLowerName[2249:2271]: MssqlDatabase,
+	_ResourceTypeName[2271:2298]:      MssqlFirewallRule,
+	_ResourceTypeLowerName[2271:2298]: MssqlFirewallRule,
+	_ResourceTypeName[2298:2318]:      MssqlServer,
+	_ResourceTypeLowerName[2298:2318]: MssqlServer,
+	_ResourceTypeName[2318:2360]:      MssqlServerSecurityAlertPolicy,
+	_ResourceTypeLowerName[2318:2360]: MssqlServerSecurityAlertPolicy,
+	_ResourceTypeName[2360:2405]:      MssqlServerVulnerabilityAssessment,
+	_ResourceTypeLowerName[2360:2405]: MssqlServerVulnerabilityAssessment,
+	_ResourceTypeName[2405:2434]:      MssqlVirtualMachine,
+	_ResourceTypeLowerName[2405:2434]: MssqlVirtualMachine,
+	_ResourceTypeName[2434:2468]:      MssqlVirtualNetworkRule,
+	_ResourceTypeLowerName[2434:2468]: MssqlVirtualNetworkRule,
+	_ResourceTypeName[2468:2487]:      RedisCache,
+	_ResourceTypeLowerName[2468:2487]: RedisCache,
+	_ResourceTypeName[2487:2514]:      RedisFirewallRule,
+	_ResourceTypeLowerName[2487:2514]: RedisFirewallRule,
+	_ResourceTypeName[2514:2530]:      DNSZone,
+	_ResourceTypeLowerName[2514:2530]: DNSZone,
+	_ResourceTypeName[2530:2550]:      DNSARecord,
+	_ResourceTypeLowerName[2530:2550]: DNSARecord,
+	_ResourceTypeName[2550:2573]:      DNSAaaaRecord,
+	_ResourceTypeLowerName[2550:2573]: DNSAaaaRecord,
+	_ResourceTypeName[2573:2595]:      DNSCaaRecord,
+	_ResourceTypeLowerName[2573:2595]: DNSCaaRecord,
+	_ResourceTypeName[2595:2619]:      DNSCnameRecord,
+	_ResourceTypeLowerName[2595:2619]: DNSCnameRecord,
+	_ResourceTypeName[2619:2640]:      DNSMxRecord,
+	_ResourceTypeLowerName[2619:2640]: DNSMxRecord,
+	_ResourceTypeName[2640:2661]:      DNSNsRecord,
+	_ResourceTypeLowerName[2640:2661]: DNSNsRecord,
+	_ResourceTypeName[2661:2683]:      DNSPtrRecord,
+	_ResourceTypeLowerName[2661:2683]: DNSPtrRecord,
+	_ResourceTypeName[2683:2705]:      DNSSrvRecord,
+	_ResourceTypeLowerName[2683:2705]: DNSSrvRecord,
+	_ResourceTypeName[2705:2727]:      DNSTxtRecord,
+	_ResourceTypeLowerName[2705:2727]: DNSTxtRecord,
+	_ResourceTypeName[2727:2751]:      PrivateDNSZone,
+	_ResourceTypeLowerName[2727:2751]: PrivateDNSZone,
+	_ResourceTypeName[2751:2779]:      PrivateDNSARecord,
+	_ResourceTypeLowerName[2751:2779]: PrivateDNSARecord,
+	_ResourceTypeName[2779:2810]:      PrivateDNSAaaaRecord,
+	_ResourceTypeLowerName[2779:2810]: PrivateDNSAaaaRecord,
+	_ResourceTypeName[2810:2842]:      PrivateDNSCnameRecord,
+	_ResourceTypeLowerName[2810:2842]: PrivateDNSCnameRecord,
+	_ResourceTypeName[2842:2871]:      PrivateDNSMxRecord,
+	_ResourceTypeLowerName[2842:2871]: PrivateDNSMxRecord,
+	_ResourceTypeName[2871:2901]:      PrivateDNSPtrRecord,
+	_ResourceTypeLowerName[2871:2901]: PrivateDNSPtrRecord,
+	_ResourceTypeName[2901:2931]:      PrivateDNSSrvRecord,
+	_ResourceTypeLowerName[2901:2931]: PrivateDNSSrvRecord,
+	_ResourceTypeName[2931:2961]:      PrivateDNSTxtRecord,
+	_ResourceTypeLowerName[2931:2961]: PrivateDNSTxtRecord,
+	_ResourceTypeName[2961:3006]:      PrivateDNSZoneVirtualNetworkLink,
+	_ResourceTypeLowerName[2961:3006]: PrivateDNSZoneVirtualNetworkLink,
+	_ResourceTypeName[3006:3031]:      PolicyDefinition,
+	_ResourceTypeLowerName[3006:3031]: PolicyDefinition,
+	_ResourceTypeName[3031:3057]:      PolicyRemediation,
+	_ResourceTypeLowerName[3031:3057]: PolicyRemediation,
+	_ResourceTypeName[3057:3086]:      PolicySetDefinition,
+	_ResourceTypeLowerName[3057:3086]: PolicySetDefinition,
+	_ResourceTypeName[3086:3103]:      KeyVault,
+	_ResourceTypeLowerName[3086:3103]: KeyVault,
+	_ResourceTypeName[3103:3134]:      KeyVaultAccessPolicy,
+	_ResourceTypeLowerName[3103:3134]: KeyVaultAccessPolicy,
+	_ResourceTypeName[3134:3158]:      KeyVaultSecret,
+	_ResourceTypeLowerName[3134:3158]: KeyVaultSecret,
+	_ResourceTypeName[3158:3186]:      ApplicationInsights,
+	_ResourceTypeLowerName[3158:3186]: ApplicationInsights,
+	_ResourceTypeName[3186:3222]:      ApplicationInsightsAPIKey,
+	_ResourceTypeLowerName[3186:3222]: ApplicationInsightsAPIKey,
+	_ResourceTypeName[3222:3265]:      ApplicationInsightsAnalyticsItem,
+	_ResourceTypeLowerName[3222:3265]: ApplicationInsightsAnalyticsItem,
+	_ResourceTypeName[3265:3296]:      LogAnalyticsWorkspace,
+	_ResourceTypeLowerName[3265:3296]: LogAnalyticsWorkspace,
+	_ResourceTypeName[3296:3332]:      LogAnalyticsLinkedService,
+	_ResourceTypeLowerName[3296:3332]: LogAnalyticsLinkedService,
+	_ResourceTypeName[3332:3392]:      LogAnalyticsDatasourceWindowsPerformanceCounter,
+	_ResourceTypeLowerName[3332:3392]: LogAnalyticsDatasourceWindowsPerformanceCounter,
+	_ResourceTypeName[3392:3438]:      LogAnalyticsDatasourceWindowsEvent,
+	_ResourceTypeLowerName[3392:3438]: LogAnalyticsDatasourceWindowsEvent,
+	_ResourceTypeName[3438:3466]:      MonitorActionGroup,
+	_ResourceTypeLowerName[3438:3466]: MonitorActionGroup,
+	_ResourceTypeName[3466:3500]:      MonitorActivityLogAlert,
+	_ResourceTypeLowerName[3466:3500]: MonitorActivityLogAlert,
+	_ResourceTypeName[3500:3533]:      MonitorAutoscaleSetting,
+	_ResourceTypeLowerName[3500:3533]: MonitorAutoscaleSetting,
+	_ResourceTypeName[3533:3560]:      MonitorLogProfile,
+	_ResourceTypeLowerName[3533:3560]: MonitorLogProfile,
+	_ResourceTypeName[3560:3588]:      MonitorMetricAlert,
+	_ResourceTypeLowerName[3560:3588]: MonitorMetricAlert,
+	_ResourceTypeName[3588:3611]:      WindowsWebApp,
+	_ResourceTypeLowerName[3588:3611]: WindowsWebApp,
+	_ResourceTypeName[3611:3632]:      LinuxWebApp,
+	_ResourceTypeLowerName[3611:3632]: LinuxWebApp,
+	_ResourceTypeName[3632:3658]:      LinuxFunctionApp,
+	_ResourceTypeLowerName[3632:3658]: LinuxFunctionApp,
+	_ResourceTypeName[3658:3686]:      WindowsFunctionApp,
+	_ResourceTypeLowerName[3658:3686]: WindowsFunctionApp,
+	_ResourceTypeName[3686:3712]:      LinuxWebAppSlot,
+	_ResourceTypeLowerName[3686:3712]: LinuxWebAppSlot,
+	_ResourceTypeName[3712:3740]:      WindowsWebAppSlot,
+	_ResourceTypeLowerName[3712:3740]: WindowsWebAppSlot,
+	_ResourceTypeName[3740:3767]:      WebAppActiveSlot,
+	_ResourceTypeLowerName[3740:3767]: WebAppActiveSlot,
+	_ResourceTypeName[3767:3787]:      ServicePlan,
+	_ResourceTypeLowerName[3767:3787]: ServicePlan,
+	_ResourceTypeName[3787:3815]:      SourceControlToken,
+	_ResourceTypeLowerName[3787:3815]: SourceControlToken,
+	_ResourceTypeName[3815:3834]:      StaticSite,
+	_ResourceTypeLowerName[3815:3834]: StaticSite,
+	_ResourceTypeName[3834:3867]:      StaticSiteCustomDomain,
+	_ResourceTypeLowerName[3834:3867]: StaticSiteCustomDomain,
+	_ResourceTypeName[3867:3900]:      WebAppHybridConnection,
+	_ResourceTypeLowerName[3867:3900]: WebAppHybridConnection,
+	_ResourceTypeName[3900:3921]:      BatchAccount,
+	_ResourceTypeLowerName[3900:3921]: BatchAccount,
+	_ResourceTypeName[3921:3939]:      BatchPool,
+	_ResourceTypeLowerName[3921:3939]: BatchPool,
+	_ResourceTypeName[3939:3971]:      HDInsightHadoopCluster,
+	_ResourceTypeLowerName[3939:3971]: HDInsightHadoopCluster,
+	_ResourceTypeName[3971:4002]:      HDInsightHbaseCluster,
+	_ResourceTypeLowerName[3971:4002]: HDInsightHbaseCluster,
+	_ResourceTypeName[4002:4045]:      HDInsightInteractiveQueryCluster,
+	_ResourceTypeLowerName[4002:4045]: HDInsightInteractiveQueryCluster,
+	_ResourceTypeName[4045:4076]:      HDInsightKafkaCluster,
+	_ResourceTypeLowerName[4045:4076]: HDInsightKafkaCluster,
+	_ResourceTypeName[4076:4107]:      HDInsightSparkCluster,
+	_ResourceTypeLowerName[4076:4107]: HDInsightSparkCluster,
+	_ResourceTypeName[4107:4135]:      DatabricksWorkspace,
+	_ResourceTypeLowerName[4107:4135]: DatabricksWorkspace,
 }
 
 var _ResourceTypeNames = []string{
@@ -440,114 +497,133 @@ var _ResourceTypeNames = []string{
 	_ResourceTypeName[507:523],
 	_ResourceTypeName[523:552],
 	_ResourceTypeName[552:571],
-	_ResourceTypeName[571:594],
-	_ResourceTypeName[594:623],
-	_ResourceTypeName[623:640],
-	_ResourceTypeName[640:664],
-	_ResourceTypeName[664:677],
-	_ResourceTypeName[677:696],
-	_ResourceTypeName[696:727],
-	_ResourceTypeName[727:769],
-	_ResourceTypeName[769:800],
-	_ResourceTypeName[800:839],
-	_ResourceTypeName[839:858],
-	_ResourceTypeName[858:892],
-	_ResourceTypeName[892:922],
-	_ResourceTypeName[922:944],
-	_ResourceTypeName[944:975],
-	_ResourceTypeName[975:1020],
-	_ResourceTypeName[1020:1030],
-	_ResourceTypeName[1030:1061],
-	_ResourceTypeName[1061:1076],
-	_ResourceTypeName[1076:1100],
-	_ResourceTypeName[1100:1119],
-	_ResourceTypeName[1119:1138],
-	_ResourceTypeName[1138:1154],
-	_ResourceTypeName[1154:1187],
-	_ResourceTypeName[1187:1228],
-	_ResourceTypeName[1228:1254],
-	_ResourceTypeName[1254:1286],
-	_ResourceTypeName[1286:1317],
-	_ResourceTypeName[1317:1343],
-	_ResourceTypeName[1343:1377],
-	_ResourceTypeName[1377:1403],
-	_ResourceTypeName[1403:1439],
-	_ResourceTypeName[1439:1462],
-	_ResourceTypeName[1462:1483],
-	_ResourceTypeName[1483:1504],
-	_ResourceTypeName[1504:1525],
-	_ResourceTypeName[1525:1545],
-	_ResourceTypeName[1545:1574],
-	_ResourceTypeName[1574:1598],
-	_ResourceTypeName[1598:1627],
-	_ResourceTypeName[1627:1649],
-	_ResourceTypeName[1649:1685],
-	_ResourceTypeName[1685:1712],
-	_ResourceTypeName[1712:1734],
-	_ResourceTypeName[1734:1761],
-	_ResourceTypeName[1761:1781],
-	_ResourceTypeName[1781:1815],
-	_ResourceTypeName[1815:1847],
-	_ResourceTypeName[1847:1874],
-	_ResourceTypeName[1874:1906],
-	_ResourceTypeName[1906:1931],
-	_ResourceTypeName[1931:1970],
-	_ResourceTypeName[1970:1995],
-	_ResourceTypeName[1995:2017],
-	_ResourceTypeName[2017:2044],
-	_ResourceTypeName[2044:2064],
-	_ResourceTypeName[2064:2106],
-	_ResourceTypeName[2106:2151],
-	_ResourceTypeName[2151:2180],
-	_ResourceTypeName[2180:2214],
-	_ResourceTypeName[2214:2233],
-	_ResourceTypeName[2233:2260],
-	_ResourceTypeName[2260:2276],
-	_ResourceTypeName[2276:2296],
-	_ResourceTypeName[2296:2319],
-	_ResourceTypeName[2319:2341],
-	_ResourceTypeName[2341:2365],
-	_ResourceTypeName[2365:2386],
-	_ResourceTypeName[2386:2407],
-	_ResourceTypeName[2407:2429],
-	_ResourceTypeName[2429:2451],
-	_ResourceTypeName[2451:2473],
-	_ResourceTypeName[2473:2497],
-	_ResourceTypeName[2497:2525],
-	_ResourceTypeName[2525:2556],
-	_ResourceTypeName[2556:2588],
-	_ResourceTypeName[2588:2617],
-	_ResourceTypeName[2617:2647],
-	_ResourceTypeName[2647:2677],
-	_ResourceTypeName[2677:2707],
-	_ResourceTypeName[2707:2752],
-	_ResourceTypeName[2752:2777],
-	_ResourceTypeName[2777:2803],
-	_ResourceTypeName[2803:2832],
-	_ResourceTypeName[2832:2849],
-	_ResourceTypeName[2849:2880],
-	_ResourceTypeName[2880:2908],
-	_ResourceTypeName[2908:2944],
-	_ResourceTypeName[2944:2987],
-	_ResourceTypeName[2987:3018],
-	_ResourceTypeName[3018:3054],
-	_ResourceTypeName[3054:3114],
-	_ResourceTypeName[3114:3160],
-	_ResourceTypeName[3160:3188],
-	_ResourceTypeName[3188:3222],
-	_ResourceTypeName[3222:3255],
-	_ResourceTypeName[3255:3282],
-	_ResourceTypeName[3282:3310],
-	_ResourceTypeName[3310:3333],
-	_ResourceTypeName[3333:3354],
-	_ResourceTypeName[3354:3380],
-	_ResourceTypeName[3380:3408],
-	_ResourceTypeName[3408:3435],
-	_ResourceTypeName[3435:3455],
-	_ResourceTypeName[3455:3483],
-	_ResourceTypeName[3483:3502],
-	_ResourceTypeName[3502:3535],
-	_ResourceTypeName[3535:3568],
+	_ResourceTypeName[571:595],
+	_ResourceTypeName[595:633],
+	_ResourceTypeName[633:682],
+	_ResourceTypeName[682:720],
+	_ResourceTypeName[720:743],
+	_ResourceTypeName[743:772],
+	_ResourceTypeName[772:789],
+	_ResourceTypeName[789:813],
+	_ResourceTypeName[813:826],
+	_ResourceTypeName[826:845],
+	_ResourceTypeName[845:876],
+	_ResourceTypeName[876:918],
+	_ResourceTypeName[918:949],
+	_ResourceTypeName[949:988],
+	_ResourceTypeName[988:1007],
+	_ResourceTypeName[1007:1041],
+	_ResourceTypeName[1041:1071],
+	_ResourceTypeName[1071:1093],
+	_ResourceTypeName[1093:1124],
+	_ResourceTypeName[1124:1169],
+	_ResourceTypeName[1169:1189],
+	_ResourceTypeName[1189:1218],
+	_ResourceTypeName[1218:1255],
+	_ResourceTypeName[1255:1274],
+	_ResourceTypeName[1274:1284],
+	_ResourceTypeName[1284:1315],
+	_ResourceTypeName[1315:1330],
+	_ResourceTypeName[1330:1354],
+	_ResourceTypeName[1354:1373],
+	_ResourceTypeName[1373:1392],
+	_ResourceTypeName[1392:1408],
+	_ResourceTypeName[1408:1441],
+	_ResourceTypeName[1441:1482],
+	_ResourceTypeName[1482:1508],
+	_ResourceTypeName[1508:1540],
+	_ResourceTypeName[1540:1571],
+	_ResourceTypeName[1571:1597],
+	_ResourceTypeName[1597:1631],
+	_ResourceTypeName[1631:1657],
+	_ResourceTypeName[1657:1693],
+	_ResourceTypeName[1693:1716],
+	_ResourceTypeName[1716:1737],
+	_ResourceTypeName[1737:1758],
+	_ResourceTypeName[1758:1779],
+	_ResourceTypeName[1779:1799],
+	_ResourceTypeName[1799:1828],
+	_ResourceTypeName[1828:1852],
+	_ResourceTypeName[1852:1881],
+	_ResourceTypeName[1881:1903],
+	_ResourceTypeName[1903:1939],
+	_ResourceTypeName[1939:1966],
+	_ResourceTypeName[1966:1988],
+	_ResourceTypeName[1988:2015],
+	_ResourceTypeName[2015:2035],
+	_ResourceTypeName[2035:2069],
+	_ResourceTypeName[2069:2101],
+	_ResourceTypeName[2101:2128],
+	_ResourceTypeName[2128:2160],
+	_ResourceTypeName[2160:2185],
+	_ResourceTypeName[2185:2224],
+	_ResourceTypeName[2224:2249],
+	_ResourceTypeName[2249:2271],
+	_ResourceTypeName[2271:2298],
+	_ResourceTypeName[2298:2318],
+	_ResourceTypeName[2318:2360],
+	_ResourceTypeName[2360:2405],
+	_ResourceTypeName[2405:2434],
+	_ResourceTypeName[2434:2468],
+	_ResourceTypeName[2468:2487],
+	_ResourceTypeName[2487:2514],
+	_ResourceTypeName[2514:2530],
+	_ResourceTypeName[2530:2550],
+	_ResourceTypeName[2550:2573],
+	_ResourceTypeName[2573:2595],
+	_ResourceTypeName[2595:2619],
+	_ResourceTypeName[2619:2640],
+	_ResourceTypeName[2640:2661],
+	_ResourceTypeName[2661:2683],
+	_ResourceTypeName[2683:2705],
+	_ResourceTypeName[2705:2727],
+	_ResourceTypeName[2727:2751],
+	_ResourceTypeName[2751:2779],
+	_ResourceTypeName[2779:2810],
+	_ResourceTypeName[2810:2842],
+	_ResourceTypeName[2842:2871],
+	_ResourceTypeName[2871:2901],
+	_ResourceTypeName[2901:2931],
+	_ResourceTypeName[2931:2961],
+	_ResourceTypeName[2961:3006],
+	_ResourceTypeName[3006:3031],
+	_ResourceTypeName[3031:3057],
+	_ResourceTypeName[3057:3086],
+	_ResourceTypeName[3086:3103],
+	_ResourceTypeName[3103:3134],
+	_ResourceTypeName[3134:3158],
+	_ResourceTypeName[3158:3186],
+	_ResourceTypeName[3186:3222],
+	_ResourceTypeName[3222:3265],
+	_ResourceTypeName[3265:3296],
+	_ResourceTypeName[3296:3332],
+	_ResourceTypeName[3332:3392],
+	_ResourceTypeName[3392:3438],
+	_ResourceTypeName[3438:3466],
+	_ResourceTypeName[3466:3500],
+	_ResourceTypeName[3500:3533],
+	_ResourceTypeName[3533:3560],
+	_ResourceTypeName[3560:3588],
+	_ResourceTypeName[3588:3611],
+	_ResourceTypeName[3611:3632],
+	_ResourceTypeName[3632:3658],
+	_ResourceTypeName[3658:3686],
+	_ResourceTypeName[3686:3712],
+	_ResourceTypeName[3712:3740],
+	_ResourceTypeName[3740:3767],
+	_ResourceTypeName[3767:3787],
+	_ResourceTypeName[3787:3815],
+	_ResourceTypeName[3815:3834],
+	_ResourceTypeName[3834:3867],
+	_ResourceTypeName[3867:3900],
+	_ResourceTypeName[3900:3921],
+	_ResourceTypeName[3921:3939],
+	_ResourceTypeName[3939:3971],
+	_ResourceTypeName[3971:4002],
+	_ResourceTypeName[4002:4045],
+	_ResourceTypeName[4045:4076],
+	_ResourceTypeName[4076:4107],
+	_ResourceTypeName[4107:4135],
 }
 
 // ResourceTypeString retrieves an enum value from the enum constants string name.
@@ -0,0 +1,86 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	azureResourcesAPI "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-05-01/resources"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/tag"
+)
+
+// quoteODataString quotes the s to be used as a
+// string of an OData $filter, escaping the quotes by doubling them
+func quoteODataString(s string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
+}
+
+// tagFilter returns the $filter of the ARM APIs of the t,
+// which only supports one tag condition per $filter
+func tagFilter(t tag.Tag) string {
+	return fmt.Sprintf("tagName eq %s and tagValue eq %s", quoteODataString(t.Name), quoteODataString(t.Value))
+}
+
+// ListTaggedResourceIDs returns the IDs, in lower case, of the resources of
+// the Resource Group of the ar tagged with the t, filtered by the ARM APIs
+func (ar *AzureReader) ListTaggedResourceIDs(ctx context.Context, t tag.Tag) (map[string]struct{}, error) {
+	client := azureResourcesAPI.NewClient(ar.config.SubscriptionID)
+	client.Authorizer = ar.authorizer
+
+	output, err := client.ListByResourceGroup(ctx, ar.GetResourceGroupName(), tagFilter(t), "", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list resources.GenericResourceExpanded from Azure APIs")
+	}
+
+	ids := make(map[string]struct{})
+	for output.NotDone() {
+		for _, res := range output.Values() {
+			if res.ID != nil {
+				ids[strings.ToLower(*res.ID)] = struct{}{}
+			}
+		}
+
+		if err := output.NextWithContext(ctx); err != nil {
+			return nil, errors.Wrap(err, "unable to list the next page of resources.GenericResourceExpanded from Azure APIs")
+		}
+	}
+
+	return ids, nil
+}
+
+// filterTaggedResources returns the resources of the ar tagged with the first
+// of the Tags of the filters, listed once per Resource Group with the ARM APIs,
+// so the ones not tagged are not read. Only the resources of the graphResourceTypes
+// are filtered, as the sub resources are not listed by the ARM APIs; the rest of the
+// Tags, and the normalization of them, are checked when reading the resources
+func filterTaggedResources(ctx context.Context, a *azurerm, ar *AzureReader, rt ResourceType, resources []provider.Resource, filters *filter.Filter) ([]provider.Resource, error) {
+	if len(filters.Tags) == 0 {
+		return resources, nil
+	}
+	if _, ok := graphResourceTypes[rt]; !ok {
+		return resources, nil
+	}
+
+	rgn := ar.GetResourceGroupName()
+	ids, ok := a.taggedResourceIDs[rgn]
+	if !ok {
+		var err error
+		ids, err = ar.ListTaggedResourceIDs(ctx, filters.Tags[0])
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list the tagged resources")
+		}
+		a.taggedResourceIDs[rgn] = ids
+	}
+
+	tagged := make([]provider.Resource, 0, len(resources))
+	for _, r := range resources {
+		if _, ok := ids[strings.ToLower(r.ID())]; ok {
+			tagged = append(tagged, r)
+		}
+	}
+	return tagged, nil
+}
@@ -0,0 +1,14 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cycloidio/terracognita/tag"
+)
+
+func TestTagFilter(t *testing.T) {
+	assert.Equal(t, "tagName eq 'env' and tagValue eq 'prod'", tagFilter(tag.Tag{Name: "env", Value: "prod"}))
+	assert.Equal(t, "tagName eq 'owner' and tagValue eq 'o''neil'", tagFilter(tag.Tag{Name: "owner", Value: "o'neil"}))
+}
@@ -0,0 +1,128 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"os"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+// azureLockInfoKey is the metadata key in which
+// Terraform stores the info of the lock
+const azureLockInfoKey = "terraformlockid"
+
+type azurermBackend struct {
+	blob    *storage.Blob
+	leaseID string
+}
+
+func newAzureRM(account, container, key string) (Backend, error) {
+	ak := os.Getenv("ARM_ACCESS_KEY")
+	if ak == "" {
+		return nil, errors.Wrap(errcode.ErrBackendCredentials, "the ARM_ACCESS_KEY is required for the azurerm backend")
+	}
+
+	c, err := storage.NewBasicClient(account, ak)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize the Azure Storage client")
+	}
+
+	return NewAzureRM(c, container, key), nil
+}
+
+// NewAzureRM returns the Backend of the TFState on the blob key of the container,
+// it's locked with a lease of the blob as Terraform does
+func NewAzureRM(c storage.Client, container, key string) Backend {
+	bs := c.GetBlobService()
+	return &azurermBackend{
+		blob: bs.GetContainerReference(container).GetBlobReference(key),
+	}
+}
+
+func (b *azurermBackend) Lock(ctx context.Context, info *statemgr.LockInfo) error {
+	// The lease can only be acquired on an existing
+	// blob, so an empty one is created if needed
+	ok, err := b.blob.Exists()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if err := b.blob.CreateBlockBlob(nil); err != nil {
+			return err
+		}
+	}
+
+	// -1 is an infinite lease, it's released on the Unlock
+	id, err := b.blob.AcquireLease(-1, info.ID, nil)
+	if isAzureStorageError(err, http.StatusConflict) {
+		return errors.Wrapf(errcode.ErrBackendLocked, "with lease on blob %s", b.blob.Name)
+	} else if err != nil {
+		return err
+	}
+	b.leaseID = id
+
+	if err := b.blob.GetMetadata(&storage.GetBlobMetadataOptions{LeaseID: b.leaseID}); err != nil {
+		return err
+	}
+	if b.blob.Metadata == nil {
+		b.blob.Metadata = make(storage.BlobMetadata)
+	}
+	b.blob.Metadata[azureLockInfoKey] = base64.StdEncoding.EncodeToString(info.Marshal())
+	return b.blob.SetMetadata(&storage.SetBlobMetadataOptions{LeaseID: b.leaseID})
+}
+
+func (b *azurermBackend) Read(ctx context.Context) (*statefile.File, error) {
+	rc, err := b.blob.Get(&storage.GetBlobOptions{LeaseID: b.leaseID})
+	if isAzureStorageError(err, http.StatusNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return readStateFile(rc)
+}
+
+func (b *azurermBackend) Write(ctx context.Context, sf *statefile.File) error {
+	var buf bytes.Buffer
+	if err := statefile.Write(sf, &buf); err != nil {
+		return err
+	}
+
+	// The Metadata, with the info of the lock, is
+	// also written so it's kept on the new content
+	b.blob.Properties.ContentType = "application/json"
+	return b.blob.CreateBlockBlobFromReader(&buf, &storage.PutBlobOptions{LeaseID: b.leaseID})
+}
+
+func (b *azurermBackend) Unlock(ctx context.Context) error {
+	if b.leaseID == "" {
+		return nil
+	}
+
+	delete(b.blob.Metadata, azureLockInfoKey)
+	if err := b.blob.SetMetadata(&storage.SetBlobMetadataOptions{LeaseID: b.leaseID}); err != nil {
+		return err
+	}
+
+	if err := b.blob.ReleaseLease(b.leaseID, nil); err != nil {
+		return err
+	}
+	b.leaseID = ""
+	return nil
+}
+
+// isAzureStorageError checks if the err is a
+// storage.AzureStorageServiceError with the code
+func isAzureStorageError(err error, code int) bool {
+	var aerr storage.AzureStorageServiceError
+	return errors.As(err, &aerr) && aerr.StatusCode == code
+}
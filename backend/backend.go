@@ -0,0 +1,146 @@
+package backend
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/state"
+)
+
+const (
+	// SchemeS3 is the AWS S3 backend, locked with a DynamoDB table:
+	// 's3://BUCKET/KEY?region=REGION&dynamodb_table=TABLE&profile=PROFILE'
+	SchemeS3 = "s3"
+
+	// SchemeGCS is the Google Cloud Storage backend:
+	// 'gcs://BUCKET/PREFIX/NAME.tfstate'
+	SchemeGCS = "gcs"
+
+	// SchemeAzureRM is the Azure Storage backend, the access
+	// key is read from the ARM_ACCESS_KEY:
+	// 'azurerm://ACCOUNT/CONTAINER/KEY'
+	SchemeAzureRM = "azurerm"
+
+	// SchemeRemote is the Terraform Cloud/Enterprise backend, the token
+	// is read from the TF_TOKEN_<HOSTNAME> or the TFE_TOKEN:
+	// 'remote://HOSTNAME/ORGANIZATION/WORKSPACE'
+	SchemeRemote = "remote"
+)
+
+// Backend is a remote storage of the TFState
+type Backend interface {
+	// Lock locks the TFState with the info
+	// so no one else can write it
+	Lock(ctx context.Context, info *statemgr.LockInfo) error
+
+	// Read returns the current TFState,
+	// or nil if there is none yet
+	Read(ctx context.Context) (*statefile.File, error)
+
+	// Write writes the sf as the current TFState
+	Write(ctx context.Context, sf *statefile.File) error
+
+	// Unlock releases the lock of the TFState
+	Unlock(ctx context.Context) error
+}
+
+// IsRemote checks if the raw is the URL of a Backend
+func IsRemote(raw string) bool {
+	for _, s := range []string{SchemeS3, SchemeGCS, SchemeAzureRM, SchemeRemote} {
+		if strings.HasPrefix(raw, s+"://") {
+			return true
+		}
+	}
+	return false
+}
+
+// New returns the Backend of the raw URL which has
+// the format of the Scheme* (ex: 's3://bucket/key')
+func New(ctx context.Context, raw string) (Backend, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, errors.Wrapf(errcode.ErrBackendInvalidURL, "with value %q: %s", raw, err)
+	}
+
+	p := strings.TrimPrefix(u.Path, "/")
+	if u.Host == "" || p == "" {
+		return nil, errors.Wrapf(errcode.ErrBackendInvalidURL, "with value %q", raw)
+	}
+
+	switch u.Scheme {
+	case SchemeS3:
+		return newS3(u.Host, p, u.Query())
+	case SchemeGCS:
+		return newGCS(ctx, u.Host, p)
+	case SchemeAzureRM:
+		parts := strings.SplitN(p, "/", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, errors.Wrapf(errcode.ErrBackendInvalidURL, "with value %q", raw)
+		}
+		return newAzureRM(u.Host, parts[0], parts[1])
+	case SchemeRemote:
+		parts := strings.Split(p, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Wrapf(errcode.ErrBackendInvalidURL, "with value %q", raw)
+		}
+		return newRemote(u.Host, parts[0], parts[1])
+	default:
+		return nil, errors.Wrapf(errcode.ErrBackendSchemeNotSupported, "with scheme %q", u.Scheme)
+	}
+}
+
+// Push writes the TFState of r to the b: it acquires the lock,
+// merges the TFState with the current one, so the resources already
+// on it are kept as they are, and releases the lock
+func Push(ctx context.Context, b Backend, r io.Reader) (res state.MergeResult, err error) {
+	info := statemgr.NewLockInfo()
+	info.Operation = "terracognita"
+	if err := b.Lock(ctx, info); err != nil {
+		return res, errors.Wrap(err, "could not acquire the lock of the TFState")
+	}
+	defer func() {
+		if uerr := b.Unlock(ctx); uerr != nil && err == nil {
+			err = errors.Wrapf(uerr, "could not release the lock %s of the TFState", info.ID)
+		}
+	}()
+
+	current, err := b.Read(ctx)
+	if err != nil {
+		return res, errors.Wrap(err, "could not read the current TFState")
+	}
+
+	sf, res, err := state.MergeFile(current, r)
+	if err != nil {
+		return res, err
+	}
+
+	// Nothing new to write
+	if current != nil && len(res.Added) == 0 {
+		return res, nil
+	}
+
+	if err := b.Write(ctx, sf); err != nil {
+		return res, errors.Wrap(err, "could not write the TFState")
+	}
+
+	return res, nil
+}
+
+// readStateFile reads the TFState of r,
+// if it's empty it returns nil
+func readStateFile(r io.Reader) (*statefile.File, error) {
+	sf, err := statefile.Read(r)
+	if errors.Is(err, statefile.ErrNoState) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "invalid TFState")
+	}
+	return sf, nil
+}
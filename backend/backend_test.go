@@ -0,0 +1,139 @@
+package backend_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/backend"
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+// memBackend is an in memory backend.Backend
+type memBackend struct {
+	locked bool
+	calls  []string
+	sf     *statefile.File
+}
+
+func (m *memBackend) Lock(ctx context.Context, info *statemgr.LockInfo) error {
+	m.calls = append(m.calls, "lock")
+	if m.locked {
+		return errcode.ErrBackendLocked
+	}
+	m.locked = true
+	return nil
+}
+
+func (m *memBackend) Read(ctx context.Context) (*statefile.File, error) {
+	m.calls = append(m.calls, "read")
+	return m.sf, nil
+}
+
+func (m *memBackend) Write(ctx context.Context, sf *statefile.File) error {
+	m.calls = append(m.calls, "write")
+	m.sf = sf
+	return nil
+}
+
+func (m *memBackend) Unlock(ctx context.Context) error {
+	m.calls = append(m.calls, "unlock")
+	m.locked = false
+	return nil
+}
+
+func newStateFile(serial uint64, addr, id string) *statefile.File {
+	ra, diags := addrs.ParseAbsResourceInstanceStr(addr)
+	if diags.HasErrors() {
+		panic(diags.Err())
+	}
+	s := states.NewState()
+	s.EnsureModule(addrs.RootModuleInstance).SetResourceInstanceCurrent(ra.Resource, &states.ResourceInstanceObjectSrc{
+		AttrsJSON: []byte(`{"id":"` + id + `"}`),
+		Status:    states.ObjectReady,
+	}, addrs.AbsProviderConfig{
+		Module:   addrs.RootModule,
+		Provider: addrs.NewDefaultProvider("aws"),
+	})
+
+	f := statemgr.NewStateFile()
+	f.Serial = serial
+	f.State = s
+	return f
+}
+
+func stateFileReader(t *testing.T, sf *statefile.File) *bytes.Buffer {
+	var b bytes.Buffer
+	require.NoError(t, statefile.Write(sf, &b))
+	return &b
+}
+
+func TestNew(t *testing.T) {
+	t.Run("ErrBackendInvalidURL", func(t *testing.T) {
+		for _, s := range []string{"s3://bucket", "s3:///key", "azurerm://account/container", "remote://app.terraform.io/org"} {
+			_, err := backend.New(context.Background(), s)
+			assert.Equal(t, errcode.ErrBackendInvalidURL, errors.Cause(err), s)
+		}
+	})
+	t.Run("ErrBackendSchemeNotSupported", func(t *testing.T) {
+		_, err := backend.New(context.Background(), "consul://host/path")
+		assert.Equal(t, errcode.ErrBackendSchemeNotSupported, errors.Cause(err))
+	})
+}
+
+func TestIsRemote(t *testing.T) {
+	assert.True(t, backend.IsRemote("s3://bucket/key"))
+	assert.True(t, backend.IsRemote("remote://app.terraform.io/org/ws"))
+	assert.False(t, backend.IsRemote("terraform.tfstate"))
+	assert.False(t, backend.IsRemote("/tmp/s3://terraform.tfstate"))
+}
+
+func TestPush(t *testing.T) {
+	t.Run("NoState", func(t *testing.T) {
+		b := &memBackend{}
+
+		res, err := backend.Push(context.Background(), b, stateFileReader(t, newStateFile(1, "aws_vpc.main", "vpc-1")))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"aws_vpc.main"}, res.Added)
+		assert.Equal(t, []string{"lock", "read", "write", "unlock"}, b.calls)
+		assert.False(t, b.locked)
+	})
+	t.Run("Merge", func(t *testing.T) {
+		current := newStateFile(4, "aws_vpc.main", "vpc-1")
+		b := &memBackend{sf: current}
+
+		res, err := backend.Push(context.Background(), b, stateFileReader(t, newStateFile(1, "aws_subnet.private", "subnet-1")))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"aws_subnet.private"}, res.Added)
+		assert.Equal(t, uint64(5), b.sf.Serial)
+		assert.Equal(t, current.Lineage, b.sf.Lineage)
+		assert.Len(t, b.sf.State.RootModule().Resources, 2)
+	})
+	t.Run("NothingNew", func(t *testing.T) {
+		b := &memBackend{sf: newStateFile(4, "aws_vpc.main", "vpc-1")}
+
+		res, err := backend.Push(context.Background(), b, stateFileReader(t, newStateFile(1, "aws_vpc.imported", "vpc-1")))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"aws_vpc.imported"}, res.Skipped)
+		assert.Equal(t, []string{"lock", "read", "unlock"}, b.calls)
+	})
+	t.Run("ErrBackendLocked", func(t *testing.T) {
+		b := &memBackend{locked: true}
+
+		_, err := backend.Push(context.Background(), b, stateFileReader(t, newStateFile(1, "aws_vpc.main", "vpc-1")))
+		assert.Equal(t, errcode.ErrBackendLocked, errors.Cause(err))
+		assert.Equal(t, []string{"lock"}, b.calls)
+		assert.True(t, b.locked)
+	})
+}
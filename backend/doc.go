@@ -0,0 +1,4 @@
+// Package backend writes the generated TFState to the remote
+// backends of Terraform, with the same locking, so it does
+// not have to be pushed manually
+package backend
@@ -0,0 +1,97 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/storage/v1"
+
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+type gcsBackend struct {
+	objects *storage.ObjectsService
+
+	bucket string
+	name   string
+}
+
+func newGCS(ctx context.Context, bucket, name string) (Backend, error) {
+	svc, err := storage.NewService(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize the GCS client")
+	}
+
+	return NewGCS(svc, bucket, name), nil
+}
+
+// NewGCS returns the Backend of the TFState on the object name of the bucket, it's
+// locked with the '.tflock' object next to it as Terraform does
+func NewGCS(svc *storage.Service, bucket, name string) Backend {
+	return &gcsBackend{
+		objects: svc.Objects,
+		bucket:  bucket,
+		name:    name,
+	}
+}
+
+// lockName is the name of the object of the lock
+func (b *gcsBackend) lockName() string {
+	return strings.TrimSuffix(b.name, ".tfstate") + ".tflock"
+}
+
+func (b *gcsBackend) Lock(ctx context.Context, info *statemgr.LockInfo) error {
+	// The generation 0 means that it
+	// can only be created if it does not exist
+	_, err := b.objects.Insert(b.bucket, &storage.Object{Name: b.lockName(), ContentType: "application/json"}).
+		Media(bytes.NewReader(info.Marshal())).
+		IfGenerationMatch(0).
+		Context(ctx).
+		Do()
+	if isGoogleAPIError(err, http.StatusPreconditionFailed) {
+		return errors.Wrapf(errcode.ErrBackendLocked, "with lock gs://%s/%s", b.bucket, b.lockName())
+	}
+	return err
+}
+
+func (b *gcsBackend) Read(ctx context.Context) (*statefile.File, error) {
+	res, err := b.objects.Get(b.bucket, b.name).Context(ctx).Download()
+	if isGoogleAPIError(err, http.StatusNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	return readStateFile(res.Body)
+}
+
+func (b *gcsBackend) Write(ctx context.Context, sf *statefile.File) error {
+	var buf bytes.Buffer
+	if err := statefile.Write(sf, &buf); err != nil {
+		return err
+	}
+
+	_, err := b.objects.Insert(b.bucket, &storage.Object{Name: b.name, ContentType: "application/json"}).
+		Media(&buf).
+		Context(ctx).
+		Do()
+	return err
+}
+
+func (b *gcsBackend) Unlock(ctx context.Context) error {
+	return b.objects.Delete(b.bucket, b.lockName()).Context(ctx).Do()
+}
+
+// isGoogleAPIError checks if the err is a
+// googleapi.Error with the code
+func isGoogleAPIError(err error, code int) bool {
+	var gerr *googleapi.Error
+	return errors.As(err, &gerr) && gerr.Code == code
+}
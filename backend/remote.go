@@ -0,0 +1,190 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+// remoteContentType is the content type of the API
+const remoteContentType = "application/vnd.api+json"
+
+type remoteBackend struct {
+	client *http.Client
+
+	address      string
+	token        string
+	organization string
+	workspace    string
+
+	// workspaceID is set on the Lock
+	workspaceID string
+}
+
+func newRemote(hostname, organization, workspace string) (Backend, error) {
+	// The same env variables than the Terraform CLI,
+	// the dots and dashes of the hostname are replaced
+	env := strings.NewReplacer("-", "__", ".", "_").Replace(hostname)
+	token := os.Getenv(fmt.Sprintf("TF_TOKEN_%s", env))
+	if token == "" {
+		token = os.Getenv("TFE_TOKEN")
+	}
+	if token == "" {
+		return nil, errors.Wrapf(errcode.ErrBackendCredentials, "the TF_TOKEN_%s or the TFE_TOKEN is required for the remote backend", env)
+	}
+
+	return NewRemote(http.DefaultClient, fmt.Sprintf("https://%s", hostname), token, organization, workspace), nil
+}
+
+// NewRemote returns the Backend of the TFState of the workspace of the organization
+// on the Terraform Cloud/Enterprise on the address, it's locked with the lock of the
+// workspace. The token has to be able to lock and to write the TFState of the workspace
+func NewRemote(c *http.Client, address, token, organization, workspace string) Backend {
+	return &remoteBackend{
+		client:       c,
+		address:      strings.TrimSuffix(address, "/"),
+		token:        token,
+		organization: organization,
+		workspace:    workspace,
+	}
+}
+
+// remoteData is the format of the
+// documents of the API
+type remoteData struct {
+	Data struct {
+		ID         string                 `json:"id,omitempty"`
+		Type       string                 `json:"type,omitempty"`
+		Attributes map[string]interface{} `json:"attributes,omitempty"`
+	} `json:"data"`
+}
+
+// do makes the request with the method to the path, with the body
+// (if any) as JSON, and decodes the response on out (if any). It
+// returns the status code of the response with the error
+func (b *remoteBackend) do(ctx context.Context, method, path string, body, out interface{}) (int, error) {
+	var rb io.Reader
+	if body != nil {
+		bb, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		rb = bytes.NewReader(bb)
+	}
+
+	u := path
+	if !strings.HasPrefix(path, "http") {
+		u = fmt.Sprintf("%s/api/v2/%s", b.address, path)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, rb)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", b.token))
+	req.Header.Set("Content-Type", remoteContentType)
+
+	res, err := b.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(res.Body)
+		return res.StatusCode, fmt.Errorf("%s %s: %s %s", method, u, res.Status, msg)
+	}
+
+	if out == nil {
+		return res.StatusCode, nil
+	}
+
+	if w, ok := out.(io.Writer); ok {
+		_, err = io.Copy(w, res.Body)
+		return res.StatusCode, err
+	}
+
+	return res.StatusCode, json.NewDecoder(res.Body).Decode(out)
+}
+
+func (b *remoteBackend) Lock(ctx context.Context, info *statemgr.LockInfo) error {
+	var ws remoteData
+	_, err := b.do(ctx, http.MethodGet, fmt.Sprintf("organizations/%s/workspaces/%s", url.PathEscape(b.organization), url.PathEscape(b.workspace)), nil, &ws)
+	if err != nil {
+		return errors.Wrapf(err, "could not read the workspace %s/%s", b.organization, b.workspace)
+	}
+	b.workspaceID = ws.Data.ID
+
+	code, err := b.do(ctx, http.MethodPost, fmt.Sprintf("workspaces/%s/actions/lock", b.workspaceID), map[string]string{"reason": fmt.Sprintf("Locked by %s (%s)", info.Who, info.ID)}, nil)
+	if code == http.StatusConflict {
+		return errors.Wrapf(errcode.ErrBackendLocked, "with workspace %s/%s", b.organization, b.workspace)
+	}
+	return err
+}
+
+func (b *remoteBackend) Read(ctx context.Context) (*statefile.File, error) {
+	var sv remoteData
+	code, err := b.do(ctx, http.MethodGet, fmt.Sprintf("workspaces/%s/current-state-version", b.workspaceID), nil, &sv)
+	if code == http.StatusNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	u, _ := sv.Data.Attributes["hosted-state-download-url"].(string)
+	if u == "" {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	if _, err := b.do(ctx, http.MethodGet, u, nil, &buf); err != nil {
+		return nil, err
+	}
+
+	return readStateFile(&buf)
+}
+
+func (b *remoteBackend) Write(ctx context.Context, sf *statefile.File) error {
+	var buf bytes.Buffer
+	if err := statefile.Write(sf, &buf); err != nil {
+		return err
+	}
+
+	sum := md5.Sum(buf.Bytes())
+	var sv remoteData
+	sv.Data.Type = "state-versions"
+	sv.Data.Attributes = map[string]interface{}{
+		"serial":  sf.Serial,
+		"md5":     hex.EncodeToString(sum[:]),
+		"lineage": sf.Lineage,
+		"state":   base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}
+
+	_, err := b.do(ctx, http.MethodPost, fmt.Sprintf("workspaces/%s/state-versions", b.workspaceID), sv, nil)
+	return err
+}
+
+func (b *remoteBackend) Unlock(ctx context.Context) error {
+	if b.workspaceID == "" {
+		return nil
+	}
+
+	_, err := b.do(ctx, http.MethodPost, fmt.Sprintf("workspaces/%s/actions/unlock", b.workspaceID), nil, nil)
+	return err
+}
@@ -0,0 +1,91 @@
+package backend_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/backend"
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+func TestRemote(t *testing.T) {
+	var (
+		locked  bool
+		written map[string]interface{}
+		calls   []string
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/organizations/org/workspaces/ws", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"data":{"id":"ws-1"}}`)
+	})
+	mux.HandleFunc("/api/v2/workspaces/ws-1/actions/lock", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "lock")
+		if locked {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		locked = true
+		fmt.Fprint(w, `{"data":{"id":"ws-1"}}`)
+	})
+	mux.HandleFunc("/api/v2/workspaces/ws-1/actions/unlock", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "unlock")
+		locked = false
+		fmt.Fprint(w, `{"data":{"id":"ws-1"}}`)
+	})
+	mux.HandleFunc("/api/v2/workspaces/ws-1/current-state-version", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "read")
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/api/v2/workspaces/ws-1/state-versions", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "write")
+		var body struct {
+			Data struct {
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"data"`
+		}
+		b, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, &body))
+		written = body.Data.Attributes
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"data":{"id":"sv-1"}}`)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	b := backend.NewRemote(srv.Client(), srv.URL, "token", "org", "ws")
+
+	sf := newStateFile(1, "aws_vpc.main", "vpc-1")
+	res, err := backend.Push(context.Background(), b, stateFileReader(t, sf))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"aws_vpc.main"}, res.Added)
+	assert.Equal(t, []string{"lock", "read", "write", "unlock"}, calls)
+	assert.False(t, locked)
+	assert.Equal(t, float64(1), written["serial"])
+	assert.Equal(t, sf.Lineage, written["lineage"])
+
+	st, err := base64.StdEncoding.DecodeString(written["state"].(string))
+	require.NoError(t, err)
+	assert.Contains(t, string(st), `"vpc-1"`)
+
+	t.Run("ErrBackendLocked", func(t *testing.T) {
+		locked = true
+		err := b.Lock(context.Background(), statemgr.NewLockInfo())
+		assert.Equal(t, errcode.ErrBackendLocked, errors.Cause(err))
+	})
+}
@@ -0,0 +1,177 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+type s3Backend struct {
+	s3       s3iface.S3API
+	dynamodb dynamodbiface.DynamoDBAPI
+
+	bucket  string
+	key     string
+	table   string
+	encrypt bool
+
+	// lockInfoID is the ID of the LockInfo
+	// stored on the Info of the lock
+	lockInfoID string
+}
+
+func newS3(bucket, key string, q url.Values) (Backend, error) {
+	cfg := aws.NewConfig()
+	if r := q.Get("region"); r != "" {
+		cfg = cfg.WithRegion(r)
+	}
+
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *cfg,
+		Profile:           q.Get("profile"),
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize the S3 session")
+	}
+
+	return NewS3(s3.New(sess), dynamodb.New(sess), bucket, key, q.Get("dynamodb_table"), q.Get("encrypt") == "true"), nil
+}
+
+// NewS3 returns the Backend of the TFState on the key of the bucket, if the table
+// is not empty it's the DynamoDB table used to lock it and to store the digest
+// of the TFState as Terraform does. If encrypt the TFState is encrypted on S3
+func NewS3(s3c s3iface.S3API, dc dynamodbiface.DynamoDBAPI, bucket, key, table string, encrypt bool) Backend {
+	return &s3Backend{
+		s3:       s3c,
+		dynamodb: dc,
+		bucket:   bucket,
+		key:      key,
+		table:    table,
+		encrypt:  encrypt,
+	}
+}
+
+// lockID is the ID of the item of the
+// lock on the DynamoDB table
+func (b *s3Backend) lockID() string {
+	return fmt.Sprintf("%s/%s", b.bucket, b.key)
+}
+
+func (b *s3Backend) Lock(ctx context.Context, info *statemgr.LockInfo) error {
+	if b.table == "" {
+		return nil
+	}
+
+	_, err := b.dynamodb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(b.table),
+		Item: map[string]*dynamodb.AttributeValue{
+			"LockID": {S: aws.String(b.lockID())},
+			"Info":   {S: aws.String(string(info.Marshal()))},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(LockID)"),
+	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+		return errors.Wrapf(errcode.ErrBackendLocked, "with lock %s on table %s", b.lockID(), b.table)
+	} else if err != nil {
+		return err
+	}
+
+	b.lockInfoID = info.ID
+	return nil
+}
+
+func (b *s3Backend) Read(ctx context.Context) (*statefile.File, error) {
+	out, err := b.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.key),
+	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer out.Body.Close()
+
+	return readStateFile(out.Body)
+}
+
+func (b *s3Backend) Write(ctx context.Context, sf *statefile.File) error {
+	var buf bytes.Buffer
+	if err := statefile.Write(sf, &buf); err != nil {
+		return err
+	}
+
+	in := &s3.PutObjectInput{
+		Bucket:      aws.String(b.bucket),
+		Key:         aws.String(b.key),
+		Body:        bytes.NewReader(buf.Bytes()),
+		ContentType: aws.String("application/json"),
+	}
+	if b.encrypt {
+		in.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAes256)
+	}
+	if _, err := b.s3.PutObjectWithContext(ctx, in); err != nil {
+		return err
+	}
+
+	if b.table == "" {
+		return nil
+	}
+
+	// Terraform checks the digest of
+	// the TFState when reading it
+	sum := md5.Sum(buf.Bytes())
+	_, err := b.dynamodb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(b.table),
+		Item: map[string]*dynamodb.AttributeValue{
+			"LockID": {S: aws.String(b.lockID() + "-md5")},
+			"Digest": {S: aws.String(hex.EncodeToString(sum[:]))},
+		},
+	})
+	return err
+}
+
+func (b *s3Backend) Unlock(ctx context.Context) error {
+	if b.table == "" || b.lockInfoID == "" {
+		return nil
+	}
+
+	// The lock is only deleted if it's still the one
+	// of the Lock, as Terraform does, so a lock taken
+	// by someone else after it was force unlocked is kept
+	_, err := b.dynamodb.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(b.table),
+		Key: map[string]*dynamodb.AttributeValue{
+			"LockID": {S: aws.String(b.lockID())},
+		},
+		ConditionExpression: aws.String("contains(Info, :id)"),
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":id": {S: aws.String(b.lockInfoID)},
+		},
+	})
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+		return errors.Wrapf(errcode.ErrBackendLocked, "with lock %s on table %s by other than %s", b.lockID(), b.table, b.lockInfoID)
+	} else if err != nil {
+		return err
+	}
+
+	b.lockInfoID = ""
+	return nil
+}
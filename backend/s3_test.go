@@ -0,0 +1,75 @@
+package backend_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/backend"
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+// dynamoDB is a DynamoDB table in memory with
+// the conditions used by the lock of the S3
+type dynamoDB struct {
+	dynamodbiface.DynamoDBAPI
+
+	items map[string]map[string]*dynamodb.AttributeValue
+}
+
+func (d *dynamoDB) PutItemWithContext(ctx aws.Context, in *dynamodb.PutItemInput, opts ...request.Option) (*dynamodb.PutItemOutput, error) {
+	id := aws.StringValue(in.Item["LockID"].S)
+	if _, ok := d.items[id]; ok && aws.StringValue(in.ConditionExpression) == "attribute_not_exists(LockID)" {
+		return nil, awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "", nil)
+	}
+	d.items[id] = in.Item
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (d *dynamoDB) DeleteItemWithContext(ctx aws.Context, in *dynamodb.DeleteItemInput, opts ...request.Option) (*dynamodb.DeleteItemOutput, error) {
+	id := aws.StringValue(in.Key["LockID"].S)
+	if aws.StringValue(in.ConditionExpression) == "contains(Info, :id)" &&
+		!strings.Contains(aws.StringValue(d.items[id]["Info"].S), aws.StringValue(in.ExpressionAttributeValues[":id"].S)) {
+		return nil, awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "", nil)
+	}
+	delete(d.items, id)
+	return &dynamodb.DeleteItemOutput{}, nil
+}
+
+func TestS3_Unlock(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db := &dynamoDB{items: make(map[string]map[string]*dynamodb.AttributeValue)}
+		b := backend.NewS3(nil, db, "bucket", "key", "table", false)
+
+		require.NoError(t, b.Lock(context.Background(), statemgr.NewLockInfo()))
+		assert.Contains(t, db.items, "bucket/key")
+
+		require.NoError(t, b.Unlock(context.Background()))
+		assert.NotContains(t, db.items, "bucket/key")
+	})
+	t.Run("ErrorLockedByOther", func(t *testing.T) {
+		db := &dynamoDB{items: make(map[string]map[string]*dynamodb.AttributeValue)}
+		b := backend.NewS3(nil, db, "bucket", "key", "table", false)
+
+		require.NoError(t, b.Lock(context.Background(), statemgr.NewLockInfo()))
+
+		// The lock is forced to be released
+		// and taken again by someone else
+		delete(db.items, "bucket/key")
+		require.NoError(t, backend.NewS3(nil, db, "bucket", "key", "table", false).Lock(context.Background(), statemgr.NewLockInfo()))
+
+		err := b.Unlock(context.Background())
+		assert.Equal(t, errcode.ErrBackendLocked, errors.Cause(err))
+		assert.Contains(t, db.items, "bucket/key")
+	})
+}
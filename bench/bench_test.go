@@ -0,0 +1,86 @@
+package bench_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/cycloidio/mxwriter"
+	"github.com/golang/mock/gomock"
+	aws "github.com/hashicorp/terraform-provider-aws/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/bench"
+	"github.com/cycloidio/terracognita/hcl"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+// sizes are the number of
+// resources of each benchmark
+var sizes = []int{1000, 10000, 100000}
+
+func TestFixture_Replay(t *testing.T) {
+	f, err := bench.LoadFixture("testdata/aws.json")
+	require.NoError(t, err)
+
+	resources, interpolation := f.Replay(7)
+	require.Len(t, resources, 7)
+	assert.Equal(t, "aws_vpc.front_0", resources[0].Key)
+	assert.Equal(t, "aws_vpc.front_1", resources[5].Key)
+	assert.Equal(t, "vpc-0a1b2c3d4e5f60718-1", resources[6].Value["vpc_id"])
+	assert.Equal(t, "${aws_subnet.front_a_1.id}", interpolation["subnet-0f1e2d3c4b5a69788-1"])
+	assert.Len(t, interpolation, 2*len(f.Interpolation))
+}
+
+func BenchmarkHCLWriter(b *testing.B) {
+	f, err := bench.LoadFixture("testdata/aws.json")
+	require.NoError(b, err)
+
+	tfp := aws.Provider()
+	for _, n := range sizes {
+		b.Run(fmt.Sprintf("%d", n), func(b *testing.B) {
+			var (
+				ctrl = gomock.NewController(b)
+				p    = mock.NewProvider(ctrl)
+			)
+			p.EXPECT().String().Return("aws").AnyTimes()
+			p.EXPECT().Source().Return("hashicorp/aws").AnyTimes()
+			p.EXPECT().TFProvider().Return(tfp).AnyTimes()
+			p.EXPECT().Configuration().Return(map[string]interface{}{"region": "eu-west-1"}).AnyTimes()
+
+			resources, interpolation := f.Replay(n)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				mx := mxwriter.NewMux()
+				hw := hcl.NewWriter(mx, p, &writer.Options{Interpolate: true, HCLProviderBlock: true})
+				for _, r := range resources {
+					// The writers keep the values so
+					// each iteration needs a copy
+					if err := hw.Write(r.Key, copyValue(r.Value)); err != nil {
+						b.Fatal(err)
+					}
+				}
+				hw.Interpolate(interpolation)
+				if err := hw.Sync(); err != nil {
+					b.Fatal(err)
+				}
+				if _, err := ioutil.ReadAll(mx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// copyValue returns a shallow copy of v
+func copyValue(v map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(v))
+	for k, e := range v {
+		c[k] = e
+	}
+	return c
+}
@@ -0,0 +1,6 @@
+// Package bench provides the fixtures used to benchmark the mapping
+// and the writers of the imported resources with different sizes of
+// imports, so the performance regressions are caught before releasing.
+//
+// The benchmarks are run with 'make bench'
+package bench
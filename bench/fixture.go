@@ -0,0 +1,108 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Fixture is a recorded import, the configuration of the resources
+// and the interpolation of the values referencing them
+type Fixture struct {
+	Resources     []Resource        `json:"resources"`
+	Interpolation map[string]string `json:"interpolation"`
+}
+
+// Resource is the configuration of a resource
+// with the key 'TYPE.NAME' used by the writers
+type Resource struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+// LoadFixture reads the Fixture from the JSON file fp
+func LoadFixture(fp string) (*Fixture, error) {
+	b, err := ioutil.ReadFile(fp)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read the fixture %s", fp)
+	}
+
+	var f Fixture
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, errors.Wrapf(err, "could not decode the fixture %s", fp)
+	}
+
+	if len(f.Resources) == 0 {
+		return nil, errors.Errorf("the fixture %s has no resources", fp)
+	}
+
+	return &f, nil
+}
+
+// Replay returns n resources, and the interpolation of them, by copying
+// the resources of the Fixture. Each copy has the names and the values
+// of the interpolation suffixed with the number of it, so the copies
+// only reference the resources of the same copy
+func (f *Fixture) Replay(n int) ([]Resource, map[string]string) {
+	resources := make([]Resource, 0, n)
+	interpolation := make(map[string]string, (n/len(f.Resources)+1)*len(f.Interpolation))
+	for c := 0; len(resources) < n; c++ {
+		values := make(map[string]string, len(f.Interpolation))
+		for v, ref := range f.Interpolation {
+			nv := fmt.Sprintf("%s-%d", v, c)
+			values[v] = nv
+			interpolation[nv] = replayReference(ref, c)
+		}
+
+		for _, r := range f.Resources {
+			if len(resources) == n {
+				break
+			}
+			resources = append(resources, Resource{
+				Key:   fmt.Sprintf("%s_%d", r.Key, c),
+				Value: replayValue(r.Value, values).(map[string]interface{}),
+			})
+		}
+	}
+
+	return resources, interpolation
+}
+
+// replayReference returns the ref '${TYPE.NAME.ATTR}'
+// with the NAME of the copy c
+func replayReference(ref string, c int) string {
+	parts := strings.SplitN(strings.TrimSuffix(strings.TrimPrefix(ref, "${"), "}"), ".", 3)
+	if len(parts) != 3 {
+		return ref
+	}
+	return fmt.Sprintf("${%s.%s_%d.%s}", parts[0], parts[1], c, parts[2])
+}
+
+// replayValue returns a deep copy of the v with the
+// values replaced with the ones of the copy
+func replayValue(v interface{}, values map[string]string) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(vv))
+		for k, e := range vv {
+			m[k] = replayValue(e, values)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, 0, len(vv))
+		for _, e := range vv {
+			s = append(s, replayValue(e, values))
+		}
+		return s
+	case string:
+		if nv, ok := values[vv]; ok {
+			return nv
+		}
+		return vv
+	default:
+		return vv
+	}
+}
@@ -0,0 +1,3 @@
+// Package cdktf has all abstracted logic related
+// to the CDK for Terraform (CDKTF) output
+package cdktf
@@ -0,0 +1,286 @@
+package cdktf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/cycloidio/mxwriter"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/cycloidio/terracognita/hcl"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+const (
+	// Category is the category in which the
+	// TypeScript is written, 'main.ts'
+	Category = "main"
+
+	// stackName is the name of the
+	// TerraformStack on the App
+	stackName = "terracognita"
+
+	indent = "  "
+)
+
+// Writer is a Writer implementation that writes the Resources
+// as CDKTF TypeScript constructs using the prebuilt Providers
+// (@cdktf/provider-NAME). The Resources are stored and interpolated
+// as on the HCL so the references are kept as '${aws_vpc.main.id}',
+// which CDKTF keeps as they are as the construct IDs are the names
+type Writer struct {
+	*hcl.Writer
+
+	writer   io.Writer
+	provider provider.Provider
+}
+
+// NewWriter returns a Writer initialization
+func NewWriter(w io.Writer, pv provider.Provider, opts *writer.Options) *Writer {
+	return &Writer{
+		// The HCL is never synced, it's only
+		// used to keep the Config
+		Writer:   hcl.NewWriter(nil, pv, opts),
+		writer:   w,
+		provider: pv,
+	}
+}
+
+// resource is a Resource from the hcl.Writer Config
+type resource struct {
+	rt   string
+	name string
+	cfg  map[string]interface{}
+}
+
+// Sync writes the TypeScript of the Config to the
+// internal w on the Category
+func (w *Writer) Sync() error {
+	resources := w.resources()
+
+	pn := w.provider.String()
+	pkg := fmt.Sprintf("@cdktf/provider-%s", pn)
+	providerClass := fmt.Sprintf("%sProvider", className(pn))
+
+	// The classes are imported once sorted by the
+	// resource type so the output is always the same
+	classes := make(map[string]string)
+	rts := make([]string, 0)
+	for _, r := range resources {
+		if _, ok := classes[r.rt]; ok {
+			continue
+		}
+		classes[r.rt] = className(strings.TrimPrefix(r.rt, pn+"_"))
+		rts = append(rts, r.rt)
+	}
+
+	b := &bytes.Buffer{}
+	fmt.Fprintln(b, `import { Construct } from "constructs";`)
+	fmt.Fprintln(b, `import { App, TerraformStack } from "cdktf";`)
+	fmt.Fprintf(b, "import { %s } from %q;\n", providerClass, pkg+"/lib/provider")
+	for _, rt := range rts {
+		fmt.Fprintf(b, "import { %s } from %q;\n", classes[rt], fmt.Sprintf("%s/lib/%s", pkg, strings.ReplaceAll(strings.TrimPrefix(rt, pn+"_"), "_", "-")))
+	}
+
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "class ImportedStack extends TerraformStack {")
+	fmt.Fprintf(b, "%sconstructor(scope: Construct, id: string) {\n", indent)
+	fmt.Fprintf(b, "%ssuper(scope, id);\n", strings.Repeat(indent, 2))
+
+	fmt.Fprintln(b)
+	pcfg := make(map[string]interface{}, len(w.provider.Configuration()))
+	for k, v := range w.provider.Configuration() {
+		pcfg[k] = v
+	}
+	psch := w.provider.TFProvider().Schema
+	if psch == nil {
+		psch = make(map[string]*schema.Schema)
+	}
+	fmt.Fprintf(b, "%snew %s(this, %q, %s);\n", strings.Repeat(indent, 2), providerClass, pn, w.value(pcfg, psch, 2))
+
+	// The construct IDs have to be unique on the stack so the
+	// repeated names use the type on the ID and keep the name
+	// as the logical ID, which is the one used on the references
+	ids := make(map[string]struct{}, len(resources))
+	for _, r := range resources {
+		sch := make(map[string]*schema.Schema)
+		if tfr, ok := w.provider.TFProvider().ResourcesMap[r.rt]; ok {
+			sch = tfr.Schema
+		}
+
+		id := r.name
+		_, repeated := ids[id]
+		if repeated {
+			id = fmt.Sprintf("%s_%s", r.rt, r.name)
+		}
+		ids[id] = struct{}{}
+
+		fmt.Fprintln(b)
+		fmt.Fprintf(b, "%snew %s(this, %q, %s)", strings.Repeat(indent, 2), classes[r.rt], id, w.value(r.cfg, sch, 2))
+		if repeated {
+			fmt.Fprintf(b, ".overrideLogicalId(%q)", r.name)
+		}
+		fmt.Fprintln(b, ";")
+	}
+
+	fmt.Fprintf(b, "%s}\n", indent)
+	fmt.Fprintln(b, "}")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "const app = new App();")
+	fmt.Fprintf(b, "new ImportedStack(app, %q);\n", stackName)
+	fmt.Fprintln(b, "app.synth();")
+
+	mxwriter.Write(w.writer, Category, b.Bytes())
+
+	return nil
+}
+
+// resources returns all the resources of all the
+// categories of the Config sorted by type and name
+func (w *Writer) resources() []resource {
+	resources := make([]resource, 0)
+	for _, c := range w.Config {
+		rs, ok := c["resource"].(map[string]map[string]interface{})
+		if !ok {
+			continue
+		}
+		for rt, nrs := range rs {
+			for n, cfg := range nrs {
+				m, ok := cfg.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				resources = append(resources, resource{rt: rt, name: n, cfg: m})
+			}
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].rt != resources[j].rt {
+			return resources[i].rt < resources[j].rt
+		}
+		return resources[i].name < resources[j].name
+	})
+
+	return resources
+}
+
+// value returns the TypeScript of the v with the level
+// of indentation. The sch is used to know the blocks that
+// are objects (MaxItems 1) and the attributes that are maps,
+// if nil the v is a map which keys are not converted to camelCase
+func (w *Writer) value(v interface{}, sch map[string]*schema.Schema, level int) string {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(vv))
+		for k := range vv {
+			if k == writer.ResourceCategoryKey {
+				continue
+			}
+			keys = append(keys, k)
+		}
+		if len(keys) == 0 {
+			return "{}"
+		}
+		sort.Strings(keys)
+
+		b := &strings.Builder{}
+		b.WriteString("{\n")
+		for _, k := range keys {
+			var (
+				ks   = camelCase(k)
+				val  = vv[k]
+				nsch map[string]*schema.Schema
+			)
+			if sch == nil {
+				// It's a map so the keys are kept
+				// and quoted if needed
+				ks = key(k)
+			} else if s, ok := sch[k]; ok {
+				switch s.Type {
+				case schema.TypeMap:
+					val = mapValue{v: val}
+				case schema.TypeList, schema.TypeSet:
+					if r, ok := s.Elem.(*schema.Resource); ok {
+						nsch = r.Schema
+						if l, ok := val.([]interface{}); ok && s.MaxItems == 1 && len(l) == 1 {
+							val = l[0]
+						}
+					}
+				}
+			}
+			fmt.Fprintf(b, "%s%s: %s,\n", strings.Repeat(indent, level+1), ks, w.value(val, nsch, level+1))
+		}
+		fmt.Fprintf(b, "%s}", strings.Repeat(indent, level))
+		return b.String()
+	case mapValue:
+		return w.value(vv.v, nil, level)
+	case []interface{}:
+		if len(vv) == 0 {
+			return "[]"
+		}
+		b := &strings.Builder{}
+		b.WriteString("[\n")
+		for _, e := range vv {
+			fmt.Fprintf(b, "%s%s,\n", strings.Repeat(indent, level+1), w.value(e, sch, level+1))
+		}
+		fmt.Fprintf(b, "%s]", strings.Repeat(indent, level))
+		return b.String()
+	default:
+		b := &bytes.Buffer{}
+		enc := json.NewEncoder(b)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(vv); err != nil {
+			return "undefined"
+		}
+		return strings.TrimSuffix(b.String(), "\n")
+	}
+}
+
+// mapValue is a value of an attribute of TypeMap
+type mapValue struct {
+	v interface{}
+}
+
+// camelCase converts the snake_case s to camelCase
+// as the attributes are named on the CDKTF bindings
+func camelCase(s string) string {
+	parts := strings.Split(s, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// className converts the snake_case s to PascalCase
+// as the classes are named on the CDKTF bindings
+func className(s string) string {
+	cc := camelCase(s)
+	if cc == "" {
+		return cc
+	}
+	return strings.ToUpper(cc[:1]) + cc[1:]
+}
+
+// key returns the k quoted if it's
+// not a valid TypeScript identifier
+func key(k string) string {
+	for i, c := range k {
+		if c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i != 0 && c >= '0' && c <= '9') {
+			continue
+		}
+		b, _ := json.Marshal(k)
+		return string(b)
+	}
+	if k == "" {
+		return `""`
+	}
+	return k
+}
@@ -0,0 +1,152 @@
+package cdktf_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/cycloidio/mxwriter"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/cdktf"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+func TestWriter_Sync(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		p    = mock.NewProvider(ctrl)
+		mw   = mxwriter.NewMux()
+		tfp  = &schema.Provider{
+			Schema: map[string]*schema.Schema{
+				"region": &schema.Schema{Type: schema.TypeString},
+			},
+			ResourcesMap: map[string]*schema.Resource{
+				"aws_instance": &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ami":           &schema.Schema{Type: schema.TypeString},
+						"instance_type": &schema.Schema{Type: schema.TypeString},
+						"tags":          &schema.Schema{Type: schema.TypeMap},
+						"root_block_device": &schema.Schema{
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"volume_size": &schema.Schema{Type: schema.TypeInt},
+								},
+							},
+						},
+						"ebs_block_device": &schema.Schema{
+							Type: schema.TypeSet,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"device_name": &schema.Schema{Type: schema.TypeString},
+								},
+							},
+						},
+					},
+				},
+				"aws_eip": &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance": &schema.Schema{Type: schema.TypeString},
+						"vpc":      &schema.Schema{Type: schema.TypeBool},
+					},
+				},
+			},
+		}
+	)
+	defer ctrl.Finish()
+
+	p.EXPECT().String().Return("aws").AnyTimes()
+	p.EXPECT().Source().Return("hashicorp/aws")
+	p.EXPECT().TFProvider().Return(tfp).AnyTimes()
+	p.EXPECT().Configuration().Return(map[string]interface{}{"region": "eu-west-1"}).AnyTimes()
+
+	cw := cdktf.NewWriter(mw, p, &writer.Options{Interpolate: true})
+
+	require.NoError(t, cw.Write("aws_instance.front", map[string]interface{}{
+		"ami":           "ami-1",
+		"instance_type": "t2.micro",
+		"tags": map[string]interface{}{
+			"Name":     "front",
+			"app:tier": "web",
+		},
+		"root_block_device": []interface{}{
+			map[string]interface{}{"volume_size": 8},
+		},
+		"ebs_block_device": []interface{}{
+			map[string]interface{}{"device_name": "/dev/sdb"},
+			map[string]interface{}{"device_name": "/dev/sdc"},
+		},
+		writer.ResourceCategoryKey: "ec2",
+	}))
+	require.NoError(t, cw.Write("aws_eip.ip", map[string]interface{}{
+		"instance": "i-1",
+		"vpc":      true,
+	}))
+	require.NoError(t, cw.Write("aws_eip.front", map[string]interface{}{
+		"vpc": true,
+	}))
+
+	cw.Interpolate(map[string]string{"i-1": "${aws_instance.front.id}"})
+	require.NoError(t, cw.Sync())
+
+	dm, err := mxwriter.NewDemux(mw)
+	require.NoError(t, err)
+	assert.Equal(t, []string{cdktf.Category}, dm.Keys())
+
+	b, err := ioutil.ReadAll(dm.Read(cdktf.Category))
+	require.NoError(t, err)
+	assert.Equal(t, `import { Construct } from "constructs";
+import { App, TerraformStack } from "cdktf";
+import { AwsProvider } from "@cdktf/provider-aws/lib/provider";
+import { Eip } from "@cdktf/provider-aws/lib/eip";
+import { Instance } from "@cdktf/provider-aws/lib/instance";
+
+class ImportedStack extends TerraformStack {
+  constructor(scope: Construct, id: string) {
+    super(scope, id);
+
+    new AwsProvider(this, "aws", {
+      region: "eu-west-1",
+    });
+
+    new Eip(this, "front", {
+      vpc: true,
+    });
+
+    new Eip(this, "ip", {
+      instance: "${aws_instance.front.id}",
+      vpc: true,
+    });
+
+    new Instance(this, "aws_instance_front", {
+      ami: "ami-1",
+      ebsBlockDevice: [
+        {
+          deviceName: "/dev/sdb",
+        },
+        {
+          deviceName: "/dev/sdc",
+        },
+      ],
+      instanceType: "t2.micro",
+      rootBlockDevice: {
+        volumeSize: 8,
+      },
+      tags: {
+        Name: "front",
+        "app:tier": "web",
+      },
+    }).overrideLogicalId("front");
+  }
+}
+
+const app = new App();
+new ImportedStack(app, "terracognita");
+app.synth();
+`, string(b))
+}
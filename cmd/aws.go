@@ -2,14 +2,19 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	kitlog "github.com/go-kit/kit/log"
 
 	"github.com/cycloidio/terracognita/aws"
+	"github.com/cycloidio/terracognita/filter"
 	"github.com/cycloidio/terracognita/log"
+	"github.com/cycloidio/terracognita/provider"
 	"github.com/cycloidio/terracognita/tag"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -23,59 +28,65 @@ var (
 		Short: "Terracognita reads from AWS and generates hcl resources and/or terraform state",
 		Long:  "Terracognita reads from AWS and generates hcl resources and/or terraform state",
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			bindAWSFlags(cmd)
+			viper.BindPFlag("print-required-actions", cmd.Flags().Lookup("print-required-actions"))
+
+			// Nothing is imported so
+			// no output is needed
+			if viper.GetBool("print-required-actions") {
+				return nil
+			}
+
 			err := preRunEOutput(cmd, args)
 			if err != nil {
 				return err
 			}
 
-			viper.BindPFlag("aws-access-key", cmd.Flags().Lookup("aws-access-key"))
-			viper.BindPFlag("aws-secret-access-key", cmd.Flags().Lookup("aws-secret-access-key"))
-			viper.BindPFlag("aws-default-region", cmd.Flags().Lookup("aws-default-region"))
-			viper.BindPFlag("aws-session-token", cmd.Flags().Lookup("aws-session-token"))
-
-			viper.BindPFlag("aws-shared-credentials-file", cmd.Flags().Lookup("aws-shared-credentials-file"))
-			viper.BindPFlag("aws-profile", cmd.Flags().Lookup("aws-profile"))
-
-			viper.BindPFlag("tags", cmd.Flags().Lookup("tags"))
-
-			// We define aliases so we have an easier access on the code
-			viper.RegisterAlias("access-key", "aws-access-key")
-			viper.RegisterAlias("secret-key", "aws-secret-access-key")
-			viper.RegisterAlias("session-token", "aws-session-token")
-			viper.RegisterAlias("region", "aws-default-region")
-
 			return nil
 		},
-		PostRunE: postRunEOutput,
+		PostRunE: func(cmd *cobra.Command, args []string) error {
+			if viper.GetBool("print-required-actions") {
+				return nil
+			}
+
+			return postRunEOutput(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			logger := log.Get()
 			logger = kitlog.With(logger, "func", "cmd.aws.RunE")
 
-			loadAWSCredentials()
+			if viper.GetBool("print-required-actions") {
+				return printRequiredActions(cmd)
+			}
+
+			ctx := context.Background()
+
+			awsP, tags, err := newAWSProvider(ctx)
+			if err != nil {
+				return err
+			}
 
-			// Validate required flags
-			if err := requiredStringFlags("access-key", "secret-key", "region"); err != nil {
+			err = setFeatureExcludes()
+			if err != nil {
 				return err
 			}
 
-			// Initialize the tags
-			tags := make([]tag.Tag, 0, len(viper.GetStringSlice("tags")))
-			for _, t := range viper.GetStringSlice("tags") {
-				tg, err := tag.New(t)
-				if err != nil {
-					return fmt.Errorf("invalid format for --tags with value %q: %w", t, err)
-				}
-				tags = append(tags, tg)
+			err = setStackTargets(ctx, logger, awsP)
+			if err != nil {
+				return err
 			}
 
-			ctx := context.Background()
+			err = setAggregatorTargets(ctx, logger, awsP)
+			if err != nil {
+				return err
+			}
 
-			awsP, err := aws.NewProvider(ctx, viper.GetString("access-key"), viper.GetString("secret-key"), viper.GetString("region"), viper.GetString("session-token"))
+			cw, err := parseCreatedWindow()
 			if err != nil {
 				return err
 			}
 
-			err = importProvider(ctx, logger, awsP, tags)
+			err = importProvider(ctx, logger, awsP, tags, cw)
 			if err != nil {
 				return err
 			}
@@ -87,17 +98,281 @@ var (
 
 func init() {
 	awsCmd.AddCommand(awsResourcesCmd)
+	awsCmd.AddCommand(awsPreflightCmd)
+	awsCmd.AddCommand(awsScanCmd)
+	awsCmd.AddCommand(newDriftCmd(awsCmd, bindAWSFlags))
 
 	// Required flags
-	awsCmd.Flags().String("aws-access-key", "", "Access Key (required)")
-	awsCmd.Flags().String("aws-secret-access-key", "", "Secret Key (required)")
-	awsCmd.Flags().String("aws-session-token", "", "Use to validate the temporary security credentials")
-	awsCmd.Flags().String("aws-default-region", "", "Region to search in, for now * is not supported (required)")
-	awsCmd.Flags().String("aws-shared-credentials-file", "", "Path to the AWS credential path")
-	awsCmd.Flags().String("aws-profile", "", "Name of the Profile to use with the Credentials")
+	awsCmd.PersistentFlags().String("aws-access-key", "", "Access Key (required)")
+	awsCmd.PersistentFlags().String("aws-secret-access-key", "", "Secret Key (required)")
+	awsCmd.PersistentFlags().String("aws-session-token", "", "Use to validate the temporary security credentials")
+	awsCmd.PersistentFlags().String("aws-default-region", "", "Region to search in, for now * is not supported (required)")
+	awsCmd.PersistentFlags().StringSlice("aws-regions", []string{}, "List of other regions to import along with the --aws-default-region, each one is written as an aliased provider named as the region (ex: 'eu_west_1'). The global services (IAM, Route53, CloudFront) are only read once on the --aws-default-region")
+	awsCmd.PersistentFlags().StringSlice("aws-region-aliases", []string{}, "List of aliases of the providers of the --aws-regions with format 'REGION=ALIAS' (ex: 'eu-west-1=prod_euw1'), by default the alias is the name of the region with '_' (ex: 'eu_west_1')")
+	awsCmd.PersistentFlags().String("aws-shared-credentials-file", "", "Path to the AWS credential path")
+	awsCmd.PersistentFlags().String("aws-profile", "", "Name of the Profile to use with the Credentials")
+	awsCmd.PersistentFlags().String("aws-partition", "", "Partition of the region (aws, aws-cn, aws-us-gov), by default it's detected from the region")
+	awsCmd.PersistentFlags().String("aws-endpoint", "", "Custom endpoint URL used for all the services, ex: LocalStack 'http://localhost:4566'")
+	awsCmd.PersistentFlags().String("aws-proxy", "", "Proxy URL used for all the requests to AWS, the supported schemes are http, https and socks5 (ex: 'socks5://localhost:1080')")
+	awsCmd.PersistentFlags().Bool("aws-disable-imds", false, "Disable the lookups to the EC2 Instance Metadata Service (IMDS) when resolving the credentials, to avoid the timeouts on environments without it")
+	awsCmd.PersistentFlags().String("aws-credentials-source", "", "Source of the credentials instead of the keys: 'imdsv2' for the role of the EC2 Instance (only with IMDSv2 token-based requests) or 'ecs' for the role of the ECS Task (from the AWS_CONTAINER_CREDENTIALS_RELATIVE_URI)")
+	awsCmd.PersistentFlags().String("aws-lambda-packages", "", "Directory to download the packages of the Lambda Functions and Layer Versions, the HCL 'filename' references them so it can be applied")
+	awsCmd.PersistentFlags().String("aws-cloudformation-stack", "", "Name or ID of a CloudFormation stack to import only the resources of it, the resources types that can not be imported are reported")
+	awsCmd.PersistentFlags().String("aws-config-aggregator", "", "Name of an AWS Config Configuration Aggregator used to discover the resources to import instead of calling the Describe/List APIs, only the resources of the account and region of the credentials are imported")
+	awsCmd.PersistentFlags().String("aws-security-group-rules", aws.SecurityGroupRulesInline, "Style used to import the rules of the Security Groups, 'inline' on the 'ingress' and 'egress' of the aws_security_group or 'separate' as aws_security_group_rule, which avoids the conflict of the inline rules with the rules managed separately")
+	awsCmd.PersistentFlags().Bool("aws-owner-cloudtrail", false, "Infer the owner of the resources without owner tags from the identity that created them on the CloudTrail events (only the last 90 days are available), it's slow as the CloudTrail lookups are limited to 2 per second")
+	awsCmd.PersistentFlags().StringSlice("aws-endpoints", []string{}, "List of custom endpoints per service with format 'SERVICE=URL', ex: 'ec2=https://vpce-xxx.ec2.us-east-1.vpce.amazonaws.com'")
+
+	for _, s := range aws.FeatureServices {
+		awsCmd.PersistentFlags().StringSlice(featureFlag(s), []string{}, fmt.Sprintf("List of features of %s to import, the resource types without the 'aws_%s_' prefix in singular or plural, the ones prefixed with '!' are not imported (ex: 'users,groups,!access_keys'). One of: %s", strings.ToUpper(s), s, strings.Join(aws.Features(s), ", ")))
+	}
 
 	// Filter flags
-	awsCmd.Flags().StringSliceVarP(&tags, "tags", "t", []string{}, "List of tags to filter with format 'NAME:VALUE'")
+	awsCmd.PersistentFlags().StringSliceVarP(&tags, "tags", "t", []string{}, "List of tags to filter with format 'NAME:VALUE'")
+	awsCmd.PersistentFlags().String("tags-normalization", tag.NormalizationPreserve, fmt.Sprintf("Normalization of the keys of the tags when filtering with --tags and naming the resources with the 'Name' tag, so 'Env' and 'env' are the same key. One of: %s", strings.Join(tag.Normalizations, ", ")))
+	awsCmd.PersistentFlags().String("filter-created-after", "", "Import only the resources created after this date, with format RFC3339 (ex: '2022-01-02T15:04:05Z') or '2022-01-02'. The resources types without a creation date are not filtered")
+	awsCmd.PersistentFlags().String("filter-created-before", "", "Import only the resources created before this date, with format RFC3339 (ex: '2022-01-02T15:04:05Z') or '2022-01-02'. The resources types without a creation date are not filtered")
+
+	awsCmd.Flags().Bool("print-required-actions", false, "Print the minimal IAM policy, as JSON, with the actions needed to read the resources (filtered with --include and --exclude) and exit without importing")
+}
+
+// bindAWSFlags binds all the AWS flags of the cmd to viper,
+// it's used by all the AWS commands that need to connect to AWS
+func bindAWSFlags(cmd *cobra.Command) {
+	viper.BindPFlag("aws-access-key", cmd.Flags().Lookup("aws-access-key"))
+	viper.BindPFlag("aws-secret-access-key", cmd.Flags().Lookup("aws-secret-access-key"))
+	viper.BindPFlag("aws-default-region", cmd.Flags().Lookup("aws-default-region"))
+	viper.BindPFlag("aws-regions", cmd.Flags().Lookup("aws-regions"))
+	viper.BindPFlag("aws-region-aliases", cmd.Flags().Lookup("aws-region-aliases"))
+	viper.BindPFlag("aws-session-token", cmd.Flags().Lookup("aws-session-token"))
+
+	viper.BindPFlag("aws-shared-credentials-file", cmd.Flags().Lookup("aws-shared-credentials-file"))
+	viper.BindPFlag("aws-profile", cmd.Flags().Lookup("aws-profile"))
+	viper.BindPFlag("aws-partition", cmd.Flags().Lookup("aws-partition"))
+	viper.BindPFlag("aws-endpoint", cmd.Flags().Lookup("aws-endpoint"))
+	viper.BindPFlag("aws-endpoints", cmd.Flags().Lookup("aws-endpoints"))
+	viper.BindPFlag("aws-proxy", cmd.Flags().Lookup("aws-proxy"))
+	viper.BindPFlag("aws-disable-imds", cmd.Flags().Lookup("aws-disable-imds"))
+	viper.BindPFlag("aws-credentials-source", cmd.Flags().Lookup("aws-credentials-source"))
+	viper.BindPFlag("aws-lambda-packages", cmd.Flags().Lookup("aws-lambda-packages"))
+	viper.BindPFlag("aws-cloudformation-stack", cmd.Flags().Lookup("aws-cloudformation-stack"))
+	viper.BindPFlag("aws-config-aggregator", cmd.Flags().Lookup("aws-config-aggregator"))
+	viper.BindPFlag("aws-owner-cloudtrail", cmd.Flags().Lookup("aws-owner-cloudtrail"))
+	viper.BindPFlag("aws-security-group-rules", cmd.Flags().Lookup("aws-security-group-rules"))
+	for _, s := range aws.FeatureServices {
+		viper.BindPFlag(featureFlag(s), cmd.Flags().Lookup(featureFlag(s)))
+	}
+
+	viper.BindPFlag("tags", cmd.Flags().Lookup("tags"))
+	viper.BindPFlag("tags-normalization", cmd.Flags().Lookup("tags-normalization"))
+	viper.BindPFlag("filter-created-after", cmd.Flags().Lookup("filter-created-after"))
+	viper.BindPFlag("filter-created-before", cmd.Flags().Lookup("filter-created-before"))
+
+	// We define aliases so we have an easier access on the code
+	viper.RegisterAlias("access-key", "aws-access-key")
+	viper.RegisterAlias("secret-key", "aws-secret-access-key")
+	viper.RegisterAlias("session-token", "aws-session-token")
+	viper.RegisterAlias("region", "aws-default-region")
+}
+
+// newAWSProvider loads the credentials, validates the required flags
+// and initializes the AWS Provider and the tags to filter with
+func newAWSProvider(ctx context.Context) (provider.Provider, []tag.Tag, error) {
+	// Validate required flags, the keys are not
+	// needed if the credentials have a source
+	if viper.GetString("aws-credentials-source") != "" {
+		if err := requiredStringFlags("region"); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		loadAWSCredentials()
+
+		if err := requiredStringFlags("access-key", "secret-key", "region"); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Initialize the tags
+	tags := make([]tag.Tag, 0, len(viper.GetStringSlice("tags")))
+	for _, t := range viper.GetStringSlice("tags") {
+		tg, err := tag.New(t)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid format for --tags with value %q: %w", t, err)
+		}
+		tags = append(tags, tg)
+	}
+
+	// Initialize the custom endpoints
+	endpoints := make(map[string]string, len(viper.GetStringSlice("aws-endpoints")))
+	for _, e := range viper.GetStringSlice("aws-endpoints") {
+		ep := strings.SplitN(e, "=", 2)
+		if len(ep) != 2 || ep[0] == "" || ep[1] == "" {
+			return nil, nil, fmt.Errorf("invalid format for --aws-endpoints with value %q, the expected format is 'SERVICE=URL'", e)
+		}
+		endpoints[ep[0]] = ep[1]
+	}
+
+	// Initialize the aliases of the regions
+	aliases := make(map[string]string, len(viper.GetStringSlice("aws-region-aliases")))
+	for _, a := range viper.GetStringSlice("aws-region-aliases") {
+		ra := strings.SplitN(a, "=", 2)
+		if len(ra) != 2 || ra[0] == "" || ra[1] == "" {
+			return nil, nil, fmt.Errorf("invalid format for --aws-region-aliases with value %q, the expected format is 'REGION=ALIAS'", a)
+		}
+		aliases[ra[0]] = ra[1]
+	}
+
+	opts := aws.Options{
+		Partition:          viper.GetString("aws-partition"),
+		Endpoint:           viper.GetString("aws-endpoint"),
+		Endpoints:          endpoints,
+		Proxy:              viper.GetString("aws-proxy"),
+		DisableIMDS:        viper.GetBool("aws-disable-imds"),
+		CredentialsSource:  viper.GetString("aws-credentials-source"),
+		LambdaPackagesDir:  viper.GetString("aws-lambda-packages"),
+		OwnerCloudTrail:    viper.GetBool("aws-owner-cloudtrail"),
+		SecurityGroupRules: viper.GetString("aws-security-group-rules"),
+	}
+
+	// The default region is the first one
+	regions := append([]string{viper.GetString("region")}, viper.GetStringSlice("aws-regions")...)
+	providers := make([]provider.Provider, 0, len(regions))
+	for _, r := range regions {
+		p, err := aws.NewProvider(ctx, viper.GetString("access-key"), viper.GetString("secret-key"), r, viper.GetString("session-token"), opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to initialize the region %s: %w", r, err)
+		}
+		providers = append(providers, p)
+	}
+
+	awsP, err := aws.NewMultiRegionProvider(providers, aliases)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return awsP, tags, nil
+}
+
+// featureFlag returns the name of the flag
+// with the features of the service
+func featureFlag(service string) string {
+	return fmt.Sprintf("aws-%s-include", service)
+}
+
+// setFeatureExcludes adds to the exclude the resource types
+// of the services that are not in the features of the
+// --aws-SERVICE-include, so only those are imported
+func setFeatureExcludes() error {
+	for _, s := range aws.FeatureServices {
+		features := viper.GetStringSlice(featureFlag(s))
+		if len(features) == 0 {
+			continue
+		}
+
+		excludes, err := aws.FeatureExcludes(s, features)
+		if err != nil {
+			return fmt.Errorf("invalid value for --%s: %w", featureFlag(s), err)
+		}
+
+		exclude = append(exclude, excludes...)
+	}
+
+	return nil
+}
+
+// setStackTargets adds to the targets the resources of the
+// --aws-cloudformation-stack if defined, so only those are imported
+func setStackTargets(ctx context.Context, logger kitlog.Logger, p provider.Provider) error {
+	stack := viper.GetString("aws-cloudformation-stack")
+	if stack == "" {
+		return nil
+	}
+
+	sts, skipped, err := aws.StackTargets(ctx, p, stack)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range skipped {
+		fmt.Fprintf(logsOut, "Skipping the resource %s of the stack %q as it can not be imported\n", s, stack)
+		logger.Log("msg", "skipping stack resource", "stack", stack, "resource", s)
+	}
+
+	// If no targets are set all the
+	// resources would be imported
+	if len(sts) == 0 {
+		return fmt.Errorf("the stack %q has no resources that can be imported", stack)
+	}
+
+	targets = append(targets, sts...)
+
+	return nil
+}
+
+// setAggregatorTargets adds to the targets the resources recorded by the
+// --aws-config-aggregator if defined, so only those are imported
+func setAggregatorTargets(ctx context.Context, logger kitlog.Logger, p provider.Provider) error {
+	aggregator := viper.GetString("aws-config-aggregator")
+	if aggregator == "" {
+		return nil
+	}
+
+	ats, err := aws.AggregatorTargets(ctx, p, aggregator, &filter.Filter{Include: include, Exclude: exclude})
+	if err != nil {
+		return err
+	}
+
+	logger.Log("msg", "discovered resources from the aggregator", "aggregator", aggregator, "resources", len(ats))
+
+	// If no targets are set all the
+	// resources would be imported
+	if len(ats) == 0 {
+		return fmt.Errorf("the aggregator %q has no resources that can be imported", aggregator)
+	}
+
+	targets = append(targets, ats...)
+
+	return nil
+}
+
+// parseCreatedWindow parses the --filter-created-after and --filter-created-before
+// into the createdWindow used to filter the resources
+func parseCreatedWindow() (createdWindow, error) {
+	var (
+		cw  createdWindow
+		err error
+	)
+
+	cw.after, err = parseCreatedDate("filter-created-after")
+	if err != nil {
+		return cw, err
+	}
+
+	cw.before, err = parseCreatedDate("filter-created-before")
+	if err != nil {
+		return cw, err
+	}
+
+	return cw, nil
+}
+
+// parseCreatedDate parses the date of the flag fl which can have
+// the RFC3339 format or only the date, the empty value returns
+// the zero time
+func parseCreatedDate(fl string) (time.Time, error) {
+	v := viper.GetString(fl)
+	if v == "" {
+		return time.Time{}, nil
+	}
+
+	for _, l := range []string{time.RFC3339, "2006-01-02"} {
+		t, err := time.Parse(l, v)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid format for --%s with value %q, the expected format is RFC3339 (ex: '2022-01-02T15:04:05Z') or '2022-01-02'", fl, v)
 }
 
 // loadAWSCredentials will first read from ENV and if AccessKey and SecretAccessKey are not found (both of them)
@@ -139,3 +414,32 @@ func loadAWSCredentials() error {
 
 	return nil
 }
+
+// printRequiredActions prints the IAM policy with the actions
+// needed to read the resources filtered with --include and --exclude
+func printRequiredActions(cmd *cobra.Command) error {
+	err := setFeatureExcludes()
+	if err != nil {
+		return err
+	}
+
+	f := &filter.Filter{
+		Include: include,
+		Exclude: exclude,
+	}
+
+	types := f.Include
+	if len(types) == 0 {
+		types = aws.ResourceTypeStrings()
+	}
+
+	p, err := aws.RequiredPolicy(types, f)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(p)
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cycloidio/terracognita/aws"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/log"
+)
+
+var (
+	awsPreflightCmd = &cobra.Command{
+		Use:   "preflight",
+		Short: "Checks if the credentials can read all the AWS Resources",
+		Long:  "Checks, by simulating with the IAM policy simulator (iam:SimulatePrincipalPolicy) the actions needed to read them, if the credentials can read all the AWS Resources to import (filtered with --include and --exclude) and reports the missing permissions. The SCPs and permission boundaries of the Organization are not simulated",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			bindAWSFlags(cmd)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := log.Get()
+			logger = kitlog.With(logger, "func", "cmd.aws.preflight.RunE")
+
+			ctx := context.Background()
+
+			awsP, tags, err := newAWSProvider(ctx)
+			if err != nil {
+				return err
+			}
+
+			f := &filter.Filter{
+				Include: include,
+				Exclude: exclude,
+				Tags:    tags,
+			}
+
+			types := f.Include
+			if len(types) == 0 {
+				types = awsP.ResourceTypes()
+			}
+
+			logger.Log("msg", "checking permissions", "types", len(types))
+			checks, err := aws.Preflight(ctx, awsP, types, f)
+			if err != nil {
+				return err
+			}
+
+			var denied int
+			for _, c := range checks {
+				if c.Allowed() {
+					fmt.Fprintf(cmd.OutOrStdout(), "OK\t%s\n", c.ResourceType)
+					continue
+				}
+
+				denied++
+				fmt.Fprintf(cmd.OutOrStdout(), "DENIED\t%s\t%s\n", c.ResourceType, c.Action)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "\n%d/%d resource types can be read with the current credentials on region %s\n", len(checks)-denied, len(checks), viper.GetString("region"))
+			if denied != 0 {
+				return fmt.Errorf("missing permissions for %d resource types", denied)
+			}
+
+			return nil
+		},
+	}
+)
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/log"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+var (
+	awsScanCmd = &cobra.Command{
+		Use:   "scan",
+		Short: "Lists the AWS Resources that would be imported",
+		Long:  "Lists the AWS Resources (filtered with --include, --exclude, --target and --tags) with their type, ID, name, region and tags without writing any HCL or TFState, so the scope of the import can be known before doing it",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			bindAWSFlags(cmd)
+			viper.BindPFlag("json", cmd.Flags().Lookup("json"))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := log.Get()
+			logger = kitlog.With(logger, "func", "cmd.aws.scan.RunE")
+
+			ctx := context.Background()
+
+			awsP, tags, err := newAWSProvider(ctx)
+			if err != nil {
+				return err
+			}
+
+			err = setFeatureExcludes()
+			if err != nil {
+				return err
+			}
+
+			err = setStackTargets(ctx, logger, awsP)
+			if err != nil {
+				return err
+			}
+
+			err = setAggregatorTargets(ctx, logger, awsP)
+			if err != nil {
+				return err
+			}
+
+			cw, err := parseCreatedWindow()
+			if err != nil {
+				return err
+			}
+
+			f := &filter.Filter{
+				Include:           include,
+				Exclude:           exclude,
+				Targets:           targets,
+				ForceTypes:        forceTypes,
+				Tags:              tags,
+				TagsNormalization: viper.GetString("tags-normalization"),
+				CreatedAfter:      cw.after,
+				CreatedBefore:     cw.before,
+			}
+
+			// The progress is written to the Stderr so
+			// the Stdout only has the Resources scanned
+			progress := cmd.ErrOrStderr()
+			if viper.GetBool("verbose") || viper.GetBool("debug") {
+				progress = ioutil.Discard
+			}
+
+			logger.Log("msg", "scanning resources")
+			srs, err := provider.Scan(ctx, awsP, f, progress)
+			if err != nil {
+				return err
+			}
+
+			if viper.GetBool("json") {
+				b, err := json.MarshalIndent(srs, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(b))
+				return nil
+			}
+
+			tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(tw, "TYPE\tID\tNAME\tREGION\tTAGS")
+			for _, sr := range srs {
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", sr.Type, sr.ID, sr.Name, sr.Region, formatTags(sr.Tags))
+			}
+
+			return tw.Flush()
+		},
+	}
+)
+
+func init() {
+	awsScanCmd.Flags().Bool("json", false, "Prints the Resources scanned as JSON")
+}
+
+// formatTags returns the tags sorted by name
+// with the format 'NAME:VALUE' separated by ','
+func formatTags(tags map[string]string) string {
+	res := make([]string, 0, len(tags))
+	for k, v := range tags {
+		res = append(res, fmt.Sprintf("%s:%s", k, v))
+	}
+	sort.Strings(res)
+
+	return strings.Join(res, ",")
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	kitlog "github.com/go-kit/kit/log"
 	"github.com/spf13/cobra"
@@ -10,9 +11,22 @@ import (
 
 	"github.com/cycloidio/terracognita/azurerm"
 	"github.com/cycloidio/terracognita/log"
+	"github.com/cycloidio/terracognita/tag"
 )
 
 var (
+	// azurermEnvs are the ENV used by the Terraform
+	// AzureRM Provider for each one of the flags
+	azurermEnvs = map[string]string{
+		"client-id":       "ARM_CLIENT_ID",
+		"client-secret":   "ARM_CLIENT_SECRET",
+		"environment":     "ARM_ENVIRONMENT",
+		"subscription-id": "ARM_SUBSCRIPTION_ID",
+		"tenant-id":       "ARM_TENANT_ID",
+		"use-msi":         "ARM_USE_MSI",
+		"msi-endpoint":    "ARM_MSI_ENDPOINT",
+	}
+
 	azurermCmd = &cobra.Command{
 		Use:   "azurerm",
 		Short: "Terracognita reads from Azure and generates hcl resources and/or terraform state",
@@ -22,12 +36,7 @@ var (
 			if err != nil {
 				return err
 			}
-			viper.BindPFlag("client-id", cmd.Flags().Lookup("client-id"))
-			viper.BindPFlag("client-secret", cmd.Flags().Lookup("client-secret"))
-			viper.BindPFlag("environment", cmd.Flags().Lookup("environment"))
-			viper.BindPFlag("resource-group-name", cmd.Flags().Lookup("resource-group-name"))
-			viper.BindPFlag("subscription-id", cmd.Flags().Lookup("subscription-id"))
-			viper.BindPFlag("tenant-id", cmd.Flags().Lookup("tenant-id"))
+			bindAzureRMFlags(cmd)
 
 			return nil
 		},
@@ -35,14 +44,34 @@ var (
 		RunE: func(cmd *cobra.Command, args []string) error {
 			logger := log.Get()
 			logger = kitlog.With(logger, "func", "cmd.azure.RunE")
-			// Validate required flags
-			if err := requiredStringFlags(
-				"client-id", "client-secret", "subscription-id", "tenant-id",
-			); err != nil {
-				return err
+			// The client secret needs all the
+			// information of the service principal
+			if viper.GetString("client-secret") != "" {
+				if err := requiredStringFlags(
+					"client-id", "subscription-id", "tenant-id",
+				); err != nil {
+					return err
+				}
+			}
+			if len(viper.GetStringSlice("resource-group-name")) != 0 && viper.GetString("resource-group-glob") != "" {
+				return fmt.Errorf("the flags 'resource-group-name' and 'resource-group-glob' can not be used at the same time")
+			}
+
+			// Initialize the tags
+			tags := make([]tag.Tag, 0, len(viper.GetStringSlice("tags")))
+			for _, t := range viper.GetStringSlice("tags") {
+				tg, err := tag.New(t)
+				if err != nil {
+					return fmt.Errorf("invalid format for --tags with value %q: %w", t, err)
+				}
+				tags = append(tags, tg)
 			}
-			if len(viper.GetStringSlice("resource-group-name")) == 0 {
-				return fmt.Errorf("the flag 'resource-group-name' is required")
+			for _, ft := range viper.GetStringSlice("filter-tags") {
+				kv := strings.SplitN(ft, "=", 2)
+				if len(kv) != 2 || kv[0] == "" {
+					return fmt.Errorf("invalid format for --filter-tags with value %q, the expected format is 'KEY=VALUE'", ft)
+				}
+				tags = append(tags, tag.Tag{Name: kv[0], Value: kv[1]})
 			}
 
 			ctx := context.Background()
@@ -55,12 +84,18 @@ var (
 				viper.GetStringSlice("resource-group-name"),
 				viper.GetString("subscription-id"),
 				viper.GetString("tenant-id"),
+				azurerm.Options{
+					ResourceGroupGlob: viper.GetString("resource-group-glob"),
+					UseMSI:            viper.GetBool("use-msi"),
+					MSIEndpoint:       viper.GetString("msi-endpoint"),
+					ResourceGraph:     viper.GetBool("resource-graph"),
+				},
 			)
 			if err != nil {
 				return err
 			}
 
-			err = importProvider(ctx, logger, azureRMP, noTags)
+			err = importProvider(ctx, logger, azureRMP, tags, noCreatedWindow)
 			if err != nil {
 				return err
 			}
@@ -72,14 +107,47 @@ var (
 
 func init() {
 	azurermCmd.AddCommand(azurermResourcesCmd)
+	azurermCmd.AddCommand(newDriftCmd(azurermCmd, bindAzureRMFlags))
 
-	// Required flags
-	azurermCmd.Flags().String("client-id", "", "Client ID (required)")
-	azurermCmd.Flags().String("client-secret", "", "Client Secret (required)")
-	azurermCmd.Flags().StringSlice("resource-group-name", nil, "Resource Group Names (required)")
-	azurermCmd.Flags().String("subscription-id", "", "Subscription ID (required)")
-	azurermCmd.Flags().String("tenant-id", "", "Tenant ID (required)")
+	// Credentials flags, if the Client Secret is not defined
+	// the managed identity (with --use-msi) or the Azure CLI are used
+	azurermCmd.PersistentFlags().String("client-id", "", "Client ID, of the service principal or of the user assigned managed identity")
+	azurermCmd.PersistentFlags().String("client-secret", "", "Client Secret, if defined the Client ID, Subscription ID and Tenant ID are required")
+	azurermCmd.PersistentFlags().String("subscription-id", "", "Subscription ID, if not defined the default one of the Azure CLI is used")
+	azurermCmd.PersistentFlags().String("tenant-id", "", "Tenant ID, if not defined the one of the Azure CLI is used")
+	azurermCmd.PersistentFlags().Bool("use-msi", false, "Authenticate with the managed identity of the VM or the pipeline when no Client Secret is defined")
+	azurermCmd.PersistentFlags().String("msi-endpoint", "", "Endpoint of the managed identity, by default the one of the Instance Metadata Service")
 
 	// Optional flags
-	azurermCmd.Flags().String("environment", "public", "Environment")
+	azurermCmd.PersistentFlags().String("environment", "public", "Environment")
+	azurermCmd.PersistentFlags().StringSlice("resource-group-name", nil, "Resource Group Names, it can be repeated or comma separated. If not defined all the Resource Groups of the subscription are imported")
+	azurermCmd.PersistentFlags().String("resource-group-glob", "", "Glob used to filter the Resource Groups imported when no 'resource-group-name' is defined (ex: 'prod-*')")
+	azurermCmd.PersistentFlags().StringSliceVarP(&tags, "tags", "t", []string{}, "List of tags to filter with format 'NAME:VALUE'")
+	azurermCmd.PersistentFlags().StringSlice("filter-tags", []string{}, "List of tags to filter with format 'KEY=VALUE', the first one is filtered on the list calls of the ARM APIs and all of them on the Resource Graph queries (ex: 'env=prod')")
+	azurermCmd.PersistentFlags().String("tags-normalization", tag.NormalizationPreserve, fmt.Sprintf("Normalization of the keys of the tags when filtering with --tags and naming the resources with the 'Name' tag, so 'Env' and 'env' are the same key. One of: %s", strings.Join(tag.Normalizations, ", ")))
+	azurermCmd.PersistentFlags().Bool("resource-graph", false, "List the resources with the Azure Resource Graph, one query per type for all the Resource Groups, instead of the ARM APIs of each Resource Group")
+}
+
+// bindAzureRMFlags binds all the AzureRM flags of the cmd to viper,
+// it's used by all the AzureRM commands that need to connect to Azure
+func bindAzureRMFlags(cmd *cobra.Command) {
+	viper.BindPFlag("client-id", cmd.Flags().Lookup("client-id"))
+	viper.BindPFlag("client-secret", cmd.Flags().Lookup("client-secret"))
+	viper.BindPFlag("environment", cmd.Flags().Lookup("environment"))
+	viper.BindPFlag("resource-group-name", cmd.Flags().Lookup("resource-group-name"))
+	viper.BindPFlag("resource-group-glob", cmd.Flags().Lookup("resource-group-glob"))
+	viper.BindPFlag("resource-graph", cmd.Flags().Lookup("resource-graph"))
+	viper.BindPFlag("tags", cmd.Flags().Lookup("tags"))
+	viper.BindPFlag("filter-tags", cmd.Flags().Lookup("filter-tags"))
+	viper.BindPFlag("tags-normalization", cmd.Flags().Lookup("tags-normalization"))
+	viper.BindPFlag("subscription-id", cmd.Flags().Lookup("subscription-id"))
+	viper.BindPFlag("tenant-id", cmd.Flags().Lookup("tenant-id"))
+	viper.BindPFlag("use-msi", cmd.Flags().Lookup("use-msi"))
+	viper.BindPFlag("msi-endpoint", cmd.Flags().Lookup("msi-endpoint"))
+
+	// The same ENV used by Terraform are
+	// also read if the flags are not defined
+	for k, e := range azurermEnvs {
+		viper.BindEnv(k, e)
+	}
 }
@@ -0,0 +1,315 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cycloidio/terracognita/backend"
+	"github.com/cycloidio/terracognita/schema"
+)
+
+const (
+	doctorOK      = "ok"
+	doctorWarning = "warning"
+	doctorError   = "error"
+)
+
+// doctorFinding is the result of one of the
+// checks done by the doctor command
+type doctorFinding struct {
+	Check   string `json:"check"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+var (
+	doctorCmd = &cobra.Command{
+		Use:   "doctor",
+		Short: "Checks the local environment",
+		Long:  "Checks the local environment without calling any Provider API: the terraform/tofu binaries, the credentials of each Provider, the embedded schema and if the output paths (--hcl, --tfstate, --module) are writable, and reports what has to be fixed",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			viper.BindPFlag("json", cmd.Flags().Lookup("json"))
+			viper.BindPFlag("aws-shared-credentials-file", cmd.Flags().Lookup("aws-shared-credentials-file"))
+			viper.BindPFlag("aws-profile", cmd.Flags().Lookup("aws-profile"))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var findings []doctorFinding
+			findings = append(findings, checkDoctorBinaries()...)
+			findings = append(findings, checkDoctorCredentials()...)
+			findings = append(findings, checkDoctorSchema()...)
+			findings = append(findings, checkDoctorOutputs()...)
+
+			if viper.GetBool("json") {
+				b, err := json.MarshalIndent(findings, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(b))
+			} else {
+				w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+				for _, f := range findings {
+					fmt.Fprintf(w, "%s\t%s\t%s\n", strings.ToUpper(f.Status), f.Check, f.Message)
+				}
+				w.Flush()
+			}
+
+			var failed int
+			for _, f := range findings {
+				if f.Status == doctorError {
+					failed++
+				}
+			}
+			if failed != 0 {
+				return fmt.Errorf("%d checks failed", failed)
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	doctorCmd.Flags().Bool("json", false, "Prints the findings as JSON")
+	doctorCmd.Flags().String("aws-shared-credentials-file", "", "Path to the AWS credential path")
+	doctorCmd.Flags().String("aws-profile", "", "Name of the Profile to use with the Credentials")
+}
+
+// checkDoctorBinaries checks if terraform or tofu are on the PATH,
+// they are not needed to import but they are to use the output
+func checkDoctorBinaries() []doctorFinding {
+	var (
+		findings []doctorFinding
+		found    bool
+	)
+	for _, b := range []string{"terraform", "tofu"} {
+		p, err := exec.LookPath(b)
+		if err != nil {
+			continue
+		}
+		found = true
+		findings = append(findings, doctorFinding{Check: "binary." + b, Status: doctorOK, Message: p})
+	}
+
+	if !found {
+		findings = append(findings, doctorFinding{
+			Check:   "binary",
+			Status:  doctorWarning,
+			Message: "no terraform or tofu binary found on the PATH, one is needed to plan the generated HCL and TFState",
+		})
+	}
+
+	return findings
+}
+
+// checkDoctorCredentials checks, for each Provider, if the credentials
+// can be resolved from the flags, the ENV or the default files
+func checkDoctorCredentials() []doctorFinding {
+	findings := []doctorFinding{checkDoctorAWSCredentials()}
+
+	gcreds := viper.GetString("credentials")
+	if gcreds == "" {
+		gcreds = os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
+	}
+	if gcreds == "" {
+		// The Application Default Credentials
+		// written by 'gcloud auth application-default login'
+		if home, err := os.UserHomeDir(); err == nil {
+			adc := filepath.Join(home, ".config", "gcloud", "application_default_credentials.json")
+			if _, err := os.Stat(adc); err == nil {
+				gcreds = adc
+			}
+		}
+	}
+	if gcreds == "" {
+		findings = append(findings, doctorFinding{Check: "credentials.google", Status: doctorWarning, Message: "no credentials found, use --credentials or CREDENTIALS/GOOGLE_APPLICATION_CREDENTIALS with the path to the JSON credential or 'gcloud auth application-default login'"})
+	} else if _, err := os.Stat(gcreds); err != nil {
+		findings = append(findings, doctorFinding{Check: "credentials.google", Status: doctorError, Message: fmt.Sprintf("the JSON credential %q can not be read: %s", gcreds, err)})
+	} else {
+		findings = append(findings, doctorFinding{Check: "credentials.google", Status: doctorOK, Message: gcreds})
+	}
+
+	findings = append(findings, checkDoctorAzureRMCredentials())
+	findings = append(findings, checkDoctorRequiredKeys("credentials.vsphere", "soap-url", "username", "password"))
+
+	return findings
+}
+
+func checkDoctorAWSCredentials() doctorFinding {
+	if viper.GetString("access-key") != "" && viper.GetString("secret-key") != "" {
+		return doctorFinding{Check: "credentials.aws", Status: doctorOK, Message: "found on ACCESS_KEY and SECRET_KEY"}
+	}
+
+	creds := credentials.NewCredentials(&credentials.ChainProvider{
+		Providers: []credentials.Provider{
+			&credentials.EnvProvider{},
+			&credentials.SharedCredentialsProvider{Filename: viper.GetString("aws-shared-credentials-file"), Profile: viper.GetString("aws-profile")},
+		},
+	})
+
+	value, err := creds.Get()
+	if err != nil {
+		if awsE, ok := err.(awserr.Error); ok && awsE.Code() == "NoCredentialProviders" {
+			return doctorFinding{Check: "credentials.aws", Status: doctorWarning, Message: "no credentials found, use --access-key and --secret-key, AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY or a shared credentials file (--aws-shared-credentials-file, --aws-profile)"}
+		}
+		return doctorFinding{Check: "credentials.aws", Status: doctorError, Message: err.Error()}
+	}
+
+	return doctorFinding{Check: "credentials.aws", Status: doctorOK, Message: fmt.Sprintf("found on %s", value.ProviderName)}
+}
+
+// checkDoctorAzureRMCredentials checks the credentials of the chain used by
+// AzureRM: the client secret, the managed identity and the Azure CLI
+func checkDoctorAzureRMCredentials() doctorFinding {
+	for k, e := range azurermEnvs {
+		viper.BindEnv(k, e)
+	}
+
+	if viper.GetString("client-secret") != "" {
+		return checkDoctorRequiredKeys("credentials.azurerm", "client-id", "client-secret", "subscription-id", "tenant-id")
+	}
+
+	if viper.GetBool("use-msi") {
+		return doctorFinding{Check: "credentials.azurerm", Status: doctorOK, Message: "managed identity with USE_MSI"}
+	}
+
+	// The profile written by 'az login'
+	if home, err := os.UserHomeDir(); err == nil {
+		if _, err := os.Stat(filepath.Join(home, ".azure", "azureProfile.json")); err == nil {
+			return doctorFinding{Check: "credentials.azurerm", Status: doctorOK, Message: "logged account of the Azure CLI"}
+		}
+	}
+
+	return doctorFinding{Check: "credentials.azurerm", Status: doctorWarning, Message: "no credentials found, set CLIENT_ID, CLIENT_SECRET, SUBSCRIPTION_ID and TENANT_ID (or ARM_*), USE_MSI for the managed identity or 'az login'"}
+}
+
+// checkDoctorRequiredKeys checks that all the keys are set, as flags
+// can not be set on the doctor command it'll only find the ENV ones
+func checkDoctorRequiredKeys(check string, keys ...string) doctorFinding {
+	var missing []string
+	for _, k := range keys {
+		if viper.GetString(k) == "" {
+			missing = append(missing, strings.ToUpper(strings.Replace(k, "-", "_", -1)))
+		}
+	}
+
+	if len(missing) == len(keys) {
+		return doctorFinding{Check: check, Status: doctorWarning, Message: fmt.Sprintf("no credentials found, set %s", strings.Join(missing, ", "))}
+	} else if len(missing) != 0 {
+		return doctorFinding{Check: check, Status: doctorError, Message: fmt.Sprintf("partial credentials found, missing %s", strings.Join(missing, ", "))}
+	}
+
+	return doctorFinding{Check: check, Status: doctorOK, Message: "found on the ENV"}
+}
+
+// checkDoctorSchema checks that the embedded schema can be loaded
+// and that it matches the resource types of each Provider
+func checkDoctorSchema() []doctorFinding {
+	s, err := schema.Get()
+	if err != nil {
+		return []doctorFinding{{Check: "schema", Status: doctorError, Message: fmt.Sprintf("the embedded schema can not be loaded: %s", err)}}
+	}
+
+	findings := []doctorFinding{{Check: "schema", Status: doctorOK, Message: fmt.Sprintf("version %d", s.Version)}}
+	for _, pc := range providerChecks {
+		check := "schema." + pc.name
+		rs, err := s.Resources(pc.name)
+		if err != nil {
+			findings = append(findings, doctorFinding{Check: check, Status: doctorError, Message: err.Error()})
+			continue
+		}
+
+		if !equalStrings(rs, pc.resourceTypes()) {
+			findings = append(findings, doctorFinding{Check: check, Status: doctorError, Message: "the embedded schema does not match the supported resource types, it has to be regenerated with 'go generate ./schema'"})
+			continue
+		}
+
+		findings = append(findings, doctorFinding{Check: check, Status: doctorOK, Message: fmt.Sprintf("%d resource types", len(rs))})
+	}
+
+	return findings
+}
+
+// checkDoctorOutputs checks that the output paths can be written
+func checkDoctorOutputs() []doctorFinding {
+	var findings []doctorFinding
+	for _, k := range []string{"hcl", "tfstate", "module"} {
+		p := viper.GetString(k)
+		if p == "" {
+			continue
+		}
+
+		check := "output." + k
+		// The remote backends are checked when writing
+		if k == "tfstate" && backend.IsRemote(p) {
+			findings = append(findings, doctorFinding{Check: check, Status: doctorOK, Message: p})
+			continue
+		}
+		if err := checkWritableDir(p); err != nil {
+			findings = append(findings, doctorFinding{Check: check, Status: doctorError, Message: fmt.Sprintf("%q is not writable: %s", p, err)})
+			continue
+		}
+		findings = append(findings, doctorFinding{Check: check, Status: doctorOK, Message: p})
+	}
+
+	if len(findings) == 0 {
+		findings = append(findings, doctorFinding{Check: "output", Status: doctorWarning, Message: "no output defined, use --hcl, --tfstate or --module"})
+	}
+
+	return findings
+}
+
+// checkWritableDir checks if the directory of p, or the closest
+// existing parent one, is writable by creating a temporary file on it
+func checkWritableDir(p string) error {
+	dir := p
+	for {
+		fi, err := os.Stat(dir)
+		if err == nil {
+			if !fi.IsDir() {
+				dir = filepath.Dir(dir)
+			}
+			break
+		}
+		if !os.IsNotExist(err) {
+			return err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return errors.Errorf("no existing directory found")
+		}
+		dir = parent
+	}
+
+	f, err := os.CreateTemp(dir, ".terracognita-doctor-")
+	if err != nil {
+		return err
+	}
+	f.Close()
+
+	return os.Remove(f.Name())
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
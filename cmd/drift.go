@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cycloidio/terracognita/backend"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/state"
+)
+
+var (
+	// driftPrev is the TFState of the --tfstate
+	// compared on the 'drift' command
+	driftPrev *statefile.File
+
+	// driftResult is the result of comparing the
+	// driftPrev with the resources imported
+	driftResult *state.DriftResult
+)
+
+// newDriftCmd returns the 'drift' command of the provider command p, which
+// imports the resources as p does but only to compare them with the --tfstate.
+// The bindFlags binds the flags of p needed to initialize the provider
+func newDriftCmd(p *cobra.Command, bindFlags func(cmd *cobra.Command)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: fmt.Sprintf("Compares the %s resources with an existing TFState", p.Name()),
+		Long:  fmt.Sprintf("Reads the %s resources (filtered with --include, --exclude, --target and the tags) and compares them with the --tfstate, reporting the resources that changed, the ones that no longer exist and the ones that are not on it, without writing any HCL or TFState", p.Name()),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			bindFlags(cmd)
+			viper.BindPFlag("json", cmd.Flags().Lookup("json"))
+
+			return preRunEDrift(cmd)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return p.RunE(cmd, args)
+		},
+		PostRunE: func(cmd *cobra.Command, args []string) error {
+			return postRunEDrift(cmd)
+		},
+	}
+
+	cmd.Flags().Bool("json", false, "Prints the drift as JSON")
+
+	return cmd
+}
+
+// preRunEDrift validates the flags of the 'drift' command
+// and reads the TFState to compare from the --tfstate
+func preRunEDrift(cmd *cobra.Command) error {
+	if err := requiredStringFlags("tfstate"); err != nil {
+		return err
+	}
+
+	// Nothing is written so the flags
+	// of the outputs can not be used
+	for _, fl := range []string{"hcl", "module", "json-output", "graph", "archive-dir", "checkpoint", "encrypt-output"} {
+		if viper.GetString(fl) != "" {
+			return fmt.Errorf("the --%s is not supported with the drift command", fl)
+		}
+	}
+	for _, fl := range []string{"merge-state", "generate-import-blocks"} {
+		if viper.GetBool(fl) {
+			return fmt.Errorf("the --%s is not supported with the drift command", fl)
+		}
+	}
+	if viper.GetDuration("max-duration") != 0 {
+		return fmt.Errorf("the --max-duration is not supported with the drift command")
+	}
+
+	ctx := context.Background()
+	tfstate := viper.GetString("tfstate")
+	if backend.IsRemote(tfstate) {
+		b, err := backend.New(ctx, tfstate)
+		if err != nil {
+			return fmt.Errorf("invalid --tfstate: %w", err)
+		}
+		driftPrev, err = b.Read(ctx)
+		if err != nil {
+			return fmt.Errorf("could not read the TFState %s: %w", tfstate, err)
+		}
+	} else {
+		f, err := os.Open(tfstate)
+		if err != nil {
+			return fmt.Errorf("could not Open %s because: %w", tfstate, err)
+		}
+		driftPrev, err = statefile.Read(f)
+		f.Close()
+		if err != nil && !errors.Is(err, statefile.ErrNoState) {
+			return fmt.Errorf("could not read the TFState %s: %w", tfstate, err)
+		}
+	}
+	if driftPrev == nil {
+		driftPrev = &statefile.File{}
+	}
+
+	// The progress of the import is written to the
+	// Stderr so the Stdout only has the drift
+	if !viper.GetBool("verbose") && !viper.GetBool("debug") {
+		logsOut = cmd.ErrOrStderr()
+	}
+
+	// The resources imported are
+	// only kept on memory
+	stateOut = &bytes.Buffer{}
+
+	return nil
+}
+
+// setDrift compares the TFState imported from the Provider p
+// with the driftPrev, the resources of the driftPrev that could
+// not be read with the f are not reported as missing
+func setDrift(p provider.Provider, f *filter.Filter) error {
+	current, err := statefile.Read(stateOut.(*bytes.Buffer))
+	if err != nil && !errors.Is(err, statefile.ErrNoState) {
+		return fmt.Errorf("could not read the TFState imported: %w", err)
+	}
+
+	// With the filters of the resources (not of the types)
+	// it's not known if a resource is missing or filtered
+	filtered := len(f.Targets) != 0 || len(f.Tags) != 0 || !f.CreatedAfter.IsZero() || !f.CreatedBefore.IsZero()
+
+	d := state.Drift(driftPrev, current, func(rt string) bool {
+		return !filtered && p.HasResourceType(rt) && f.IsIncluded(rt) && !f.IsExcluded(rt)
+	})
+	driftResult = &d
+
+	return nil
+}
+
+// postRunEDrift prints the driftResult
+func postRunEDrift(cmd *cobra.Command) error {
+	if driftResult == nil {
+		return nil
+	}
+
+	out := cmd.OutOrStdout()
+	if viper.GetBool("json") {
+		b, err := json.MarshalIndent(driftResult, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(b))
+		return nil
+	}
+
+	if !driftResult.HasDrift() {
+		fmt.Fprintf(out, "No drift detected with %s\n", viper.GetString("tfstate"))
+		return nil
+	}
+
+	fmt.Fprintf(out, "Drift detected with %s: %d changed, %d missing, %d unmanaged\n", viper.GetString("tfstate"), len(driftResult.Changed), len(driftResult.Missing), len(driftResult.Unmanaged))
+	for _, c := range driftResult.Changed {
+		fmt.Fprintf(out, "~ %s (%s)\n", c.Address, strings.Join(c.Attributes, ", "))
+	}
+	for _, r := range driftResult.Missing {
+		fmt.Fprintf(out, "- %s\n", r)
+	}
+	for _, r := range driftResult.Unmanaged {
+		fmt.Fprintf(out, "+ %s\n", r)
+	}
+
+	return nil
+}
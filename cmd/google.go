@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	kitlog "github.com/go-kit/kit/log"
 	"github.com/spf13/cobra"
@@ -23,11 +24,7 @@ var (
 			if err != nil {
 				return err
 			}
-			viper.BindPFlag("credentials", cmd.Flags().Lookup("credentials"))
-			viper.BindPFlag("project", cmd.Flags().Lookup("project"))
-			viper.BindPFlag("region", cmd.Flags().Lookup("region"))
-			viper.BindPFlag("labels", cmd.Flags().Lookup("labels"))
-			viper.BindPFlag("max-results", cmd.Flags().Lookup("max-results"))
+			bindGoogleFlags(cmd)
 
 			return nil
 		},
@@ -36,9 +33,12 @@ var (
 			logger := log.Get()
 			logger = kitlog.With(logger, "func", "cmd.google.RunE")
 			// Validate required flags
-			if err := requiredStringFlags("region", "project", "credentials"); err != nil {
+			if err := requiredStringFlags("region"); err != nil {
 				return err
 			}
+			if len(viper.GetStringSlice("project")) == 0 {
+				return fmt.Errorf("the flag 'project' is required")
+			}
 
 			// Initialize the tags
 			tags := make([]tag.Tag, 0, len(viper.GetStringSlice("labels")))
@@ -49,21 +49,41 @@ var (
 				}
 				tags = append(tags, tg)
 			}
+			for _, l := range viper.GetStringSlice("filter-label") {
+				kv := strings.SplitN(l, "=", 2)
+				if len(kv) != 2 || kv[0] == "" {
+					return fmt.Errorf("invalid format for --filter-label with value %q, the expected format is 'KEY=VALUE'", l)
+				}
+				tags = append(tags, tag.Tag{Name: kv[0], Value: kv[1]})
+			}
 
 			ctx := context.Background()
 
-			googleP, err := google.NewProvider(
+			projects, err := google.ExpandProjects(ctx, viper.GetString("credentials"), viper.GetString("gcp-impersonate-service-account"), viper.GetStringSlice("project"))
+			if err != nil {
+				return err
+			}
+			if len(projects) == 0 {
+				return fmt.Errorf("no active projects found on %s", strings.Join(viper.GetStringSlice("project"), ", "))
+			}
+			logger.Log("msg", "importing projects", "projects", strings.Join(projects, ","))
+
+			googleP, err := google.NewMultiProjectProvider(
 				ctx,
 				viper.GetUint64("max-results"),
-				viper.GetString("project"),
+				projects,
 				viper.GetString("region"),
 				viper.GetString("credentials"),
+				google.Options{
+					ImpersonateServiceAccount: viper.GetString("gcp-impersonate-service-account"),
+					IAMStyle:                  viper.GetString("iam-style"),
+				},
 			)
 			if err != nil {
 				return err
 			}
 
-			err = importProvider(ctx, logger, googleP, tags)
+			err = importProvider(ctx, logger, googleP, tags, noCreatedWindow)
 			if err != nil {
 				return err
 			}
@@ -75,15 +95,32 @@ var (
 
 func init() {
 	googleCmd.AddCommand(googleResourcesCmd)
+	googleCmd.AddCommand(newDriftCmd(googleCmd, bindGoogleFlags))
 
 	// Required flags
-	googleCmd.Flags().String("credentials", "", "path to the JSON credential (required)")
-	googleCmd.Flags().String("project", "", "project (required)")
-	googleCmd.Flags().String("region", "", "region (required)")
+	googleCmd.PersistentFlags().StringSlice("project", nil, "List of projects, a 'folders/ID' or 'organizations/ID' imports all the active projects inside of it (required)")
+	googleCmd.PersistentFlags().String("region", "", "region (required)")
 
 	// Filter flags
-	googleCmd.Flags().StringSliceVarP(&tags, "labels", "t", []string{}, "List of labels to filter with format 'NAME:VALUE'")
+	googleCmd.PersistentFlags().StringSliceVarP(&tags, "labels", "t", []string{}, "List of labels to filter with format 'NAME:VALUE'")
+	googleCmd.PersistentFlags().StringSlice("filter-label", []string{}, "List of labels to filter with format 'KEY=VALUE', the resources that support it are filtered on the list calls (ex: 'env=prod')")
 
 	// Optional flags
-	googleCmd.Flags().Uint64("max-results", 500, "max results to fetch when pagination is used")
+	googleCmd.PersistentFlags().String("credentials", "", "path to the JSON credential, a service account key or an external account (Workload Identity Federation) configuration. If not set the Application Default Credentials are used")
+	googleCmd.PersistentFlags().String("gcp-impersonate-service-account", "", "email of the service account to impersonate, the credentials need the 'roles/iam.serviceAccountTokenCreator' role on it")
+	googleCmd.PersistentFlags().Uint64("max-results", 500, "max results to fetch when pagination is used")
+	googleCmd.PersistentFlags().String("iam-style", google.IAMStyleMember, "Style used to import the IAM Policy of the projects, 'member' (google_project_iam_member), 'binding' (google_project_iam_binding) or 'policy' (google_project_iam_policy)")
+}
+
+// bindGoogleFlags binds all the Google flags of the cmd to viper,
+// it's used by all the Google commands that need to connect to GCP
+func bindGoogleFlags(cmd *cobra.Command) {
+	viper.BindPFlag("credentials", cmd.Flags().Lookup("credentials"))
+	viper.BindPFlag("project", cmd.Flags().Lookup("project"))
+	viper.BindPFlag("region", cmd.Flags().Lookup("region"))
+	viper.BindPFlag("labels", cmd.Flags().Lookup("labels"))
+	viper.BindPFlag("filter-label", cmd.Flags().Lookup("filter-label"))
+	viper.BindPFlag("max-results", cmd.Flags().Lookup("max-results"))
+	viper.BindPFlag("iam-style", cmd.Flags().Lookup("iam-style"))
+	viper.BindPFlag("gcp-impersonate-service-account", cmd.Flags().Lookup("gcp-impersonate-service-account"))
 }
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cycloidio/terracognita/archive"
+)
+
+var (
+	historyCmd = &cobra.Command{
+		Use:   "history",
+		Short: "Shows the Snapshots archived with --archive-dir",
+		Long:  "Shows the Snapshots archived with --archive-dir on each run, and how the imported resources changed between them",
+	}
+
+	historyListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "Lists the Snapshots archived",
+		Long:  "Lists the timestamps of the Snapshots archived on the --archive-dir with the provider and the number of resources of each one",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := requiredStringFlags("archive-dir"); err != nil {
+				return err
+			}
+
+			dir := viper.GetString("archive-dir")
+			ts, err := archive.List(dir)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			for _, t := range ts {
+				s, err := archive.Read(dir, t)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d resources\n", t, s.Manifest.Provider, s.Manifest.Region, len(s.Resources))
+			}
+			w.Flush()
+
+			return nil
+		},
+	}
+
+	historyDiffCmd = &cobra.Command{
+		Use:   "diff <t1> <t2>",
+		Short: "Shows the changes between two Snapshots",
+		Long:  "Shows the resources added and removed from the Snapshot t1 to the t2, which are the timestamps of the Snapshots archived on the --archive-dir (see 'history list') or the paths to them",
+		Args:  cobra.ExactArgs(2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			viper.BindPFlag("json", cmd.Flags().Lookup("json"))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir := viper.GetString("archive-dir")
+			from, err := archive.Read(dir, args[0])
+			if err != nil {
+				return err
+			}
+			to, err := archive.Read(dir, args[1])
+			if err != nil {
+				return err
+			}
+
+			d := archive.NewDiff(*from, *to)
+
+			if viper.GetBool("json") {
+				b, err := json.MarshalIndent(d, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(b))
+				return nil
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "From %s (%d resources) to %s (%d resources)\n", args[0], len(from.Resources), args[1], len(to.Resources))
+			for _, r := range d.Added {
+				fmt.Fprintf(out, "+ %s\n", r)
+			}
+			for _, r := range d.Removed {
+				fmt.Fprintf(out, "- %s\n", r)
+			}
+
+			// The change of the number of
+			// resources of each type
+			types := make(map[string]struct{})
+			for t := range from.Manifest.Counts {
+				types[t] = struct{}{}
+			}
+			for t := range to.Manifest.Counts {
+				types[t] = struct{}{}
+			}
+			sorted := make([]string, 0, len(types))
+			for t := range types {
+				if from.Manifest.Counts[t] != to.Manifest.Counts[t] {
+					sorted = append(sorted, t)
+				}
+			}
+			sort.Strings(sorted)
+
+			if len(sorted) != 0 {
+				fmt.Fprintf(out, "Resource types changed:\n")
+				w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+				for _, t := range sorted {
+					fmt.Fprintf(w, "\t%s\t%d\t->\t%d\n", t, from.Manifest.Counts[t], to.Manifest.Counts[t])
+				}
+				w.Flush()
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	historyCmd.AddCommand(historyListCmd)
+	historyCmd.AddCommand(historyDiffCmd)
+
+	historyDiffCmd.Flags().Bool("json", false, "Prints the diff as JSON")
+}
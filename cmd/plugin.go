@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cycloidio/terracognita/log"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+var (
+	pluginCmd = &cobra.Command{
+		Use:   "plugin [NAME]",
+		Short: "Terracognita reads from a Provider plugin and generates hcl resources and/or terraform state",
+		Long:  "Terracognita reads from a Provider plugin (terracognita-provider-NAME binary) found on the --plugin-dir and generates hcl resources and/or terraform state. Without NAME it lists the plugins found",
+		Args:  cobra.MaximumNArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			viper.BindPFlag("plugin-dir", cmd.Flags().Lookup("plugin-dir"))
+			viper.BindPFlag("plugin-config", cmd.Flags().Lookup("plugin-config"))
+
+			if len(args) == 0 {
+				return nil
+			}
+
+			return preRunEOutput(cmd, args)
+		},
+		PostRunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return nil
+			}
+
+			return postRunEOutput(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger := log.Get()
+			logger = kitlog.With(logger, "func", "cmd.plugin.RunE")
+
+			dir, err := pluginDir()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				plugins, err := provider.Plugins(dir)
+				if err != nil {
+					return err
+				}
+				for _, n := range provider.PluginNames(plugins) {
+					fmt.Fprintln(cmd.OutOrStdout(), n)
+				}
+				return nil
+			}
+
+			cfg := make(map[string]string, len(viper.GetStringSlice("plugin-config")))
+			for _, c := range viper.GetStringSlice("plugin-config") {
+				kv := strings.SplitN(c, "=", 2)
+				if len(kv) != 2 || kv[0] == "" {
+					return fmt.Errorf("invalid format for --plugin-config with value %q, the expected format is 'KEY=VALUE'", c)
+				}
+				cfg[kv[0]] = kv[1]
+			}
+
+			pl, err := provider.OpenPlugin(dir, args[0])
+			if err != nil {
+				return err
+			}
+			defer pl.Close()
+
+			ctx := context.Background()
+
+			p, err := pl.NewProvider(ctx, cfg)
+			if err != nil {
+				return fmt.Errorf("unable to initialize the plugin %s: %w", args[0], err)
+			}
+
+			err = importProvider(ctx, logger, p, noTags, noCreatedWindow)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+)
+
+// pluginDir returns the --plugin-dir or, if not set,
+// the default one on '~/.terracognita/plugins'
+func pluginDir() (string, error) {
+	if d := viper.GetString("plugin-dir"); d != "" {
+		return d, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("could not find the default --plugin-dir: %w", err)
+	}
+
+	return filepath.Join(home, ".terracognita", "plugins"), nil
+}
+
+func init() {
+	pluginCmd.Flags().String("plugin-dir", "", "Directory with the Provider plugins, by default '~/.terracognita/plugins'")
+	pluginCmd.Flags().StringSlice("plugin-config", []string{}, "List of configurations passed to the Provider plugin with format 'KEY=VALUE' (ex: 'region=eu-west-1')")
+}
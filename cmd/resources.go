@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cycloidio/terracognita/schema"
+)
+
+var (
+	resourcesCmd = &cobra.Command{
+		Use:   "resources",
+		Short: "List of all the supported Resources of all the Providers",
+		Long:  "List of all the supported Resources of all the Providers from the embedded versioned snapshot of the schema, generated from the Providers on each release, so the supported Resources can be compared between releases",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			viper.BindPFlag("schema-version", cmd.Flags().Lookup("schema-version"))
+			viper.BindPFlag("provider", cmd.Flags().Lookup("provider"))
+			viper.BindPFlag("json", cmd.Flags().Lookup("json"))
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := schema.Get()
+			if err != nil {
+				return err
+			}
+
+			if viper.GetBool("schema-version") {
+				fmt.Fprintln(cmd.OutOrStdout(), s.Version)
+				return nil
+			}
+
+			if p := viper.GetString("provider"); p != "" {
+				rs, err := s.Resources(p)
+				if err != nil {
+					return err
+				}
+				s.Providers = map[string][]string{p: rs}
+			}
+
+			if viper.GetBool("json") {
+				b, err := json.MarshalIndent(s, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(b))
+				return nil
+			}
+
+			for _, p := range s.ProviderNames() {
+				for _, r := range s.Providers[p] {
+					fmt.Fprintln(cmd.OutOrStdout(), r)
+				}
+			}
+
+			return nil
+		},
+	}
+)
+
+func init() {
+	resourcesCmd.Flags().Bool("schema-version", false, "Prints only the version of the schema, it changes each time the list of supported Resources changes")
+	resourcesCmd.Flags().String("provider", "", "Lists only the Resources of the Provider (aws, azurerm, google, vsphere)")
+	resourcesCmd.Flags().Bool("json", false, "Prints the schema as JSON with the version and the Resources of each Provider")
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -10,34 +11,92 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"text/template"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/adrg/xdg"
 	"github.com/cycloidio/mxwriter"
+	"github.com/cycloidio/terracognita/archive"
+	"github.com/cycloidio/terracognita/backend"
+	"github.com/cycloidio/terracognita/cdktf"
+	"github.com/cycloidio/terracognita/encrypt"
 	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/graph"
 	"github.com/cycloidio/terracognita/hcl"
+	"github.com/cycloidio/terracognita/inventory"
 	"github.com/cycloidio/terracognita/log"
+	"github.com/cycloidio/terracognita/profile"
 	"github.com/cycloidio/terracognita/provider"
 	"github.com/cycloidio/terracognita/state"
 	"github.com/cycloidio/terracognita/tag"
+	"github.com/cycloidio/terracognita/transform"
 	"github.com/cycloidio/terracognita/writer"
 	kitlog "github.com/go-kit/kit/log"
+	"github.com/hashicorp/terraform/states/statefile"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"gopkg.in/yaml.v2"
 )
 
+// defaultCheckpointPath is the file used to write the
+// checkpoint if none is specified
+const defaultCheckpointPath = "terracognita-checkpoint.json"
+
+const (
+	// hclFormat, jsonFormat and cdktfFormat are
+	// the supported values of the --format
+	hclFormat   = "hcl"
+	jsonFormat  = "tf.json"
+	cdktfFormat = "cdktf-ts"
+
+	// streamNDJSON is the supported value of the --stream
+	streamNDJSON = "ndjson"
+)
+
 var (
 	isHCLDir bool
 	noTags   []tag.Tag = nil
 	hclOut   io.ReadWriter
 	stateOut io.Writer
 
+	// noCreatedWindow is used by the providers
+	// without the creation date filters
+	noCreatedWindow createdWindow
+
+	// hclBlocks are the blocks already written on
+	// the --hcl directory, if the new ones are appended
+	hclBlocks map[string]struct{}
+
+	// importsOut has the import blocks
+	// if the --generate-import-blocks is defined
+	importsOut *bytes.Buffer
+
+	// inventoryOut has the JSON of the resources
+	// if the --json-output is defined
+	inventoryOut *bytes.Buffer
+
+	// graphOut has the dependency graph
+	// if the --graph is defined
+	graphOut *bytes.Buffer
+
+	// stateBackend is the remote backend to which
+	// the TFState is pushed if the --tfstate is an URL
+	stateBackend backend.Backend
+
+	// encryptOut is used to encrypt the outputs
+	// if the --encrypt-output is defined
+	encryptOut encrypt.Encrypter
+
 	closeOut = make([]io.Closer, 0, 0)
 
-	include, exclude, targets []string
-	logsOut                   io.Writer
+	include, exclude, targets, forceTypes []string
+	logsOut                               io.Writer
+
+	// profiler is used to profile the run
+	// if the --profile is defined
+	profiler *profile.Profiler
 
 	// RootCmd it's the entry command for the cmd on terracognita
 	RootCmd = &cobra.Command{
@@ -54,24 +113,60 @@ var (
 				return err
 			}
 			closeOut = append(closeOut, logFile)
+
+			// With --stream the Stdout is only used for the
+			// events so the logs are written to the Stderr
+			var stdout io.Writer = os.Stdout
+			if s := viper.GetString("stream"); s != "" && s != streamNDJSON {
+				return fmt.Errorf("invalid --stream %q, the supported one is %s", s, streamNDJSON)
+			} else if s == streamNDJSON {
+				stdout = os.Stderr
+			}
+
 			// Initialize the logs by setting by default the logs
 			// to Stdout, but if 'v' or 'd' is defined the logger
 			// will be initialized and structured logs will be used
 			// and if 'd' it's defined TF_LOG will be used too
 			if viper.GetBool("verbose") || viper.GetBool("debug") {
 				logsOut = ioutil.Discard
-				w := io.MultiWriter(os.Stdout, logFile)
+				w := io.MultiWriter(stdout, logFile)
 				log.Init(w, viper.GetBool("debug"))
 			} else {
-				logsOut = os.Stdout
+				logsOut = stdout
 				log.Init(logFile, false)
 			}
 
+			if viper.GetString("stream") == streamNDJSON {
+				logsOut = provider.NewNDJSONWriter(logsOut, os.Stdout)
+			}
+
+			if kinds := viper.GetStringSlice("profile"); len(kinds) != 0 {
+				profiler, err = profile.Start(viper.GetString("profile-dir"), kinds)
+				if err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
+		PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+			return StopProfile()
+		},
 	}
 )
 
+// StopProfile stops the profiling of the run started with the --profile,
+// it has to be called also when the command fails to write the profiles
+func StopProfile() error {
+	if profiler == nil {
+		return nil
+	}
+
+	err := profiler.Stop()
+	profiler = nil
+	return err
+}
+
 func requiredStringFlags(names ...string) error {
 	for _, n := range names {
 		if viper.GetString(n) == "" {
@@ -82,7 +177,83 @@ func requiredStringFlags(names ...string) error {
 	return nil
 }
 
+// confirm asks for confirmation with the msg and returns an error
+// if it's not accepted. The question is written to the Stderr
+// so the Stdout is kept for the output, like the --stream events
+func confirm(msg string) error {
+	fmt.Fprintf(os.Stderr, "%s Yes/No (Y/N):\n", msg)
+	var s string
+	fmt.Scanf("%s\n", &s)
+	s = strings.ToLower(s)
+	if s != "yes" && s != "y" {
+		return errors.New("the import was stopped")
+	}
+	return nil
+}
+
 func preRunEOutput(cmd *cobra.Command, args []string) error {
+	if f := viper.GetString("format"); f != hclFormat && f != jsonFormat && f != cdktfFormat {
+		return fmt.Errorf("invalid --format %q, the supported ones are %s, %s and %s", f, hclFormat, jsonFormat, cdktfFormat)
+	}
+
+	// The CDKTF only has the Resources and the
+	// Provider, without variables nor data blocks
+	if viper.GetString("format") == cdktfFormat {
+		unsupported := map[string]bool{
+			"module":                   viper.GetString("module") != "",
+			"parameterize":             len(viper.GetStringSlice("parameterize")) != 0,
+			"extract-variables":        viper.GetBool("extract-variables"),
+			"depends-on":               viper.GetBool("depends-on"),
+			"redact-secrets":           viper.GetBool("redact-secrets"),
+			"transformations":          viper.GetString("transformations") != "",
+			"external-references-data": viper.GetBool("external-references-data"),
+			"generate-import-blocks":   viper.GetBool("generate-import-blocks"),
+		}
+		for _, fl := range []string{"module", "parameterize", "extract-variables", "depends-on", "redact-secrets", "transformations", "external-references-data", "generate-import-blocks"} {
+			if unsupported[fl] {
+				return fmt.Errorf("the --%s is not supported with the --format %s", fl, cdktfFormat)
+			}
+		}
+	}
+
+	if eo := viper.GetString("encrypt-output"); eo != "" {
+		e, err := encrypt.New(eo)
+		if err != nil {
+			return fmt.Errorf("invalid --encrypt-output: %w", err)
+		}
+		encryptOut = e
+	} else if viper.GetBool("encrypt-hcl") {
+		return fmt.Errorf("the --encrypt-hcl requires the --encrypt-output")
+	}
+
+	if viper.GetBool("extract-variables") && viper.GetInt("extract-variables-threshold") < 2 {
+		return fmt.Errorf("the --extract-variables-threshold has to be at least 2")
+	}
+
+	if ml := viper.GetString("module-layout"); ml != "" {
+		if ml != writer.ModuleLayoutService && ml != writer.ModuleLayoutRegion && ml != writer.ModuleLayoutResourceGroup {
+			return fmt.Errorf("invalid --module-layout %q, the supported ones are %s, %s and %s", ml, writer.ModuleLayoutService, writer.ModuleLayoutRegion, writer.ModuleLayoutResourceGroup)
+		}
+		if viper.GetString("module") == "" {
+			return fmt.Errorf("the --module-layout requires the --module")
+		}
+		// The nested modules only have the resources
+		// and the variables and outputs between them
+		unsupported := map[string]bool{
+			"parameterize":             len(viper.GetStringSlice("parameterize")) != 0,
+			"extract-variables":        viper.GetBool("extract-variables"),
+			"depends-on":               viper.GetBool("depends-on"),
+			"redact-secrets":           viper.GetBool("redact-secrets"),
+			"external-references-data": viper.GetBool("external-references-data"),
+			"generate-import-blocks":   viper.GetBool("generate-import-blocks"),
+		}
+		for _, fl := range []string{"parameterize", "extract-variables", "depends-on", "redact-secrets", "external-references-data", "generate-import-blocks"} {
+			if unsupported[fl] {
+				return fmt.Errorf("the --%s is not supported with the --module-layout", fl)
+			}
+		}
+	}
+
 	// Initializes/Validates the HCL and TFSTATE flags
 	if module := viper.GetString("module"); module != "" {
 
@@ -101,12 +272,9 @@ func preRunEOutput(cmd *cobra.Command, args []string) error {
 		// so we'll ask for confirmation before deleting the
 		// existent content
 		if err == nil {
-			fmt.Printf("We are about to remove all content from %q, are you sure? Yes/No (Y/N):\n", module)
-			var s string
-			fmt.Scanf("%s\n", &s)
-			s = strings.ToLower(s)
-			if s != "yes" && s != "y" {
-				return errors.New("the import was stopped")
+			err = confirm(fmt.Sprintf("We are about to remove all content from %q, are you sure?", module))
+			if err != nil {
+				return err
 			}
 		}
 
@@ -125,33 +293,48 @@ func preRunEOutput(cmd *cobra.Command, args []string) error {
 		}
 
 		hclOut = mxwriter.NewMux()
-	} else if hcl := viper.GetString("hcl"); hcl != "" {
+	} else if hclPath := viper.GetString("hcl"); hclPath != "" {
 		// We check if there is any error checking it
 		// if its NotExist we do not care as we'll create it
-		fi, err := os.Stat(hcl)
+		fi, err := os.Stat(hclPath)
 		if err != nil && !os.IsNotExist(err) {
 			return err
 		}
-		hasExt := filepath.Ext(hcl) != ""
+		hasExt := filepath.Ext(hclPath) != ""
 		if (err == nil && !fi.IsDir()) || hasExt {
 			isHCLDir = false
+		} else if err == nil && viper.GetString("format") == hclFormat && !viper.GetBool("encrypt-hcl") && !viper.GetBool("hcl-overwrite") {
+			isHCLDir = true
+			// The resources of an existing directory are kept,
+			// with the changes done on them, and only the new
+			// ones are appended. The encrypted HCL and the
+			// other formats can not be read so are overwritten
+			hclBlocks, err = hcl.ReadBlocks(hclPath)
+			if err != nil {
+				return fmt.Errorf("could not read the HCL of %q: %w", hclPath, err)
+			}
 		} else {
 			isHCLDir = true
 			// It means is a existing directory
 			// so we'll ask for confirmation before deleting the
 			// existent content
 			if err == nil {
-				fmt.Printf("We are about to remove all content from %q, are you sure? Yes/No (Y/N):\n", hcl)
-				var s string
-				fmt.Scanf("%s\n", &s)
-				s = strings.ToLower(s)
-				if s != "yes" && s != "y" {
-					return errors.New("the import was stopped")
+				// The content is only appended with the HCL format
+				// so we say why it'll be removed instead
+				reason := "--hcl-overwrite"
+				if viper.GetBool("encrypt-hcl") {
+					reason = "--encrypt-hcl"
+				} else if f := viper.GetString("format"); f != hclFormat {
+					reason = fmt.Sprintf("--format %s", f)
+				}
+				err = confirm(fmt.Sprintf("With the %s the new resources can not be appended to %q so we are about to remove all its content instead, are you sure?", reason, hclPath))
+				if err != nil {
+					return err
 				}
 			}
 
 			// Clean the module dir
-			err = os.RemoveAll(hcl)
+			err = os.RemoveAll(hclPath)
 			if err != nil {
 				return err
 			}
@@ -159,7 +342,7 @@ func preRunEOutput(cmd *cobra.Command, args []string) error {
 			// Recreate it just if it was not created
 			// RemoveAll will not return error if
 			// it does not exists
-			err = os.MkdirAll(hcl, 0700)
+			err = os.MkdirAll(hclPath, 0700)
 			if err != nil {
 				return err
 			}
@@ -167,7 +350,32 @@ func preRunEOutput(cmd *cobra.Command, args []string) error {
 
 		hclOut = mxwriter.NewMux()
 	}
-	if viper.GetString("tfstate") != "" {
+	if tfstate := viper.GetString("tfstate"); backend.IsRemote(tfstate) {
+		// The remote backends have their own encryption
+		if encryptOut != nil {
+			return fmt.Errorf("the --encrypt-output is not supported with a remote --tfstate")
+		}
+		b, err := backend.New(context.Background(), tfstate)
+		if err != nil {
+			return fmt.Errorf("invalid --tfstate: %w", err)
+		}
+		stateBackend = b
+		// The State is merged with the remote
+		// one once it has all the content
+		stateOut = &bytes.Buffer{}
+	} else if viper.GetString("tfstate") != "" && viper.GetBool("merge-state") {
+		// The encrypted State can not be read to merge it
+		if encryptOut != nil {
+			return fmt.Errorf("the --encrypt-output is not supported with the --merge-state")
+		}
+		// The State is merged with the existing
+		// one once it has all the content
+		stateOut = &bytes.Buffer{}
+	} else if viper.GetString("tfstate") != "" && encryptOut != nil {
+		// The State is encrypted and written
+		// once it has all the content
+		stateOut = &bytes.Buffer{}
+	} else if viper.GetString("tfstate") != "" {
 		f, err := os.OpenFile(viper.GetString("tfstate"), os.O_APPEND|os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
 		if err != nil {
 			return fmt.Errorf("could not OpenFile %s because: %s", viper.GetString("tfstate"), err)
@@ -176,8 +384,23 @@ func preRunEOutput(cmd *cobra.Command, args []string) error {
 		closeOut = append(closeOut, f)
 	}
 
-	if viper.GetString("tfstate") == "" && viper.GetString("hcl") == "" && viper.GetString("module") == "" {
-		return fmt.Errorf("one of --module, --hcl  or --tfstate are required")
+	if viper.GetBool("generate-import-blocks") {
+		importsOut = &bytes.Buffer{}
+	}
+
+	if viper.GetString("json-output") != "" {
+		inventoryOut = &bytes.Buffer{}
+	}
+
+	if g := viper.GetString("graph"); g != "" {
+		if g != graph.FormatDOT {
+			return fmt.Errorf("invalid --graph %q, the supported one is %s", g, graph.FormatDOT)
+		}
+		graphOut = &bytes.Buffer{}
+	}
+
+	if viper.GetString("tfstate") == "" && viper.GetString("hcl") == "" && viper.GetString("module") == "" && !viper.GetBool("generate-import-blocks") && viper.GetString("json-output") == "" && viper.GetString("graph") == "" {
+		return fmt.Errorf("one of --module, --hcl, --tfstate, --generate-import-blocks, --json-output or --graph are required")
 	}
 	return nil
 }
@@ -199,9 +422,13 @@ func postRunEOutput(cmd *cobra.Command, args []string) error {
 		moduleName := filepath.Base(m)
 		mdir := fmt.Sprintf("module-%s", moduleName)
 
-		err = os.Mkdir(filepath.Join(m, mdir), 0700)
-		if err != nil {
-			return err
+		// With the --module-layout the categories
+		// are already the paths of the nested modules
+		if viper.GetString("module-layout") == "" {
+			err = os.Mkdir(filepath.Join(m, mdir), 0700)
+			if err != nil {
+				return err
+			}
 		}
 
 		for _, k := range dm.Keys() {
@@ -209,17 +436,21 @@ func postRunEOutput(cmd *cobra.Command, args []string) error {
 				filep string
 			)
 			if k == writer.ModuleCategoryKey {
-				filep = filepath.Join(m, "module.tf")
+				filep = filepath.Join(m, fmt.Sprintf("module%s", hclExt()))
+			} else if viper.GetString("module-layout") != "" {
+				filep = filepath.Join(m, filepath.FromSlash(fmt.Sprintf("%s%s", k, hclExt())))
+				err = os.MkdirAll(filepath.Dir(filep), 0700)
+				if err != nil {
+					return err
+				}
 			} else {
-				filep = filepath.Join(m, mdir, fmt.Sprintf("%s.tf", k))
+				filep = filepath.Join(m, mdir, fmt.Sprintf("%s%s", k, hclExt()))
 			}
 
-			f, err := os.OpenFile(filep, os.O_APPEND|os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
+			err = writeOutputFile(filep, dm.Read(k), viper.GetBool("encrypt-hcl"))
 			if err != nil {
-				return fmt.Errorf("could not OpenFile %s because: %s", filep, err)
+				return err
 			}
-			io.Copy(f, dm.Read(k))
-			f.Close()
 		}
 	} else if hcl := viper.GetString("hcl"); hcl != "" {
 		dm, err := mxwriter.NewDemux(hclOut)
@@ -227,29 +458,188 @@ func postRunEOutput(cmd *cobra.Command, args []string) error {
 			return err
 		}
 		if isHCLDir {
+			var appended int
 			for _, k := range dm.Keys() {
-				filep := filepath.Join(hcl, fmt.Sprintf("%s.tf", k))
+				filep := filepath.Join(hcl, fmt.Sprintf("%s%s", k, hclExt()))
 
-				f, err := os.OpenFile(filep, os.O_APPEND|os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
+				var r io.Reader = dm.Read(k)
+				if hclBlocks != nil {
+					b, n, err := appendHCLFile(filep, r)
+					if err != nil {
+						return err
+					}
+					r = bytes.NewReader(b)
+					appended += n
+				}
+
+				err = writeOutputFile(filep, r, viper.GetBool("encrypt-hcl"))
 				if err != nil {
-					return fmt.Errorf("could not OpenFile %s because: %s", filep, err)
+					return err
 				}
-				io.Copy(f, dm.Read(k))
-				f.Close()
+			}
+			if hclBlocks != nil {
+				fmt.Fprintf(logsOut, "HCL appended to %s: %d blocks added\n", hcl, appended)
 			}
 		} else {
-			f, err := os.OpenFile(viper.GetString("hcl"), os.O_APPEND|os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
+			err = writeOutputFile(viper.GetString("hcl"), hclOut, viper.GetBool("encrypt-hcl"))
 			if err != nil {
-				return fmt.Errorf("could not OpenFile %s because: %s", viper.GetString("hcl"), err)
+				return err
 			}
-			io.Copy(f, hclOut)
-			f.Close()
+		}
+	}
+
+	if tfstate := viper.GetString("tfstate"); tfstate != "" && encryptOut != nil {
+		err := writeOutputFile(tfstate, stateOut.(*bytes.Buffer), true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if stateBackend != nil {
+		res, err := backend.Push(context.Background(), stateBackend, stateOut.(*bytes.Buffer))
+		if err != nil {
+			return fmt.Errorf("could not write the TFState to %s: %w", viper.GetString("tfstate"), err)
+		}
+		writeMergeResult(viper.GetString("tfstate"), res)
+	} else if tfstate := viper.GetString("tfstate"); tfstate != "" && viper.GetBool("merge-state") {
+		res, err := mergeStateFile(tfstate, stateOut.(*bytes.Buffer))
+		if err != nil {
+			return err
+		}
+		writeMergeResult(tfstate, res)
+	}
+
+	if importsOut != nil {
+		err := writeOutputFile(importsPath(), importsOut, viper.GetBool("encrypt-hcl"))
+		if err != nil {
+			return err
+		}
+	}
+
+	// The JSON has the attributes as the
+	// TFState so it's also encrypted
+	if inventoryOut != nil {
+		err := writeOutputFile(viper.GetString("json-output"), inventoryOut, encryptOut != nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if graphOut != nil {
+		err := writeOutputFile(viper.GetString("graph-output"), graphOut, false)
+		if err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// writeOutputFile writes the content of r to the filep, if encrypted
+// the content is encrypted with the encryptOut before writing it
+func writeOutputFile(filep string, r io.Reader, encrypted bool) error {
+	f, err := os.OpenFile(filep, os.O_APPEND|os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("could not OpenFile %s because: %s", filep, err)
+	}
+	defer f.Close()
+
+	if !encrypted {
+		_, err = io.Copy(f, r)
+		return err
+	}
+
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	eb, err := encryptOut.Encrypt(context.Background(), b)
+	if err != nil {
+		return fmt.Errorf("could not encrypt %s because: %w", filep, err)
+	}
+
+	_, err = f.Write(eb)
+	return err
+}
+
+// mergeStateFile merges the TFState of r into the one of the filep, if it
+// exists, and writes it. The previous one is kept on the filep.backup
+func mergeStateFile(filep string, r io.Reader) (state.MergeResult, error) {
+	var current *statefile.File
+	f, err := os.Open(filep)
+	if err == nil {
+		current, err = statefile.Read(f)
+		f.Close()
+		if err != nil && !errors.Is(err, statefile.ErrNoState) {
+			return state.MergeResult{}, fmt.Errorf("could not read the TFState %s: %w", filep, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return state.MergeResult{}, fmt.Errorf("could not Open %s because: %w", filep, err)
+	}
+
+	sf, res, err := state.MergeFile(current, r)
+	if err != nil {
+		return res, err
+	}
+
+	// Nothing new to write
+	if current != nil && len(res.Added) == 0 {
+		return res, nil
+	}
+
+	// It's written to a temporary file first so
+	// the TFState is never left half written
+	tmp := fmt.Sprintf("%s.tmp", filep)
+	tf, err := os.OpenFile(tmp, os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return res, fmt.Errorf("could not OpenFile %s because: %w", tmp, err)
+	}
+	err = statefile.Write(sf, tf)
+	tf.Close()
+	if err != nil {
+		return res, fmt.Errorf("could not write the TFState to %s: %w", tmp, err)
+	}
+
+	if current != nil {
+		if err := os.Rename(filep, fmt.Sprintf("%s.backup", filep)); err != nil {
+			return res, err
+		}
+	}
+
+	return res, os.Rename(tmp, filep)
+}
+
+// appendHCLFile returns the content of the filep with the blocks of the r
+// that are not already on the --hcl directory appended, and the number of them
+func appendHCLFile(filep string, r io.Reader) ([]byte, int, error) {
+	src, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dst, err := ioutil.ReadFile(filep)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, 0, fmt.Errorf("could not ReadFile on path %q: %w", filep, err)
+	}
+
+	b, appended, err := hcl.Append(dst, src, hclBlocks)
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not append the HCL to %s: %w", filep, err)
+	}
+
+	return b, len(appended), nil
+}
+
+// writeMergeResult writes to the logsOut the
+// result of merging the TFState to the tfstate
+func writeMergeResult(tfstate string, res state.MergeResult) {
+	fmt.Fprintf(logsOut, "TFState written to %s: %d added, %d already present\n", tfstate, len(res.Added), len(res.Skipped))
+	for _, c := range res.Conflicts {
+		fmt.Fprintf(logsOut, "\t%s not added as the address is used by another resource\n", c)
+	}
+}
+
 // getWriterOptions will initialize the common writer.Options from the flags
 func getWriterOptions() (*writer.Options, error) {
 	var module string
@@ -287,20 +677,105 @@ func getWriterOptions() (*writer.Options, error) {
 		}
 	}
 
+	var trs *transform.Transformations
+	if pt := viper.GetString("transformations"); pt != "" {
+		b, err := ioutil.ReadFile(pt)
+		if err != nil {
+			return nil, fmt.Errorf("could not ReadFile on path %q: %w", pt, err)
+		}
+
+		trs = &transform.Transformations{}
+		switch filepath.Ext(pt) {
+		case ".yml", ".yaml":
+			err := yaml.Unmarshal(b, trs)
+			if err != nil {
+				return nil, fmt.Errorf("invalid YAML on transformations file %s: %w", pt, err)
+			}
+		case ".json":
+			err = json.Unmarshal(b, trs)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON on transformations file %s: %w", pt, err)
+			}
+		default:
+			return nil, fmt.Errorf("invalid transformations %s, only supported extensions are yaml/yml/json", pt)
+		}
+
+		if err := trs.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid transformations file %s: %w", pt, err)
+		}
+	}
+
+	var extract int
+	if viper.GetBool("extract-variables") {
+		extract = viper.GetInt("extract-variables-threshold")
+	}
+
+	var nameTemplate *template.Template
+	if nt := viper.GetString("resource-name-template"); nt != "" {
+		t, err := provider.ParseNameTemplate(nt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --resource-name-template: %w", err)
+		}
+		nameTemplate = t
+	}
+
 	return &writer.Options{
-		Interpolate:      viper.GetBool("interpolate"),
-		Module:           module,
-		ModuleVariables:  mv,
-		HCLProviderBlock: viper.GetBool("hcl-provider-block"),
+		Interpolate:            viper.GetBool("interpolate"),
+		InterpolationExclude:   viper.GetStringSlice("interpolate-exclude"),
+		DependsOn:              viper.GetBool("depends-on"),
+		Module:                 module,
+		ModuleVariables:        mv,
+		ModuleLayout:           viper.GetString("module-layout"),
+		HCLProviderBlock:       viper.GetBool("hcl-provider-block"),
+		HCLProviderVariables:   viper.GetStringSlice("hcl-provider-variables"),
+		ExternalReferencesData: viper.GetBool("external-references-data"),
+		Transformations:        trs,
+		ExtractVariables:       extract,
+		RedactSecrets:          viper.GetBool("redact-secrets"),
+		RedactSecretsState:     viper.GetBool("redact-secrets-state"),
+		JSON:                   viper.GetString("format") == jsonFormat,
+		NameTemplate:           nameTemplate,
 	}, nil
 }
 
-func importProvider(ctx context.Context, logger kitlog.Logger, p provider.Provider, tags []tag.Tag) error {
+// createdWindow is the window of creation of the resources
+// to import, only the providers with the --filter-created-after
+// and --filter-created-before flags have one
+type createdWindow struct {
+	after, before time.Time
+}
+
+func importProvider(ctx context.Context, logger kitlog.Logger, p provider.Provider, tags []tag.Tag, cw createdWindow) error {
 	f := &filter.Filter{
-		Include: include,
-		Exclude: exclude,
-		Targets: targets,
-		Tags:    tags,
+		Include:           include,
+		Exclude:           exclude,
+		Targets:           targets,
+		ForceTypes:        forceTypes,
+		Tags:              tags,
+		TagsNormalization: viper.GetString("tags-normalization"),
+		CreatedAfter:      cw.after,
+		CreatedBefore:     cw.before,
+	}
+
+	cpPath := viper.GetString("checkpoint")
+	if cpPath != "" {
+		cp, err := readCheckpoint(cpPath)
+		if err != nil {
+			return err
+		}
+		if cp != nil {
+			if cp.Provider != p.String() {
+				return fmt.Errorf("the checkpoint %s is from the provider %q", cpPath, cp.Provider)
+			}
+			logger.Log("msg", "resuming from checkpoint", "file", cpPath, "pending", len(cp.Pending))
+			cp.Resume(f)
+		}
+	}
+
+	if d := viper.GetDuration("max-duration"); d != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d)
+		defer cancel()
 	}
 
 	var hclW, stateW writer.Writer
@@ -309,9 +784,22 @@ func importProvider(ctx context.Context, logger kitlog.Logger, p provider.Provid
 		return err
 	}
 
-	if hclOut != nil {
+	if names := viper.GetStringSlice("parameterize"); len(names) != 0 {
+		logger.Log("msg", "reading the parameters", "names", strings.Join(names, ","))
+		options.Parameters, err = provider.Parameters(ctx, p, names)
+		if err != nil {
+			return errors.Wrap(err, "could not read the parameters")
+		}
+	}
+
+	var hw *hcl.Writer
+	if hclOut != nil && viper.GetString("format") == cdktfFormat {
+		logger.Log("msg", "initializing CDKTF writer")
+		hclW = cdktf.NewWriter(hclOut, p, options)
+	} else if hclOut != nil {
 		logger.Log("msg", "initializing HCL writer")
-		hclW = hcl.NewWriter(hclOut, p, options)
+		hw = hcl.NewWriter(hclOut, p, options)
+		hclW = hw
 	}
 
 	if stateOut != nil {
@@ -319,44 +807,250 @@ func importProvider(ctx context.Context, logger kitlog.Logger, p provider.Provid
 		stateW = state.NewWriter(stateOut, options)
 	}
 
+	// The import blocks, the JSON and the graph are
+	// written with the Resources with state, as the TFState
+	stateWs := make([]writer.Writer, 0, 4)
+	if stateW != nil {
+		stateWs = append(stateWs, stateW)
+	}
+	if importsOut != nil {
+		logger.Log("msg", "initializing import blocks writer")
+		stateWs = append(stateWs, hcl.NewImportWriter(importsOut, options))
+	}
+	if inventoryOut != nil {
+		logger.Log("msg", "initializing JSON writer")
+		stateWs = append(stateWs, inventory.NewWriter(inventoryOut, filepath.Ext(viper.GetString("json-output")) == ".ndjson"))
+	}
+	if graphOut != nil {
+		logger.Log("msg", "initializing graph writer")
+		stateWs = append(stateWs, graph.NewWriter(graphOut))
+	}
+	if len(stateWs) > 1 {
+		stateW = writer.NewMulti(stateWs...)
+	} else if len(stateWs) == 1 {
+		stateW = stateWs[0]
+	}
+
 	logger.Log("msg", "importing")
 
+	// With --archive-dir the Resources written
+	// are recorded to archive the Snapshot
+	var (
+		out = logsOut
+		rec *archive.Recorder
+	)
+	if viper.GetString("archive-dir") != "" {
+		rec = archive.NewRecorder(logsOut)
+		out = rec
+	}
+
 	fmt.Fprintf(logsOut, "Starting Terracognita with version %s\n", Version)
 	logger.Log("msg", "starting terracognita", "version", Version)
-	err = provider.Import(ctx, p, hclW, stateW, f, logsOut)
-	if err != nil {
+	startedAt := time.Now().UTC()
+	err = provider.Import(ctx, p, hclW, stateW, f, out)
+	var derr *provider.DeadlineError
+	if errors.As(err, &derr) {
+		// The output has been written with the resources
+		// imported so it's not an error
+		if cpPath == "" {
+			cpPath = defaultCheckpointPath
+		}
+		err = writeCheckpoint(cpPath, derr.Checkpoint)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(logsOut, "The max duration has been reached, to import the %d resource types pending use --checkpoint %s\n", len(derr.Checkpoint.Pending), cpPath)
+	} else if err != nil {
 		return errors.Wrap(err, "could not import from "+p.String())
+	} else if cpPath != "" {
+		// All the pending resource types have been
+		// imported so the checkpoint is no longer needed
+		err = os.Remove(cpPath)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("could not remove the checkpoint %s because: %s", cpPath, err)
+		}
+	}
+
+	// With the 'drift' command the resources imported
+	// are only compared with the existing TFState
+	if driftPrev != nil {
+		return setDrift(p, f)
+	}
+
+	if rec != nil {
+		s := rec.Snapshot(archive.Manifest{
+			Provider: p.String(),
+			Region:   p.Region(),
+			Version:  Version,
+			Time:     startedAt,
+			Filters:  f.String(),
+		})
+		filep, err := archive.Write(viper.GetString("archive-dir"), s)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(logsOut, "Snapshot archived on %s\n", filep)
+	}
+
+	if hw != nil && len(options.Parameters) != 0 {
+		filep := tfvarsPath()
+		tf, err := os.OpenFile(filep, os.O_TRUNC|os.O_RDWR|os.O_CREATE, 0644)
+		if err != nil {
+			return fmt.Errorf("could not OpenFile %s because: %s", filep, err)
+		}
+		defer tf.Close()
+
+		err = hw.WriteTFVars(tf)
+		if err != nil {
+			return errors.Wrapf(err, "could not write the tfvars to %s", filep)
+		}
+	}
+
+	if hw != nil && options.RedactSecrets {
+		var b bytes.Buffer
+		ok, err := hw.WriteSecretsExample(&b)
+		if err != nil {
+			return errors.Wrap(err, "could not write the secrets example")
+		}
+		if ok {
+			filep := secretsExamplePath()
+			err = ioutil.WriteFile(filep, b.Bytes(), 0644)
+			if err != nil {
+				return fmt.Errorf("could not WriteFile %s because: %s", filep, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// readCheckpoint reads the checkpoint from the filep,
+// if it does not exist it returns nil
+func readCheckpoint(filep string) (*provider.Checkpoint, error) {
+	b, err := ioutil.ReadFile(filep)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read the checkpoint %s because: %s", filep, err)
+	}
+
+	var cp provider.Checkpoint
+	err = json.Unmarshal(b, &cp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid checkpoint %s: %w", filep, err)
+	}
+
+	return &cp, nil
+}
+
+// writeCheckpoint writes the cp to the filep
+func writeCheckpoint(filep string, cp provider.Checkpoint) error {
+	b, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(filep, b, 0644)
+	if err != nil {
+		return fmt.Errorf("could not write the checkpoint %s because: %s", filep, err)
 	}
 
 	return nil
 }
 
+// hclExt returns the extension of the
+// files generated with the --format
+func hclExt() string {
+	switch viper.GetString("format") {
+	case jsonFormat:
+		return ".tf.json"
+	case cdktfFormat:
+		return ".ts"
+	}
+	return ".tf"
+}
+
+// tfvarsPath returns the path of the tfvars file with the
+// values of the parameters, it's next to the HCL files
+func tfvarsPath() string {
+	name := fmt.Sprintf("%s.tfvars", viper.GetString("parameterize-environment"))
+	if m := viper.GetString("module"); m != "" {
+		return filepath.Join(m, name)
+	}
+	if isHCLDir {
+		return filepath.Join(viper.GetString("hcl"), name)
+	}
+	return filepath.Join(filepath.Dir(viper.GetString("hcl")), name)
+}
+
+// secretsExamplePath returns the path of the example of the tfvars
+// file of the secrets redacted, next to the one of the parameters
+func secretsExamplePath() string {
+	return filepath.Join(filepath.Dir(tfvarsPath()), "secrets.auto.tfvars.example")
+}
+
+// importsPath returns the path of the file with the import blocks,
+// it's on the root of the module or next to the HCL files
+func importsPath() string {
+	name := fmt.Sprintf("imports%s", hclExt())
+	if m := viper.GetString("module"); m != "" {
+		return filepath.Join(m, name)
+	}
+	if hcl := viper.GetString("hcl"); hcl != "" {
+		if isHCLDir {
+			return filepath.Join(hcl, name)
+		}
+		return filepath.Join(filepath.Dir(hcl), name)
+	}
+	return name
+}
+
 func init() {
 	cobra.OnInitialize(initViper)
 	RootCmd.AddCommand(awsCmd)
 	RootCmd.AddCommand(googleCmd)
 	RootCmd.AddCommand(azurermCmd)
 	RootCmd.AddCommand(vsphereCmd)
+	RootCmd.AddCommand(pluginCmd)
 	RootCmd.AddCommand(versionCmd)
+	RootCmd.AddCommand(resourcesCmd)
+	RootCmd.AddCommand(doctorCmd)
+	RootCmd.AddCommand(historyCmd)
 
-	RootCmd.PersistentFlags().String("hcl", "", "HCL output file or directory. If it's a directory it'll be emptied before importing")
+	RootCmd.PersistentFlags().String("hcl", "", "HCL output file or directory. If it's an existing directory only the resources that are not already on it are appended, unless --hcl-overwrite is used which empties it (after confirming it) before importing")
 	_ = viper.BindPFlag("hcl", RootCmd.PersistentFlags().Lookup("hcl"))
 
-	RootCmd.PersistentFlags().String("tfstate", "", "TFState output file")
+	RootCmd.PersistentFlags().String("format", hclFormat, "Syntax of the generated configuration files, 'hcl' (.tf), 'tf.json' (.tf.json) for the Terraform JSON syntax or 'cdktf-ts' (main.ts) for a CDK for Terraform TypeScript stack")
+	_ = viper.BindPFlag("format", RootCmd.PersistentFlags().Lookup("format"))
+
+	RootCmd.PersistentFlags().String("tfstate", "", "TFState output file, or remote backend to which it's merged with the format s3://BUCKET/KEY?region=REGION&dynamodb_table=TABLE, gcs://BUCKET/PREFIX/NAME.tfstate, azurerm://ACCOUNT/CONTAINER/KEY or remote://HOSTNAME/ORGANIZATION/WORKSPACE")
 	_ = viper.BindPFlag("tfstate", RootCmd.PersistentFlags().Lookup("tfstate"))
 
+	RootCmd.PersistentFlags().Bool("hcl-overwrite", false, "Overwrite the content of an existing --hcl directory (after confirming it) instead of appending only the resources that are not already on it")
+	_ = viper.BindPFlag("hcl-overwrite", RootCmd.PersistentFlags().Lookup("hcl-overwrite"))
+
+	RootCmd.PersistentFlags().Bool("merge-state", false, "Merge the generated TFState into the existing --tfstate file instead of overwriting it: the resources already present (same provider, type and ID) are skipped and only the new ones are added, the previous file is kept as .backup")
+	_ = viper.BindPFlag("merge-state", RootCmd.PersistentFlags().Lookup("merge-state"))
+
 	RootCmd.PersistentFlags().String("module", "", "Generates the output in module format into the directory specified. With this flag (--module) the --hcl is ignored and will be generated inside of the module")
 	_ = viper.BindPFlag("module", RootCmd.PersistentFlags().Lookup("module"))
 
 	RootCmd.PersistentFlags().String("module-variables", "", "Path to a file containing the list of attributes to use as variables when building the module. The format is a JSON/YAML, more information on https://github.com/cycloidio/terracognita#modules")
 	_ = viper.BindPFlag("module-variables", RootCmd.PersistentFlags().Lookup("module-variables"))
 
+	RootCmd.PersistentFlags().String("module-layout", "", "Splits the --module in nested modules on 'modules/NAME', one per 'service' (category), 'region' or 'resource-group' (azurerm), with the variables and outputs of the references between them")
+	_ = viper.BindPFlag("module-layout", RootCmd.PersistentFlags().Lookup("module-layout"))
+
 	RootCmd.PersistentFlags().StringSliceVarP(&include, "include", "i", []string{}, "List of resources to import, this names are the ones on TF (ex: aws_instance). If not set then means that all the resources will be imported")
 	_ = viper.BindPFlag("include", RootCmd.PersistentFlags().Lookup("include"))
 
 	RootCmd.PersistentFlags().StringSliceVarP(&exclude, "exclude", "e", []string{}, "List of resources to not import, this names are the ones on TF (ex: aws_instance). If not set then means that none the resources will be excluded")
 	_ = viper.BindPFlag("exclude", RootCmd.PersistentFlags().Lookup("exclude"))
 
+	RootCmd.PersistentFlags().StringSliceVar(&forceTypes, "force-types", []string{}, "List of resources to import even if they have known problems with the version of the Terraform Provider used, which are skipped with a warning by default")
+	_ = viper.BindPFlag("force-types", RootCmd.PersistentFlags().Lookup("force-types"))
+
 	RootCmd.PersistentFlags().StringSliceVar(&targets, "target", []string{}, "List of resources to import via ID, those IDs are the ones documented on Terraform that are needed to Import. The format is 'aws_instance.ID'")
 	_ = viper.BindPFlag("target", RootCmd.PersistentFlags().Lookup("target"))
 
@@ -372,8 +1066,80 @@ func init() {
 	RootCmd.PersistentFlags().BoolP("interpolate", "", true, "Activate the interpolation for the HCL and the dependencies building for the State file")
 	_ = viper.BindPFlag("interpolate", RootCmd.PersistentFlags().Lookup("interpolate"))
 
+	RootCmd.PersistentFlags().StringSlice("interpolate-exclude", []string{}, "List of attributes, with the format TYPE.ATTRIBUTE and glob patterns (ex: aws_instance.subnet_id,*.tags.*), which values are not replaced with references to other resources on the HCL")
+	_ = viper.BindPFlag("interpolate-exclude", RootCmd.PersistentFlags().Lookup("interpolate-exclude"))
+
+	RootCmd.PersistentFlags().Bool("depends-on", false, "Add a 'depends_on' on the HCL with the resources that each resource depends on but can not be referenced, like the ones on a policy document or the attributes of --interpolate-exclude")
+	_ = viper.BindPFlag("depends-on", RootCmd.PersistentFlags().Lookup("depends-on"))
+
+	RootCmd.PersistentFlags().String("resource-name-template", "", "Template (Go text/template) used to name the resources on the HCL and the TFState, with the .ID, .Type, .Name, .Tags and .Attributes of each one and the functions snakecase, lower, upper, trim, replace and default (ex: '{{.Tags.Name | snakecase}}'). The names are normalized, suffixed with '_2', '_3', ... on collision and the 'Name' tag or the ID are used if it's empty")
+	_ = viper.BindPFlag("resource-name-template", RootCmd.PersistentFlags().Lookup("resource-name-template"))
+
 	RootCmd.PersistentFlags().BoolP("hcl-provider-block", "", true, "Generate or not the 'provider {}' block for the imported provider")
 	_ = viper.BindPFlag("hcl-provider-block", RootCmd.PersistentFlags().Lookup("hcl-provider-block"))
+
+	RootCmd.PersistentFlags().StringSlice("hcl-provider-variables", []string{"region", "profile", "project"}, "List of keys of the configuration of the 'provider {}' block, besides the required ones, to set with variables so the HCL is portable across environments. The ones without value default to null so they are read from the environment (ex: AWS_PROFILE), the keys that the provider does not have are ignored")
+	_ = viper.BindPFlag("hcl-provider-variables", RootCmd.PersistentFlags().Lookup("hcl-provider-variables"))
+
+	RootCmd.PersistentFlags().String("transformations", "", "Path to a file containing the transformations (regexp replace or values lookup) to apply to the HCL attribute values before writing them. The format is a JSON/YAML with the 'rules' and the 'data' blocks needed by them")
+	_ = viper.BindPFlag("transformations", RootCmd.PersistentFlags().Lookup("transformations"))
+
+	RootCmd.PersistentFlags().StringSlice("parameterize", []string{}, "List of values to replace with variables on the HCL so it can be reused across environments (ex: region,account_id,vpc_cidr). The values are written to a tfvars file next to the HCL")
+	_ = viper.BindPFlag("parameterize", RootCmd.PersistentFlags().Lookup("parameterize"))
+
+	RootCmd.PersistentFlags().String("parameterize-environment", "terraform", "Name of the environment used for the tfvars file name generated with --parameterize and --extract-variables, by default 'terraform.tfvars' which is loaded automatically by Terraform")
+	_ = viper.BindPFlag("parameterize-environment", RootCmd.PersistentFlags().Lookup("parameterize-environment"))
+
+	RootCmd.PersistentFlags().Bool("extract-variables", false, "Replace the literal values repeated on the HCL (ex: AMI IDs, CIDRs, instance types) with variables named after the attribute. The values are written to the tfvars file of the --parameterize-environment")
+	_ = viper.BindPFlag("extract-variables", RootCmd.PersistentFlags().Lookup("extract-variables"))
+
+	RootCmd.PersistentFlags().Int("extract-variables-threshold", 3, "Minimum number of times a value has to be repeated to be extracted with --extract-variables")
+	_ = viper.BindPFlag("extract-variables-threshold", RootCmd.PersistentFlags().Lookup("extract-variables-threshold"))
+
+	RootCmd.PersistentFlags().Bool("external-references-data", false, "Generate 'data' blocks for the references to entities outside of the imported scope (other accounts, regions or global services) when possible")
+	_ = viper.BindPFlag("external-references-data", RootCmd.PersistentFlags().Lookup("external-references-data"))
+
+	RootCmd.PersistentFlags().Duration("max-duration", 0, "Maximum duration of the import (ex: 30m), when reached the resource types left are not imported, the output is written with the ones imported and a checkpoint is written to continue from it")
+	_ = viper.BindPFlag("max-duration", RootCmd.PersistentFlags().Lookup("max-duration"))
+
+	RootCmd.PersistentFlags().String("checkpoint", "", fmt.Sprintf("Checkpoint file written when the --max-duration is reached (by default %s), if it exists only the resource types pending on it are imported", defaultCheckpointPath))
+	_ = viper.BindPFlag("checkpoint", RootCmd.PersistentFlags().Lookup("checkpoint"))
+
+	RootCmd.PersistentFlags().Bool("redact-secrets", false, "Replace the values of the sensitive attributes (ex: passwords, private keys, connection strings, SSM parameter values) with variables on the HCL, listed without value on 'secrets.auto.tfvars.example' next to it. The real values are still written on the State unless --redact-secrets-state")
+	_ = viper.BindPFlag("redact-secrets", RootCmd.PersistentFlags().Lookup("redact-secrets"))
+
+	RootCmd.PersistentFlags().Bool("redact-secrets-state", false, "Omit the values of the sensitive attributes from the TFState, they are written as null so the next plan shows them as changed")
+	_ = viper.BindPFlag("redact-secrets-state", RootCmd.PersistentFlags().Lookup("redact-secrets-state"))
+
+	RootCmd.PersistentFlags().Bool("generate-import-blocks", false, "Generates the 'import {}' blocks (Terraform >= 1.5) of the imported resources on 'imports.tf', next to the HCL or on the current directory, so they can be imported with 'terraform plan -generate-config-out' without the TFState")
+	_ = viper.BindPFlag("generate-import-blocks", RootCmd.PersistentFlags().Lookup("generate-import-blocks"))
+
+	RootCmd.PersistentFlags().String("json-output", "", "JSON output file with all the imported resources (provider, type, name, id, attributes and dependencies) to be consumed by other tools. If the extension is '.ndjson' it's written with one resource per line")
+	_ = viper.BindPFlag("json-output", RootCmd.PersistentFlags().Lookup("json-output"))
+
+	RootCmd.PersistentFlags().String("graph", "", "Format of the dependency graph of the imported resources to write to the --graph-output, the supported one is 'dot' (Graphviz)")
+	_ = viper.BindPFlag("graph", RootCmd.PersistentFlags().Lookup("graph"))
+
+	RootCmd.PersistentFlags().String("graph-output", "graph.dot", "Output file of the dependency graph generated with --graph")
+	_ = viper.BindPFlag("graph-output", RootCmd.PersistentFlags().Lookup("graph-output"))
+
+	RootCmd.PersistentFlags().String("encrypt-output", "", "Encrypts the TFState and the --json-output before writing them, as they have sensitive data. The format is 'kms:KEY_ARN' to encrypt with a data key generated by the AWS KMS key")
+	_ = viper.BindPFlag("encrypt-output", RootCmd.PersistentFlags().Lookup("encrypt-output"))
+
+	RootCmd.PersistentFlags().Bool("encrypt-hcl", false, "Encrypts also the HCL files with the --encrypt-output")
+	_ = viper.BindPFlag("encrypt-hcl", RootCmd.PersistentFlags().Lookup("encrypt-hcl"))
+
+	RootCmd.PersistentFlags().String("stream", "", "Streams on the Stdout the events of each resource (discovered, mapped, skipped and written) as they happen, the logs are then written to the Stderr. The supported format is 'ndjson', one JSON per line")
+	_ = viper.BindPFlag("stream", RootCmd.PersistentFlags().Lookup("stream"))
+
+	RootCmd.PersistentFlags().String("archive-dir", "", "Directory where to archive a compressed Snapshot, with the manifest of the run and the resources imported, named with the timestamp of the run. The changes between the Snapshots are shown with 'terracognita history diff'")
+	_ = viper.BindPFlag("archive-dir", RootCmd.PersistentFlags().Lookup("archive-dir"))
+
+	RootCmd.PersistentFlags().StringSlice("profile", []string{}, "List of profiles to write of the run, the supported ones are 'cpu' (cpu.pprof), 'mem' (mem.pprof) and 'trace' (trace.out). The profiles can be read with 'go tool pprof' and 'go tool trace'")
+	_ = viper.BindPFlag("profile", RootCmd.PersistentFlags().Lookup("profile"))
+
+	RootCmd.PersistentFlags().String("profile-dir", ".", "Directory where the --profile files are written")
+	_ = viper.BindPFlag("profile-dir", RootCmd.PersistentFlags().Lookup("profile-dir"))
 }
 
 func initViper() {
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setFlags sets the values of the flags on the viper
+// and restores the previous ones at the end of the t
+func setFlags(t *testing.T, flags map[string]interface{}) {
+	for k, v := range flags {
+		prev := viper.Get(k)
+		viper.Set(k, v)
+		k := k
+		t.Cleanup(func() { viper.Set(k, prev) })
+	}
+}
+
+func TestPreRunEOutput(t *testing.T) {
+	t.Run("ErrorModuleLayoutWithImportBlocks", func(t *testing.T) {
+		setFlags(t, map[string]interface{}{
+			"format":                 hclFormat,
+			"module":                 t.TempDir(),
+			"module-layout":          "service",
+			"generate-import-blocks": true,
+		})
+
+		err := preRunEOutput(RootCmd, nil)
+		require.Error(t, err)
+		assert.Equal(t, "the --generate-import-blocks is not supported with the --module-layout", err.Error())
+	})
+}
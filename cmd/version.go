@@ -1,25 +1,127 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"os/exec"
+	"time"
 
+	tfschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfaws "github.com/hashicorp/terraform-provider-aws/provider"
+	tfazurerm "github.com/hashicorp/terraform-provider-azurerm/provider"
+	tfgoogle "github.com/hashicorp/terraform-provider-google/google"
+	tfvsphere "github.com/hashicorp/terraform-provider-vsphere/vsphere"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cycloidio/terracognita/aws"
+	"github.com/cycloidio/terracognita/azurerm"
+	"github.com/cycloidio/terracognita/google"
+	"github.com/cycloidio/terracognita/schema"
+	"github.com/cycloidio/terracognita/vsphere"
 )
 
+// providerCheck has the information needed to check
+// the compatibility of a Provider
+type providerCheck struct {
+	name          string
+	source        string
+	version       string
+	tfProvider    func() *tfschema.Provider
+	resourceTypes func() []string
+}
+
 var (
 	// Version is the value of the current verion, this
 	// is set via -ldflags
 	Version string
 
+	providerChecks = []providerCheck{
+		{name: "aws", source: "hashicorp/aws", version: aws.TFProviderVersion, tfProvider: tfaws.Provider, resourceTypes: aws.ResourceTypeStrings},
+		{name: "azurerm", source: "hashicorp/azurerm", version: azurerm.TFProviderVersion, tfProvider: tfazurerm.AzureProvider, resourceTypes: azurerm.ResourceTypeStrings},
+		{name: "google", source: "hashicorp/google", version: google.TFProviderVersion, tfProvider: tfgoogle.Provider, resourceTypes: google.ResourceTypeStrings},
+		{name: "vsphere", source: "hashicorp/vsphere", version: vsphere.TFProviderVersion, tfProvider: tfvsphere.Provider, resourceTypes: vsphere.ResourceTypeStrings},
+	}
+
 	versionCmd = &cobra.Command{
 		Use:   "version",
 		Short: "Prints the current build version",
+		Long:  "Prints the current build version, with --check-providers it also reports the versions of the Providers supported against the latest ones on the registry and the supported resource types which schema drifted on the latest ones, which are downloaded with the terraform (or tofu) binary of the PATH",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			viper.BindPFlag("check-providers", cmd.Flags().Lookup("check-providers"))
+			viper.BindPFlag("registry", cmd.Flags().Lookup("registry"))
+
+			return nil
+		},
 		Run: func(cmd *cobra.Command, args []string) {
 			if Version != "" {
 				fmt.Printf("The current version is: %s\n", Version)
 			} else {
 				fmt.Printf("No version defined\n")
 			}
+
+			if viper.GetBool("check-providers") {
+				checkProviders(cmd)
+			}
 		},
 	}
 )
+
+// checkProviders prints for each Provider the version supported, the latest
+// one on the registry and the resource types which schema drifted on the
+// latest one compared to the supported one
+func checkProviders(cmd *cobra.Command) {
+	ctx := context.Background()
+	c := &http.Client{Timeout: 10 * time.Second}
+	bin := terraformBinary()
+
+	for _, pc := range providerChecks {
+		status := "up to date"
+		latest, err := schema.LatestVersion(ctx, c, viper.GetString("registry"), pc.source)
+		if err != nil {
+			latest = "unknown"
+			status = err.Error()
+		} else if latest != pc.version {
+			status = "outdated"
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "\n%s\tsupported: %s\tlatest: %s\t%s\n", pc.name, pc.version, latest, status)
+
+		// Only an outdated Provider can drift
+		if status != "outdated" {
+			continue
+		}
+
+		if bin == "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "\tDRIFT\tnot checked, no terraform or tofu binary found on the PATH\n")
+			continue
+		}
+
+		ls, err := schema.FetchProviderSchema(ctx, bin, pc.source, latest)
+		if err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "\tDRIFT\tnot checked, %s\n", err)
+			continue
+		}
+
+		for _, d := range schema.Drifts(pc.tfProvider(), ls, pc.resourceTypes()) {
+			fmt.Fprintf(cmd.OutOrStdout(), "\tDRIFT\t%s\n", d)
+		}
+	}
+}
+
+// terraformBinary returns the path of the terraform,
+// or tofu, binary of the PATH or empty if none is
+func terraformBinary() string {
+	for _, b := range []string{"terraform", "tofu"} {
+		if p, err := exec.LookPath(b); err == nil {
+			return p
+		}
+	}
+	return ""
+}
+
+func init() {
+	versionCmd.Flags().Bool("check-providers", false, "Reports the versions of the Providers supported against the latest ones on the registry and the supported resource types which schema drifted on the latest ones")
+	versionCmd.Flags().String("registry", schema.RegistryURL, "Registry used to check the latest versions of the Providers with --check-providers")
+}
@@ -21,11 +21,7 @@ var (
 			if err != nil {
 				return err
 			}
-			viper.BindPFlag("soap-url", cmd.Flags().Lookup("soap-url"))
-			viper.BindPFlag("username", cmd.Flags().Lookup("username"))
-			viper.BindPFlag("password", cmd.Flags().Lookup("password"))
-			viper.BindPFlag("vsphereserver", cmd.Flags().Lookup("vsphereserver"))
-			viper.BindPFlag("insecure", cmd.Flags().Lookup("insecure"))
+			bindVSphereFlags(cmd)
 
 			return nil
 		},
@@ -52,7 +48,7 @@ var (
 				return err
 			}
 
-			err = importProvider(ctx, logger, vsphereProvider, noTags)
+			err = importProvider(ctx, logger, vsphereProvider, noTags, noCreatedWindow)
 			if err != nil {
 				return err
 			}
@@ -64,11 +60,22 @@ var (
 
 func init() {
 	vsphereCmd.AddCommand(vsphereResourcesCmd)
+	vsphereCmd.AddCommand(newDriftCmd(vsphereCmd, bindVSphereFlags))
 
 	// Required flags
-	vsphereCmd.Flags().String("soap-url", "", "URL of a vCenter or ESXi instance (required)")
-	vsphereCmd.Flags().String("username", "", "Username (required)")
-	vsphereCmd.Flags().String("password", "", "Password (required)")
-	vsphereCmd.Flags().String("vsphereserver", "", "This is the vCenter Server FQDN or IP Address for vSphere API operations (required)")
-	vsphereCmd.Flags().Bool("insecure", true, "Insecure")
+	vsphereCmd.PersistentFlags().String("soap-url", "", "URL of a vCenter or ESXi instance (required)")
+	vsphereCmd.PersistentFlags().String("username", "", "Username (required)")
+	vsphereCmd.PersistentFlags().String("password", "", "Password (required)")
+	vsphereCmd.PersistentFlags().String("vsphereserver", "", "This is the vCenter Server FQDN or IP Address for vSphere API operations (required)")
+	vsphereCmd.PersistentFlags().Bool("insecure", true, "Insecure")
+}
+
+// bindVSphereFlags binds all the vSphere flags of the cmd to viper,
+// it's used by all the vSphere commands that need to connect to vSphere
+func bindVSphereFlags(cmd *cobra.Command) {
+	viper.BindPFlag("soap-url", cmd.Flags().Lookup("soap-url"))
+	viper.BindPFlag("username", cmd.Flags().Lookup("username"))
+	viper.BindPFlag("password", cmd.Flags().Lookup("password"))
+	viper.BindPFlag("vsphereserver", cmd.Flags().Lookup("vsphereserver"))
+	viper.BindPFlag("insecure", cmd.Flags().Lookup("insecure"))
 }
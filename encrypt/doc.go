@@ -0,0 +1,3 @@
+// Package encrypt provides the encryption of the outputs
+// before writing them, as the State has sensitive data
+package encrypt
@@ -0,0 +1,129 @@
+package encrypt
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+const (
+	// SchemeKMS encrypts with a data key generated
+	// by the AWS KMS key
+	SchemeKMS = "kms"
+
+	// SchemeAge encrypts to an age recipient
+	SchemeAge = "age"
+)
+
+// Encrypter encrypts the content of the outputs
+type Encrypter interface {
+	// Encrypt returns the encrypted b
+	Encrypt(ctx context.Context, b []byte) ([]byte, error)
+}
+
+// Envelope is the encrypted content written, the Ciphertext is
+// encrypted with AES-256-GCM using the data key which is
+// written encrypted with the KMS key on EncryptedKey
+type Envelope struct {
+	Scheme       string `json:"scheme"`
+	KeyID        string `json:"key_id"`
+	EncryptedKey []byte `json:"encrypted_key"`
+	Nonce        []byte `json:"nonce"`
+	Ciphertext   []byte `json:"ciphertext"`
+}
+
+// New returns the Encrypter for the spec which has
+// the format 'SCHEME:KEY', like 'kms:KEY_ARN'
+func New(spec string) (Encrypter, error) {
+	parts := strings.SplitN(spec, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, errors.Wrapf(errcode.ErrEncryptInvalidFormat, "with value %q", spec)
+	}
+
+	scheme, key := parts[0], parts[1]
+	switch scheme {
+	case SchemeKMS:
+		cfg := aws.NewConfig()
+		// If the key is an ARN the region is the one of
+		// the key, if not the default one is used
+		if a, err := arn.Parse(key); err == nil {
+			cfg = cfg.WithRegion(a.Region)
+		}
+
+		sess, err := session.NewSessionWithOptions(session.Options{
+			Config:            *cfg,
+			SharedConfigState: session.SharedConfigEnable,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize the KMS session")
+		}
+
+		return NewKMS(kms.New(sess), key), nil
+	case SchemeAge:
+		// The age encryption needs the filippo.io/age library
+		// which is not yet a dependency
+		return nil, errors.Wrapf(errcode.ErrEncryptSchemeNotSupported, "with scheme %q", scheme)
+	default:
+		return nil, errors.Wrapf(errcode.ErrEncryptSchemeNotSupported, "with scheme %q", scheme)
+	}
+}
+
+type kmsEncrypter struct {
+	client kmsiface.KMSAPI
+	keyID  string
+}
+
+// NewKMS returns an Encrypter that encrypts with a data
+// key generated with the KMS keyID using the client
+func NewKMS(client kmsiface.KMSAPI, keyID string) Encrypter {
+	return &kmsEncrypter{
+		client: client,
+		keyID:  keyID,
+	}
+}
+
+func (k *kmsEncrypter) Encrypt(ctx context.Context, b []byte) ([]byte, error) {
+	dk, err := k.client.GenerateDataKeyWithContext(ctx, &kms.GenerateDataKeyInput{
+		KeyId:   aws.String(k.keyID),
+		KeySpec: aws.String(kms.DataKeySpecAes256),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not generate the data key with %s", k.keyID)
+	}
+
+	block, err := aes.NewCipher(dk.Plaintext)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize the cipher")
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not initialize the cipher")
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "could not generate the nonce")
+	}
+
+	return json.MarshalIndent(Envelope{
+		Scheme:       SchemeKMS,
+		KeyID:        aws.StringValue(dk.KeyId),
+		EncryptedKey: dk.CiphertextBlob,
+		Nonce:        nonce,
+		Ciphertext:   gcm.Seal(nil, nonce, b, nil),
+	}, "", "  ")
+}
@@ -0,0 +1,73 @@
+package encrypt_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/encrypt"
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+type kmsClient struct {
+	kmsiface.KMSAPI
+
+	key []byte
+}
+
+func (k kmsClient) GenerateDataKeyWithContext(ctx aws.Context, input *kms.GenerateDataKeyInput, opts ...request.Option) (*kms.GenerateDataKeyOutput, error) {
+	return &kms.GenerateDataKeyOutput{
+		KeyId:          input.KeyId,
+		Plaintext:      k.key,
+		CiphertextBlob: []byte("encrypted"),
+	}, nil
+}
+
+func TestNew(t *testing.T) {
+	t.Run("ErrEncryptInvalidFormat", func(t *testing.T) {
+		for _, s := range []string{"kms", "kms:", ""} {
+			_, err := encrypt.New(s)
+			assert.Equal(t, errcode.ErrEncryptInvalidFormat, errors.Cause(err), s)
+		}
+	})
+	t.Run("ErrEncryptSchemeNotSupported", func(t *testing.T) {
+		for _, s := range []string{"age:age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p", "gpg:key"} {
+			_, err := encrypt.New(s)
+			assert.Equal(t, errcode.ErrEncryptSchemeNotSupported, errors.Cause(err), s)
+		}
+	})
+}
+
+func TestKMSEncrypt(t *testing.T) {
+	key := bytes.Repeat([]byte("k"), 32)
+	e := encrypt.NewKMS(kmsClient{key: key}, "arn:aws:kms:eu-west-1:123456789012:key/id")
+
+	b, err := e.Encrypt(context.Background(), []byte("state"))
+	require.NoError(t, err)
+
+	var env encrypt.Envelope
+	require.NoError(t, json.Unmarshal(b, &env))
+	assert.Equal(t, encrypt.SchemeKMS, env.Scheme)
+	assert.Equal(t, "arn:aws:kms:eu-west-1:123456789012:key/id", env.KeyID)
+	assert.Equal(t, []byte("encrypted"), env.EncryptedKey)
+
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	plain, err := gcm.Open(nil, env.Nonce, env.Ciphertext, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("state"), plain)
+}
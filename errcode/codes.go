@@ -8,6 +8,11 @@ var (
 	ErrProviderResourceNotRead       = errors.New("the resource did not return an ID")
 	ErrProviderResourceDoNotMatchTag = errors.New("the resource does not match the required tags")
 	ErrProviderResourceAutogenerated = errors.New("the resource is autogenerated and should not be imported")
+	ErrProviderParameterNotSupported = errors.New("the parameter is not supported")
+	ErrProviderParameterNoValue      = errors.New("the parameter has no value")
+	ErrProviderImportDeadline        = errors.New("the deadline was reached before importing all the resources")
+	ErrProviderPluginNotFound        = errors.New("the provider plugin was not found")
+	ErrProviderPluginInvalid         = errors.New("the provider plugin is not a valid Terracognita plugin")
 
 	ErrCacheKeyNotFound        = errors.New("the key used to search was not found")
 	ErrCacheKeyAlreadyExisting = errors.New("the key already exists on the cache")
@@ -18,10 +23,26 @@ var (
 	ErrWriterInvalidTypeValue = errors.New("invalid type of value")
 	ErrWriterAlreadyExistsKey = errors.New("the key already exists")
 
-	ErrFilterTargetsInvalid = errors.New("the filter targets has an invalid format")
+	ErrFilterTargetsInvalid           = errors.New("the filter targets has an invalid format")
+	ErrFilterCreatedInvalid           = errors.New("the filter created window is invalid")
+	ErrFilterTagsNormalizationInvalid = errors.New("the filter tags normalization is invalid")
 
 	ErrTagInvalidForamt = errors.New("invalid format for tag, the expected format is 'NAME:VALUE'")
 
+	ErrTransformInvalidRule = errors.New("the transformation rule is invalid")
+
+	ErrSchemaProviderNotFound = errors.New("the provider is not on the schema")
+
+	ErrEncryptInvalidFormat      = errors.New("invalid format for the encryption, the expected format is 'SCHEME:KEY'")
+	ErrEncryptSchemeNotSupported = errors.New("the encryption scheme is not supported")
+
+	ErrProfileKindNotSupported = errors.New("the profile kind is not supported")
+
+	ErrBackendInvalidURL         = errors.New("invalid URL for the backend, the expected format is 'SCHEME://BUCKET/KEY'")
+	ErrBackendSchemeNotSupported = errors.New("the backend scheme is not supported")
+	ErrBackendCredentials        = errors.New("the credentials of the backend are missing")
+	ErrBackendLocked             = errors.New("the TFState of the backend is locked")
+
 	// ErrProviderAPI will be raised when an error occurs provider side while
 	// using its APIs (authorization error, unavailable operation, ...)
 	ErrProviderAPI = errors.New("error while requesting the provider APIs")
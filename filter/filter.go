@@ -3,6 +3,7 @@ package filter
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cycloidio/terracognita/errcode"
 	"github.com/cycloidio/terracognita/tag"
@@ -18,6 +19,21 @@ type Filter struct {
 	Exclude []string
 	Targets []string
 
+	// ForceTypes are the types to import even if
+	// the Provider reports them as broken
+	ForceTypes []string
+
+	// TagsNormalization is the tag.Normalizations used to
+	// compare the keys of the Tags with the ones of the
+	// resources, the zero value is tag.NormalizationPreserve
+	TagsNormalization string
+
+	// CreatedAfter and CreatedBefore are the window of
+	// creation of the resources, the zero value means
+	// that there is no limit on that side
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+
 	exclude map[string]struct{}
 	include map[string]struct{}
 }
@@ -60,6 +76,37 @@ func (f *Filter) IsIncluded(v ...string) bool {
 	return true
 }
 
+// IsForced checks if the v is on the ForceTypes list
+func (f *Filter) IsForced(v string) bool {
+	for _, ft := range f.ForceTypes {
+		if ft == v {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCreatedIn checks if the creation time t is inside of the
+// CreatedAfter and CreatedBefore window
+func (f *Filter) IsCreatedIn(t time.Time) bool {
+	if !f.CreatedAfter.IsZero() && t.Before(f.CreatedAfter) {
+		return false
+	}
+
+	if !f.CreatedBefore.IsZero() && t.After(f.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
+
+// IsTagsCaseSensitive checks if the keys of the Tags are compared
+// as they are, so they can be used to filter on the provider
+// APIs, which are case sensitive
+func (f *Filter) IsTagsCaseSensitive() bool {
+	return f.TagsNormalization == "" || f.TagsNormalization == tag.NormalizationPreserve
+}
+
 // Validate validates that the data inside of the filters is right
 func (f *Filter) Validate() error {
 	// Validate that the Targets have the right format
@@ -72,6 +119,14 @@ func (f *Filter) Validate() error {
 		}
 	}
 
+	if !tag.IsValidNormalization(f.TagsNormalization) {
+		return errors.Wrapf(errcode.ErrFilterTagsNormalizationInvalid, "the tags normalization %q is not one of %s", f.TagsNormalization, strings.Join(tag.Normalizations, ", "))
+	}
+
+	if !f.CreatedAfter.IsZero() && !f.CreatedBefore.IsZero() && !f.CreatedAfter.Before(f.CreatedBefore) {
+		return errors.Wrapf(errcode.ErrFilterCreatedInvalid, "the created after %s is not before the created before %s", f.CreatedAfter.Format(time.RFC3339), f.CreatedBefore.Format(time.RFC3339))
+	}
+
 	return nil
 }
 
@@ -103,12 +158,27 @@ func (f *Filter) TargetsTypesWithIDs() map[string][]string {
 
 // String returns a stringification of the Filter
 func (f *Filter) String() string {
-	return fmt.Sprintf(`
+	s := fmt.Sprintf(`
 	Tags:    %s,
 	Include: %s,
 	Exclude: %s,
 	Targets: %s,
 `, f.Tags, f.Include, f.Exclude, f.Targets)
+
+	if len(f.ForceTypes) != 0 {
+		s += fmt.Sprintf("\tForce Types: %s,\n", f.ForceTypes)
+	}
+	if !f.IsTagsCaseSensitive() {
+		s += fmt.Sprintf("\tTags Normalization: %s,\n", f.TagsNormalization)
+	}
+	if !f.CreatedAfter.IsZero() {
+		s += fmt.Sprintf("\tCreated After:  %s,\n", f.CreatedAfter.Format(time.RFC3339))
+	}
+	if !f.CreatedBefore.IsZero() {
+		s += fmt.Sprintf("\tCreated Before: %s,\n", f.CreatedBefore.Format(time.RFC3339))
+	}
+
+	return s
 }
 
 // calculateExcludeMap makes a map of the Exclude so
@@ -2,6 +2,7 @@ package filter_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cycloidio/terracognita/errcode"
 	"github.com/cycloidio/terracognita/filter"
@@ -48,6 +49,31 @@ func TestIsIncluded(t *testing.T) {
 	})
 }
 
+func TestIsCreatedIn(t *testing.T) {
+	after := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	t.Run("True", func(t *testing.T) {
+		f := filter.Filter{CreatedAfter: after, CreatedBefore: before}
+		assert.True(t, f.IsCreatedIn(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)))
+	})
+	t.Run("TrueWithoutWindow", func(t *testing.T) {
+		f := filter.Filter{}
+		assert.True(t, f.IsCreatedIn(time.Date(2010, 3, 1, 0, 0, 0, 0, time.UTC)))
+	})
+	t.Run("TrueOnlyAfter", func(t *testing.T) {
+		f := filter.Filter{CreatedAfter: after}
+		assert.True(t, f.IsCreatedIn(time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC)))
+	})
+	t.Run("FalseBefore", func(t *testing.T) {
+		f := filter.Filter{CreatedAfter: after, CreatedBefore: before}
+		assert.False(t, f.IsCreatedIn(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)))
+	})
+	t.Run("FalseAfter", func(t *testing.T) {
+		f := filter.Filter{CreatedAfter: after, CreatedBefore: before}
+		assert.False(t, f.IsCreatedIn(time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)))
+	})
+}
+
 func TestTargetsTypesWithIDs(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		f := filter.Filter{Targets: []string{"aws_instance.2", "aws_instance.3", "aws_iam_user.2", "aws_instance.2"}}
@@ -74,4 +100,17 @@ func TestValidate(t *testing.T) {
 		err := f.Validate()
 		assert.Error(t, errors.Cause(err), errcode.ErrFilterTargetsInvalid)
 	})
+	t.Run("ErrorCreatedWindow", func(t *testing.T) {
+		f := filter.Filter{
+			CreatedAfter:  time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+			CreatedBefore: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+		err := f.Validate()
+		assert.Equal(t, errcode.ErrFilterCreatedInvalid, errors.Cause(err))
+	})
+	t.Run("ErrorTagsNormalization", func(t *testing.T) {
+		f := filter.Filter{TagsNormalization: "upper"}
+		err := f.Validate()
+		assert.Equal(t, errcode.ErrFilterTagsNormalizationInvalid, errors.Cause(err))
+	})
 }
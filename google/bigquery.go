@@ -0,0 +1,24 @@
+package google
+
+// configureBigQueryTable removes from the cfg of a google_bigquery_table the
+// schema of the views and the materialized views, as it's computed from the
+// query of the view definition, and the one of the external tables with
+// the schema autodetected from the source
+func configureBigQueryTable(cfg map[string]interface{}) {
+	for _, k := range []string{"view", "materialized_view"} {
+		if v, ok := cfg[k].([]interface{}); ok && len(v) != 0 {
+			delete(cfg, "schema")
+			return
+		}
+	}
+
+	edcs, ok := cfg["external_data_configuration"].([]interface{})
+	if !ok || len(edcs) == 0 {
+		return
+	}
+	if edc, ok := edcs[0].(map[string]interface{}); ok {
+		if ad, ok := edc["autodetect"].(bool); ok && ad {
+			delete(cfg, "schema")
+		}
+	}
+}
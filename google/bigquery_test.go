@@ -0,0 +1,56 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigureBigQueryTable(t *testing.T) {
+	t.Run("Table", func(t *testing.T) {
+		cfg := map[string]interface{}{
+			"table_id": "table",
+			"schema":   `[{"name":"id","type":"INTEGER"}]`,
+		}
+
+		configureBigQueryTable(cfg)
+
+		assert.Equal(t, map[string]interface{}{
+			"table_id": "table",
+			"schema":   `[{"name":"id","type":"INTEGER"}]`,
+		}, cfg)
+	})
+
+	t.Run("View", func(t *testing.T) {
+		cfg := map[string]interface{}{
+			"table_id": "view",
+			"schema":   `[{"name":"id","type":"INTEGER"}]`,
+			"view": []interface{}{
+				map[string]interface{}{"query": "SELECT id FROM dataset.table", "use_legacy_sql": false},
+			},
+		}
+
+		configureBigQueryTable(cfg)
+
+		assert.Equal(t, map[string]interface{}{
+			"table_id": "view",
+			"view": []interface{}{
+				map[string]interface{}{"query": "SELECT id FROM dataset.table", "use_legacy_sql": false},
+			},
+		}, cfg)
+	})
+
+	t.Run("ExternalAutodetect", func(t *testing.T) {
+		cfg := map[string]interface{}{
+			"table_id": "external",
+			"schema":   `[{"name":"id","type":"INTEGER"}]`,
+			"external_data_configuration": []interface{}{
+				map[string]interface{}{"autodetect": true, "source_format": "CSV"},
+			},
+		}
+
+		configureBigQueryTable(cfg)
+
+		assert.NotContains(t, cfg, "schema")
+	})
+}
@@ -14,6 +14,7 @@ import (
 // Cloud-dns: dns_managed_zones
 // Cloud-sql: sql_databases_instance (except the on-prem type ones)
 // Storage: storage_buckets
+// BigQuery: bigquery_datasets
 
 //compute instances
 func cacheComputeInstances(ctx context.Context, g *google, rt string, filters *filter.Filter) ([]provider.Resource, error) {
@@ -152,3 +153,70 @@ func getSQLDatabaseInstances(ctx context.Context, g *google, rt string, filters
 
 	return names, nil
 }
+
+// spanner
+func cacheSpannerInstances(ctx context.Context, g *google, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := g.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+		rs, err = spannerInstance(ctx, g, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get spanner instances")
+		}
+		err = g.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+func getSpannerInstances(ctx context.Context, g *google, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheSpannerInstances(ctx, g, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(rs))
+	for _, i := range rs {
+		names = append(names, i.ID())
+	}
+
+	return names, nil
+}
+
+// bigquery
+
+func cacheBigQueryDatasets(ctx context.Context, g *google, rt string, filters *filter.Filter) ([]provider.Resource, error) {
+	rs, err := g.cache.Get(rt)
+	if err != nil {
+		if errors.Cause(err) != errcode.ErrCacheKeyNotFound {
+			return nil, errors.WithStack(err)
+		}
+		rs, err = bigQueryDataset(ctx, g, rt, filters)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get bigquery datasets")
+		}
+		err = g.cache.Set(rt, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+func getBigQueryDatasets(ctx context.Context, g *google, rt string, filters *filter.Filter) ([]string, error) {
+	rs, err := cacheBigQueryDatasets(ctx, g, rt, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(rs))
+	for _, i := range rs {
+		names = append(names, i.Data().Get("dataset_id").(string))
+	}
+
+	return names, nil
+}
@@ -23,16 +23,16 @@ var functions = []Function{
 	Function{Resource: "Bucket", NoFilter: true, AddAPISufix: true, API: "storage", ResourceList: "Buckets"},
 	// compute
 	Function{Resource: "Address", Region: true},
-	Function{Resource: "Autoscaler", Zone: true},
+	Function{Resource: "Autoscaler", Zone: true, Aggregated: true},
 	Function{Resource: "BackendService"},
 	Function{Resource: "BackendBucket"},
-	Function{Resource: "Disk", Zone: true},
+	Function{Resource: "Disk", Zone: true, Aggregated: true},
 	Function{Resource: "Firewall"},
 	Function{Resource: "ForwardingRule", PluralName: "GlobalForwardingRules", ServiceName: "GlobalForwardingRules"},
 	Function{Resource: "ForwardingRule", Region: true},
 	Function{Resource: "HealthCheck"},
-	Function{Resource: "Instance", Zone: true},
-	Function{Resource: "InstanceGroup", Zone: true},
+	Function{Resource: "Instance", Zone: true, Aggregated: true},
+	Function{Resource: "InstanceGroup", Zone: true, Aggregated: true},
 	Function{Resource: "Network"},
 	Function{Resource: "SslCertificate", PluralName: "SSLCertificates"},
 	Function{Resource: "TargetHttpProxy", PluralName: "TargetHTTPProxies", ServiceName: "TargetHttpProxies"},
@@ -40,10 +40,10 @@ var functions = []Function{
 	Function{Resource: "UrlMap", PluralName: "URLMaps"},
 	Function{Resource: "Address", Region: true, FunctionName: "ListGlobalAddresses", PluralName: "GlobalAddress", ResourceList: "AddressList"},
 	Function{Resource: "Image"},
-	Function{Resource: "InstanceGroupManager", Zone: true},
+	Function{Resource: "InstanceGroupManager", Zone: true, Aggregated: true},
 	Function{Resource: "InstanceTemplate"},
 	Function{Resource: "SslCertificate", FunctionName: "ListManagedSslCertificates"},
-	Function{Resource: "NetworkEndpointGroup", Zone: true},
+	Function{Resource: "NetworkEndpointGroup", Zone: true, Aggregated: true},
 	Function{Resource: "Route"},
 	Function{Resource: "SecurityPolicy"},
 	Function{Resource: "ServiceAttachment", Region: true},
@@ -51,16 +51,22 @@ var functions = []Function{
 	Function{Resource: "SslPolicy", ResourceList: "SslPoliciesList"},
 	Function{Resource: "Subnetwork", Region: true},
 	Function{Resource: "TargetGrpcProxy"},
-	Function{Resource: "TargetInstance", Zone: true},
+	Function{Resource: "TargetInstance", Zone: true, Aggregated: true},
 	Function{Resource: "TargetPool", Region: true},
 	Function{Resource: "TargetSslProxy"},
 	Function{Resource: "TargetTcpProxy", FunctionName: "ListTargetTCPProxies"},
+	Function{Resource: "BackendService", Region: true, FunctionName: "ListRegionBackendServices", ServiceName: "RegionBackendServices"},
+	Function{Resource: "InstanceGroupManager", Region: true, FunctionName: "ListRegionInstanceGroupManagers", PluralName: "RegionInstanceGroupManagers", ServiceName: "RegionInstanceGroupManagers", ResourceList: "RegionInstanceGroupManagerList"},
+	Function{Resource: "Router", Region: true},
 	//file
 	Function{Resource: "Instance", FunctionName: "ListFilestoreInstances", API: "file", ServiceName: "ProjectsLocationsInstances", MaxResultFunc: "PageSize", ParentListScope: true, ResourceList: "ListInstancesResponse", ItemName: "Instances"},
 	// kubernetes container engine
 	Function{Resource: "Cluster", AddAPISufix: true, ServiceName: "ProjectsLocationsClusters", API: "container", DoMethodToList: true, ParentListScope: true, NoProjectScope: true, ItemName: "Clusters"},
 	//	redis
 	Function{Resource: "Instance", FunctionName: "ListRedisInstances", API: "redis", ServiceName: "ProjectsLocationsInstances", MaxResultFunc: "PageSize", NoFilter: true, ParentListScope: true, ResourceList: "ListInstancesResponse", ItemName: "Instances"},
+	//	spanner
+	Function{Resource: "Instance", FunctionName: "ListSpannerInstances", API: "spanner", ServiceName: "ProjectsInstances", MaxResultFunc: "PageSize", NoFilter: true, ParentListScope: true, ResourceList: "ListInstancesResponse", ItemName: "Instances"},
+	Function{Resource: "Database", FunctionName: "ListSpannerDatabases", API: "spanner", ServiceName: "ProjectsInstancesDatabases", MaxResultFunc: "PageSize", NoFilter: true, ParentListScope: true, ResourceList: "ListDatabasesResponse", ItemName: "Databases"},
 	//	logging
 	Function{Resource: "LogMetric", ServiceName: "ProjectsMetrics", API: "logging", MaxResultFunc: "PageSize", ParentListScope: true, NoFilter: true, ResourceList: "ListLogMetricsResponse", ItemName: "Metrics"},
 	// monitoring
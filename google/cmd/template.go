@@ -35,6 +35,34 @@ const (
 	// {{ .FunctionName }} returns a list of {{ .PluralName }}{{ if .NoProjectScope }}{{ else }} within a project {{ if .Zone }}and a zone {{ else if .OtherListArg }}and a {{ .OtherListArg }}{{ end }}{{ end }}
 	func (r *GCPReader) {{ .FunctionName }} (ctx context.Context{{ if not .NoFilter }}, filter string {{ end }}{{ if or .ParentListScope .ParentFunction}}, parent string {{ end }}{{ if .OtherListArg }}, {{ .OtherListArg }} []string{{ end }}) ({{ if or .Zone .OtherListArg }}map[string]{{end}}[]{{ .API }}.{{ .Resource }}, error) {
 		service := {{ .API }}.New{{ .ServiceName}}Service(r.{{ .API }})
+		{{ if .Aggregated }}
+		list := make(map[string][]{{ .API }}.{{ .Resource }})
+
+		// The aggregated list returns the resources
+		// of all the zones on one call
+		err := service.AggregatedList(r.project).
+		{{ if not .NoFilter }}
+			Filter(filter).
+		{{ end }}
+		{{ if .MaxResultFunc }}{{ .MaxResultFunc }}{{ else }}MaxResults{{ end }}(int64(r.maxResults)).
+		Pages(ctx, func(aggregatedList *{{ .API }}.{{ .Resource }}AggregatedList) error {
+			for scope, scopedList := range aggregatedList.Items {
+				zone, ok := r.scopeZone(scope)
+				if !ok {
+					continue
+				}
+				for _, res := range scopedList.{{ .ServiceName }} {
+					list[zone] = append(list[zone], *res)
+				}
+			}
+			return nil
+		})
+
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list {{ .API }} {{ .Resource }} from google APIs")
+		}
+		return list, nil
+		{{ else }}
 		{{ if .Zone }}
 		list := make(map[string][]{{ .API }}.{{ .Resource }})
 		zones, err := r.getZones()
@@ -85,6 +113,7 @@ const (
 		{{ else }}
 		return resources, nil
 		{{ end }}
+		{{ end }}
 	}
 	`
 )
@@ -152,7 +181,13 @@ type Function struct {
 	// Optional field: use if the List method of the resource requires Zone as a param
 	Zone bool
 
+	// Aggregated is used with Zone to list the resources of all the zones
+	// with one AggregatedList call instead of one List call per zone
+	// Optional field: use if the resource has an AggregatedList method
+	Aggregated bool
+
 	// Region is used to determine whether the resource is dedicated to a region or not
+	// If it is the case the list will be done on the region of the provider
 	// Optional field: use if the List method of the resource requires region as a param
 	Region bool
 
@@ -222,6 +257,9 @@ func (f Function) Execute(w io.Writer) error {
 			f.FunctionName = "List" + strings.ToUpper(f.API) + f.PluralName
 		}
 	}
+	if f.Aggregated && !f.Zone {
+		return errors.Errorf("the Aggregated can only be used with Zone on %s", f.Resource)
+	}
 	if err := fnTmpl.Execute(w, f); err != nil {
 		return errors.Wrapf(err, "failed to Execute with Function %+v", f)
 	}
@@ -0,0 +1,70 @@
+package google
+
+import (
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// ConfigureResource normalizes the HCL configuration of the GKE
+// clusters and node pools and the BigQuery tables, removing the
+// attributes that are computed, so it can be applied without changes
+func (g *google) ConfigureResource(r provider.Resource, cfg map[string]interface{}) error {
+	switch r.Type() {
+	case ContainerCluster.String():
+		configureContainerCluster(cfg)
+	case ContainerNodePool.String():
+		configureContainerNodePool(cfg)
+	case BigQueryTable.String():
+		configureBigQueryTable(cfg)
+	}
+
+	return nil
+}
+
+// configureContainerCluster removes from the cfg of a google_container_cluster
+// the attributes of the default node pool, which are the ones of the first
+// node pool, and the ones that can not be set on an Autopilot cluster
+func configureContainerCluster(cfg map[string]interface{}) {
+	if ap, ok := cfg["enable_autopilot"].(bool); ok && ap {
+		// The nodes of the Autopilot
+		// clusters are managed by GKE
+		delete(cfg, "node_pool")
+		delete(cfg, "node_config")
+		delete(cfg, "initial_node_count")
+		delete(cfg, "node_version")
+		return
+	}
+
+	nps, ok := cfg["node_pool"].([]interface{})
+	if !ok || len(nps) == 0 {
+		return
+	}
+
+	// The node_pool blocks already define
+	// all the node pools with their versions
+	delete(cfg, "node_config")
+	delete(cfg, "initial_node_count")
+	delete(cfg, "node_version")
+
+	for _, np := range nps {
+		if npcfg, ok := np.(map[string]interface{}); ok {
+			configureContainerNodePool(npcfg)
+		}
+	}
+}
+
+// configureContainerNodePool removes from the cfg of a node pool the
+// node_count when it's autoscaled and the version when it's auto upgraded,
+// as both are changed by GKE
+func configureContainerNodePool(cfg map[string]interface{}) {
+	if as, ok := cfg["autoscaling"].([]interface{}); ok && len(as) != 0 {
+		delete(cfg, "node_count")
+	}
+
+	if ms, ok := cfg["management"].([]interface{}); ok && len(ms) != 0 {
+		if m, ok := ms[0].(map[string]interface{}); ok {
+			if au, ok := m["auto_upgrade"].(bool); ok && au {
+				delete(cfg, "version")
+			}
+		}
+	}
+}
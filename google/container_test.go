@@ -0,0 +1,85 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigureContainerCluster(t *testing.T) {
+	t.Run("NodePools", func(t *testing.T) {
+		cfg := map[string]interface{}{
+			"name":               "cluster",
+			"location":           "europe-west1",
+			"initial_node_count": 1,
+			"node_version":       "1.22.8-gke.202",
+			"node_config":        []interface{}{map[string]interface{}{"machine_type": "e2-medium"}},
+			"node_pool": []interface{}{
+				map[string]interface{}{
+					"name":        "pool",
+					"node_count":  3,
+					"version":     "1.22.8-gke.202",
+					"autoscaling": []interface{}{map[string]interface{}{"min_node_count": 1, "max_node_count": 5}},
+					"management":  []interface{}{map[string]interface{}{"auto_upgrade": true}},
+				},
+			},
+		}
+
+		configureContainerCluster(cfg)
+
+		assert.Equal(t, map[string]interface{}{
+			"name":     "cluster",
+			"location": "europe-west1",
+			"node_pool": []interface{}{
+				map[string]interface{}{
+					"name":        "pool",
+					"autoscaling": []interface{}{map[string]interface{}{"min_node_count": 1, "max_node_count": 5}},
+					"management":  []interface{}{map[string]interface{}{"auto_upgrade": true}},
+				},
+			},
+		}, cfg)
+	})
+	t.Run("Autopilot", func(t *testing.T) {
+		cfg := map[string]interface{}{
+			"name":             "cluster",
+			"enable_autopilot": true,
+			"node_config":      []interface{}{map[string]interface{}{"machine_type": "e2-medium"}},
+			"node_pool":        []interface{}{map[string]interface{}{"name": "pool"}},
+		}
+
+		configureContainerCluster(cfg)
+
+		assert.Equal(t, map[string]interface{}{
+			"name":             "cluster",
+			"enable_autopilot": true,
+		}, cfg)
+	})
+	t.Run("NoNodePools", func(t *testing.T) {
+		cfg := map[string]interface{}{
+			"name":               "cluster",
+			"initial_node_count": 1,
+		}
+
+		configureContainerCluster(cfg)
+
+		assert.Equal(t, map[string]interface{}{
+			"name":               "cluster",
+			"initial_node_count": 1,
+		}, cfg)
+	})
+}
+
+func TestConfigureContainerNodePool(t *testing.T) {
+	t.Run("Fixed", func(t *testing.T) {
+		cfg := map[string]interface{}{
+			"node_count": 3,
+			"version":    "1.22.8-gke.202",
+			"management": []interface{}{map[string]interface{}{"auto_upgrade": false}},
+		}
+
+		configureContainerNodePool(cfg)
+
+		assert.Equal(t, 3, cfg["node_count"])
+		assert.Equal(t, "1.22.8-gke.202", cfg["version"])
+	})
+}
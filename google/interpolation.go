@@ -0,0 +1,36 @@
+package google
+
+import (
+	"regexp"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// selfLinkMatcher matches the resources referenced by the self link,
+// 'https://www.googleapis.com/compute/v1/projects/PROJECT/...', or by
+// the relative path, 'projects/PROJECT/...', as both are used
+var selfLinkMatcher = provider.NewRegexpMatcher(regexp.MustCompile(`^(?:https://www\.googleapis\.com/compute/(?:v1|beta)/)?(projects/[^/]+/.+)$`), false, 10)
+
+// InterpolationMatchers returns the Matchers used to resolve
+// the values to interpolate that have other formats
+func (g *google) InterpolationMatchers() []provider.Matcher {
+	return []provider.Matcher{selfLinkMatcher}
+}
+
+// attributesReference are the attributes, by resource type, that are
+// referenced by other resources and are not exported on the TF docs
+var attributesReference = map[ResourceType][]string{
+	// The google_dns_record_set reference
+	// the zone by name on the 'managed_zone'
+	DNSManagedZone: {"name"},
+}
+
+// AttributesReference returns the attributes of the rt that are
+// referenced by other resources and are not exported on the TF docs
+func (g *google) AttributesReference(rt string) []string {
+	t, err := ResourceTypeString(rt)
+	if err != nil {
+		return nil
+	}
+	return attributesReference[t]
+}
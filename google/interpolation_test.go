@@ -0,0 +1,35 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelfLinkMatcher(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Value string
+		NV    string
+		OK    bool
+	}{
+		{Name: "SelfLink", Value: "https://www.googleapis.com/compute/v1/projects/pj/global/networks/net", NV: "projects/pj/global/networks/net", OK: true},
+		{Name: "SelfLinkBeta", Value: "https://www.googleapis.com/compute/beta/projects/pj/global/networks/net", NV: "projects/pj/global/networks/net", OK: true},
+		{Name: "Relative", Value: "projects/pj/global/networks/net", NV: "projects/pj/global/networks/net", OK: true},
+		{Name: "Name", Value: "net", OK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			nv, ok := selfLinkMatcher.Match(tt.Value)
+			assert.Equal(t, tt.OK, ok)
+			assert.Equal(t, tt.NV, nv)
+		})
+	}
+}
+
+func TestAttributesReference(t *testing.T) {
+	g := &google{}
+	assert.Equal(t, []string{"name"}, g.AttributesReference(DNSManagedZone.String()))
+	assert.Nil(t, g.AttributesReference(ComputeInstance.String()))
+	assert.Nil(t, g.AttributesReference("potato"))
+}
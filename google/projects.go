@@ -0,0 +1,167 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	"google.golang.org/api/cloudresourcemanager/v3"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/log"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+const (
+	folderPrefix       = "folders/"
+	organizationPrefix = "organizations/"
+)
+
+// ExpandProjects returns the IDs of the projects, the folders ('folders/ID')
+// and organizations ('organizations/ID') are expanded to all the active
+// projects inside of them, sub folders included
+func ExpandProjects(ctx context.Context, credentials, impersonateServiceAccount string, projects []string) ([]string, error) {
+	var (
+		ids     = make([]string, 0, len(projects))
+		parents []string
+		seen    = make(map[string]struct{})
+	)
+
+	for _, p := range projects {
+		if strings.HasPrefix(p, folderPrefix) || strings.HasPrefix(p, organizationPrefix) {
+			parents = append(parents, p)
+			continue
+		}
+		if _, ok := seen[p]; !ok {
+			seen[p] = struct{}{}
+			ids = append(ids, p)
+		}
+	}
+
+	if len(parents) == 0 {
+		return ids, nil
+	}
+
+	crm, err := cloudresourcemanager.NewService(ctx, clientOptions(credentials, impersonateServiceAccount)...)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create cloud resource manager service")
+	}
+
+	for len(parents) != 0 {
+		parent := parents[0]
+		parents = parents[1:]
+
+		err = crm.Projects.List().Parent(parent).Pages(ctx, func(list *cloudresourcemanager.ListProjectsResponse) error {
+			for _, p := range list.Projects {
+				if p.State != "ACTIVE" {
+					continue
+				}
+				if _, ok := seen[p.ProjectId]; !ok {
+					seen[p.ProjectId] = struct{}{}
+					ids = append(ids, p.ProjectId)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list the projects of %s", parent)
+		}
+
+		err = crm.Folders.List().Parent(parent).Pages(ctx, func(list *cloudresourcemanager.ListFoldersResponse) error {
+			for _, f := range list.Folders {
+				parents = append(parents, f.Name)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list the folders of %s", parent)
+		}
+	}
+
+	return ids, nil
+}
+
+// multiProject is a Provider that reads from multiple
+// projects, one google Provider for each one of them,
+// so all of them are imported on the same output
+type multiProject struct {
+	projects  []string
+	providers []provider.Provider
+}
+
+// NewMultiProjectProvider returns a Google Provider that imports all the
+// projects with the opts, if only one is defined it's the same as NewProvider
+func NewMultiProjectProvider(ctx context.Context, maxResults uint64, projects []string, region, credentials string, opts Options) (provider.Provider, error) {
+	if len(projects) == 0 {
+		return nil, errors.New("at least one project is required")
+	} else if len(projects) == 1 {
+		return NewProvider(ctx, maxResults, projects[0], region, credentials, opts)
+	}
+
+	providers := make([]provider.Provider, 0, len(projects))
+	for _, p := range projects {
+		log.Get().Log("func", "google.NewMultiProjectProvider", "msg", "loading project", "project", p)
+		gp, err := NewProvider(ctx, maxResults, p, region, credentials, opts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to initialize the project %s: %w", p, err)
+		}
+		providers = append(providers, gp)
+	}
+
+	return &multiProject{
+		projects:  projects,
+		providers: providers,
+	}, nil
+}
+
+func (m *multiProject) HasResourceType(t string) bool         { return m.providers[0].HasResourceType(t) }
+func (m *multiProject) Region() string                        { return m.providers[0].Region() }
+func (m *multiProject) String() string                        { return m.providers[0].String() }
+func (m *multiProject) TagKey() string                        { return m.providers[0].TagKey() }
+func (m *multiProject) Source() string                        { return m.providers[0].Source() }
+func (m *multiProject) Version() string                       { return TFProviderVersion }
+func (m *multiProject) Configuration() map[string]interface{} { return m.providers[0].Configuration() }
+func (m *multiProject) ResourceTypes() []string               { return m.providers[0].ResourceTypes() }
+func (m *multiProject) TFClient() interface{}                 { return m.providers[0].TFClient() }
+func (m *multiProject) TFProvider() *schema.Provider          { return m.providers[0].TFProvider() }
+
+// Resources returns the Resources of all the projects, the ones that return
+// an errcode.ErrProviderAPI are skipped unless all of them fail with it
+func (m *multiProject) Resources(ctx context.Context, t string, f *filter.Filter) ([]provider.Resource, error) {
+	var (
+		resources []provider.Resource
+		apiErr    error
+		read      bool
+	)
+	for i, p := range m.providers {
+		res, err := p.Resources(ctx, t, f)
+		if err != nil {
+			if errors.Is(err, errcode.ErrProviderAPI) {
+				apiErr = err
+				continue
+			}
+			return nil, errors.Wrapf(err, "on project %s", m.projects[i])
+		}
+		read = true
+		resources = append(resources, res...)
+	}
+
+	if !read && apiErr != nil {
+		return nil, apiErr
+	}
+
+	return resources, nil
+}
+
+// InterpolationMatchers returns the Matchers of the google Provider
+func (m *multiProject) InterpolationMatchers() []provider.Matcher {
+	return m.providers[0].(provider.InterpolationMatcher).InterpolationMatchers()
+}
+
+// ResourceOwner returns the owner of the r from the owner labels
+func (m *multiProject) ResourceOwner(ctx context.Context, r provider.Resource) (string, string, error) {
+	return m.providers[0].(provider.OwnerResolver).ResourceOwner(ctx, r)
+}
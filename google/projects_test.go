@@ -0,0 +1,96 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+func TestExpandProjects(t *testing.T) {
+	ids, err := ExpandProjects(context.Background(), "", "", []string{"project-a", "project-b", "project-a"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"project-a", "project-b"}, ids)
+}
+
+func TestMultiProjectResources(t *testing.T) {
+	var (
+		ctx = context.Background()
+		f   = &filter.Filter{}
+		rt  = "google_compute_instance"
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pa := mock.NewProvider(ctrl)
+		pb := mock.NewProvider(ctrl)
+		ra := mock.NewResource(ctrl)
+		rb := mock.NewResource(ctrl)
+
+		pa.EXPECT().Resources(ctx, rt, f).Return([]provider.Resource{ra}, nil)
+		pb.EXPECT().Resources(ctx, rt, f).Return([]provider.Resource{rb}, nil)
+
+		m := &multiProject{projects: []string{"a", "b"}, providers: []provider.Provider{pa, pb}}
+		rs, err := m.Resources(ctx, rt, f)
+		require.NoError(t, err)
+		assert.Equal(t, []provider.Resource{ra, rb}, rs)
+	})
+
+	t.Run("SkipProviderAPI", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pa := mock.NewProvider(ctrl)
+		pb := mock.NewProvider(ctrl)
+		rb := mock.NewResource(ctrl)
+
+		pa.EXPECT().Resources(ctx, rt, f).Return(nil, fmt.Errorf("%w: disabled", errcode.ErrProviderAPI))
+		pb.EXPECT().Resources(ctx, rt, f).Return([]provider.Resource{rb}, nil)
+
+		m := &multiProject{projects: []string{"a", "b"}, providers: []provider.Provider{pa, pb}}
+		rs, err := m.Resources(ctx, rt, f)
+		require.NoError(t, err)
+		assert.Equal(t, []provider.Resource{rb}, rs)
+	})
+
+	t.Run("ErrorProviderAPI", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pa := mock.NewProvider(ctrl)
+		pb := mock.NewProvider(ctrl)
+
+		pa.EXPECT().Resources(ctx, rt, f).Return(nil, fmt.Errorf("%w: disabled", errcode.ErrProviderAPI))
+		pb.EXPECT().Resources(ctx, rt, f).Return(nil, fmt.Errorf("%w: disabled", errcode.ErrProviderAPI))
+
+		m := &multiProject{projects: []string{"a", "b"}, providers: []provider.Provider{pa, pb}}
+		_, err := m.Resources(ctx, rt, f)
+		assert.True(t, errors.Is(err, errcode.ErrProviderAPI))
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pa := mock.NewProvider(ctrl)
+		pb := mock.NewProvider(ctrl)
+
+		pa.EXPECT().Resources(ctx, rt, f).Return(nil, nil)
+		pb.EXPECT().Resources(ctx, rt, f).Return(nil, errors.New("failed"))
+
+		m := &multiProject{projects: []string{"a", "b"}, providers: []provider.Provider{pa, pb}}
+		_, err := m.Resources(ctx, rt, f)
+		assert.EqualError(t, err, "on project b: failed")
+	})
+}
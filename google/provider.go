@@ -3,6 +3,7 @@ package google
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cycloidio/terracognita/cache"
 	"github.com/cycloidio/terracognita/errcode"
@@ -26,20 +27,67 @@ var skippableCodes = map[string]struct{}{
 	"accessNotConfigured": struct{}{},
 }
 
+// TFProviderVersion is the version of the Google Terraform
+// Provider used to import the resources
+const TFProviderVersion = "4.9.0"
+
+// List of the styles to import the IAM Policy
+// of the project
+const (
+	// IAMStyleMember imports each member of each role
+	// as a google_project_iam_member
+	IAMStyleMember = "member"
+
+	// IAMStyleBinding imports each role with all
+	// its members as a google_project_iam_binding
+	IAMStyleBinding = "binding"
+
+	// IAMStylePolicy imports all the Policy as
+	// a google_project_iam_policy
+	IAMStylePolicy = "policy"
+)
+
+// IAMStyles are all the supported IAM styles
+var IAMStyles = []string{IAMStyleMember, IAMStyleBinding, IAMStylePolicy}
+
 type google struct {
 	tfGoogleClient interface{}
 	tfProvider     *schema.Provider
 	gcpr           *GCPReader
 
+	// iamStyle is one of the IAMStyles, only the
+	// resource type of it is imported
+	iamStyle string
+
 	cache cache.Cache
 }
 
-// NewProvider returns a Gooogle Provider
-func NewProvider(ctx context.Context, maxResults uint64, project, region, credentials string) (provider.Provider, error) {
+// Options are the optional configuration of the Google
+// Provider, the zero value is the default one
+type Options struct {
+	// ImpersonateServiceAccount, if set, is the
+	// Service Account all the calls are done as
+	ImpersonateServiceAccount string
+
+	// IAMStyle is one of the IAMStyles used to import the IAM
+	// Policy of the project, IAMStyleMember if empty
+	IAMStyle string
+}
+
+// NewProvider returns a Gooogle Provider configured with the opts
+func NewProvider(ctx context.Context, maxResults uint64, project, region, credentials string, opts Options) (provider.Provider, error) {
+	if opts.IAMStyle == "" {
+		opts.IAMStyle = IAMStyleMember
+	}
+	if !isValidIAMStyle(opts.IAMStyle) {
+		return nil, errors.Errorf("invalid IAM style %q, the supported ones are: %s", opts.IAMStyle, strings.Join(IAMStyles, ", "))
+	}
+
 	cfg := tfgoogle.Config{
-		Credentials: credentials,
-		Project:     project,
-		Region:      region,
+		Credentials:               credentials,
+		ImpersonateServiceAccount: opts.ImpersonateServiceAccount,
+		Project:                   project,
+		Region:                    region,
 	}
 
 	tfgoogle.ConfigureBasePaths(&cfg)
@@ -52,7 +100,7 @@ func NewProvider(ctx context.Context, maxResults uint64, project, region, creden
 	tfp.SetMeta(&cfg)
 
 	log.Get().Log("func", "google.NewProvider", "msg", "loading GCP client")
-	reader, err := NewGcpReader(ctx, maxResults, project, region, credentials)
+	reader, err := NewGcpReader(ctx, maxResults, project, region, credentials, opts.ImpersonateServiceAccount)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize GCPReader: %v", err)
 	}
@@ -61,6 +109,7 @@ func NewProvider(ctx context.Context, maxResults uint64, project, region, creden
 		tfGoogleClient: &cfg,
 		tfProvider:     tfp,
 		gcpr:           reader,
+		iamStyle:       opts.IAMStyle,
 		cache:          cache.New(),
 	}, nil
 }
@@ -75,6 +124,7 @@ func (g *google) Project() string                       { return g.tfGoogleClien
 func (g *google) String() string                        { return "google" }
 func (g *google) TagKey() string                        { return "labels" }
 func (g *google) Source() string                        { return "hashicorp/google" }
+func (g *google) Version() string                       { return TFProviderVersion }
 func (g *google) Configuration() map[string]interface{} { return make(map[string]interface{}) }
 
 func (g *google) ResourceTypes() []string {
@@ -124,3 +174,18 @@ func (g *google) TFClient() interface{} {
 func (g *google) TFProvider() *schema.Provider {
 	return g.tfProvider
 }
+
+// ResourceOwner returns the owner of the r from the owner labels
+func (g *google) ResourceOwner(ctx context.Context, r provider.Resource) (string, string, error) {
+	o, s := provider.OwnerFromTags(g, r)
+	return o, s, nil
+}
+
+func isValidIAMStyle(s string) bool {
+	for _, st := range IAMStyles {
+		if s == st {
+			return true
+		}
+	}
+	return false
+}
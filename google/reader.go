@@ -6,7 +6,10 @@ import (
 
 	"github.com/pkg/errors"
 
+	"google.golang.org/api/bigquery/v2"
 	"google.golang.org/api/cloudbilling/v1"
+	"google.golang.org/api/cloudfunctions/v1"
+	"google.golang.org/api/cloudresourcemanager/v3"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/container/v1"
 	"google.golang.org/api/dns/v1"
@@ -15,7 +18,9 @@ import (
 	"google.golang.org/api/logging/v2"
 	"google.golang.org/api/monitoring/v3"
 	"google.golang.org/api/option"
+	"google.golang.org/api/pubsub/v1"
 	"google.golang.org/api/redis/v1"
+	"google.golang.org/api/spanner/v1"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 	"google.golang.org/api/storage/v1"
 )
@@ -30,67 +35,109 @@ type GCPReader struct {
 	dns          *dns.Service
 	iam          *iam.Service
 	cloudbilling *cloudbilling.APIService
+	crm          *cloudresourcemanager.Service
 	file         *file.Service
 	container    *container.Service
 	redis        *redis.Service
+	spanner      *spanner.Service
 	logging      *logging.Service
 	monitoring   *monitoring.Service
+	pubsub       *pubsub.Service
+	functions    *cloudfunctions.Service
+	bigquery     *bigquery.Service
 	project      string
 	region       string
 	zones        []string
 	maxResults   uint64
 }
 
+// clientOptions returns the options to authenticate the GCP clients,
+// the credentials can be a service account key or an external account
+// (Workload Identity Federation) file and if empty the Application
+// Default Credentials are used. If impersonateServiceAccount is set
+// the calls are done as that service account
+func clientOptions(credentials, impersonateServiceAccount string) []option.ClientOption {
+	opts := make([]option.ClientOption, 0, 2)
+	if credentials != "" {
+		opts = append(opts, option.WithCredentialsFile(credentials))
+	}
+	if impersonateServiceAccount != "" {
+		opts = append(opts, option.ImpersonateCredentials(impersonateServiceAccount))
+	}
+	return opts
+}
+
 // NewGcpReader returns a GCPReader with a catalog of services
 // ready to be used
-func NewGcpReader(ctx context.Context, maxResults uint64, project, region, credentials string) (*GCPReader, error) {
+func NewGcpReader(ctx context.Context, maxResults uint64, project, region, credentials, impersonateServiceAccount string) (*GCPReader, error) {
 	if maxResults > 500 {
 		return nil, errors.New("max-results must be between 0 and 500, inclusive")
 	}
-	comp, err := compute.NewService(ctx, option.WithCredentialsFile(credentials))
+	opts := clientOptions(credentials, impersonateServiceAccount)
+	comp, err := compute.NewService(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create compute service")
 	}
-	storage, err := storage.NewService(ctx, option.WithCredentialsFile(credentials))
+	storage, err := storage.NewService(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create storage service")
 	}
-	sql, err := sqladmin.NewService(ctx, option.WithCredentialsFile(credentials))
+	sql, err := sqladmin.NewService(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create sqladmin service")
 	}
-	d, err := dns.NewService(ctx, option.WithCredentialsFile(credentials))
+	d, err := dns.NewService(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create sqladmin service")
 	}
-	i, err := iam.NewService(ctx, option.WithCredentialsFile(credentials))
+	i, err := iam.NewService(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create iam service")
 	}
-	bill, err := cloudbilling.NewService(ctx, option.WithCredentialsFile(credentials))
+	bill, err := cloudbilling.NewService(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create cloud billing service")
 	}
-	file, err := file.NewService(ctx, option.WithCredentialsFile(credentials))
+	crm, err := cloudresourcemanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create cloud resource manager service")
+	}
+	file, err := file.NewService(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create filestore service")
 	}
-	container, err := container.NewService(ctx, option.WithCredentialsFile(credentials))
+	container, err := container.NewService(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create container service")
 	}
-	redis, err := redis.NewService(ctx, option.WithCredentialsFile(credentials))
+	redis, err := redis.NewService(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create redis service")
 	}
-	logging, err := logging.NewService(ctx, option.WithCredentialsFile(credentials))
+	spanner, err := spanner.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create spanner service")
+	}
+	logging, err := logging.NewService(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create logging service")
 	}
-	monitoring, err := monitoring.NewService(ctx, option.WithCredentialsFile(credentials))
+	monitoring, err := monitoring.NewService(ctx, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create logging service")
 	}
+	pubsub, err := pubsub.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create pubsub service")
+	}
+	functions, err := cloudfunctions.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create cloud functions service")
+	}
+	bigquery, err := bigquery.NewService(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create bigquery service")
+	}
 	return &GCPReader{
 		compute:      comp,
 		storage:      storage,
@@ -100,11 +147,16 @@ func NewGcpReader(ctx context.Context, maxResults uint64, project, region, crede
 		dns:          d,
 		iam:          i,
 		cloudbilling: bill,
+		crm:          crm,
 		file:         file,
 		container:    container,
 		redis:        redis,
+		spanner:      spanner,
 		logging:      logging,
 		monitoring:   monitoring,
+		pubsub:       pubsub,
+		functions:    functions,
+		bigquery:     bigquery,
 		zones:        []string{},
 		maxResults:   maxResults,
 	}, nil
@@ -130,3 +182,20 @@ func (r *GCPReader) getZones() ([]string, error) {
 	r.zones = zones
 	return zones, nil
 }
+
+// scopeZone returns the zone of the scope of an aggregated list, which
+// has the format 'zones/ZONE', if it's one of the zones of the region
+func (r *GCPReader) scopeZone(scope string) (string, bool) {
+	if !strings.HasPrefix(scope, "zones/") {
+		return "", false
+	}
+
+	// The zones have the format 'REGION-SUFFIX'
+	// like 'us-central1-c'
+	zone := strings.TrimPrefix(scope, "zones/")
+	if !strings.HasPrefix(zone, r.region+"-") {
+		return "", false
+	}
+
+	return zone, true
+}
@@ -14,6 +14,7 @@ import (
 	"google.golang.org/api/logging/v2"
 	"google.golang.org/api/monitoring/v3"
 	"google.golang.org/api/redis/v1"
+	"google.golang.org/api/spanner/v1"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 	"google.golang.org/api/storage/v1"
 )
@@ -215,29 +216,27 @@ func (r *GCPReader) ListAutoscalers(ctx context.Context, filter string) (map[str
 	service := compute.NewAutoscalersService(r.compute)
 
 	list := make(map[string][]compute.Autoscaler)
-	zones, err := r.getZones()
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to get zones in region")
-	}
-	for _, zone := range zones {
 
-		resources := make([]compute.Autoscaler, 0)
-
-		err := service.List(r.project, zone).
-			Filter(filter).
-			MaxResults(int64(r.maxResults)).
-			Pages(ctx, func(list *compute.AutoscalerList) error {
-				for _, res := range list.Items {
-					resources = append(resources, *res)
+	// The aggregated list returns the resources
+	// of all the zones on one call
+	err := service.AggregatedList(r.project).
+		Filter(filter).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(aggregatedList *compute.AutoscalerAggregatedList) error {
+			for scope, scopedList := range aggregatedList.Items {
+				zone, ok := r.scopeZone(scope)
+				if !ok {
+					continue
 				}
-				return nil
-			})
-
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to list compute Autoscaler from google APIs")
-		}
+				for _, res := range scopedList.Autoscalers {
+					list[zone] = append(list[zone], *res)
+				}
+			}
+			return nil
+		})
 
-		list[zone] = resources
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list compute Autoscaler from google APIs")
 	}
 	return list, nil
 
@@ -296,29 +295,27 @@ func (r *GCPReader) ListDisks(ctx context.Context, filter string) (map[string][]
 	service := compute.NewDisksService(r.compute)
 
 	list := make(map[string][]compute.Disk)
-	zones, err := r.getZones()
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to get zones in region")
-	}
-	for _, zone := range zones {
-
-		resources := make([]compute.Disk, 0)
 
-		err := service.List(r.project, zone).
-			Filter(filter).
-			MaxResults(int64(r.maxResults)).
-			Pages(ctx, func(list *compute.DiskList) error {
-				for _, res := range list.Items {
-					resources = append(resources, *res)
+	// The aggregated list returns the resources
+	// of all the zones on one call
+	err := service.AggregatedList(r.project).
+		Filter(filter).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(aggregatedList *compute.DiskAggregatedList) error {
+			for scope, scopedList := range aggregatedList.Items {
+				zone, ok := r.scopeZone(scope)
+				if !ok {
+					continue
 				}
-				return nil
-			})
-
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to list compute Disk from google APIs")
-		}
+				for _, res := range scopedList.Disks {
+					list[zone] = append(list[zone], *res)
+				}
+			}
+			return nil
+		})
 
-		list[zone] = resources
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list compute Disk from google APIs")
 	}
 	return list, nil
 
@@ -425,29 +422,27 @@ func (r *GCPReader) ListInstances(ctx context.Context, filter string) (map[strin
 	service := compute.NewInstancesService(r.compute)
 
 	list := make(map[string][]compute.Instance)
-	zones, err := r.getZones()
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to get zones in region")
-	}
-	for _, zone := range zones {
-
-		resources := make([]compute.Instance, 0)
 
-		err := service.List(r.project, zone).
-			Filter(filter).
-			MaxResults(int64(r.maxResults)).
-			Pages(ctx, func(list *compute.InstanceList) error {
-				for _, res := range list.Items {
-					resources = append(resources, *res)
+	// The aggregated list returns the resources
+	// of all the zones on one call
+	err := service.AggregatedList(r.project).
+		Filter(filter).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(aggregatedList *compute.InstanceAggregatedList) error {
+			for scope, scopedList := range aggregatedList.Items {
+				zone, ok := r.scopeZone(scope)
+				if !ok {
+					continue
 				}
-				return nil
-			})
-
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to list compute Instance from google APIs")
-		}
+				for _, res := range scopedList.Instances {
+					list[zone] = append(list[zone], *res)
+				}
+			}
+			return nil
+		})
 
-		list[zone] = resources
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list compute Instance from google APIs")
 	}
 	return list, nil
 
@@ -458,29 +453,27 @@ func (r *GCPReader) ListInstanceGroups(ctx context.Context, filter string) (map[
 	service := compute.NewInstanceGroupsService(r.compute)
 
 	list := make(map[string][]compute.InstanceGroup)
-	zones, err := r.getZones()
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to get zones in region")
-	}
-	for _, zone := range zones {
 
-		resources := make([]compute.InstanceGroup, 0)
-
-		err := service.List(r.project, zone).
-			Filter(filter).
-			MaxResults(int64(r.maxResults)).
-			Pages(ctx, func(list *compute.InstanceGroupList) error {
-				for _, res := range list.Items {
-					resources = append(resources, *res)
+	// The aggregated list returns the resources
+	// of all the zones on one call
+	err := service.AggregatedList(r.project).
+		Filter(filter).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(aggregatedList *compute.InstanceGroupAggregatedList) error {
+			for scope, scopedList := range aggregatedList.Items {
+				zone, ok := r.scopeZone(scope)
+				if !ok {
+					continue
 				}
-				return nil
-			})
-
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to list compute InstanceGroup from google APIs")
-		}
+				for _, res := range scopedList.InstanceGroups {
+					list[zone] = append(list[zone], *res)
+				}
+			}
+			return nil
+		})
 
-		list[zone] = resources
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list compute InstanceGroup from google APIs")
 	}
 	return list, nil
 
@@ -659,29 +652,27 @@ func (r *GCPReader) ListInstanceGroupManagers(ctx context.Context, filter string
 	service := compute.NewInstanceGroupManagersService(r.compute)
 
 	list := make(map[string][]compute.InstanceGroupManager)
-	zones, err := r.getZones()
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to get zones in region")
-	}
-	for _, zone := range zones {
 
-		resources := make([]compute.InstanceGroupManager, 0)
-
-		err := service.List(r.project, zone).
-			Filter(filter).
-			MaxResults(int64(r.maxResults)).
-			Pages(ctx, func(list *compute.InstanceGroupManagerList) error {
-				for _, res := range list.Items {
-					resources = append(resources, *res)
+	// The aggregated list returns the resources
+	// of all the zones on one call
+	err := service.AggregatedList(r.project).
+		Filter(filter).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(aggregatedList *compute.InstanceGroupManagerAggregatedList) error {
+			for scope, scopedList := range aggregatedList.Items {
+				zone, ok := r.scopeZone(scope)
+				if !ok {
+					continue
 				}
-				return nil
-			})
-
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to list compute InstanceGroupManager from google APIs")
-		}
+				for _, res := range scopedList.InstanceGroupManagers {
+					list[zone] = append(list[zone], *res)
+				}
+			}
+			return nil
+		})
 
-		list[zone] = resources
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list compute InstanceGroupManager from google APIs")
 	}
 	return list, nil
 
@@ -740,29 +731,27 @@ func (r *GCPReader) ListNetworkEndpointGroups(ctx context.Context, filter string
 	service := compute.NewNetworkEndpointGroupsService(r.compute)
 
 	list := make(map[string][]compute.NetworkEndpointGroup)
-	zones, err := r.getZones()
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to get zones in region")
-	}
-	for _, zone := range zones {
-
-		resources := make([]compute.NetworkEndpointGroup, 0)
 
-		err := service.List(r.project, zone).
-			Filter(filter).
-			MaxResults(int64(r.maxResults)).
-			Pages(ctx, func(list *compute.NetworkEndpointGroupList) error {
-				for _, res := range list.Items {
-					resources = append(resources, *res)
+	// The aggregated list returns the resources
+	// of all the zones on one call
+	err := service.AggregatedList(r.project).
+		Filter(filter).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(aggregatedList *compute.NetworkEndpointGroupAggregatedList) error {
+			for scope, scopedList := range aggregatedList.Items {
+				zone, ok := r.scopeZone(scope)
+				if !ok {
+					continue
 				}
-				return nil
-			})
-
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to list compute NetworkEndpointGroup from google APIs")
-		}
+				for _, res := range scopedList.NetworkEndpointGroups {
+					list[zone] = append(list[zone], *res)
+				}
+			}
+			return nil
+		})
 
-		list[zone] = resources
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list compute NetworkEndpointGroup from google APIs")
 	}
 	return list, nil
 
@@ -941,29 +930,27 @@ func (r *GCPReader) ListTargetInstances(ctx context.Context, filter string) (map
 	service := compute.NewTargetInstancesService(r.compute)
 
 	list := make(map[string][]compute.TargetInstance)
-	zones, err := r.getZones()
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to get zones in region")
-	}
-	for _, zone := range zones {
-
-		resources := make([]compute.TargetInstance, 0)
 
-		err := service.List(r.project, zone).
-			Filter(filter).
-			MaxResults(int64(r.maxResults)).
-			Pages(ctx, func(list *compute.TargetInstanceList) error {
-				for _, res := range list.Items {
-					resources = append(resources, *res)
+	// The aggregated list returns the resources
+	// of all the zones on one call
+	err := service.AggregatedList(r.project).
+		Filter(filter).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(aggregatedList *compute.TargetInstanceAggregatedList) error {
+			for scope, scopedList := range aggregatedList.Items {
+				zone, ok := r.scopeZone(scope)
+				if !ok {
+					continue
 				}
-				return nil
-			})
-
-		if err != nil {
-			return nil, errors.Wrap(err, "unable to list compute TargetInstance from google APIs")
-		}
+				for _, res := range scopedList.TargetInstances {
+					list[zone] = append(list[zone], *res)
+				}
+			}
+			return nil
+		})
 
-		list[zone] = resources
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list compute TargetInstance from google APIs")
 	}
 	return list, nil
 
@@ -1041,6 +1028,78 @@ func (r *GCPReader) ListTargetTCPProxies(ctx context.Context, filter string) ([]
 
 }
 
+// ListRegionBackendServices returns a list of BackendServices within a project
+func (r *GCPReader) ListRegionBackendServices(ctx context.Context, filter string) ([]compute.BackendService, error) {
+	service := compute.NewRegionBackendServicesService(r.compute)
+
+	resources := make([]compute.BackendService, 0)
+
+	err := service.List(r.project, r.region).
+		Filter(filter).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(list *compute.BackendServiceList) error {
+			for _, res := range list.Items {
+				resources = append(resources, *res)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list compute BackendService from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListRegionInstanceGroupManagers returns a list of RegionInstanceGroupManagers within a project
+func (r *GCPReader) ListRegionInstanceGroupManagers(ctx context.Context, filter string) ([]compute.InstanceGroupManager, error) {
+	service := compute.NewRegionInstanceGroupManagersService(r.compute)
+
+	resources := make([]compute.InstanceGroupManager, 0)
+
+	err := service.List(r.project, r.region).
+		Filter(filter).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(list *compute.RegionInstanceGroupManagerList) error {
+			for _, res := range list.Items {
+				resources = append(resources, *res)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list compute InstanceGroupManager from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListRouters returns a list of Routers within a project
+func (r *GCPReader) ListRouters(ctx context.Context, filter string) ([]compute.Router, error) {
+	service := compute.NewRoutersService(r.compute)
+
+	resources := make([]compute.Router, 0)
+
+	err := service.List(r.project, r.region).
+		Filter(filter).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(list *compute.RouterList) error {
+			for _, res := range list.Items {
+				resources = append(resources, *res)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list compute Router from google APIs")
+	}
+
+	return resources, nil
+
+}
+
 // ListFilestoreInstances returns a list of Instances within a project
 func (r *GCPReader) ListFilestoreInstances(ctx context.Context, filter string, parent string) ([]file.Instance, error) {
 	service := file.NewProjectsLocationsInstancesService(r.file)
@@ -1109,6 +1168,52 @@ func (r *GCPReader) ListRedisInstances(ctx context.Context, parent string) ([]re
 
 }
 
+// ListSpannerInstances returns a list of Instances within a project
+func (r *GCPReader) ListSpannerInstances(ctx context.Context, parent string) ([]spanner.Instance, error) {
+	service := spanner.NewProjectsInstancesService(r.spanner)
+
+	resources := make([]spanner.Instance, 0)
+
+	err := service.List(parent).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *spanner.ListInstancesResponse) error {
+			for _, res := range list.Instances {
+				resources = append(resources, *res)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list spanner Instance from google APIs")
+	}
+
+	return resources, nil
+
+}
+
+// ListSpannerDatabases returns a list of Databases within a project
+func (r *GCPReader) ListSpannerDatabases(ctx context.Context, parent string) ([]spanner.Database, error) {
+	service := spanner.NewProjectsInstancesDatabasesService(r.spanner)
+
+	resources := make([]spanner.Database, 0)
+
+	err := service.List(parent).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *spanner.ListDatabasesResponse) error {
+			for _, res := range list.Databases {
+				resources = append(resources, *res)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list spanner Database from google APIs")
+	}
+
+	return resources, nil
+
+}
+
 // ListLogMetrics returns a list of LogMetrics within a project
 func (r *GCPReader) ListLogMetrics(ctx context.Context, parent string) ([]logging.LogMetric, error) {
 	service := logging.NewProjectsMetricsService(r.logging)
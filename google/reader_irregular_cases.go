@@ -2,11 +2,18 @@ package google
 
 import (
 	"context"
+	"fmt"
 
 	"strings"
 
 	"github.com/pkg/errors"
+	"google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/cloudfunctions/v1"
+	"google.golang.org/api/cloudresourcemanager/v3"
+	"google.golang.org/api/iam/v1"
+	"google.golang.org/api/pubsub/v1"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	"google.golang.org/api/storage/v1"
 )
 
 // ListSQLDatabases returns a list of Databases within a project and a instances
@@ -39,3 +46,182 @@ func (r *GCPReader) ListSQLDatabases(ctx context.Context, filter string, instanc
 	return list, nil
 
 }
+
+// GetStorageBucketIAMPolicy returns the IAM Policy of the bucket, the version 3
+// is requested so the conditional bindings are returned with the condition
+func (r *GCPReader) GetStorageBucketIAMPolicy(ctx context.Context, bucket string) (*storage.Policy, error) {
+	policy, err := storage.NewBucketsService(r.storage).GetIamPolicy(bucket).OptionsRequestedPolicyVersion(3).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get storage Policy from google APIs")
+	}
+
+	return policy, nil
+}
+
+// ListStorageNotifications returns a list of Notifications of the bucket
+func (r *GCPReader) ListStorageNotifications(ctx context.Context, bucket string) ([]storage.Notification, error) {
+	list, err := storage.NewNotificationsService(r.storage).List(bucket).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list storage Notification from google APIs")
+	}
+
+	resources := make([]storage.Notification, 0, len(list.Items))
+	for _, res := range list.Items {
+		resources = append(resources, *res)
+	}
+
+	return resources, nil
+}
+
+// ListServiceAccounts returns a list of ServiceAccounts within a project
+func (r *GCPReader) ListServiceAccounts(ctx context.Context) ([]iam.ServiceAccount, error) {
+	service := iam.NewProjectsServiceAccountsService(r.iam)
+
+	resources := make([]iam.ServiceAccount, 0)
+
+	err := service.List("projects/"+r.project).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *iam.ListServiceAccountsResponse) error {
+			for _, res := range list.Accounts {
+				resources = append(resources, *res)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list iam ServiceAccount from google APIs")
+	}
+
+	return resources, nil
+}
+
+// GetProjectIAMPolicy returns the IAM Policy of the project, the version 3
+// is requested so the conditional bindings are returned with the condition
+func (r *GCPReader) GetProjectIAMPolicy(ctx context.Context) (*cloudresourcemanager.Policy, error) {
+	policy, err := cloudresourcemanager.NewProjectsService(r.crm).GetIamPolicy("projects/"+r.project, &cloudresourcemanager.GetIamPolicyRequest{
+		Options: &cloudresourcemanager.GetPolicyOptions{
+			RequestedPolicyVersion: 3,
+		},
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get cloudresourcemanager Policy from google APIs")
+	}
+
+	return policy, nil
+}
+
+// ListPubSubTopics returns a list of Topics within a project
+func (r *GCPReader) ListPubSubTopics(ctx context.Context) ([]pubsub.Topic, error) {
+	service := pubsub.NewProjectsTopicsService(r.pubsub)
+
+	resources := make([]pubsub.Topic, 0)
+
+	err := service.List("projects/"+r.project).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *pubsub.ListTopicsResponse) error {
+			for _, res := range list.Topics {
+				resources = append(resources, *res)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list pubsub Topic from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListPubSubSubscriptions returns a list of Subscriptions within a project
+func (r *GCPReader) ListPubSubSubscriptions(ctx context.Context) ([]pubsub.Subscription, error) {
+	service := pubsub.NewProjectsSubscriptionsService(r.pubsub)
+
+	resources := make([]pubsub.Subscription, 0)
+
+	err := service.List("projects/"+r.project).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *pubsub.ListSubscriptionsResponse) error {
+			for _, res := range list.Subscriptions {
+				resources = append(resources, *res)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list pubsub Subscription from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListCloudFunctions returns a list of CloudFunctions within a project and the region
+func (r *GCPReader) ListCloudFunctions(ctx context.Context) ([]cloudfunctions.CloudFunction, error) {
+	service := cloudfunctions.NewProjectsLocationsFunctionsService(r.functions)
+
+	resources := make([]cloudfunctions.CloudFunction, 0)
+
+	err := service.List(fmt.Sprintf("projects/%s/locations/%s", r.project, r.region)).
+		PageSize(int64(r.maxResults)).
+		Pages(ctx, func(list *cloudfunctions.ListFunctionsResponse) error {
+			for _, res := range list.Functions {
+				resources = append(resources, *res)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list cloudfunctions CloudFunction from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListBigQueryDatasets returns a list of Datasets within a project
+func (r *GCPReader) ListBigQueryDatasets(ctx context.Context) ([]bigquery.DatasetListDatasets, error) {
+	service := bigquery.NewDatasetsService(r.bigquery)
+
+	resources := make([]bigquery.DatasetListDatasets, 0)
+
+	err := service.List(r.project).
+		MaxResults(int64(r.maxResults)).
+		Pages(ctx, func(list *bigquery.DatasetList) error {
+			for _, res := range list.Datasets {
+				resources = append(resources, *res)
+			}
+			return nil
+		})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list bigquery Dataset from google APIs")
+	}
+
+	return resources, nil
+}
+
+// ListBigQueryTables returns a list of Tables within a project and a datasets,
+// the views and the external tables included
+func (r *GCPReader) ListBigQueryTables(ctx context.Context, datasets []string) (map[string][]bigquery.TableListTables, error) {
+	service := bigquery.NewTablesService(r.bigquery)
+
+	list := make(map[string][]bigquery.TableListTables)
+	for _, elem := range datasets {
+
+		resources := make([]bigquery.TableListTables, 0)
+
+		err := service.List(r.project, elem).
+			MaxResults(int64(r.maxResults)).
+			Pages(ctx, func(list *bigquery.TableList) error {
+				for _, res := range list.Tables {
+					resources = append(resources, *res)
+				}
+				return nil
+			})
+
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list bigquery Table from google APIs")
+		}
+
+		list[elem] = resources
+	}
+	return list, nil
+}
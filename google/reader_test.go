@@ -0,0 +1,31 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopeZone(t *testing.T) {
+	r := &GCPReader{region: "europe-west1"}
+
+	tests := []struct {
+		Name  string
+		Scope string
+		Zone  string
+		OK    bool
+	}{
+		{Name: "ZoneOfRegion", Scope: "zones/europe-west1-b", Zone: "europe-west1-b", OK: true},
+		{Name: "ZoneOfOtherRegion", Scope: "zones/europe-west10-a"},
+		{Name: "Region", Scope: "regions/europe-west1"},
+		{Name: "Global", Scope: "global"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			zone, ok := r.scopeZone(tt.Scope)
+			assert.Equal(t, tt.Zone, zone)
+			assert.Equal(t, tt.OK, ok)
+		})
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cycloidio/terracognita/filter"
 	"github.com/cycloidio/terracognita/provider"
 	"github.com/pkg/errors"
+	"google.golang.org/api/container/v1"
 )
 
 // ResourceType is the type used to define all the Resources
@@ -52,12 +54,19 @@ const (
 	ComputeTargetPool
 	ComputeTargetSSLProxy
 	ComputeTargetTCPProxy
+	ComputeRegionBackendService
+	ComputeRegionInstanceGroupManager
+	ComputeRouter
 	// cloud dns
 	DNSManagedZone
 	DNSRecordSet
 	DNSPolicy
 	// cloud platform
 	ProjectIAMCustomRole
+	ProjectIAMMember
+	ProjectIAMBinding
+	ProjectIAMPolicy
+	ServiceAccount
 	BillingSubaccount
 	// cloud sql
 	SQLDatabaseInstance
@@ -65,6 +74,8 @@ const (
 	// cloud storage
 	StorageBucket
 	StorageBucketIAMPolicy
+	StorageBucketIAMMember
+	StorageNotification
 	// filestore
 	FilestoreInstance
 	// k8s container engine
@@ -72,6 +83,17 @@ const (
 	ContainerNodePool
 	// memorystore (redis)
 	RedisInstance
+	// spanner
+	SpannerInstance
+	SpannerDatabase
+	// pub/sub
+	PubSubTopic        // pubsub_topic
+	PubSubSubscription // pubsub_subscription
+	// cloud functions
+	CloudFunctionsFunction // cloudfunctions_function
+	// bigquery
+	BigQueryDataset // bigquery_dataset
+	BigQueryTable   // bigquery_table
 	// cloud (Stackdriver) Logging
 	LoggingMetric
 	// cloud (Stackdriver) Monitoring
@@ -88,41 +110,44 @@ type rtFn func(ctx context.Context, g *google, resourceType string, filters *fil
 var (
 	resources = map[ResourceType]rtFn{
 		// compute engine
-		ComputeInstance:              computeInstance,
-		ComputeFirewall:              computeFirewall,
-		ComputeNetwork:               computeNetwork,
-		ComputeHealthCheck:           computeHealthCheck,
-		ComputeInstanceGroup:         computeInstanceGroup,
-		ComputeInstanceIAMPolicy:     computeInstanceIAMPolicy,
-		ComputeBackendService:        computeBackendService,
-		ComputeBackendBucket:         computeBackendBucket,
-		ComputeSSLCertificate:        computeSSLCertificate,
-		ComputeTargetHTTPProxy:       computeTargetHTTPProxy,
-		ComputeTargetHTTPSProxy:      computeTargetHTTPSProxy,
-		ComputeURLMap:                computeURLMap,
-		ComputeGlobalForwardingRule:  computeGlobalForwardingRule,
-		ComputeForwardingRule:        computeForwardingRule,
-		ComputeDisk:                  computeDisk,
-		ComputeAddress:               computeAddress,
-		ComputeAttachedDisk:          computeAttachedDisk,
-		ComputeAutoscaler:            computeAutoscaler,
-		ComputeGlobalAddress:         computeGlobalAddress,
-		ComputeImage:                 computeImage,
-		ComputeInstanceGroupManager:  computeInstanceGroupManager,
-		ComputeInstanceTemplate:      computeInstanceTemplate,
-		ComputeManagedSSLCertificate: computeManagedSSLCertificate,
-		ComputeNetworkEndpointGroup:  computeNetworkEndpointGroup,
-		ComputeRoute:                 computeRoute,
-		ComputeSecurityPolicy:        computeSecurityPolicy,
-		ComputeServiceAttachment:     computeServiceAttachment,
-		ComputeSnapshot:              computeSnapshot,
-		ComputeSSLPolicy:             computeSSLPolicy,
-		ComputeSubnetwork:            computeSubnetwork,
-		ComputeTargetGRPCProxy:       computeTargetGRPCProxy,
-		ComputeTargetInstance:        computeTargetInstance,
-		ComputeTargetPool:            computeTargetPool,
-		ComputeTargetSSLProxy:        computeTargetSSLProxy,
-		ComputeTargetTCPProxy:        computeTargetTCPProxy,
+		ComputeInstance:                   computeInstance,
+		ComputeFirewall:                   computeFirewall,
+		ComputeNetwork:                    computeNetwork,
+		ComputeHealthCheck:                computeHealthCheck,
+		ComputeInstanceGroup:              computeInstanceGroup,
+		ComputeInstanceIAMPolicy:          computeInstanceIAMPolicy,
+		ComputeBackendService:             computeBackendService,
+		ComputeBackendBucket:              computeBackendBucket,
+		ComputeSSLCertificate:             computeSSLCertificate,
+		ComputeTargetHTTPProxy:            computeTargetHTTPProxy,
+		ComputeTargetHTTPSProxy:           computeTargetHTTPSProxy,
+		ComputeURLMap:                     computeURLMap,
+		ComputeGlobalForwardingRule:       computeGlobalForwardingRule,
+		ComputeForwardingRule:             computeForwardingRule,
+		ComputeDisk:                       computeDisk,
+		ComputeAddress:                    computeAddress,
+		ComputeAttachedDisk:               computeAttachedDisk,
+		ComputeAutoscaler:                 computeAutoscaler,
+		ComputeGlobalAddress:              computeGlobalAddress,
+		ComputeImage:                      computeImage,
+		ComputeInstanceGroupManager:       computeInstanceGroupManager,
+		ComputeInstanceTemplate:           computeInstanceTemplate,
+		ComputeManagedSSLCertificate:      computeManagedSSLCertificate,
+		ComputeNetworkEndpointGroup:       computeNetworkEndpointGroup,
+		ComputeRoute:                      computeRoute,
+		ComputeSecurityPolicy:             computeSecurityPolicy,
+		ComputeServiceAttachment:          computeServiceAttachment,
+		ComputeSnapshot:                   computeSnapshot,
+		ComputeSSLPolicy:                  computeSSLPolicy,
+		ComputeSubnetwork:                 computeSubnetwork,
+		ComputeTargetGRPCProxy:            computeTargetGRPCProxy,
+		ComputeTargetInstance:             computeTargetInstance,
+		ComputeTargetPool:                 computeTargetPool,
+		ComputeTargetSSLProxy:             computeTargetSSLProxy,
+		ComputeTargetTCPProxy:             computeTargetTCPProxy,
+		ComputeRegionBackendService:       computeRegionBackendService,
+		ComputeRegionInstanceGroupManager: computeRegionInstanceGroupManager,
+		ComputeRouter:                     computeRouter,
 
 		// cloud dns
 		DNSManagedZone: dnsManagedZone,
@@ -130,6 +155,10 @@ var (
 		DNSPolicy:      dnsPolicy,
 		// cloud platform
 		ProjectIAMCustomRole: projectIAMCustomRole,
+		ProjectIAMMember:     projectIAMMember,
+		ProjectIAMBinding:    projectIAMBinding,
+		ProjectIAMPolicy:     projectIAMPolicy,
+		ServiceAccount:       serviceAccount,
 		BillingSubaccount:    billingSubaccount,
 		// cloud sql
 		SQLDatabaseInstance: sqlDatabaseInstance,
@@ -137,6 +166,8 @@ var (
 		// cloud storage
 		StorageBucket:          storageBucket,
 		StorageBucketIAMPolicy: storageBucketIAMPolicy,
+		StorageBucketIAMMember: storageBucketIAMMember,
+		StorageNotification:    storageNotification,
 		// filestore
 		FilestoreInstance: filestoreInstance,
 		// k8s container engine
@@ -144,6 +175,17 @@ var (
 		ContainerNodePool: containerNodePool,
 		// memorystore (redis)
 		RedisInstance: redisInstance,
+		// spanner
+		SpannerInstance: cacheSpannerInstances,
+		SpannerDatabase: spannerDatabase,
+		// pub/sub
+		PubSubTopic:        pubSubTopic,
+		PubSubSubscription: pubSubSubscription,
+		// cloud functions
+		CloudFunctionsFunction: cloudFunctionsFunction,
+		// bigquery
+		BigQueryDataset: cacheBigQueryDatasets,
+		BigQueryTable:   bigQueryTable,
 		// cloud (Stackdriver) Logging
 		LoggingMetric: loggingMetric,
 		// cloud (Stackdriver) Monitoring
@@ -154,13 +196,16 @@ var (
 	}
 )
 
+// initializeFilter returns the filter of the list calls with the
+// labels of the filters, so they are filtered server-side
 func initializeFilter(filters *filter.Filter) string {
 	var b bytes.Buffer
 	for _, t := range filters.Tags {
-		// if multiple tags, we suppose it's a "AND" operation
-		b.WriteString(fmt.Sprintf("(labels.%s=%s) ", t.Name, t.Value))
+		// if multiple tags, we suppose it's a "AND" operation,
+		// the value is quoted so it can have any character
+		b.WriteString(fmt.Sprintf("(labels.%s=%q) ", t.Name, t.Value))
 	}
-	return b.String()
+	return strings.TrimSpace(b.String())
 }
 
 //compute
@@ -394,7 +439,8 @@ func computeInstanceIAMPolicy(ctx context.Context, g *google, resourceType strin
 }
 
 func computeAddress(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	addresses, err := g.gcpr.ListAddresses(ctx, noFilter)
+	f := initializeFilter(filters)
+	addresses, err := g.gcpr.ListAddresses(ctx, f)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list adresses from reader")
 	}
@@ -446,7 +492,8 @@ func computeAutoscaler(ctx context.Context, g *google, resourceType string, filt
 }
 
 func computeGlobalAddress(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	globalAddresses, err := g.gcpr.ListGlobalAddresses(ctx, noFilter)
+	f := initializeFilter(filters)
+	globalAddresses, err := g.gcpr.ListGlobalAddresses(ctx, f)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list global addresses from reader")
 	}
@@ -459,7 +506,8 @@ func computeGlobalAddress(ctx context.Context, g *google, resourceType string, f
 }
 
 func computeImage(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	images, err := g.gcpr.ListImages(ctx, noFilter)
+	f := initializeFilter(filters)
+	images, err := g.gcpr.ListImages(ctx, f)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list compute images from reader")
 	}
@@ -570,7 +618,8 @@ func computeServiceAttachment(ctx context.Context, g *google, resourceType strin
 }
 
 func computeSnapshot(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	snapshots, err := g.gcpr.ListSnapshots(ctx, noFilter)
+	f := initializeFilter(filters)
+	snapshots, err := g.gcpr.ListSnapshots(ctx, f)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list snapshots from reader")
 	}
@@ -675,6 +724,45 @@ func computeTargetTCPProxy(ctx context.Context, g *google, resourceType string,
 	return resources, nil
 }
 
+func computeRegionBackendService(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	backends, err := g.gcpr.ListRegionBackendServices(ctx, noFilter)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list region backend services from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, backend := range backends {
+		r := provider.NewResource(fmt.Sprintf("%s/%s/%s", g.Project(), g.Region(), backend.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeRegionInstanceGroupManager(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	managers, err := g.gcpr.ListRegionInstanceGroupManagers(ctx, noFilter)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list region instance group managers from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, manager := range managers {
+		r := provider.NewResource(fmt.Sprintf("%s/%s/%s", g.Project(), g.Region(), manager.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func computeRouter(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	routers, err := g.gcpr.ListRouters(ctx, noFilter)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list routers from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, router := range routers {
+		r := provider.NewResource(fmt.Sprintf("%s/%s/%s", g.Project(), g.Region(), router.Name), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
 //cloud dns
 func dnsManagedZone(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	zones, err := g.gcpr.ListDNSManagedZones(ctx)
@@ -741,6 +829,82 @@ func projectIAMCustomRole(ctx context.Context, g *google, resourceType string, f
 	return resources, nil
 }
 
+// projectIAMMember will import each one of the members of the bindings of
+// the project policy if the IAM style is IAMStyleMember. The conditional
+// bindings and the roles of the Google service agents are not imported
+func projectIAMMember(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if g.iamStyle != IAMStyleMember {
+		return nil, nil
+	}
+	policy, err := g.gcpr.GetProjectIAMPolicy(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get project IAM policy from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, binding := range policy.Bindings {
+		if binding.Condition != nil || isServiceAgentRole(binding.Role) {
+			continue
+		}
+		for _, member := range binding.Members {
+			r := provider.NewResource(fmt.Sprintf("%s %s %s", g.Project(), binding.Role, member), resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+// projectIAMBinding will import each one of the bindings of the
+// project policy if the IAM style is IAMStyleBinding
+func projectIAMBinding(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if g.iamStyle != IAMStyleBinding {
+		return nil, nil
+	}
+	policy, err := g.gcpr.GetProjectIAMPolicy(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get project IAM policy from reader")
+	}
+	resources := make([]provider.Resource, 0, len(policy.Bindings))
+	for _, binding := range policy.Bindings {
+		if binding.Condition != nil || isServiceAgentRole(binding.Role) {
+			continue
+		}
+		r := provider.NewResource(fmt.Sprintf("%s %s", g.Project(), binding.Role), resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// projectIAMPolicy will import the policy of the
+// project if the IAM style is IAMStylePolicy
+func projectIAMPolicy(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if g.iamStyle != IAMStylePolicy {
+		return nil, nil
+	}
+	r := provider.NewResource(g.Project(), resourceType, g)
+	return []provider.Resource{r}, nil
+}
+
+func serviceAccount(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	accounts, err := g.gcpr.ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list service accounts from reader")
+	}
+	resources := make([]provider.Resource, 0, len(accounts))
+	for _, account := range accounts {
+		// The Name has the format 'projects/{project}/serviceAccounts/{email}'
+		// which is also the ID used to import it
+		r := provider.NewResource(account.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// isServiceAgentRole checks if the role is one of the ones granted
+// automatically to the Google service agents when enabling an API
+func isServiceAgentRole(role string) bool {
+	return strings.HasSuffix(role, ".serviceAgent") || strings.HasSuffix(role, "ServiceAgent")
+}
+
 func billingSubaccount(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	billingSubaccounts, err := g.gcpr.ListBillingSubaccounts(ctx)
 	if err != nil {
@@ -833,33 +997,110 @@ func storageBucketIAMPolicy(ctx context.Context, g *google, resourceType string,
 	return resources, nil
 }
 
+// storageBucketIAMMember will import each one of the members of the
+// bindings of the bucket policies. The conditional bindings are not
+// imported as the condition can not be set on the ID
+func storageBucketIAMMember(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	buckets, err := getStorageBuckets(ctx, g, StorageBucket.String(), filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list previously fetch storage buckets")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, bucket := range buckets {
+		policy, err := g.gcpr.GetStorageBucketIAMPolicy(ctx, bucket)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get storage bucket IAM policy from reader")
+		}
+		for _, binding := range policy.Bindings {
+			if binding.Condition != nil {
+				continue
+			}
+			for _, member := range binding.Members {
+				r := provider.NewResource(fmt.Sprintf("b/%s %s %s", bucket, binding.Role, member), resourceType, g)
+				resources = append(resources, r)
+			}
+		}
+	}
+	return resources, nil
+}
+
+func storageNotification(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	buckets, err := getStorageBuckets(ctx, g, StorageBucket.String(), filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list previously fetch storage buckets")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, bucket := range buckets {
+		notifications, err := g.gcpr.ListStorageNotifications(ctx, bucket)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list storage notifications from reader")
+		}
+		for _, notification := range notifications {
+			r := provider.NewResource(fmt.Sprintf("%s/notificationConfigs/%s", bucket, notification.Id), resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
 // filestore
 func filestoreInstance(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
-	// To retrieve instance information for all locations, use "-" for the `{location}` value.
-	instances, err := g.gcpr.ListFilestoreInstances(ctx, noFilter, fmt.Sprintf("projects/%s/locations/%s", g.Project(), g.Region()))
+	// The BASIC instances are zonal and the ENTERPRISE ones are regional
+	// so all the locations are listed, with "-", and only the ones
+	// of the region or of its zones are imported
+	instances, err := g.gcpr.ListFilestoreInstances(ctx, noFilter, fmt.Sprintf("projects/%s/locations/-", g.Project()))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list filestore instances from reader")
 	}
 	resources := make([]provider.Resource, 0)
 	for _, instance := range instances {
-		r := provider.NewResource(fmt.Sprintf("%s/%s", g.Region(), instance.Name), resourceType, g)
+		// The Name has the format 'projects/{project}/locations/{location}/instances/{name}'
+		// which is also the ID used to import it
+		parts := strings.Split(instance.Name, "/")
+		if len(parts) != 6 {
+			continue
+		}
+		if location := parts[3]; location != g.Region() && !strings.HasPrefix(location, g.Region()+"-") {
+			continue
+		}
+		r := provider.NewResource(instance.Name, resourceType, g)
 		resources = append(resources, r)
 	}
 	return resources, nil
 }
 
 // k8s container engine
-func containerCluster(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+
+// listContainerClusters returns the zonal and regional clusters of the
+// region of the provider, the zonal ones are on a zone of the region
+func listContainerClusters(ctx context.Context, g *google, filters *filter.Filter) ([]container.Cluster, error) {
 	f := initializeFilter(filters)
 	// To retrieve instance information for all locations, use "-" for the `{location}` value.
-	clusters, err := g.gcpr.ListCONTAINERClusters(ctx, f, fmt.Sprintf("projects/%s/locations/%s", g.Project(), g.Region()))
+	clusters, err := g.gcpr.ListCONTAINERClusters(ctx, f, fmt.Sprintf("projects/%s/locations/-", g.Project()))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list kubernetes clusters from reader")
 	}
 
+	regional := make([]container.Cluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		if cluster.Location == g.Region() || strings.HasPrefix(cluster.Location, g.Region()+"-") {
+			regional = append(regional, cluster)
+		}
+	}
+	return regional, nil
+}
+
+func containerCluster(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	clusters, err := listContainerClusters(ctx, g, filters)
+	if err != nil {
+		return nil, err
+	}
+
 	resources := make([]provider.Resource, 0)
 	for _, cluster := range clusters {
-		r := provider.NewResource(fmt.Sprintf("%s/%s", g.Region(), cluster.Name), resourceType, g)
+		// the location is the region for the regional clusters
+		// and the zone for the zonal ones
+		r := provider.NewResource(fmt.Sprintf("%s/%s", cluster.Location, cluster.Name), resourceType, g)
 		resources = append(resources, r)
 	}
 	return resources, nil
@@ -872,11 +1113,9 @@ func containerNodePool(ctx context.Context, g *google, resourceType string, filt
 		return nil, nil
 	}
 
-	f := initializeFilter(filters)
-	// To retrieve instance information for all locations, use "-" for the `{location}` value.
-	clusters, err := g.gcpr.ListCONTAINERClusters(ctx, f, fmt.Sprintf("projects/%s/locations/%s", g.Project(), g.Region()))
+	clusters, err := listContainerClusters(ctx, g, filters)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to list kubernetes clusters from reader")
+		return nil, err
 	}
 
 	resources := make([]provider.Resource, 0)
@@ -904,6 +1143,131 @@ func redisInstance(ctx context.Context, g *google, resourceType string, filters
 	return resources, nil
 }
 
+// spanner
+
+func spannerInstance(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	instances, err := g.gcpr.ListSpannerInstances(ctx, fmt.Sprintf("projects/%s", g.Project()))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list spanner instances from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, instance := range instances {
+		// the name has the format projects/{project}/instances/{instance}
+		// which is also valid to import it
+		r := provider.NewResource(instance.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func spannerDatabase(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	instances, err := getSpannerInstances(ctx, g, SpannerInstance.String(), filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list previously fetch spanner instances")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, instance := range instances {
+		databases, err := g.gcpr.ListSpannerDatabases(ctx, instance)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list spanner databases from reader")
+		}
+		for _, database := range databases {
+			r := provider.NewResource(database.Name, resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
+// pub/sub
+
+func pubSubTopic(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	topics, err := g.gcpr.ListPubSubTopics(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list pubsub topics from reader")
+	}
+	resources := make([]provider.Resource, 0, len(topics))
+	for _, topic := range topics {
+		// The Name has the format 'projects/{project}/topics/{name}'
+		// which is also the ID used to import it
+		r := provider.NewResource(topic.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func pubSubSubscription(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	subscriptions, err := g.gcpr.ListPubSubSubscriptions(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list pubsub subscriptions from reader")
+	}
+	resources := make([]provider.Resource, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		// The Name has the format 'projects/{project}/subscriptions/{name}'
+		// which is also the ID used to import it
+		r := provider.NewResource(subscription.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// cloud functions
+
+func cloudFunctionsFunction(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	functions, err := g.gcpr.ListCloudFunctions(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list cloud functions from reader")
+	}
+	resources := make([]provider.Resource, 0, len(functions))
+	for _, function := range functions {
+		// The Name has the format 'projects/{project}/locations/{region}/functions/{name}'
+		// which is also the ID used to import it
+		r := provider.NewResource(function.Name, resourceType, g)
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// bigquery
+
+func bigQueryDataset(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	datasets, err := g.gcpr.ListBigQueryDatasets(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list bigquery datasets from reader")
+	}
+	resources := make([]provider.Resource, 0, len(datasets))
+	for _, dataset := range datasets {
+		r := provider.NewResource(fmt.Sprintf("projects/%s/datasets/%s", dataset.DatasetReference.ProjectId, dataset.DatasetReference.DatasetId), resourceType, g)
+		// we set the dataset_id prior of reading it from the state
+		// as it is required to able to List the tables of it
+		if err := r.Data().Set("dataset_id", dataset.DatasetReference.DatasetId); err != nil {
+			return nil, errors.Wrapf(err, "unable to set dataset_id data on the provider.Resource for the dataset '%s'", dataset.DatasetReference.DatasetId)
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func bigQueryTable(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	datasets, err := getBigQueryDatasets(ctx, g, BigQueryDataset.String(), filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list previously fetch bigquery datasets")
+	}
+	tablesList, err := g.gcpr.ListBigQueryTables(ctx, datasets)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list bigquery tables from reader")
+	}
+	resources := make([]provider.Resource, 0)
+	for _, tables := range tablesList {
+		for _, table := range tables {
+			ref := table.TableReference
+			r := provider.NewResource(fmt.Sprintf("projects/%s/datasets/%s/tables/%s", ref.ProjectId, ref.DatasetId, ref.TableId), resourceType, g)
+			resources = append(resources, r)
+		}
+	}
+	return resources, nil
+}
+
 // cloud (Stackdriver) Logging
 func loggingMetric(ctx context.Context, g *google, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
 	logMetrics, err := g.gcpr.ListLogMetrics(ctx, fmt.Sprintf("projects/%s", g.Project()))
@@ -0,0 +1,26 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/tag"
+)
+
+func TestInitializeFilter(t *testing.T) {
+	t.Run("NoLabels", func(t *testing.T) {
+		assert.Equal(t, "", initializeFilter(&filter.Filter{}))
+	})
+
+	t.Run("Labels", func(t *testing.T) {
+		f := &filter.Filter{
+			Tags: []tag.Tag{
+				{Name: "env", Value: "prod"},
+				{Name: "team", Value: "front end"},
+			},
+		}
+		assert.Equal(t, `(labels.env="prod") (labels.team="front end")`, initializeFilter(f))
+	})
+}
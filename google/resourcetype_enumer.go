@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _ResourceTypeName = "google_compute_instancegoogle_compute_firewallgoogle_compute_networkgoogle_compute_health_checkgoogle_compute_instance_groupgoogle_compute_instance_iam_policygoogle_compute_backend_bucketgoogle_compute_backend_servicegoogle_compute_ssl_certificategoogle_compute_target_http_proxygoogle_compute_target_https_proxygoogle_compute_url_mapgoogle_compute_global_forwarding_rulegoogle_compute_forwarding_rulegoogle_compute_diskgoogle_compute_addressgoogle_compute_attached_diskgoogle_compute_autoscalergoogle_compute_global_addressgoogle_compute_imagegoogle_compute_instance_group_managergoogle_compute_instance_templategoogle_compute_managed_ssl_certificategoogle_compute_network_endpoint_groupgoogle_compute_routegoogle_compute_security_policygoogle_compute_service_attachmentgoogle_compute_snapshotgoogle_compute_ssl_policygoogle_compute_subnetworkgoogle_compute_target_grpc_proxygoogle_compute_target_instancegoogle_compute_target_poolgoogle_compute_target_ssl_proxygoogle_compute_target_tcp_proxygoogle_dns_managed_zonegoogle_dns_record_setgoogle_dns_policygoogle_project_iam_custom_rolegoogle_billing_subaccountgoogle_sql_database_instancegoogle_sql_databasegoogle_storage_bucketgoogle_storage_bucket_iam_policygoogle_filestore_instancegoogle_container_clustergoogle_container_node_poolgoogle_redis_instancegoogle_logging_metricgoogle_monitoring_alert_policygoogle_monitoring_groupgoogle_monitoring_notification_channelgoogle_monitoring_uptime_check_config"
+const _ResourceTypeName = "google_compute_instancegoogle_compute_firewallgoogle_compute_networkgoogle_compute_health_checkgoogle_compute_instance_groupgoogle_compute_instance_iam_policygoogle_compute_backend_bucketgoogle_compute_backend_servicegoogle_compute_ssl_certificategoogle_compute_target_http_proxygoogle_compute_target_https_proxygoogle_compute_url_mapgoogle_compute_global_forwarding_rulegoogle_compute_forwarding_rulegoogle_compute_diskgoogle_compute_addressgoogle_compute_attached_diskgoogle_compute_autoscalergoogle_compute_global_addressgoogle_compute_imagegoogle_compute_instance_group_managergoogle_compute_instance_templategoogle_compute_managed_ssl_certificategoogle_compute_network_endpoint_groupgoogle_compute_routegoogle_compute_security_policygoogle_compute_service_attachmentgoogle_compute_snapshotgoogle_compute_ssl_policygoogle_compute_subnetworkgoogle_compute_target_grpc_proxygoogle_compute_target_instancegoogle_compute_target_poolgoogle_compute_target_ssl_proxygoogle_compute_target_tcp_proxygoogle_compute_region_backend_servicegoogle_compute_region_instance_group_managergoogle_compute_routergoogle_dns_managed_zonegoogle_dns_record_setgoogle_dns_policygoogle_project_iam_custom_rolegoogle_project_iam_membergoogle_project_iam_bindinggoogle_project_iam_policygoogle_service_accountgoogle_billing_subaccountgoogle_sql_database_instancegoogle_sql_databasegoogle_storage_bucketgoogle_storage_bucket_iam_policygoogle_storage_bucket_iam_membergoogle_storage_notificationgoogle_filestore_instancegoogle_container_clustergoogle_container_node_poolgoogle_redis_instancegoogle_spanner_instancegoogle_spanner_databasegoogle_pubsub_topicgoogle_pubsub_subscriptiongoogle_cloudfunctions_functiongoogle_bigquery_datasetgoogle_bigquery_tablegoogle_logging_metricgoogle_monitoring_alert_policygoogle_monitoring_groupgoogle_monitoring_notification_channelgoogle_monitoring_uptime_check_config"
 
-var _ResourceTypeIndex = [...]uint16{0, 23, 46, 68, 95, 124, 158, 187, 217, 247, 279, 312, 334, 371, 401, 420, 442, 470, 495, 524, 544, 581, 613, 651, 688, 708, 738, 771, 794, 819, 844, 876, 906, 932, 963, 994, 1017, 1038, 1055, 1085, 1110, 1138, 1157, 1178, 1210, 1235, 1259, 1285, 1306, 1327, 1357, 1380, 1418, 1455}
+var _ResourceTypeIndex = [...]uint16{0, 23, 46, 68, 95, 124, 158, 187, 217, 247, 279, 312, 334, 371, 401, 420, 442, 470, 495, 524, 544, 581, 613, 651, 688, 708, 738, 771, 794, 819, 844, 876, 906, 932, 963, 994, 1031, 1075, 1096, 1119, 1140, 1157, 1187, 1212, 1238, 1263, 1285, 1310, 1338, 1357, 1378, 1410, 1442, 1469, 1494, 1518, 1544, 1565, 1588, 1611, 1630, 1656, 1686, 1709, 1730, 1751, 1781, 1804, 1842, 1879}
 
-const _ResourceTypeLowerName = "google_compute_instancegoogle_compute_firewallgoogle_compute_networkgoogle_compute_health_checkgoogle_compute_instance_groupgoogle_compute_instance_iam_policygoogle_compute_backend_bucketgoogle_compute_backend_servicegoogle_compute_ssl_certificategoogle_compute_target_http_proxygoogle_compute_target_https_proxygoogle_compute_url_mapgoogle_compute_global_forwarding_rulegoogle_compute_forwarding_rulegoogle_compute_diskgoogle_compute_addressgoogle_compute_attached_diskgoogle_compute_autoscalergoogle_compute_global_addressgoogle_compute_imagegoogle_compute_instance_group_managergoogle_compute_instance_templategoogle_compute_managed_ssl_certificategoogle_compute_network_endpoint_groupgoogle_compute_routegoogle_compute_security_policygoogle_compute_service_attachmentgoogle_compute_snapshotgoogle_compute_ssl_policygoogle_compute_subnetworkgoogle_compute_target_grpc_proxygoogle_compute_target_instancegoogle_compute_target_poolgoogle_compute_target_ssl_proxygoogle_compute_target_tcp_proxygoogle_dns_managed_zonegoogle_dns_record_setgoogle_dns_policygoogle_project_iam_custom_rolegoogle_billing_subaccountgoogle_sql_database_instancegoogle_sql_databasegoogle_storage_bucketgoogle_storage_bucket_iam_policygoogle_filestore_instancegoogle_container_clustergoogle_container_node_poolgoogle_redis_instancegoogle_logging_metricgoogle_monitoring_alert_policygoogle_monitoring_groupgoogle_monitoring_notification_channelgoogle_monitoring_uptime_check_config"
+const _ResourceTypeLowerName = "google_compute_instancegoogle_compute_firewallgoogle_compute_networkgoogle_compute_health_checkgoogle_compute_instance_groupgoogle_compute_instance_iam_policygoogle_compute_backend_bucketgoogle_compute_backend_servicegoogle_compute_ssl_certificategoogle_compute_target_http_proxygoogle_compute_target_https_proxygoogle_compute_url_mapgoogle_compute_global_forwarding_rulegoogle_compute_forwarding_rulegoogle_compute_diskgoogle_compute_addressgoogle_compute_attached_diskgoogle_compute_autoscalergoogle_compute_global_addressgoogle_compute_imagegoogle_compute_instance_group_managergoogle_compute_instance_templategoogle_compute_managed_ssl_certificategoogle_compute_network_endpoint_groupgoogle_compute_routegoogle_compute_security_policygoogle_compute_service_attachmentgoogle_compute_snapshotgoogle_compute_ssl_policygoogle_compute_subnetworkgoogle_compute_target_grpc_proxygoogle_compute_target_instancegoogle_compute_target_poolgoogle_compute_target_ssl_proxygoogle_compute_target_tcp_proxygoogle_compute_region_backend_servicegoogle_compute_region_instance_group_managergoogle_compute_routergoogle_dns_managed_zonegoogle_dns_record_setgoogle_dns_policygoogle_project_iam_custom_rolegoogle_project_iam_membergoogle_project_iam_bindinggoogle_project_iam_policygoogle_service_accountgoogle_billing_subaccountgoogle_sql_database_instancegoogle_sql_databasegoogle_storage_bucketgoogle_storage_bucket_iam_policygoogle_storage_bucket_iam_membergoogle_storage_notificationgoogle_filestore_instancegoogle_container_clustergoogle_container_node_poolgoogle_redis_instancegoogle_spanner_instancegoogle_spanner_databasegoogle_pubsub_topicgoogle_pubsub_subscriptiongoogle_cloudfunctions_functiongoogle_bigquery_datasetgoogle_bigquery_tablegoogle_logging_metricgoogle_monitoring_alert_policygoogle_monitoring_groupgoogle_monitoring_notification_channelgoogle_monitoring_uptime_check_config"
 
 func (i ResourceType) String() string {
 	if i < 0 || i >= ResourceType(len(_ResourceTypeIndex)-1) {
@@ -59,27 +59,43 @@ func _ResourceTypeNoOp() {
 	_ = x[ComputeTargetPool-(32)]
 	_ = x[ComputeTargetSSLProxy-(33)]
 	_ = x[ComputeTargetTCPProxy-(34)]
-	_ = x[DNSManagedZone-(35)]
-	_ = x[DNSRecordSet-(36)]
-	_ = x[DNSPolicy-(37)]
-	_ = x[ProjectIAMCustomRole-(38)]
-	_ = x[BillingSubaccount-(39)]
-	_ = x[SQLDatabaseInstance-(40)]
-	_ = x[SQLDatabase-(41)]
-	_ = x[StorageBucket-(42)]
-	_ = x[StorageBucketIAMPolicy-(43)]
-	_ = x[FilestoreInstance-(44)]
-	_ = x[ContainerCluster-(45)]
-	_ = x[ContainerNodePool-(46)]
-	_ = x[RedisInstance-(47)]
-	_ = x[LoggingMetric-(48)]
-	_ = x[MonitoringAlertPolicy-(49)]
-	_ = x[MonitoringGroup-(50)]
-	_ = x[MonitoringNotificationChannel-(51)]
-	_ = x[MonitoringUptimeCheckConfig-(52)]
+	_ = x[ComputeRegionBackendService-(35)]
+	_ = x[ComputeRegionInstanceGroupManager-(36)]
+	_ = x[ComputeRouter-(37)]
+	_ = x[DNSManagedZone-(38)]
+	_ = x[DNSRecordSet-(39)]
+	_ = x[DNSPolicy-(40)]
+	_ = x[ProjectIAMCustomRole-(41)]
+	_ = x[ProjectIAMMember-(42)]
+	_ = x[ProjectIAMBinding-(43)]
+	_ = x[ProjectIAMPolicy-(44)]
+	_ = x[ServiceAccount-(45)]
+	_ = x[BillingSubaccount-(46)]
+	_ = x[SQLDatabaseInstance-(47)]
+	_ = x[SQLDatabase-(48)]
+	_ = x[StorageBucket-(49)]
+	_ = x[StorageBucketIAMPolicy-(50)]
+	_ = x[StorageBucketIAMMember-(51)]
+	_ = x[StorageNotification-(52)]
+	_ = x[FilestoreInstance-(53)]
+	_ = x[ContainerCluster-(54)]
+	_ = x[ContainerNodePool-(55)]
+	_ = x[RedisInstance-(56)]
+	_ = x[SpannerInstance-(57)]
+	_ = x[SpannerDatabase-(58)]
+	_ = x[PubSubTopic-(59)]
+	_ = x[PubSubSubscription-(60)]
+	_ = x[CloudFunctionsFunction-(61)]
+	_ = x[BigQueryDataset-(62)]
+	_ = x[BigQueryTable-(63)]
+	_ = x[LoggingMetric-(64)]
+	_ = x[MonitoringAlertPolicy-(65)]
+	_ = x[MonitoringGroup-(66)]
+	_ = x[MonitoringNotificationChannel-(67)]
+	_ = x[MonitoringUptimeCheckConfig-(68)]
 }
 
-var _ResourceTypeValues = []ResourceType{ComputeInstance, ComputeFirewall, ComputeNetwork, ComputeHealthCheck, ComputeInstanceGroup, ComputeInstanceIAMPolicy, ComputeBackendBucket, ComputeBackendService, ComputeSSLCertificate, ComputeTargetHTTPProxy, ComputeTargetHTTPSProxy, ComputeURLMap, ComputeGlobalForwardingRule, ComputeForwardingRule, ComputeDisk, ComputeAddress, ComputeAttachedDisk, ComputeAutoscaler, ComputeGlobalAddress, ComputeImage, ComputeInstanceGroupManager, ComputeInstanceTemplate, ComputeManagedSSLCertificate, ComputeNetworkEndpointGroup, ComputeRoute, ComputeSecurityPolicy, ComputeServiceAttachment, ComputeSnapshot, ComputeSSLPolicy, ComputeSubnetwork, ComputeTargetGRPCProxy, ComputeTargetInstance, ComputeTargetPool, ComputeTargetSSLProxy, ComputeTargetTCPProxy, DNSManagedZone, DNSRecordSet, DNSPolicy, ProjectIAMCustomRole, BillingSubaccount, SQLDatabaseInstance, SQLDatabase, StorageBucket, StorageBucketIAMPolicy, FilestoreInstance, ContainerCluster, ContainerNodePool, RedisInstance, LoggingMetric, MonitoringAlertPolicy, MonitoringGroup, MonitoringNotificationChannel, MonitoringUptimeCheckConfig}
+var _ResourceTypeValues = []ResourceType{ComputeInstance, ComputeFirewall, ComputeNetwork, ComputeHealthCheck, ComputeInstanceGroup, ComputeInstanceIAMPolicy, ComputeBackendBucket, ComputeBackendService, ComputeSSLCertificate, ComputeTargetHTTPProxy, ComputeTargetHTTPSProxy, ComputeURLMap, ComputeGlobalForwardingRule, ComputeForwardingRule, ComputeDisk, ComputeAddress, ComputeAttachedDisk, ComputeAutoscaler, ComputeGlobalAddress, ComputeImage, ComputeInstanceGroupManager, ComputeInstanceTemplate, ComputeManagedSSLCertificate, ComputeNetworkEndpointGroup, ComputeRoute, ComputeSecurityPolicy, ComputeServiceAttachment, ComputeSnapshot, ComputeSSLPolicy, ComputeSubnetwork, ComputeTargetGRPCProxy, ComputeTargetInstance, ComputeTargetPool, ComputeTargetSSLProxy, ComputeTargetTCPProxy, ComputeRegionBackendService, ComputeRegionInstanceGroupManager, ComputeRouter, DNSManagedZone, DNSRecordSet, DNSPolicy, ProjectIAMCustomRole, ProjectIAMMember, ProjectIAMBinding, ProjectIAMPolicy, ServiceAccount, BillingSubaccount, SQLDatabaseInstance, SQLDatabase, StorageBucket, StorageBucketIAMPolicy, StorageBucketIAMMember, StorageNotification, FilestoreInstance, ContainerCluster, ContainerNodePool, RedisInstance, SpannerInstance, SpannerDatabase, PubSubTopic, PubSubSubscription, CloudFunctionsFunction, BigQueryDataset, BigQueryTable, LoggingMetric, MonitoringAlertPolicy, MonitoringGroup, MonitoringNotificationChannel, MonitoringUptimeCheckConfig}
 
 var _ResourceTypeNameToValueMap = map[string]ResourceType{
 	_ResourceTypeName[0:23]:           ComputeInstance,
@@ -152,42 +168,74 @@ var _ResourceTypeNameToValueMap = map[string]ResourceType{
 	_ResourceTypeLowerName[932:963]:   ComputeTargetSSLProxy,
 	_ResourceTypeName[963:994]:        ComputeTargetTCPProxy,
 	_ResourceTypeLowerName[963:994]:   ComputeTargetTCPProxy,
-	_ResourceTypeName[994:1017]:       DNSManagedZone,
-	_ResourceTypeLowerName[994:1017]:  DNSManagedZone,
-	_ResourceTypeName[1017:1038]:      DNSRecordSet,
-	_ResourceTypeLowerName[1017:1038]: DNSRecordSet,
-	_ResourceTypeName[1038:1055]:      DNSPolicy,
-	_ResourceTypeLowerName[1038:1055]: DNSPolicy,
-	_ResourceTypeName[1055:1085]:      ProjectIAMCustomRole,
-	_ResourceTypeLowerName[1055:1085]: ProjectIAMCustomRole,
-	_ResourceTypeName[1085:1110]:      BillingSubaccount,
-	_ResourceTypeLowerName[1085:1110]: BillingSubaccount,
-	_ResourceTypeName[1110:1138]:      SQLDatabaseInstance,
-	_ResourceTypeLowerName[1110:1138]: SQLDatabaseInstance,
-	_ResourceTypeName[1138:1157]:      SQLDatabase,
-	_ResourceTypeLowerName[1138:1157]: SQLDatabase,
-	_ResourceTypeName[1157:1178]:      StorageBucket,
-	_ResourceTypeLowerName[1157:1178]: StorageBucket,
-	_ResourceTypeName[1178:1210]:      StorageBucketIAMPolicy,
-	_ResourceTypeLowerName[1178:1210]: StorageBucketIAMPolicy,
-	_ResourceTypeName[1210:1235]:      FilestoreInstance,
-	_ResourceTypeLowerName[1210:1235]: FilestoreInstance,
-	_ResourceTypeName[1235:1259]:      ContainerCluster,
-	_ResourceTypeLowerName[1235:1259]: ContainerCluster,
-	_ResourceTypeName[1259:1285]:      ContainerNodePool,
-	_ResourceTypeLowerName[1259:1285]: ContainerNodePool,
-	_ResourceTypeName[1285:1306]:      RedisInstance,
-	_ResourceTypeLowerName[1285:1306]: RedisInstance,
-	_ResourceTypeName[1306:1327]:      LoggingMetric,
-	_ResourceTypeLowerName[1306:1327]: LoggingMetric,
-	_ResourceTypeName[1327:1357]:      MonitoringAlertPolicy,
-	_ResourceTypeLowerName[1327:1357]: MonitoringAlertPolicy,
-	_ResourceTypeName[1357:1380]:      MonitoringGroup,
-	_ResourceTypeLowerName[1357:1380]: MonitoringGroup,
-	_ResourceTypeName[1380:1418]:      MonitoringNotificationChannel,
-	_ResourceTypeLowerName[1380:1418]: MonitoringNotificationChannel,
-	_ResourceTypeName[1418:1455]:      MonitoringUptimeCheckConfig,
-	_ResourceTypeLowerName[1418:1455]: MonitoringUptimeCheckConfig,
+	_ResourceTypeName[994:1031]:       ComputeRegionBackendService,
+	_ResourceTypeLowerName[994:1031]:  ComputeRegionBackendService,
+	_ResourceTypeName[1031:1075]:      ComputeRegionInstanceGroupManager,
+	_ResourceTypeLowerName[1031:1075]: ComputeRegionInstanceGroupManager,
+	_ResourceTypeName[1075:1096]:      ComputeRouter,
+	_ResourceTypeLowerName[1075:1096]: ComputeRouter,
+	_ResourceTypeName[1096:1119]:      DNSManagedZone,
+	_ResourceTypeLowerName[1096:1119]: DNSManagedZone,
+	_ResourceTypeName[1119:1140]:      DNSRecordSet,
+	_ResourceTypeLowerName[1119:1140]: DNSRecordSet,
+	_ResourceTypeName[1140:1157]:      DNSPolicy,
+	_ResourceTypeLowerName[1140:1157]: DNSPolicy,
+	_ResourceTypeName[1157:1187]:      ProjectIAMCustomRole,
+	_ResourceTypeLowerName[1157:1187]: ProjectIAMCustomRole,
+	_ResourceTypeName[1187:1212]:      ProjectIAMMember,
+	_ResourceTypeLowerName[1187:1212]: ProjectIAMMember,
+	_ResourceTypeName[1212:1238]:      ProjectIAMBinding,
+	_ResourceTypeLowerName[1212:1238]: ProjectIAMBinding,
+	_ResourceTypeName[1238:1263]:      ProjectIAMPolicy,
+	_ResourceTypeLowerName[1238:1263]: ProjectIAMPolicy,
+	_ResourceTypeName[1263:1285]:      ServiceAccount,
+	_ResourceTypeLowerName[1263:1285]: ServiceAccount,
+	_ResourceTypeName[1285:1310]:      BillingSubaccount,
+	_ResourceTypeLowerName[1285:1310]: BillingSubaccount,
+	_ResourceTypeName[1310:1338]:      SQLDatabaseInstance,
+	_ResourceTypeLowerName[1310:1338]: SQLDatabaseInstance,
+	_ResourceTypeName[1338:1357]:      SQLDatabase,
+	_ResourceTypeLowerName[1338:1357]: SQLDatabase,
+	_ResourceTypeName[1357:1378]:      StorageBucket,
+	_ResourceTypeLowerName[1357:1378]: StorageBucket,
+	_ResourceTypeName[1378:1410]:      StorageBucketIAMPolicy,
+	_ResourceTypeLowerName[1378:1410]: StorageBucketIAMPolicy,
+	_ResourceTypeName[1410:1442]:      StorageBucketIAMMember,
+	_ResourceTypeLowerName[1410:1442]: StorageBucketIAMMember,
+	_ResourceTypeName[1442:1469]:      StorageNotification,
+	_ResourceTypeLowerName[1442:1469]: StorageNotification,
+	_ResourceTypeName[1469:1494]:      FilestoreInstance,
+	_ResourceTypeLowerName[1469:1494]: FilestoreInstance,
+	_ResourceTypeName[1494:1518]:      ContainerCluster,
+	_ResourceTypeLowerName[1494:1518]: ContainerCluster,
+	_ResourceTypeName[1518:1544]:      ContainerNodePool,
+	_ResourceTypeLowerName[1518:1544]: ContainerNodePool,
+	_ResourceTypeName[1544:1565]:      RedisInstance,
+	_ResourceTypeLowerName[1544:1565]: RedisInstance,
+	_ResourceTypeName[1565:1588]:      SpannerInstance,
+	_ResourceTypeLowerName[1565:1588]: SpannerInstance,
+	_ResourceTypeName[1588:1611]:      SpannerDatabase,
+	_ResourceTypeLowerName[1588:1611]: SpannerDatabase,
+	_ResourceTypeName[1611:1630]:      PubSubTopic,
+	_ResourceTypeLowerName[1611:1630]: PubSubTopic,
+	_ResourceTypeName[1630:1656]:      PubSubSubscription,
+	_ResourceTypeLowerName[1630:1656]: PubSubSubscription,
+	_ResourceTypeName[1656:1686]:      CloudFunctionsFunction,
+	_ResourceTypeLowerName[1656:1686]: CloudFunctionsFunction,
+	_ResourceTypeName[1686:1709]:      BigQueryDataset,
+	_ResourceTypeLowerName[1686:1709]: BigQueryDataset,
+	_ResourceTypeName[1709:1730]:      BigQueryTable,
+	_ResourceTypeLowerName[1709:1730]: BigQueryTable,
+	_ResourceTypeName[1730:1751]:      LoggingMetric,
+	_ResourceTypeLowerName[1730:1751]: LoggingMetric,
+	_ResourceTypeName[1751:1781]:      MonitoringAlertPolicy,
+	_ResourceTypeLowerName[1751:1781]: MonitoringAlertPolicy,
+	_ResourceTypeName[1781:1804]:      MonitoringGroup,
+	_ResourceTypeLowerName[1781:1804]: MonitoringGroup,
+	_ResourceTypeName[1804:1842]:      MonitoringNotificationChannel,
+	_ResourceTypeLowerName[1804:1842]: MonitoringNotificationChannel,
+	_ResourceTypeName[1842:1879]:      MonitoringUptimeCheckConfig,
+	_ResourceTypeLowerName[1842:1879]: MonitoringUptimeCheckConfig,
 }
 
 var _ResourceTypeNames = []string{
@@ -226,24 +274,40 @@ var _ResourceTypeNames = []string{
 	_ResourceTypeName[906:932],
 	_ResourceTypeName[932:963],
 	_ResourceTypeName[963:994],
-	_ResourceTypeName[994:1017],
-	_ResourceTypeName[1017:1038],
-	_ResourceTypeName[1038:1055],
-	_ResourceTypeName[1055:1085],
-	_ResourceTypeName[1085:1110],
-	_ResourceTypeName[1110:1138],
-	_ResourceTypeName[1138:1157],
-	_ResourceTypeName[1157:1178],
-	_ResourceTypeName[1178:1210],
-	_ResourceTypeName[1210:1235],
-	_ResourceTypeName[1235:1259],
-	_ResourceTypeName[1259:1285],
-	_ResourceTypeName[1285:1306],
-	_ResourceTypeName[1306:1327],
-	_ResourceTypeName[1327:1357],
-	_ResourceTypeName[1357:1380],
-	_ResourceTypeName[1380:1418],
-	_ResourceTypeName[1418:1455],
+	_ResourceTypeName[994:1031],
+	_ResourceTypeName[1031:1075],
+	_ResourceTypeName[1075:1096],
+	_ResourceTypeName[1096:1119],
+	_ResourceTypeName[1119:1140],
+	_ResourceTypeName[1140:1157],
+	_ResourceTypeName[1157:1187],
+	_ResourceTypeName[1187:1212],
+	_ResourceTypeName[1212:1238],
+	_ResourceTypeName[1238:1263],
+	_ResourceTypeName[1263:1285],
+	_ResourceTypeName[1285:1310],
+	_ResourceTypeName[1310:1338],
+	_ResourceTypeName[1338:1357],
+	_ResourceTypeName[1357:1378],
+	_ResourceTypeName[1378:1410],
+	_ResourceTypeName[1410:1442],
+	_ResourceTypeName[1442:1469],
+	_ResourceTypeName[1469:1494],
+	_ResourceTypeName[1494:1518],
+	_ResourceTypeName[1518:1544],
+	_ResourceTypeName[1544:1565],
+	_ResourceTypeName[1565:1588],
+	_ResourceTypeName[1588:1611],
+	_ResourceTypeName[1611:1630],
+	_ResourceTypeName[1630:1656],
+	_ResourceTypeName[1656:1686],
+	_ResourceTypeName[1686:1709],
+	_ResourceTypeName[1709:1730],
+	_ResourceTypeName[1730:1751],
+	_ResourceTypeName[1751:1781],
+	_ResourceTypeName[1781:1804],
+	_ResourceTypeName[1804:1842],
+	_ResourceTypeName[1842:1879],
 }
 
 // ResourceTypeString retrieves an enum value from the enum constants string name.
@@ -0,0 +1,3 @@
+// Package graph has the Writer that builds the dependency graph
+// of the imported resources and writes it in the Graphviz format
+package graph
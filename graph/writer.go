@@ -0,0 +1,158 @@
+package graph
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/log"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/util"
+)
+
+// FormatDOT is the Graphviz format
+const FormatDOT = "dot"
+
+// used to match a TF resource ${aws_instance.my-instance.id}
+var regexResource = regexp.MustCompile(`^\${([^.]+)\.([^.]+)\.[^}]+}$`)
+
+// Writer is a Writer implementation that writes the dependency
+// graph of the Resources, in which each Resource points to the
+// ones referenced by its attributes, in the Graphviz format
+type Writer struct {
+	// Config has the Resources with the
+	// key (<resource_type>.<name>)
+	Config map[string]provider.Resource
+
+	// edges are the dependencies of each
+	// Resource set from the Interpolate
+	edges map[string][]string
+
+	writer io.Writer
+}
+
+// NewWriter returns a Writer initialization
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		Config: make(map[string]provider.Resource),
+		edges:  make(map[string][]string),
+		writer: w,
+	}
+}
+
+// Write expects a key similar to "aws_instance.your_name" and
+// the value to be a provider.Resource, repeated keys will report an error
+func (w *Writer) Write(key string, value interface{}) error {
+	if key == "" {
+		return errcode.ErrWriterRequiredKey
+	}
+
+	if value == nil {
+		return errcode.ErrWriterRequiredValue
+	}
+
+	if _, ok := w.Config[key]; ok {
+		return errors.Wrapf(errcode.ErrWriterAlreadyExistsKey, "with key %q", key)
+	}
+
+	if len(strings.Split(key, ".")) != 2 {
+		return errors.Wrapf(errcode.ErrWriterInvalidKey, "with key %q", key)
+	}
+
+	r, ok := value.(provider.Resource)
+	if !ok {
+		return errors.Wrapf(errcode.ErrWriterInvalidTypeValue, "expected provider.Resource, found %T", value)
+	}
+
+	log.Get().Log("func", "writer.Write(Graph)", "msg", "writing to internal config", "key", key)
+	w.Config[key] = r
+
+	return nil
+}
+
+// Has checks if the given key it's already present or not
+func (w *Writer) Has(key string) (bool, error) {
+	_, ok := w.Config[key]
+	return ok, nil
+}
+
+// Interpolate sets the edges of each Resource from the values of its
+// attributes that are on the i, or that have them, like the ARNs on
+// a policy document
+func (w *Writer) Interpolate(i map[string]string) {
+	for key, r := range w.Config {
+		is := r.InstanceState()
+		if is == nil {
+			continue
+		}
+
+		deps := make(map[string]struct{})
+		for k, v := range is.Attributes {
+			// The ID is the one that other
+			// Resources reference
+			if k == "id" {
+				continue
+			}
+			refs := []string{v}
+			if _, ok := i[v]; !ok {
+				refs = util.ReferenceTokens(v)
+			}
+			for _, ref := range refs {
+				a, ok := i[ref]
+				if !ok {
+					continue
+				}
+				m := regexResource.FindStringSubmatch(a)
+				if m == nil {
+					continue
+				}
+				dep := fmt.Sprintf("%s.%s", m[1], m[2])
+				if dep == key {
+					continue
+				}
+				deps[dep] = struct{}{}
+			}
+		}
+
+		sdeps := make([]string, 0, len(deps))
+		for d := range deps {
+			sdeps = append(sdeps, d)
+		}
+		sort.Strings(sdeps)
+		w.edges[key] = sdeps
+	}
+}
+
+// Sync writes the graph with the Resources
+// sorted by the key to the internal w
+func (w *Writer) Sync() error {
+	keys := make([]string, 0, len(w.Config))
+	for k := range w.Config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	sb.WriteString("digraph {\n")
+	sb.WriteString("\trankdir = \"LR\";\n")
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "\t%q;\n", k)
+	}
+	for _, k := range keys {
+		for _, d := range w.edges[k] {
+			fmt.Fprintf(&sb, "\t%q -> %q;\n", k, d)
+		}
+	}
+	sb.WriteString("}\n")
+
+	if _, err := io.WriteString(w.writer, sb.String()); err != nil {
+		return errors.Wrap(err, "unable to write the graph")
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package graph_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/graph"
+	"github.com/cycloidio/terracognita/mock"
+)
+
+func TestWriter_Write(t *testing.T) {
+	t.Run("ErrRequiredKey", func(t *testing.T) {
+		gw := graph.NewWriter(&bytes.Buffer{})
+		err := gw.Write("", "")
+		assert.Equal(t, errcode.ErrWriterRequiredKey, errors.Cause(err))
+	})
+	t.Run("ErrRequiredValue", func(t *testing.T) {
+		gw := graph.NewWriter(&bytes.Buffer{})
+		err := gw.Write("type.name", nil)
+		assert.Equal(t, errcode.ErrWriterRequiredValue, errors.Cause(err))
+	})
+	t.Run("ErrInvalidKey", func(t *testing.T) {
+		gw := graph.NewWriter(&bytes.Buffer{})
+		err := gw.Write("name", "")
+		assert.Equal(t, errcode.ErrWriterInvalidKey, errors.Cause(err))
+	})
+	t.Run("ErrInvalidTypeValue", func(t *testing.T) {
+		gw := graph.NewWriter(&bytes.Buffer{})
+		err := gw.Write("type.name", "")
+		assert.Equal(t, errcode.ErrWriterInvalidTypeValue, errors.Cause(err))
+	})
+}
+
+func TestWriter_Sync(t *testing.T) {
+	var (
+		ctrl   = gomock.NewController(t)
+		bucket = mock.NewResource(ctrl)
+		policy = mock.NewResource(ctrl)
+		vpc    = mock.NewResource(ctrl)
+		subnet = mock.NewResource(ctrl)
+		b      = &bytes.Buffer{}
+		gw     = graph.NewWriter(b)
+		out    = `digraph {
+	rankdir = "LR";
+	"aws_iam_policy.read";
+	"aws_s3_bucket.logs";
+	"aws_subnet.private";
+	"aws_vpc.main";
+	"aws_iam_policy.read" -> "aws_s3_bucket.logs";
+	"aws_subnet.private" -> "aws_vpc.main";
+}
+`
+	)
+	defer ctrl.Finish()
+
+	bucket.EXPECT().InstanceState().Return(&terraform.InstanceState{
+		Attributes: map[string]string{"id": "logs", "arn": "arn:aws:s3:::logs"},
+	})
+	policy.EXPECT().InstanceState().Return(&terraform.InstanceState{
+		Attributes: map[string]string{"id": "read", "policy": `{"Statement":[{"Resource":"arn:aws:s3:::logs/*"}]}`},
+	})
+	vpc.EXPECT().InstanceState().Return(&terraform.InstanceState{
+		Attributes: map[string]string{"id": "vpc-1"},
+	})
+	subnet.EXPECT().InstanceState().Return(&terraform.InstanceState{
+		Attributes: map[string]string{"id": "subnet-1", "vpc_id": "vpc-1"},
+	})
+
+	require.NoError(t, gw.Write("aws_s3_bucket.logs", bucket))
+	require.NoError(t, gw.Write("aws_iam_policy.read", policy))
+	require.NoError(t, gw.Write("aws_vpc.main", vpc))
+	require.NoError(t, gw.Write("aws_subnet.private", subnet))
+
+	ok, err := gw.Has("aws_vpc.main")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	gw.Interpolate(map[string]string{
+		"logs":              "${aws_s3_bucket.logs.id}",
+		"arn:aws:s3:::logs": "${aws_s3_bucket.logs.arn}",
+		"vpc-1":             "${aws_vpc.main.id}",
+		"subnet-1":          "${aws_subnet.private.id}",
+	})
+	require.NoError(t, gw.Sync())
+
+	assert.Equal(t, out, b.String())
+}
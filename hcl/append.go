@@ -0,0 +1,80 @@
+package hcl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	hcl2 "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/pkg/errors"
+)
+
+// ReadBlocks returns the keys of the blocks, like 'resource.aws_vpc.main',
+// of the HCL files ('.tf') of the dir, so the ones already written
+// are not written again with Append
+func ReadBlocks(dir string) (map[string]struct{}, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make(map[string]struct{})
+	for _, fp := range files {
+		b, err := ioutil.ReadFile(fp)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read %s", fp)
+		}
+		f, diags := hclwrite.ParseConfig(b, fp, hcl2.InitialPos)
+		if diags.HasErrors() {
+			return nil, errors.Wrapf(diags, "invalid HCL on %s", fp)
+		}
+		for _, blk := range f.Body().Blocks() {
+			blocks[blockKey(blk)] = struct{}{}
+		}
+	}
+
+	return blocks, nil
+}
+
+// Append appends to the dst the blocks of the src which keys are not on
+// the blocks, the content of the dst is kept as it is so the changes done
+// on it are preserved. It returns the new content and the keys of the
+// blocks appended, which are also added to the blocks
+func Append(dst, src []byte, blocks map[string]struct{}) ([]byte, []string, error) {
+	f, diags := hclwrite.ParseConfig(src, "", hcl2.InitialPos)
+	if diags.HasErrors() {
+		return nil, nil, errors.Wrap(diags, "invalid HCL generated")
+	}
+
+	var (
+		buf      bytes.Buffer
+		appended []string
+	)
+	buf.Write(bytes.TrimRight(dst, "\n"))
+	for _, blk := range f.Body().Blocks() {
+		k := blockKey(blk)
+		if _, ok := blocks[k]; ok {
+			continue
+		}
+		blocks[k] = struct{}{}
+		appended = append(appended, k)
+
+		if buf.Len() != 0 {
+			buf.WriteString("\n\n")
+		}
+		buf.Write(bytes.TrimSpace(blk.BuildTokens(nil).Bytes()))
+	}
+	if buf.Len() != 0 {
+		buf.WriteString("\n")
+	}
+
+	return buf.Bytes(), appended, nil
+}
+
+// blockKey returns the key of the blk which
+// is the type and the labels joined by '.'
+func blockKey(blk *hclwrite.Block) string {
+	return strings.Join(append([]string{blk.Type()}, blk.Labels()...), ".")
+}
@@ -0,0 +1,88 @@
+package hcl_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/hcl"
+)
+
+func TestReadBlocks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terracognita-hcl")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "vpc.tf"), []byte(`
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "hcl.tf"), []byte(`
+terraform {
+  required_version = ">= 1.0"
+}
+
+variable "region" {
+}
+`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "terraform.tfvars"), []byte(`region = "eu-west-1"`), 0644))
+
+	blocks, err := hcl.ReadBlocks(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]struct{}{
+		"resource.aws_vpc.main": struct{}{},
+		"terraform":             struct{}{},
+		"variable.region":       struct{}{},
+	}, blocks)
+}
+
+func TestAppend(t *testing.T) {
+	var (
+		dst = []byte(`# Edited by the user
+resource "aws_vpc" "main" {
+  cidr_block = "10.1.0.0/16" # changed
+}
+`)
+		src = []byte(`resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+}
+
+resource "aws_subnet" "private" {
+  vpc_id = aws_vpc.main.id
+}
+`)
+		out = `# Edited by the user
+resource "aws_vpc" "main" {
+  cidr_block = "10.1.0.0/16" # changed
+}
+
+resource "aws_subnet" "private" {
+  vpc_id = aws_vpc.main.id
+}
+`
+		blocks = map[string]struct{}{
+			"resource.aws_vpc.main": struct{}{},
+		}
+	)
+
+	b, appended, err := hcl.Append(dst, src, blocks)
+	require.NoError(t, err)
+
+	assert.Equal(t, out, string(b))
+	assert.Equal(t, []string{"resource.aws_subnet.private"}, appended)
+	assert.Contains(t, blocks, "resource.aws_subnet.private")
+
+	t.Run("NoDst", func(t *testing.T) {
+		b, appended, err := hcl.Append(nil, src, map[string]struct{}{})
+		require.NoError(t, err)
+
+		assert.Equal(t, string(src), string(b))
+		assert.Len(t, appended, 2)
+	})
+}
@@ -0,0 +1,138 @@
+package hcl
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cycloidio/terracognita/util"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+// extractMinLength is the minimum length of the values to extract, as
+// the shorter ones (ex: 'tcp', '80') are too generic to be replaced
+const extractMinLength = 4
+
+// extractVariables adds to the Parameters the literal values that are repeated
+// at least ExtractVariables times on the resources, so they are replaced by
+// variables. The name of the variable is the attribute with more occurrences
+// of the value, with a suffix if more than one value has the same name
+func (w *Writer) extractVariables() {
+	// The values already on the Parameters,
+	// like the region, keep their name
+	params := make(map[string]struct{}, len(w.opts.Parameters))
+	for _, v := range w.opts.Parameters {
+		params[v] = struct{}{}
+	}
+
+	// values has the occurrences of each
+	// value per attribute name
+	values := make(map[string]map[string]int)
+	for _, k := range w.categories {
+		if k == writer.ModuleCategoryKey || k == variablesCategoryKey || k == w.opts.TerraformCategoryKey {
+			continue
+		}
+		for _, resource := range w.Config[k]["resource"].(map[string]map[string]interface{}) {
+			for _, block := range resource {
+				walkExtract(block, "", values)
+			}
+		}
+	}
+
+	type candidate struct {
+		value, attr string
+		count       int
+	}
+
+	candidates := make([]candidate, 0)
+	for v, attrs := range values {
+		if _, ok := params[v]; ok {
+			continue
+		}
+		c := candidate{value: v}
+		for a, n := range attrs {
+			c.count += n
+			if c.attr == "" || n > attrs[c.attr] || (n == attrs[c.attr] && a < c.attr) {
+				c.attr = a
+			}
+		}
+		if c.count < w.opts.ExtractVariables {
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+
+	// The most repeated values get the name
+	// without suffix if there is a conflict
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].count != candidates[j].count {
+			return candidates[i].count > candidates[j].count
+		}
+		return candidates[i].value < candidates[j].value
+	})
+
+	// The variables already declared, like the ones
+	// of the provider, can not be used as names
+	declared, _ := w.Config[w.terraformCategoryKey()]["variable"].(map[string]interface{})
+
+	if w.opts.Parameters == nil {
+		w.opts.Parameters = make(map[string]string, len(candidates))
+	}
+	for _, c := range candidates {
+		name := c.attr
+		for i := 2; ; i++ {
+			_, isParam := w.opts.Parameters[name]
+			_, isDeclared := declared[name]
+			if !isParam && !isDeclared {
+				break
+			}
+			name = fmt.Sprintf("%s_%d", c.attr, i)
+		}
+		w.opts.Parameters[name] = c.value
+	}
+}
+
+// walkExtract walks the value v and counts on values the string
+// values that can be extracted to a variable with the attribute
+// key, without the indexes of the lists, as name
+func walkExtract(v interface{}, key string, values map[string]map[string]int) {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		for k, val := range vv {
+			if k == writer.ResourceCategoryKey {
+				continue
+			}
+			walkExtract(val, util.NormalizeName(strings.TrimPrefix(k, "=tc=")), values)
+		}
+	case []interface{}:
+		for _, val := range vv {
+			walkExtract(val, key, values)
+		}
+	case string:
+		if key == "" || !isExtractable(vv) {
+			return
+		}
+		if _, ok := values[vv]; !ok {
+			values[vv] = make(map[string]int)
+		}
+		values[vv][key]++
+	}
+}
+
+// isExtractable checks if the value v is a literal
+// that can be replaced with a variable
+func isExtractable(v string) bool {
+	// The values with interpolations are ignored
+	// as they already reference other entities
+	if len(v) < extractMinLength || strings.Contains(v, "${") {
+		return false
+	}
+	if _, err := strconv.ParseFloat(v, 64); err == nil {
+		return false
+	}
+	if _, err := strconv.ParseBool(v); err == nil {
+		return false
+	}
+	return true
+}
@@ -35,6 +35,16 @@ var (
 			match:   regexp.MustCompile(`"\$\${([^$}{]+)\.([^$}{]+)}"`),
 			replace: []byte(`$1.$2`),
 		},
+		{
+			// Used for references inside of strings
+			// Replace all the `"a-$${data.b.c}"` for `"a-${data.b.c}"` as
+			// the hclwriter escapes them. Only the data, var and local
+			// references are replaced so the IAM Policies variables
+			// like `${aws:username}` are kept escaped.
+			// The '$$${' are escaped on the original value so are ignored
+			match:   regexp.MustCompile(`([^$])\$\$\{((?:data|var|local)\.[^$}{"]+)\}`),
+			replace: []byte(`$1$${$2}`),
+		},
 		{
 			// Replace all the `"key" = "value"` for `key = "value"` except
 			// if it has a `.` on the key
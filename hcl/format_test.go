@@ -28,6 +28,19 @@ func TestFormat(t *testing.T) {
 				t2tag = "t2value"
 			`),
 		},
+		{
+			name: "ReplaceReferencesInsideStrings",
+			in: []byte(`
+				"arn" = "arn:aws:iam::$${data.aws_caller_identity.current.account_id}:role/a"
+				"policy" = "arn:aws:s3:::bucket/$${aws:username}"
+				"escaped" = "a-$$${var.b}"
+			`),
+			out: []byte(`
+				arn = "arn:aws:iam::${data.aws_caller_identity.current.account_id}:role/a"
+				policy = "arn:aws:s3:::bucket/$${aws:username}"
+				escaped = "a-$$${var.b}"
+			`),
+		},
 		{
 			name: "ReplaceEmptyLines",
 			in: []byte(`
@@ -0,0 +1,182 @@
+package hcl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+
+	hcl2 "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/pkg/errors"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/log"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+// importBlock is an 'import {}' block of a Resource
+type importBlock struct {
+	To       string `json:"to"`
+	ID       string `json:"id"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// ImportWriter is a Writer implementation that writes the
+// 'import {}' blocks (Terraform >= 1.5) of the Resources, so
+// they can be imported with 'terraform plan -generate-config-out'
+// instead of using the TFState
+type ImportWriter struct {
+	// Config has the blocks with the key
+	// of the Resource (<resource_type>.<name>)
+	Config map[string]importBlock
+	writer io.Writer
+	opts   *writer.Options
+}
+
+// NewImportWriter returns an ImportWriter initialization
+func NewImportWriter(w io.Writer, opts *writer.Options) *ImportWriter {
+	return &ImportWriter{
+		Config: make(map[string]importBlock),
+		writer: w,
+		opts:   opts,
+	}
+}
+
+// Write expects a key similar to "aws_instance.your_name" and
+// the value to be a provider.Resource, repeated keys will report an error
+func (w *ImportWriter) Write(key string, value interface{}) error {
+	if key == "" {
+		return errcode.ErrWriterRequiredKey
+	}
+
+	if value == nil {
+		return errcode.ErrWriterRequiredValue
+	}
+
+	if _, ok := w.Config[key]; ok {
+		return errors.Wrapf(errcode.ErrWriterAlreadyExistsKey, "with key %q", key)
+	}
+
+	if len(strings.Split(key, ".")) != 2 {
+		return errors.Wrapf(errcode.ErrWriterInvalidKey, "with key %q", key)
+	}
+
+	r, ok := value.(provider.Resource)
+	if !ok {
+		return errors.Wrapf(errcode.ErrWriterInvalidTypeValue, "expected provider.Resource, found %T", value)
+	}
+
+	// The import blocks are only allowed on the root
+	// module so the Resources of the Module are referenced
+	// through it
+	to := key
+	if w.opts.HasModule() {
+		to = fmt.Sprintf("module.%s.%s", w.opts.Module, key)
+	}
+
+	ib := importBlock{
+		To: to,
+		ID: r.ID(),
+	}
+
+	// The Resources read with an aliased configuration
+	// of the Provider have to be imported with it
+	rp := r.Provider()
+	if al, ok := rp.(provider.Aliaser); ok && al.Alias() != "" {
+		ib.Provider = fmt.Sprintf("%s.%s", rp.String(), al.Alias())
+	}
+
+	log.Get().Log("func", "writer.Write(Import)", "msg", "writing to internal config", "key", key, "id", ib.ID)
+	w.Config[key] = ib
+
+	return nil
+}
+
+// Has checks if the given key it's already present or not
+func (w *ImportWriter) Has(key string) (bool, error) {
+	_, ok := w.Config[key]
+	return ok, nil
+}
+
+// Sync writes the import blocks sorted by the key to the
+// internal w with the HCL or JSON syntax
+func (w *ImportWriter) Sync() error {
+	keys := make([]string, 0, len(w.Config))
+	for k := range w.Config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	if w.opts.JSON {
+		blocks := make([]importBlock, 0, len(keys))
+		for _, k := range keys {
+			ib := w.Config[k]
+			// All the strings are templates on
+			// the JSON syntax so they are escaped
+			ib.ID = strings.NewReplacer("${", "$${", "%{", "%%{").Replace(ib.ID)
+			blocks = append(blocks, ib)
+		}
+
+		b := &bytes.Buffer{}
+		enc := json.NewEncoder(b)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+
+		err := enc.Encode(map[string]interface{}{"import": blocks})
+		if err != nil {
+			return errors.Wrap(err, "unable to encode JSON import blocks")
+		}
+
+		_, err = io.Copy(w.writer, b)
+		return err
+	}
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	for i, k := range keys {
+		ib := w.Config[k]
+		if i != 0 {
+			body.AppendNewline()
+		}
+
+		bbody := body.AppendNewBlock("import", nil).Body()
+		bbody.SetAttributeTraversal("to", traversal(ib.To))
+		bbody.SetAttributeValue("id", cty.StringVal(ib.ID))
+		if ib.Provider != "" {
+			bbody.SetAttributeTraversal("provider", traversal(ib.Provider))
+		}
+	}
+
+	_, err := w.writer.Write(hclwrite.Format(f.Bytes()))
+	return err
+}
+
+// NameTemplate returns the template of the Options
+// used to name the resources, nil if there is none
+func (w *ImportWriter) NameTemplate() *template.Template {
+	if w.opts == nil {
+		return nil
+	}
+	return w.opts.NameTemplate
+}
+
+// Interpolate does nothing as the import
+// blocks have no references
+func (w *ImportWriter) Interpolate(i map[string]string) {}
+
+// traversal returns the hcl2.Traversal of the
+// address a, like 'aws_instance.front'
+func traversal(a string) hcl2.Traversal {
+	parts := strings.Split(a, ".")
+	t := hcl2.Traversal{hcl2.TraverseRoot{Name: parts[0]}}
+	for _, p := range parts[1:] {
+		t = append(t, hcl2.TraverseAttr{Name: p})
+	}
+	return t
+}
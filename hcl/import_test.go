@@ -0,0 +1,155 @@
+package hcl_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/hcl"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+// aliasedProvider is a mock.Provider that implements
+// the provider.Aliaser with the 'eu_west_1' alias
+type aliasedProvider struct {
+	*mock.Provider
+}
+
+func (aliasedProvider) Alias() string { return "eu_west_1" }
+
+func (aliasedProvider) Aliases() map[string]map[string]interface{} { return nil }
+
+func TestImportWriter_Write(t *testing.T) {
+	t.Run("ErrRequiredKey", func(t *testing.T) {
+		iw := hcl.NewImportWriter(&bytes.Buffer{}, &writer.Options{})
+		err := iw.Write("", "")
+		assert.Equal(t, errcode.ErrWriterRequiredKey, errors.Cause(err))
+	})
+	t.Run("ErrRequiredValue", func(t *testing.T) {
+		iw := hcl.NewImportWriter(&bytes.Buffer{}, &writer.Options{})
+		err := iw.Write("type.name", nil)
+		assert.Equal(t, errcode.ErrWriterRequiredValue, errors.Cause(err))
+	})
+	t.Run("ErrInvalidKey", func(t *testing.T) {
+		iw := hcl.NewImportWriter(&bytes.Buffer{}, &writer.Options{})
+		err := iw.Write("name", "")
+		assert.Equal(t, errcode.ErrWriterInvalidKey, errors.Cause(err))
+	})
+	t.Run("ErrInvalidTypeValue", func(t *testing.T) {
+		iw := hcl.NewImportWriter(&bytes.Buffer{}, &writer.Options{})
+		err := iw.Write("type.name", "")
+		assert.Equal(t, errcode.ErrWriterInvalidTypeValue, errors.Cause(err))
+	})
+	t.Run("ErrAlreadyExistsKey", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			p    = mock.NewProvider(ctrl)
+			r    = mock.NewResource(ctrl)
+			iw   = hcl.NewImportWriter(&bytes.Buffer{}, &writer.Options{})
+		)
+		defer ctrl.Finish()
+
+		r.EXPECT().ID().Return("i-1")
+		r.EXPECT().Provider().Return(p)
+
+		require.NoError(t, iw.Write("aws_instance.front", r))
+
+		ok, err := iw.Has("aws_instance.front")
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		err = iw.Write("aws_instance.front", r)
+		assert.Equal(t, errcode.ErrWriterAlreadyExistsKey, errors.Cause(err))
+	})
+}
+
+func TestImportWriter_Sync(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   *writer.Options
+		hcl    string
+		module string
+	}{
+		{
+			name: "HCL",
+			opts: &writer.Options{},
+			hcl: `import {
+  to       = aws_instance.back
+  id       = "i-2"
+  provider = aws.eu_west_1
+}
+
+import {
+  to = aws_instance.front
+  id = "i-1"
+}
+`,
+		},
+		{
+			name: "Module",
+			opts: &writer.Options{Module: "infra"},
+			hcl: `import {
+  to       = module.infra.aws_instance.back
+  id       = "i-2"
+  provider = aws.eu_west_1
+}
+
+import {
+  to = module.infra.aws_instance.front
+  id = "i-1"
+}
+`,
+		},
+		{
+			name: "JSON",
+			opts: &writer.Options{JSON: true},
+			hcl: `{
+  "import": [
+    {
+      "to": "aws_instance.back",
+      "id": "i-2",
+      "provider": "aws.eu_west_1"
+    },
+    {
+      "to": "aws_instance.front",
+      "id": "i-1"
+    }
+  ]
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctrl  = gomock.NewController(t)
+				p     = mock.NewProvider(ctrl)
+				ap    = aliasedProvider{Provider: mock.NewProvider(ctrl)}
+				front = mock.NewResource(ctrl)
+				back  = mock.NewResource(ctrl)
+				b     = &bytes.Buffer{}
+				iw    = hcl.NewImportWriter(b, tt.opts)
+			)
+			defer ctrl.Finish()
+
+			front.EXPECT().ID().Return("i-1")
+			front.EXPECT().Provider().Return(p)
+			back.EXPECT().ID().Return("i-2")
+			back.EXPECT().Provider().Return(ap)
+			ap.Provider.EXPECT().String().Return("aws")
+
+			require.NoError(t, iw.Write("aws_instance.front", front))
+			require.NoError(t, iw.Write("aws_instance.back", back))
+			require.NoError(t, iw.Sync())
+
+			assert.Equal(t, tt.hcl, b.String())
+		})
+	}
+}
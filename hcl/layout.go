@@ -0,0 +1,245 @@
+package hcl
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cycloidio/terracognita/util"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+const (
+	// layoutDir is the directory of the
+	// Modules of the ModuleLayout
+	layoutDir = "modules"
+
+	// defaultGroup is the group of the resources that have
+	// no value for the ModuleLayout (ex: no Resource Group)
+	defaultGroup = "default"
+)
+
+// layoutReferenceRe matches the values that are only a
+// reference to a resource, like '${aws_vpc.main.id}'
+var layoutReferenceRe = regexp.MustCompile(`^\$\{([^.${}]+)\.([^.${}]+)\.([^${}]+)\}$`)
+
+// layoutReference is a reference from a resource of
+// the Module from to one of the Module to
+type layoutReference struct {
+	from, to string
+	name     string
+	value    string
+}
+
+// setLayout moves the resources to one Module per group of the ModuleLayout
+// on 'modules/<group>', with their variables, and the module blocks on the
+// ModuleCategoryKey. The references between resources of different groups
+// are replaced by variables set from the outputs of the referenced Module.
+// It returns the categories to write
+func (w *Writer) setLayout() []string {
+	type resource struct {
+		category, rt, name string
+		cfg                map[string]interface{}
+	}
+
+	// The resources are sorted so the
+	// output is always the same
+	resources := make([]resource, 0)
+	categories := make([]string, 0)
+	for _, c := range w.categories {
+		if c == writer.ModuleCategoryKey || c == variablesCategoryKey || c == w.opts.TerraformCategoryKey {
+			continue
+		}
+		categories = append(categories, c)
+		for rt, rs := range w.Config[c]["resource"].(map[string]map[string]interface{}) {
+			for n, b := range rs {
+				cfg, ok := b.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				resources = append(resources, resource{category: c, rt: rt, name: n, cfg: cfg})
+			}
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].rt != resources[j].rt {
+			return resources[i].rt < resources[j].rt
+		}
+		return resources[i].name < resources[j].name
+	})
+
+	groups := make(map[string]string, len(resources))
+	for _, r := range resources {
+		groups[fmt.Sprintf("%s.%s", r.rt, r.name)] = w.layoutGroup(r.category, r.cfg)
+	}
+
+	for _, c := range categories {
+		delete(w.Config, c)
+	}
+
+	module := w.Config[writer.ModuleCategoryKey]["module"].(map[string]interface{})
+	delete(module, w.opts.Module)
+
+	var (
+		newCategories = make([]string, 0)
+		variables     = make(map[string]map[string]interface{})
+		outputs       = make(map[string]map[string]interface{})
+		refs          = make([]layoutReference, 0)
+	)
+	for _, r := range resources {
+		g := groups[fmt.Sprintf("%s.%s", r.rt, r.name)]
+		if _, ok := module[g]; !ok {
+			module[g] = map[string]interface{}{
+				"source": fmt.Sprintf("./%s/%s", layoutDir, g),
+			}
+			variables[g] = make(map[string]interface{})
+		}
+
+		// On the region layout the aliased configuration of
+		// the Provider is the default one of the Module
+		if w.opts.ModuleLayout == writer.ModuleLayoutRegion {
+			if p, ok := r.cfg["provider"].(string); ok {
+				delete(r.cfg, "provider")
+				module[g].(map[string]interface{})["=tc=providers"] = map[string]interface{}{
+					w.provider.String(): p,
+				}
+			}
+		}
+
+		cfg := walkLayoutReferences(r.cfg, groups, g, &refs)
+		cfg = walkVariables(map[string]interface{}{r.name: cfg}, w.opts.ModuleVariables, r.rt, variables[g])[r.name].(map[string]interface{})
+
+		cat := path.Join(layoutDir, g, r.category)
+		if _, ok := w.Config[cat]; !ok {
+			w.Config[cat] = map[string]interface{}{
+				"resource": make(map[string]map[string]interface{}),
+			}
+			newCategories = append(newCategories, cat)
+		}
+		crs := w.Config[cat]["resource"].(map[string]map[string]interface{})
+		if _, ok := crs[r.rt]; !ok {
+			crs[r.rt] = make(map[string]interface{})
+		}
+		crs[r.rt][r.name] = cfg
+	}
+
+	for _, ref := range refs {
+		variables[ref.from][ref.name] = make(map[string]interface{})
+		module[ref.from].(map[string]interface{})[ref.name] = fmt.Sprintf("${module.%s.%s}", ref.to, ref.name)
+
+		if _, ok := outputs[ref.to]; !ok {
+			outputs[ref.to] = make(map[string]interface{})
+		}
+		outputs[ref.to][ref.name] = map[string]interface{}{
+			"value": ref.value,
+		}
+	}
+
+	gs := make([]string, 0, len(variables))
+	for g := range variables {
+		gs = append(gs, g)
+	}
+	sort.Strings(gs)
+	for _, g := range gs {
+		if len(variables[g]) != 0 {
+			cat := path.Join(layoutDir, g, variablesCategoryKey)
+			w.Config[cat] = map[string]interface{}{
+				"variable": variables[g],
+			}
+			newCategories = append(newCategories, cat)
+		}
+		for vn, v := range variables[g] {
+			if d, ok := v.(map[string]interface{})["default"]; ok {
+				module[g].(map[string]interface{})[vn] = d
+			}
+		}
+		if len(outputs[g]) != 0 {
+			cat := path.Join(layoutDir, g, "outputs")
+			w.Config[cat] = map[string]interface{}{
+				"output": outputs[g],
+			}
+			newCategories = append(newCategories, cat)
+		}
+	}
+
+	w.categories = newCategories
+	if w.opts.TerraformCategoryKey != "" {
+		w.categories = append(w.categories, w.opts.TerraformCategoryKey)
+	}
+
+	return append(w.categories, writer.ModuleCategoryKey)
+}
+
+// layoutGroup returns the name of the group of the ModuleLayout
+// of the resource with the cfg on the category
+func (w *Writer) layoutGroup(category string, cfg map[string]interface{}) string {
+	var g string
+	switch w.opts.ModuleLayout {
+	case writer.ModuleLayoutService:
+		g = category
+	case writer.ModuleLayoutRegion:
+		// The aliases of the Provider are the regions
+		// and the default one is the Provider region
+		g = w.provider.Region()
+		if p, ok := cfg["provider"].(string); ok {
+			g = strings.TrimPrefix(strings.TrimSuffix(strings.TrimPrefix(p, "${"), "}"), w.provider.String()+".")
+		}
+	case writer.ModuleLayoutResourceGroup:
+		rg, _ := cfg["resource_group_name"].(string)
+		// If it has been interpolated it's the
+		// name of the Resource Group referenced
+		if m := layoutReferenceRe.FindStringSubmatch(rg); m != nil {
+			rg = ""
+			for _, c := range w.categories {
+				if rgcfg, ok := w.Config[c]["resource"].(map[string]map[string]interface{})[m[1]][m[2]].(map[string]interface{}); ok {
+					rg, _ = rgcfg[m[3]].(string)
+					break
+				}
+			}
+		}
+		g = rg
+	}
+
+	if g == "" {
+		return defaultGroup
+	}
+
+	return util.NormalizeName(g)
+}
+
+// walkLayoutReferences replaces the references on the v to resources of other
+// groups than the group g with a variable, that is added to the refs
+func walkLayoutReferences(v map[string]interface{}, groups map[string]string, g string, refs *[]layoutReference) map[string]interface{} {
+	for k, val := range v {
+		v[k] = walkLayoutReferencesValue(val, groups, g, refs)
+	}
+	return v
+}
+
+func walkLayoutReferencesValue(v interface{}, groups map[string]string, g string, refs *[]layoutReference) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		return walkLayoutReferences(vv, groups, g, refs)
+	case []interface{}:
+		for i, e := range vv {
+			vv[i] = walkLayoutReferencesValue(e, groups, g, refs)
+		}
+		return vv
+	case string:
+		m := layoutReferenceRe.FindStringSubmatch(vv)
+		if m == nil {
+			return vv
+		}
+		to, ok := groups[fmt.Sprintf("%s.%s", m[1], m[2])]
+		if !ok || to == g {
+			return vv
+		}
+		name := util.NormalizeName(fmt.Sprintf("%s_%s_%s", m[1], m[2], m[3]))
+		*refs = append(*refs, layoutReference{from: g, to: to, name: name, value: vv})
+		return fmt.Sprintf("${var.%s}", name)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,114 @@
+package hcl_test
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/cycloidio/mxwriter"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/hcl"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+func TestHCLWriter_SyncModuleLayout(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		p    = mock.NewProvider(ctrl)
+		mx   = mxwriter.NewMux()
+		ehcl = map[string]string{
+			"modules/ec2/ec2": `
+resource "aws_instance" "front" {
+	instance_type = var.aws_instance_front_instance_type
+	subnet_id = var.aws_subnet_private_id
+}
+`,
+			"modules/ec2/variables": `
+variable "aws_instance_front_instance_type" {
+	default = "t2.micro"
+}
+
+variable "aws_subnet_private_id" { }
+`,
+			"modules/vpc/vpc": `
+resource "aws_subnet" "private" {
+	vpc_id = aws_vpc.main.id
+}
+
+resource "aws_vpc" "main" {
+	cidr_block = "10.0.0.0/16"
+}
+`,
+			"modules/vpc/outputs": `
+output "aws_subnet_private_id" {
+	value = aws_subnet.private.id
+}
+`,
+			writer.ModuleCategoryKey: `
+module "ec2" {
+	aws_instance_front_instance_type = "t2.micro"
+	aws_subnet_private_id = module.vpc.aws_subnet_private_id
+	source = "./modules/ec2"
+}
+
+module "vpc" {
+	source = "./modules/vpc"
+}
+
+terraform {
+	required_providers {
+		aws = {
+			source = "hashicorp/aws"
+		}
+	}
+	required_version = ">= 1.0"
+}
+`,
+		}
+	)
+	defer ctrl.Finish()
+
+	p.EXPECT().String().Return("aws")
+	p.EXPECT().Source().Return("hashicorp/aws")
+
+	hw := hcl.NewWriter(mx, p, &writer.Options{
+		Interpolate:     true,
+		Module:          "test",
+		ModuleLayout:    writer.ModuleLayoutService,
+		ModuleVariables: map[string]struct{}{"aws_instance.instance_type": struct{}{}},
+	})
+
+	require.NoError(t, hw.Write("aws_vpc.main", map[string]interface{}{
+		"cidr_block":               "10.0.0.0/16",
+		writer.ResourceCategoryKey: "vpc",
+	}))
+	require.NoError(t, hw.Write("aws_subnet.private", map[string]interface{}{
+		"vpc_id":                   "vpc-1",
+		writer.ResourceCategoryKey: "vpc",
+	}))
+	require.NoError(t, hw.Write("aws_instance.front", map[string]interface{}{
+		"instance_type":            "t2.micro",
+		"subnet_id":                "subnet-1",
+		writer.ResourceCategoryKey: "ec2",
+	}))
+
+	hw.Interpolate(map[string]string{
+		"vpc-1":    "${aws_vpc.main.id}",
+		"subnet-1": "${aws_subnet.private.id}",
+	})
+	require.NoError(t, hw.Sync())
+
+	dm, err := mxwriter.NewDemux(mx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"modules/ec2/ec2", "modules/ec2/variables", "modules/vpc/vpc", "modules/vpc/outputs", writer.ModuleCategoryKey}, dm.Keys())
+
+	for k, e := range ehcl {
+		b, err := ioutil.ReadAll(dm.Read(k))
+		require.NoError(t, err)
+		assert.Equal(t, strings.Join(strings.Fields(e), " "), strings.Join(strings.Fields(string(b)), " "), k)
+	}
+}
@@ -1,13 +1,16 @@
 package hcl
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"path"
 	"reflect"
 	"regexp"
 	"sort"
 	"strings"
+	"text/template"
 
 	kitlog "github.com/go-kit/kit/log"
 
@@ -15,9 +18,11 @@ import (
 	"github.com/cycloidio/terracognita/errcode"
 	"github.com/cycloidio/terracognita/log"
 	"github.com/cycloidio/terracognita/provider"
+	"github.com/cycloidio/terracognita/transform"
 	"github.com/cycloidio/terracognita/util"
 	"github.com/cycloidio/terracognita/writer"
 	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 	"github.com/zclconf/go-cty/cty"
 	cjson "github.com/zclconf/go-cty/cty/json"
@@ -26,6 +31,7 @@ import (
 const (
 	defaultCategory      = "hcl"
 	variablesCategoryKey = "variables"
+	dataCategoryKey      = "data"
 )
 
 // Writer is a Writer implementation that writes to
@@ -39,6 +45,15 @@ type Writer struct {
 	writer     io.Writer
 	opts       *writer.Options
 	provider   provider.Provider
+
+	// dependsOn are the resources that each resource
+	// depends on but can not be referenced, set on
+	// the Interpolate if the DependsOn is enabled
+	dependsOn map[string]map[string]struct{}
+
+	// secrets are the names of the variables of
+	// the values redacted with the RedactSecrets
+	secrets []string
 }
 
 // NewWriter rerturns an Writer initialization
@@ -52,6 +67,14 @@ func NewWriter(w io.Writer, pv provider.Provider, opts *writer.Options) *Writer
 		provider: pv,
 	}
 
+	rp := map[string]interface{}{
+		"source": pv.Source(),
+	}
+	// The version is pinned to the one used to read
+	// the resources so the HCL is valid with it
+	if vr, ok := pv.(provider.Versioner); ok && vr.Version() != "" {
+		rp["version"] = vr.Version()
+	}
 	tfcfg := map[string]interface{}{
 		"required_version": ">= 1.0",
 		"required_providers": map[string]interface{}{
@@ -60,9 +83,7 @@ func NewWriter(w io.Writer, pv provider.Provider, opts *writer.Options) *Writer
 			// on the formater we have we replace all the '= {` for
 			// just '{' so this would be included too and it would
 			// be invalid configuration
-			fmt.Sprintf("=tc=%s", pv.String()): map[string]interface{}{
-				"source": pv.Source(),
-			},
+			fmt.Sprintf("=tc=%s", pv.String()): rp,
 		},
 	}
 	var cat string
@@ -98,6 +119,29 @@ func NewWriter(w io.Writer, pv provider.Provider, opts *writer.Options) *Writer
 		}
 		wr.Config[tfKey]["provider"] = pvcfg
 		wr.setProviderConfig(tfKey)
+
+		// Each aliased configuration of the Provider is
+		// another block with the same name after the default one
+		if al, ok := pv.(provider.Aliaser); ok && len(al.Aliases()) != 0 {
+			pn := pv.String()
+			blocks := []interface{}{pvcfg[pn]}
+			aliases := al.Aliases()
+			names := make([]string, 0, len(aliases))
+			for n := range aliases {
+				names = append(names, n)
+			}
+			sort.Strings(names)
+			for _, n := range names {
+				acfg := map[string]interface{}{
+					"alias": n,
+				}
+				for k, v := range aliases[n] {
+					acfg[k] = v
+				}
+				blocks = append(blocks, acfg)
+			}
+			pvcfg[pn] = blocks
+		}
 	}
 
 	return wr
@@ -186,13 +230,53 @@ func (w *Writer) Sync() error {
 	logger := log.Get()
 	logger = kitlog.With(logger, "func", "writer.Write(HCL)")
 
+	if w.opts.Transformations != nil {
+		w.setTransformations()
+	}
+
+	// The secrets have to be redacted before the module
+	// variables so they are not used as defaults
+	var secrets map[string]interface{}
+	if w.opts.RedactSecrets {
+		secrets = w.redactSecrets()
+		for n := range secrets {
+			w.secrets = append(w.secrets, n)
+		}
+		sort.Strings(w.secrets)
+	}
+
+	// The values are extracted before the module variables
+	// so they are counted on the resources
+	if w.opts.ExtractVariables > 0 {
+		w.extractVariables()
+	}
+
 	categories := w.categories
-	if w.opts.HasModule() {
+	if w.opts.HasModule() && w.opts.ModuleLayout != "" {
+		categories = w.setLayout()
+	} else if w.opts.HasModule() {
 		categories = append(categories, []string{writer.ModuleCategoryKey, variablesCategoryKey}...)
 		w.setVariables()
 	}
 
+	if len(secrets) != 0 {
+		w.declareVariables(secrets)
+	}
+
+	// The parameters have to be set after the module
+	// variables as the defaults can not be variables
+	if len(w.opts.Parameters) != 0 {
+		w.setParameters()
+	}
+
 	for _, category := range categories {
+		if w.opts.JSON {
+			if err := w.writeJSON(category); err != nil {
+				return err
+			}
+			continue
+		}
+
 		f := hclwrite.NewEmptyFile()
 		body := f.Body()
 		cfg, ok := w.Config[category]
@@ -252,25 +336,33 @@ func (w *Writer) Sync() error {
 			// resourceType is the type of the resource (e.g: `aws_security_groups`)
 			for _, resourceType := range resourceKeys {
 				resources := resourceMap[resourceType]
-				if blockType == "variable" || blockType == "module" || blockType == "provider" {
-					block := hclwrite.NewBlock(blockType, []string{resourceType})
-					bbody := block.Body()
+				if blockType == "variable" || blockType == "module" || blockType == "provider" || blockType == "output" {
+					// The aliased providers are a list
+					// of blocks with the same name
+					blocks := []cty.Value{resources}
+					if blockType == "provider" && resources.Type().IsTupleType() {
+						blocks = resources.AsValueSlice()
+					}
+					for _, b := range blocks {
+						block := hclwrite.NewBlock(blockType, []string{resourceType})
+						bbody := block.Body()
+
+						attrKeys := getValueKeys(b)
+						if len(attrKeys) == 0 {
+							// This will allow to declare empty blocks like
+							// empty variable definitions
+							body.AppendBlock(block)
+							body.AppendNewline()
+							continue
+						}
+						attrMap := b.AsValueMap()
+						for _, attr := range attrKeys {
+							bbody.SetAttributeValue(attr, attrMap[attr])
+						}
 
-					attrKeys := getValueKeys(resources)
-					if len(attrKeys) == 0 {
-						// This will allow to declare empty blocks like
-						// empty variable definitions
 						body.AppendBlock(block)
 						body.AppendNewline()
-						continue
-					}
-					attrMap := resources.AsValueMap()
-					for _, attr := range attrKeys {
-						bbody.SetAttributeValue(attr, attrMap[attr])
 					}
-
-					body.AppendBlock(block)
-					body.AppendNewline()
 					continue
 				}
 				resourceKeys := getValueKeys(resources)
@@ -285,6 +377,12 @@ func (w *Writer) Sync() error {
 
 					attrKeys := getValueKeys(resource)
 					if len(attrKeys) == 0 {
+						// The data blocks can be empty
+						// like 'data "aws_region" "current" {}'
+						if blockType == "data" {
+							body.AppendBlock(block)
+							body.AppendNewline()
+						}
 						continue
 					}
 					attrMap := resource.AsValueMap()
@@ -322,6 +420,118 @@ func (w *Writer) Sync() error {
 	return nil
 }
 
+// writeJSON writes the Config of the category with the Terraform
+// JSON syntax, which is the same content that the HCL one has
+func (w *Writer) writeJSON(category string) error {
+	cfg, ok := w.Config[category]
+	if !ok {
+		return nil
+	}
+
+	// It's marshaled and unmarshaled to have a copy of the
+	// Config with only generic types that can be walked
+	src, err := json.Marshal(cfg)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal JSON config")
+	}
+
+	var v map[string]interface{}
+	err = json.Unmarshal(src, &v)
+	if err != nil {
+		return errors.Wrap(err, "unable to unmarshal JSON config")
+	}
+
+	// The resources without attributes are not written
+	// as it's done on the HCL, only the data ones
+	if rs, ok := v["resource"].(map[string]interface{}); ok {
+		for rt, nrs := range rs {
+			for n, r := range nrs.(map[string]interface{}) {
+				attrs := r.(map[string]interface{})
+				delete(attrs, writer.ResourceCategoryKey)
+				// The 'provider' is not a template on JSON
+				// but the reference directly, like 'aws.alias'
+				if p, ok := attrs["provider"].(string); ok && strings.HasPrefix(p, "${") {
+					attrs["provider"] = strings.TrimSuffix(strings.TrimPrefix(p, "${"), "}")
+				}
+				// As the 'depends_on' which are the addresses
+				if deps, ok := attrs["depends_on"].([]interface{}); ok {
+					for i, d := range deps {
+						if sd, ok := d.(string); ok {
+							deps[i] = strings.TrimSuffix(strings.TrimPrefix(sd, "${"), "}")
+						}
+					}
+				}
+				if len(attrs) == 0 {
+					delete(nrs.(map[string]interface{}), n)
+				}
+			}
+			if len(nrs.(map[string]interface{})) == 0 {
+				delete(rs, rt)
+			}
+		}
+		if len(rs) == 0 {
+			delete(v, "resource")
+		}
+	}
+
+	if len(v) == 0 {
+		return nil
+	}
+
+	b := &bytes.Buffer{}
+	enc := json.NewEncoder(b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	err = enc.Encode(walkJSON(v))
+	if err != nil {
+		return errors.Wrap(err, "unable to encode JSON config")
+	}
+
+	mxwriter.Write(w.writer, category, b.Bytes())
+
+	return nil
+}
+
+var (
+	// jsonReferenceRe matches the values that are only
+	// a reference, like '$${aws_instance.front.id}' or '$${var.name}'
+	jsonReferenceRe = regexp.MustCompile(`^\$\$\{([^$}{]+\.[^$}{]+)\}$`)
+
+	// jsonInnerReferenceRe matches the references inside of
+	// strings, only for the data, var and local ones as it's done on Format
+	jsonInnerReferenceRe = regexp.MustCompile(`(^|[^$])\$\$\{((?:data|var|local)\.[^$}{"]+)\}`)
+)
+
+// walkJSON returns the v with the values escaped for the
+// Terraform JSON syntax, in which all the strings are templates,
+// so only the references are kept as interpolations.
+// The '=tc=' prefix of the keys is also removed
+func walkJSON(v interface{}) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(vv))
+		for k, val := range vv {
+			res[strings.TrimPrefix(k, "=tc=")] = walkJSON(val)
+		}
+		return res
+	case []interface{}:
+		for i, val := range vv {
+			vv[i] = walkJSON(val)
+		}
+		return vv
+	case string:
+		s := strings.ReplaceAll(vv, "${", "$${")
+		s = strings.ReplaceAll(s, "%{", "%%{")
+		if jsonReferenceRe.MatchString(s) {
+			return jsonReferenceRe.ReplaceAllString(s, "$${$1}")
+		}
+		return jsonInnerReferenceRe.ReplaceAllString(s, "$1$${$2}")
+	default:
+		return v
+	}
+}
+
 // getValueKeys will return a sorted list of the keys the val has
 // so then they can be used to access maps without having to deal
 // with random order which messes the output and would generate
@@ -398,6 +608,21 @@ func walkVariables(cfg map[string]interface{}, validVariables map[string]struct{
 				}
 			}
 		default:
+			// The category is for internal usage
+			// and it's not written on the HCL
+			if key == writer.ResourceCategoryKey {
+				continue
+			}
+			// The values already replaced by a variable,
+			// like the redacted secrets, can not be defaults
+			if s, ok := v.(string); ok && strings.HasPrefix(s, "${var.") {
+				continue
+			}
+			// The 'provider' of the resources read with an aliased
+			// Provider is a meta-argument and not an attribute
+			if key == "provider" && strings.Count(currentKey, ".") == 2 {
+				continue
+			}
 			// This means is a "simple" value so we can
 			// directly replace it with the variable
 			if hasKey(validVariables, currentKey) {
@@ -476,6 +701,15 @@ func writeTuple(pbody, body *hclwrite.Body, attr string, value cty.Value) {
 	}
 }
 
+// NameTemplate returns the template of the Options
+// used to name the resources, nil if there is none
+func (w *Writer) NameTemplate() *template.Template {
+	if w.opts == nil {
+		return nil
+	}
+	return w.opts.NameTemplate
+}
+
 // Interpolate replaces the hardcoded resources link
 // with TF interpolation.
 // It has the following format:
@@ -483,6 +717,10 @@ func writeTuple(pbody, body *hclwrite.Body, attr string, value cty.Value) {
 // So then each value matching the VALUE will
 // be replaced with the reference
 func (w *Writer) Interpolate(i map[string]string) {
+	if w.opts.ExternalReferencesData {
+		w.setExternalReferencesData(i)
+	}
+
 	// If Interpolation is disabled or
 	// a module without specific attributes to
 	// create as variables (so all are variables),
@@ -494,38 +732,137 @@ func (w *Writer) Interpolate(i map[string]string) {
 		(w.opts.HasModule() && len(w.opts.ModuleVariables) == 0) {
 		return
 	}
-	for k, v := range w.Config {
+	// who's interpolated with who, it's shared by all
+	// the categories as the references can be between them
+	relations := make(map[string]struct{}, 0)
+	// the relations from each source to its targets, to
+	// check the cycles without going through all the relations
+	edges := make(map[string][]string)
+	w.dependsOn = make(map[string]map[string]struct{})
+
+	// The categories, types and names are sorted so when
+	// a mutual interpolation is avoided it's always the same
+	categories := make([]string, 0, len(w.Config))
+	for k := range w.Config {
 		if k == writer.ModuleCategoryKey || k == variablesCategoryKey || k == w.opts.TerraformCategoryKey {
 			continue
 		}
-		resources := v["resource"]
-		// who's interpolated with who
-		relations := make(map[string]struct{}, 0)
+		categories = append(categories, k)
+	}
+	sort.Strings(categories)
+
+	for _, k := range categories {
+		resources, ok := w.Config[k]["resource"].(map[string]map[string]interface{})
+		if !ok {
+			continue
+		}
+		rts := make([]string, 0, len(resources))
+		for rt := range resources {
+			rts = append(rts, rt)
+		}
+		sort.Strings(rts)
 		// we need to isolate each resource
 		// getting each resource is easier to avoid cycle
 		// or interpolation.
 		// We first loop over resource type (e.g: aws_instance)
-		for rt, resource := range resources.(map[string]map[string]interface{}) {
+		for _, rt := range rts {
+			resource := resources[rt]
+			names := make([]string, 0, len(resource))
+			for name := range resource {
+				names = append(names, name)
+			}
+			sort.Strings(names)
 			// we loop over a resource (e.g: aws_instance.oDSOj)
-			for name, block := range resource {
+			for _, name := range names {
+				block := resource[name]
 				src := reflect.ValueOf(block)
 
 				// this will store the updated block
 				dest := reflect.New(src.Type()).Elem()
 
 				// walk through the resources to interpolate the good values
-				w.walkInterpolation(dest, src, i, name, "", rt, &relations)
+				w.walkInterpolation(dest, src, i, name, "", rt, &relations, edges)
 
 				// remove reflect.Value wrapper from dest
-				resources.(map[string]map[string]interface{})[rt][name] = dest.Interface()
+				resource[name] = dest.Interface()
 			}
 		}
 	}
+
+	if w.opts.DependsOn {
+		w.setDependsOn(relations, edges)
+	}
+}
+
+// setDependsOn adds the 'depends_on' to the resources with the ones
+// they depend on that are not already referenced by them. The ones
+// that would create a cycle are ignored
+func (w *Writer) setDependsOn(relations map[string]struct{}, edges map[string][]string) {
+	sources := make([]string, 0, len(w.dependsOn))
+	for source := range w.dependsOn {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	for _, source := range sources {
+		targets := make([]string, 0, len(w.dependsOn[source]))
+		for target := range w.dependsOn[source] {
+			targets = append(targets, target)
+		}
+		sort.Strings(targets)
+
+		// The value is a reference to the resource,
+		// like '${aws_vpc.main}', as the other ones.
+		// Each relation is stored before checking the next
+		// one so the cycles are checked with all of them
+		dependsOn := make([]interface{}, 0, len(targets))
+		for _, target := range targets {
+			if target == source || isMutualInterpolation(target, source, &relations) || isCyclicInterpolation(target, source, edges) {
+				continue
+			}
+			addRelation(source, target, relations, edges)
+			dependsOn = append(dependsOn, fmt.Sprintf("${%s}", target))
+		}
+		if len(dependsOn) == 0 {
+			continue
+		}
+
+		rt, name := splitAddress(source)
+		for k := range w.Config {
+			resources, ok := w.Config[k]["resource"].(map[string]map[string]interface{})
+			if !ok {
+				continue
+			}
+			if cfg, ok := resources[rt][name].(map[string]interface{}); ok {
+				cfg["depends_on"] = dependsOn
+			}
+		}
+	}
+}
+
+// splitAddress returns the resource type and the
+// name of the address a, like 'aws_vpc.main'
+func splitAddress(a string) (string, string) {
+	s := strings.SplitN(a, ".", 2)
+	if len(s) != 2 {
+		return a, ""
+	}
+	return s[0], s[1]
+}
+
+// addDependsOn adds the resource of the reference
+// ref as a dependency of the source one
+func (w *Writer) addDependsOn(source, ref string) {
+	rt, name := extractResourceTypeAndName(ref)
+	if _, ok := w.dependsOn[source]; !ok {
+		w.dependsOn[source] = make(map[string]struct{})
+	}
+	w.dependsOn[source][fmt.Sprintf("%s.%s", rt, name)] = struct{}{}
 }
 
 // walkInterpolation through a resource block. it's easier since we do not know how the block is made
 // `dest` will be the new "block" with the values interpolated from `interpolate`
-func (w *Writer) walkInterpolation(dest, src reflect.Value, interpolate map[string]string, name, key string, resourceType string, relations *map[string]struct{}) {
+func (w *Writer) walkInterpolation(dest, src reflect.Value, interpolate map[string]string, name, key string, resourceType string, relations *map[string]struct{}, edges map[string][]string) {
 	switch src.Kind() {
 	// it's an interface, so we basically need
 	// to extract the elem and walk through it
@@ -533,7 +870,7 @@ func (w *Writer) walkInterpolation(dest, src reflect.Value, interpolate map[stri
 	case reflect.Interface:
 		srcValue := src.Elem()
 		destValue := reflect.New(srcValue.Type()).Elem()
-		w.walkInterpolation(destValue, srcValue, interpolate, name, key, resourceType, relations)
+		w.walkInterpolation(destValue, srcValue, interpolate, name, key, resourceType, relations, edges)
 		dest.Set(destValue)
 
 	// if the current `src` is a slice
@@ -541,7 +878,7 @@ func (w *Writer) walkInterpolation(dest, src reflect.Value, interpolate map[stri
 	case reflect.Array, reflect.Slice:
 		dest.Set(reflect.MakeSlice(src.Type(), src.Len(), src.Cap()))
 		for i := 0; i < src.Len(); i++ {
-			w.walkInterpolation(dest.Index(i), src.Index(i), interpolate, name, key, resourceType, relations)
+			w.walkInterpolation(dest.Index(i), src.Index(i), interpolate, name, key, resourceType, relations, edges)
 		}
 
 	// it's a map
@@ -555,7 +892,7 @@ func (w *Writer) walkInterpolation(dest, src reflect.Value, interpolate map[stri
 			if key != "" {
 				nk = fmt.Sprintf("%s.%s", key, iter.Key())
 			}
-			w.walkInterpolation(destValue, iter.Value(), interpolate, name, nk, resourceType, relations)
+			w.walkInterpolation(destValue, iter.Value(), interpolate, name, nk, resourceType, relations, edges)
 			dest.SetMapIndex(iter.Key(), destValue)
 		}
 
@@ -576,17 +913,37 @@ func (w *Writer) walkInterpolation(dest, src reflect.Value, interpolate map[stri
 					return
 				}
 			}
-			// avoid to interpolate a resource by "itself" (interpolaception) and avoid to interpolate a resource type with resource
-			// of the same type (cyclic interpolation)
-			// we also check for mutual interpolation
-			if !(strings.Contains(interpolatedValue, name) || strings.Contains(interpolatedValue, resourceType) || isMutualInterpolation(target, source, relations)) {
+			// The attributes excluded keep the value
+			// but the relation can be kept with a depends_on
+			if isInterpolationExcluded(w.opts.InterpolationExclude, resourceType, key) {
+				if w.opts.DependsOn {
+					w.addDependsOn(source, interpolatedValue)
+				}
+				dest.Set(src)
+				return
+			}
+			// avoid to interpolate a resource by "itself" (interpolaception),
+			// check for mutual interpolation and for longer cycles like
+			// A -> B -> C -> A (cyclic interpolation)
+			if target != source && !isMutualInterpolation(target, source, relations) && !isCyclicInterpolation(target, source, edges) {
 				dest.SetString(interpolatedValue)
 				// we store this new relationship
-				(*relations)[fmt.Sprintf("%s+%s", source, target)] = struct{}{}
+				addRelation(source, target, *relations, edges)
 			} else {
 				dest.SetString(src.Interface().(string))
 			}
 		} else {
+			// The values that have references to other resources,
+			// like the ARNs of a policy document, can not be
+			// interpolated but are dependencies
+			if w.opts.DependsOn {
+				source := fmt.Sprintf("%s.%s", resourceType, name)
+				for _, t := range util.ReferenceTokens(src.Interface().(string)) {
+					if ref, ok := interpolate[t]; ok {
+						w.addDependsOn(source, ref)
+					}
+				}
+			}
 			dest.SetString(src.Interface().(string))
 		}
 	default:
@@ -594,6 +951,407 @@ func (w *Writer) walkInterpolation(dest, src reflect.Value, interpolate map[stri
 	}
 }
 
+// setTransformations applies the Transformations to all the resources
+// values and adds the data blocks needed by them
+func (w *Writer) setTransformations() {
+	for _, k := range w.categories {
+		if k == writer.ModuleCategoryKey || k == variablesCategoryKey || k == w.opts.TerraformCategoryKey {
+			continue
+		}
+		for rt, resource := range w.Config[k]["resource"].(map[string]map[string]interface{}) {
+			for name, block := range resource {
+				resource[name] = walkTransformations(block, w.opts.Transformations, rt, "")
+			}
+		}
+	}
+
+	if len(w.opts.Transformations.Data) == 0 {
+		return
+	}
+
+	data := make(map[string]map[string]interface{})
+	for k, v := range w.opts.Transformations.Data {
+		keys := strings.Split(k, ".")
+		if _, ok := data[keys[0]]; !ok {
+			data[keys[0]] = make(map[string]interface{})
+		}
+		cfg := make(map[string]interface{}, len(v))
+		for ck, cv := range v {
+			cfg[ck] = cv
+		}
+		data[keys[0]][keys[1]] = cfg
+	}
+	w.Config[dataCategoryKey] = map[string]interface{}{
+		"data":     data,
+		"resource": make(map[string]map[string]interface{}),
+	}
+	w.categories = append(w.categories, dataCategoryKey)
+}
+
+// walkTransformations walks the value v of the resource of type rt and
+// applies the Transformations to all the string values
+func walkTransformations(v interface{}, t *transform.Transformations, rt, key string) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		for k, val := range vv {
+			// The category is internal and the map attributes
+			// have the =tc= prefix that is not part of the name
+			if k == writer.ResourceCategoryKey {
+				continue
+			}
+			nk := strings.TrimPrefix(k, "=tc=")
+			if key != "" {
+				nk = fmt.Sprintf("%s.%s", key, nk)
+			}
+			vv[k] = walkTransformations(val, t, rt, nk)
+		}
+		return vv
+	case []interface{}:
+		for idx, val := range vv {
+			vv[idx] = walkTransformations(val, t, rt, key)
+		}
+		return vv
+	case string:
+		return t.Apply(rt, key, vv)
+	default:
+		return v
+	}
+}
+
+// setParameters replaces the values of the Parameters with the variables
+// on all the resources and declares those variables. On a module the variables
+// that have a parameter on the default value are set only on the module block
+func (w *Writer) setParameters() {
+	// The longest values are replaced first so a value
+	// contained on another one does not break it
+	names := make([]string, 0, len(w.opts.Parameters))
+	for n := range w.opts.Parameters {
+		names = append(names, n)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		vi, vj := w.opts.Parameters[names[i]], w.opts.Parameters[names[j]]
+		if len(vi) != len(vj) {
+			return len(vi) > len(vj)
+		}
+		return names[i] < names[j]
+	})
+
+	for _, k := range w.categories {
+		if k == writer.ModuleCategoryKey || k == variablesCategoryKey || k == w.opts.TerraformCategoryKey {
+			continue
+		}
+		for _, resource := range w.Config[k]["resource"].(map[string]map[string]interface{}) {
+			for name, block := range resource {
+				resource[name] = walkParameters(block, w.opts.Parameters, names)
+			}
+		}
+	}
+
+	if w.opts.HasModule() {
+		module := w.Config[writer.ModuleCategoryKey]["module"].(map[string]interface{})[w.opts.Module].(map[string]interface{})
+		mvariables := w.Config[variablesCategoryKey]["variable"].(map[string]interface{})
+		for vn, v := range mvariables {
+			mv := v.(map[string]interface{})
+			d, ok := mv["default"]
+			if !ok || !hasParameters(d, w.opts.Parameters) {
+				continue
+			}
+			delete(mv, "default")
+			module[vn] = walkParameters(module[vn], w.opts.Parameters, names)
+		}
+	}
+
+	// If the variable was already declared, like
+	// the region of the provider, the default is
+	// removed as the value is on the tfvars
+	variables := make(map[string]interface{}, len(names))
+	for _, n := range names {
+		variables[n] = make(map[string]interface{})
+	}
+	w.declareVariables(variables)
+}
+
+// declareVariables declares the variables on the category of the
+// Terraform block. On a module they are also declared on the module
+// and passed to it from the module block
+func (w *Writer) declareVariables(variables map[string]interface{}) {
+	tfKey := w.terraformCategoryKey()
+	if _, ok := w.Config[tfKey]["variable"]; !ok {
+		w.Config[tfKey]["variable"] = make(map[string]interface{})
+	}
+	tfvariables := w.Config[tfKey]["variable"].(map[string]interface{})
+	for n, v := range variables {
+		tfvariables[n] = v
+	}
+
+	if !w.opts.HasModule() {
+		return
+	}
+
+	module := w.Config[writer.ModuleCategoryKey]["module"].(map[string]interface{})[w.opts.Module].(map[string]interface{})
+	mvariables := w.Config[variablesCategoryKey]["variable"].(map[string]interface{})
+	for n, v := range variables {
+		mvariables[n] = v
+		module[n] = fmt.Sprintf("${var.%s}", n)
+	}
+}
+
+// redactSecrets replaces the values of the attributes marked as
+// Sensitive on the schema of the resources with variables without
+// default, so the secrets are not written on the HCL. It returns
+// the declarations of the variables used
+func (w *Writer) redactSecrets() map[string]interface{} {
+	variables := make(map[string]interface{})
+	rsm := w.provider.TFProvider().ResourcesMap
+	sm, _ := w.provider.(provider.SecretMatcher)
+	for _, k := range w.categories {
+		if k == writer.ModuleCategoryKey || k == variablesCategoryKey || k == w.opts.TerraformCategoryKey {
+			continue
+		}
+		for rt, resource := range w.Config[k]["resource"].(map[string]map[string]interface{}) {
+			rs, ok := rsm[rt]
+			if !ok {
+				continue
+			}
+			for name, block := range resource {
+				cfg, ok := block.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				resource[name] = walkSecrets(cfg, rs.Schema, sm, rt, fmt.Sprintf("%s.%s", rt, name), variables)
+			}
+		}
+	}
+	return variables
+}
+
+// walkSecrets walks the cfg following the sch and replaces the values of the
+// Sensitive attributes, and the values of the maps matched by the sm (if any),
+// with a variable, the k is the current key of the resource type rt
+// (ex: aws_db_instance.front.password) used to name the variable
+func walkSecrets(cfg map[string]interface{}, sch map[string]*schema.Schema, sm provider.SecretMatcher, rt, k string, variables map[string]interface{}) map[string]interface{} {
+	for key, value := range cfg {
+		// The keys of the maps may have
+		// the =tc= prefix that is not part of the name
+		as, ok := sch[strings.TrimPrefix(key, "=tc=")]
+		if !ok {
+			continue
+		}
+		currentKey := fmt.Sprintf("%s.%s", k, strings.TrimPrefix(key, "=tc="))
+		if provider.IsSensitive(strings.TrimPrefix(key, "=tc="), as) {
+			varName := util.NormalizeName(strings.ReplaceAll(currentKey, ".", "_"))
+			variables[varName] = map[string]interface{}{
+				"sensitive": true,
+			}
+			cfg[key] = fmt.Sprintf("${var.%s}", varName)
+			continue
+		}
+
+		if m, ok := value.(map[string]interface{}); ok && as.Type == schema.TypeMap && sm != nil {
+			attr := strings.TrimPrefix(key, "=tc=")
+			for mk, mv := range m {
+				smv, ok := mv.(string)
+				if !ok || !sm.IsSecret(rt, attr, strings.TrimPrefix(mk, "=tc="), smv) {
+					continue
+				}
+				varName := util.NormalizeName(strings.ReplaceAll(fmt.Sprintf("%s.%s", currentKey, strings.TrimPrefix(mk, "=tc=")), ".", "_"))
+				variables[varName] = map[string]interface{}{
+					"sensitive": true,
+				}
+				m[mk] = fmt.Sprintf("${var.%s}", varName)
+			}
+			continue
+		}
+
+		r, ok := as.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+		switch v := value.(type) {
+		case map[string]interface{}:
+			cfg[key] = walkSecrets(v, r.Schema, sm, rt, currentKey, variables)
+		case []interface{}:
+			for i, vv := range v {
+				if m, ok := vv.(map[string]interface{}); ok {
+					v[i] = walkSecrets(m, r.Schema, sm, rt, fmt.Sprintf("%s.%d", currentKey, i), variables)
+				}
+			}
+		}
+	}
+	return cfg
+}
+
+// walkParameters walks the value v and replaces on all the string values
+// the values of the params, in the order of names, for the variable
+func walkParameters(v interface{}, params map[string]string, names []string) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		for k, val := range vv {
+			if k == writer.ResourceCategoryKey {
+				continue
+			}
+			vv[k] = walkParameters(val, params, names)
+		}
+		return vv
+	case []interface{}:
+		for idx, val := range vv {
+			vv[idx] = walkParameters(val, params, names)
+		}
+		return vv
+	case string:
+		// The values with interpolations are ignored as
+		// the names of the resources may have the values
+		if strings.Contains(vv, "${") {
+			return vv
+		}
+		for _, n := range names {
+			vv = strings.ReplaceAll(vv, params[n], fmt.Sprintf("${var.%s}", n))
+		}
+		return vv
+	default:
+		return v
+	}
+}
+
+// hasParameters checks if any of the values of the params is on v
+func hasParameters(v interface{}, params map[string]string) bool {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return false
+	}
+	for _, p := range params {
+		if strings.Contains(string(b), p) {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteTFVars writes to out the values of the Parameters
+// with the format of a '.tfvars' file
+func (w *Writer) WriteTFVars(out io.Writer) error {
+	names := make([]string, 0, len(w.opts.Parameters))
+	for n := range w.opts.Parameters {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	f := hclwrite.NewEmptyFile()
+	for _, n := range names {
+		f.Body().SetAttributeValue(n, cty.StringVal(w.opts.Parameters[n]))
+	}
+
+	_, err := f.WriteTo(out)
+	return err
+}
+
+// WriteSecretsExample writes to out the variables of the values redacted
+// with the RedactSecrets, without value, with the format of a '.tfvars'
+// file so it can be used as example of the values that have to be set.
+// It returns false if there were no values redacted
+func (w *Writer) WriteSecretsExample(out io.Writer) (bool, error) {
+	if len(w.secrets) == 0 {
+		return false, nil
+	}
+
+	_, err := fmt.Fprintln(out, "# Values of the sensitive attributes redacted from the HCL")
+	if err != nil {
+		return true, err
+	}
+
+	f := hclwrite.NewEmptyFile()
+	for _, n := range w.secrets {
+		f.Body().SetAttributeValue(n, cty.StringVal(""))
+	}
+
+	_, err = f.WriteTo(out)
+	return true, err
+}
+
+// terraformCategoryKey returns the category in which
+// the Terraform and provider blocks are written
+func (w *Writer) terraformCategoryKey() string {
+	if w.opts.TerraformCategoryKey != "" {
+		return w.opts.TerraformCategoryKey
+	}
+	if w.opts.HasModule() {
+		return writer.ModuleCategoryKey
+	}
+	return defaultCategory
+}
+
+// setExternalReferencesData will add a 'data' block for each value that
+// references an entity outside of the imported scope, if the Provider knows
+// how to fetch it, and will replace the value with the reference to it.
+// The values present on i are ignored as they are part of the imported scope
+func (w *Writer) setExternalReferencesData(i map[string]string) {
+	er, ok := w.provider.(provider.ExternalReferencer)
+	if !ok {
+		return
+	}
+
+	// names has the value referenced as key and the
+	// interpolation to the data block as value, so the same
+	// value is only declared once on all the categories
+	names := make(map[string]string)
+	for _, k := range w.categories {
+		if k == writer.ModuleCategoryKey || k == variablesCategoryKey || k == w.opts.TerraformCategoryKey {
+			continue
+		}
+		cfg := w.Config[k]
+		data := make(map[string]map[string]interface{})
+		for rt, resource := range cfg["resource"].(map[string]map[string]interface{}) {
+			for name, block := range resource {
+				resource[name] = walkExternalReferences(block, er, i, rt, "", names, data)
+			}
+		}
+		if len(data) != 0 {
+			cfg["data"] = data
+		}
+	}
+}
+
+// walkExternalReferences walks the value v of the resource of type rt and replaces the values
+// that are external references with the interpolation to the data block, which is added to data
+func walkExternalReferences(v interface{}, er provider.ExternalReferencer, i map[string]string, rt, key string, names map[string]string, data map[string]map[string]interface{}) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		for k, val := range vv {
+			nk := k
+			if key != "" {
+				nk = fmt.Sprintf("%s.%s", key, k)
+			}
+			vv[k] = walkExternalReferences(val, er, i, rt, nk, names, data)
+		}
+		return vv
+	case []interface{}:
+		for idx, val := range vv {
+			vv[idx] = walkExternalReferences(val, er, i, rt, key, names, data)
+		}
+		return vv
+	case string:
+		if _, ok := i[vv]; ok {
+			return vv
+		}
+		if ref, ok := names[vv]; ok {
+			return ref
+		}
+		ref, ok := er.ExternalReference(rt, key, vv)
+		if !ok || ref.DataSource == "" {
+			return vv
+		}
+		name := util.NormalizeName(vv)
+		if _, ok := data[ref.DataSource]; !ok {
+			data[ref.DataSource] = make(map[string]interface{})
+		}
+		data[ref.DataSource][name] = ref.DataSourceConfig
+		names[vv] = fmt.Sprintf("${data.%s.%s.%s}", ref.DataSource, name, ref.DataSourceAttribute)
+		return names[vv]
+	default:
+		return v
+	}
+}
+
 // isMutualInterpolation will simply go through the list of relations to find out
 // if a relation is already present between the two resources in one direction
 // or the other
@@ -607,6 +1365,46 @@ func isMutualInterpolation(target, source string, relations *map[string]struct{}
 	return false
 }
 
+// addRelation stores the relation from the source to
+// the target on the relations and on the edges
+func addRelation(source, target string, relations map[string]struct{}, edges map[string][]string) {
+	relations[fmt.Sprintf("%s+%s", source, target)] = struct{}{}
+	edges[source] = append(edges[source], target)
+}
+
+// isCyclicInterpolation checks if adding the relation from source to target
+// would create a cycle, which is if the source can already be reached from
+// the target following the edges of the relations
+func isCyclicInterpolation(target, source string, edges map[string][]string) bool {
+	visited := make(map[string]struct{})
+	stack := []string{target}
+	for len(stack) != 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if n == source {
+			return true
+		}
+		if _, ok := visited[n]; ok {
+			continue
+		}
+		visited[n] = struct{}{}
+		stack = append(stack, edges[n]...)
+	}
+	return false
+}
+
+// isInterpolationExcluded checks if the attribute key of the resource type rt
+// matches any of the patterns, with the format 'TYPE.ATTRIBUTE' (ex: '*.subnet_id')
+func isInterpolationExcluded(patterns []string, rt, key string) bool {
+	attr := fmt.Sprintf("%s.%s", rt, strings.ReplaceAll(key, "=tc=", ""))
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, attr); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // extractResourceTypeAndName will parse a TF variable to return
 // the resource type and the name of the resource
 func extractResourceTypeAndName(value string) (string, string) {
@@ -616,18 +1414,22 @@ func extractResourceTypeAndName(value string) (string, string) {
 
 }
 
-// setProviderConfig will set the required fields to the provider configuration
-// under the given category
+// setProviderConfig will set the required fields, and the HCLProviderVariables,
+// to the provider configuration under the given category
 func (w *Writer) setProviderConfig(cat string) {
 	pcfg := w.provider.Configuration()
 	for k, s := range w.provider.TFProvider().Schema {
-		if s.Required {
+		if s.Required || isProviderVariable(w.opts.HCLProviderVariables, k, s) {
 			if _, ok := w.Config[cat]["variable"]; !ok {
 				w.Config[cat]["variable"] = make(map[string]interface{})
 			}
 			varVal := map[string]interface{}{}
 			if v, ok := pcfg[k]; ok {
 				varVal["default"] = v
+			} else if !s.Required {
+				// The optional ones without value are null so the TF
+				// Provider reads them from the environment when applying
+				varVal["default"] = nil
 			} else if s.Default != nil {
 				varVal["default"] = s.Default
 			} else if s.DefaultFunc != nil {
@@ -642,3 +1444,20 @@ func (w *Writer) setProviderConfig(cat string) {
 		}
 	}
 }
+
+// isProviderVariable checks if the k is one of the
+// HCLProviderVariables and s is of a primitive type
+func isProviderVariable(vars []string, k string, s *schema.Schema) bool {
+	switch s.Type {
+	case schema.TypeString, schema.TypeBool, schema.TypeInt, schema.TypeFloat:
+	default:
+		return false
+	}
+
+	for _, v := range vars {
+		if v == k {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package hcl_test
 
 import (
+	"bytes"
 	"io/ioutil"
 	"reflect"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/cycloidio/terracognita/errcode"
 	"github.com/cycloidio/terracognita/hcl"
 	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
 	"github.com/cycloidio/terracognita/writer"
 	"github.com/golang/mock/gomock"
 	aws "github.com/hashicorp/terraform-provider-aws/provider"
@@ -48,6 +50,35 @@ func TestNewHCLWriter(t *testing.T) {
 			},
 		}, hw.Config)
 	})
+	t.Run("SuccessWithVersion", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			p    = versionerProvider{Provider: mock.NewProvider(ctrl)}
+		)
+		p.EXPECT().String().Return("aws").Times(2)
+		p.EXPECT().Source().Return("hashicorp/aws")
+		p.EXPECT().TFProvider().Return(aws.Provider())
+		p.EXPECT().Configuration().Return(map[string]interface{}{
+			"region": "eu-west-1",
+		})
+
+		hw := hcl.NewWriter(nil, p, &writer.Options{HCLProviderBlock: true})
+		assert.Equal(t, map[string]map[string]interface{}{
+			"hcl": map[string]interface{}{
+				"provider": map[string]interface{}{"aws": map[string]interface{}{}},
+				"resource": map[string]map[string]interface{}{},
+				"terraform": map[string]interface{}{
+					"required_providers": map[string]interface{}{
+						"=tc=aws": map[string]interface{}{
+							"source":  "hashicorp/aws",
+							"version": "4.9.0",
+						},
+					},
+					"required_version": ">= 1.0",
+				},
+			},
+		}, hw.Config)
+	})
 	t.Run("SuccessWithSplitConfig", func(t *testing.T) {
 		var (
 			ctrl = gomock.NewController(t)
@@ -82,6 +113,44 @@ func TestNewHCLWriter(t *testing.T) {
 			},
 		}, hw.Config))
 	})
+	t.Run("SuccessWithProviderVariables", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			p    = mock.NewProvider(ctrl)
+		)
+		p.EXPECT().String().Return("aws").Times(4)
+		p.EXPECT().Source().Return("hashicorp/aws")
+		p.EXPECT().TFProvider().Return(aws.Provider())
+		p.EXPECT().Configuration().Return(map[string]interface{}{
+			"region": "eu-west-1",
+		})
+
+		hw := hcl.NewWriter(nil, p, &writer.Options{
+			HCLProviderBlock:     true,
+			HCLProviderVariables: []string{"region", "profile", "assume_role", "potato"},
+		})
+		assert.Equal(t, map[string]map[string]interface{}{
+			"hcl": map[string]interface{}{
+				"provider": map[string]interface{}{"aws": map[string]interface{}{
+					"region":  "${var.region}",
+					"profile": "${var.profile}",
+				}},
+				"variable": map[string]interface{}{
+					"region":  map[string]interface{}{"default": "eu-west-1"},
+					"profile": map[string]interface{}{"default": nil},
+				},
+				"resource": map[string]map[string]interface{}{},
+				"terraform": map[string]interface{}{
+					"required_providers": map[string]interface{}{
+						"=tc=aws": map[string]interface{}{
+							"source": "hashicorp/aws",
+						},
+					},
+					"required_version": ">= 1.0",
+				},
+			},
+		}, hw.Config)
+	})
 	t.Run("SuccessWithoutProviderBLock", func(t *testing.T) {
 		var (
 			ctrl = gomock.NewController(t)
@@ -349,6 +418,60 @@ resource "type" "name" {
 
 		assert.Equal(t, strings.Join(strings.Fields(ehcl), " "), strings.Join(strings.Fields(string(b)), " "))
 	})
+	t.Run("SuccessWithAliases", func(t *testing.T) {
+		var (
+			ctrl  = gomock.NewController(t)
+			p     = aliaserProvider{Provider: mock.NewProvider(ctrl)}
+			mx    = mxwriter.NewMux()
+			value = map[string]interface{}{
+				"key":      "value",
+				"provider": "${aws.eu_west_1}",
+			}
+			ehcl = `
+provider "aws" { }
+
+provider "aws" {
+	alias  = "eu_west_1"
+	region = "eu-west-1"
+}
+
+terraform {
+	required_providers {
+		aws = {
+			source = "hashicorp/aws"
+		}
+	}
+	required_version = ">= 1.0"
+}
+
+resource "type" "name" {
+  key      = "value"
+  provider = aws.eu_west_1
+}
+
+`
+		)
+
+		p.EXPECT().String().Return("aws").Times(3)
+		p.EXPECT().Source().Return("hashicorp/aws")
+		p.EXPECT().TFProvider().Return(aws.Provider())
+		p.EXPECT().Configuration().Return(map[string]interface{}{
+			"region": "us-east-1",
+		})
+
+		hw := hcl.NewWriter(mx, p, &writer.Options{HCLProviderBlock: true, Interpolate: true})
+
+		err := hw.Write("type.name", value)
+		require.NoError(t, err)
+
+		err = hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mx)
+		require.NoError(t, err)
+
+		assert.Equal(t, strings.Join(strings.Fields(ehcl), " "), strings.Join(strings.Fields(string(b)), " "))
+	})
 	t.Run("SuccessWithoutProviderBlock", func(t *testing.T) {
 		var (
 			ctrl  = gomock.NewController(t)
@@ -807,6 +930,324 @@ terraform {
 
 		assert.Equal(t, strings.Join(strings.Fields(ehcl), " "), strings.Join(strings.Fields(string(b)), " "))
 	})
+	t.Run("Parameters", func(t *testing.T) {
+		var (
+			ctrl  = gomock.NewController(t)
+			p     = mock.NewProvider(ctrl)
+			mw    = mxwriter.NewMux()
+			value = map[string]interface{}{
+				"arn":       "arn:aws:iam::123456789012:role/front",
+				"cidr":      "10.0.0.0/16",
+				"zone":      "eu-west-1a",
+				"reference": "${aws_iam_role.front_123456789012.arn}",
+			}
+			ehcl = `
+resource "type" "name" {
+	arn       = "arn:aws:iam::${var.account_id}:role/front"
+	cidr      = var.vpc_cidr
+	reference = aws_iam_role.front_123456789012.arn
+	zone      = "${var.region}a"
+}
+
+terraform {
+	required_providers {
+		aws = {
+			source = "hashicorp/aws"
+		}
+	}
+	required_version = ">= 1.0"
+}
+
+variable "account_id" {
+}
+
+variable "region" {
+}
+
+variable "vpc_cidr" {
+}
+`
+			etfvars = `
+account_id = "123456789012"
+region     = "eu-west-1"
+vpc_cidr   = "10.0.0.0/16"
+`
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{Interpolate: true, Parameters: map[string]string{
+			"account_id": "123456789012",
+			"region":     "eu-west-1",
+			"vpc_cidr":   "10.0.0.0/16",
+		}})
+
+		err := hw.Write("type.name", value)
+		require.NoError(t, err)
+
+		err = hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mw)
+		require.NoError(t, err)
+
+		assert.Equal(t, strings.Join(strings.Fields(ehcl), " "), strings.Join(strings.Fields(string(b)), " "))
+
+		var tfvars bytes.Buffer
+		err = hw.WriteTFVars(&tfvars)
+		require.NoError(t, err)
+
+		assert.Equal(t, strings.Join(strings.Fields(etfvars), " "), strings.Join(strings.Fields(tfvars.String()), " "))
+	})
+	t.Run("ExtractVariables", func(t *testing.T) {
+		var (
+			ctrl   = gomock.NewController(t)
+			p      = mock.NewProvider(ctrl)
+			mw     = mxwriter.NewMux()
+			values = map[string]interface{}{
+				"type.a": map[string]interface{}{
+					"ami":           "ami-0123456789",
+					"instance_type": "t2.micro",
+					"port":          "8080",
+				},
+				"type.b": map[string]interface{}{
+					"ami":           "ami-0123456789",
+					"instance_type": "t2.micro",
+					"port":          "8080",
+				},
+				"type.c": map[string]interface{}{
+					"ami":       "ami-0123456789",
+					"port":      "8080",
+					"reference": "${type.a.id}",
+				},
+			}
+			ehcl = `
+resource "type" "a" {
+	ami           = var.ami
+	instance_type = "t2.micro"
+	port          = "8080"
+}
+
+resource "type" "b" {
+	ami           = var.ami
+	instance_type = "t2.micro"
+	port          = "8080"
+}
+
+resource "type" "c" {
+	ami       = var.ami
+	port      = "8080"
+	reference = type.a.id
+}
+
+terraform {
+	required_providers {
+		aws = {
+			source = "hashicorp/aws"
+		}
+	}
+	required_version = ">= 1.0"
+}
+
+variable "ami" {
+}
+`
+			etfvars = `
+ami = "ami-0123456789"
+`
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{Interpolate: true, ExtractVariables: 3})
+
+		for _, k := range []string{"type.a", "type.b", "type.c"} {
+			err := hw.Write(k, values[k])
+			require.NoError(t, err)
+		}
+
+		err := hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mw)
+		require.NoError(t, err)
+
+		assert.Equal(t, strings.Join(strings.Fields(ehcl), " "), strings.Join(strings.Fields(string(b)), " "))
+
+		var tfvars bytes.Buffer
+		err = hw.WriteTFVars(&tfvars)
+		require.NoError(t, err)
+
+		assert.Equal(t, strings.Join(strings.Fields(etfvars), " "), strings.Join(strings.Fields(tfvars.String()), " "))
+	})
+	t.Run("RedactSecrets", func(t *testing.T) {
+		var (
+			ctrl  = gomock.NewController(t)
+			p     = mock.NewProvider(ctrl)
+			mw    = mxwriter.NewMux()
+			value = map[string]interface{}{
+				"name":  "db_password",
+				"type":  "SecureString",
+				"value": "secret",
+			}
+			ehcl = `
+resource "aws_ssm_parameter" "db" {
+	name  = "db_password"
+	type  = "SecureString"
+	value = var.aws_ssm_parameter_db_value
+}
+
+terraform {
+	required_providers {
+		aws = {
+			source = "hashicorp/aws"
+		}
+	}
+	required_version = ">= 1.0"
+}
+
+variable "aws_ssm_parameter_db_value" {
+	sensitive = true
+}
+`
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+		p.EXPECT().TFProvider().Return(aws.Provider())
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{Interpolate: true, RedactSecrets: true})
+
+		err := hw.Write("aws_ssm_parameter.db", value)
+		require.NoError(t, err)
+
+		err = hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mw)
+		require.NoError(t, err)
+
+		assert.Equal(t, strings.Join(strings.Fields(ehcl), " "), strings.Join(strings.Fields(string(b)), " "))
+
+		var example bytes.Buffer
+		ok, err := hw.WriteSecretsExample(&example)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "# Values of the sensitive attributes redacted from the HCL aws_ssm_parameter_db_value = \"\"", strings.Join(strings.Fields(example.String()), " "))
+	})
+	t.Run("RedactSecretsWithSecretMatcher", func(t *testing.T) {
+		var (
+			ctrl  = gomock.NewController(t)
+			p     = secretMatcherProvider{Provider: mock.NewProvider(ctrl)}
+			mw    = mxwriter.NewMux()
+			value = map[string]interface{}{
+				"function_name": "api",
+				"environment": []interface{}{
+					map[string]interface{}{
+						"=tc=variables": map[string]interface{}{
+							"DB_PASSWORD": "secret",
+							"ENV":         "prod",
+						},
+					},
+				},
+			}
+			ehcl = `
+resource "aws_lambda_function" "api" {
+	function_name = "api"
+	environment {
+		variables = {
+			DB_PASSWORD = var.aws_lambda_function_api_environment_0_variables_db_password
+			ENV         = "prod"
+		}
+	}
+}
+
+terraform {
+	required_providers {
+		aws = {
+			source = "hashicorp/aws"
+		}
+	}
+	required_version = ">= 1.0"
+}
+
+variable "aws_lambda_function_api_environment_0_variables_db_password" {
+	sensitive = true
+}
+`
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+		p.EXPECT().TFProvider().Return(aws.Provider())
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{Interpolate: true, RedactSecrets: true})
+
+		err := hw.Write("aws_lambda_function.api", value)
+		require.NoError(t, err)
+
+		err = hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mw)
+		require.NoError(t, err)
+
+		assert.Equal(t, strings.Join(strings.Fields(ehcl), " "), strings.Join(strings.Fields(string(b)), " "))
+	})
+	t.Run("JSON", func(t *testing.T) {
+		var (
+			ctrl  = gomock.NewController(t)
+			p     = mock.NewProvider(ctrl)
+			mw    = mxwriter.NewMux()
+			value = map[string]interface{}{
+				"name":   "front-${var.env}",
+				"policy": `{"Resource": "arn:aws:s3:::bucket/${aws:username}"}`,
+				"vpc_id": "${aws_vpc.main.id}",
+				"ingress": []interface{}{
+					map[string]interface{}{
+						"from_port": 80,
+					},
+				},
+			}
+			ejson = `{
+  "resource": {
+    "aws_security_group": {
+      "front": {
+        "ingress": [
+          {
+            "from_port": 80
+          }
+        ],
+        "name": "front-${var.env}",
+        "policy": "{\"Resource\": \"arn:aws:s3:::bucket/$${aws:username}\"}",
+        "vpc_id": "${aws_vpc.main.id}"
+      }
+    }
+  },
+  "terraform": {
+    "required_providers": {
+      "aws": {
+        "source": "hashicorp/aws"
+      }
+    },
+    "required_version": ">= 1.0"
+  }
+}`
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{Interpolate: true, JSON: true})
+
+		err := hw.Write("aws_security_group.front", value)
+		require.NoError(t, err)
+
+		err = hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mw)
+		require.NoError(t, err)
+
+		assert.JSONEq(t, ejson, string(b))
+	})
 }
 
 func TestHCLWriter_Interpolate(t *testing.T) {
@@ -1001,6 +1442,196 @@ func TestHCLWriter_Interpolate(t *testing.T) {
 		// check if we have exactly one value starting by `aws_`
 		assert.Equal(t, 1, strings.Count(string(b), "= aws_"))
 	})
+	t.Run("SuccessSameType", func(t *testing.T) {
+		var (
+			mw    = mxwriter.NewMux()
+			ctrl  = gomock.NewController(t)
+			p     = mock.NewProvider(ctrl)
+			front = map[string]interface{}{
+				"id": "sg-front",
+				"ingress": []interface{}{
+					map[string]interface{}{
+						"security_groups": []interface{}{"sg-lb"},
+					},
+				},
+			}
+			lb = map[string]interface{}{
+				"id": "sg-lb",
+			}
+			subnet = map[string]interface{}{
+				"vpc_id": "vpc-private",
+			}
+			i = make(map[string]string)
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{Interpolate: true})
+		i["sg-front"] = "${aws_security_group.front.id}"
+		i["sg-lb"] = "${aws_security_group.lb.id}"
+		i["vpc-private"] = "${aws_vpc.private.id}"
+		hw.Write("aws_security_group.front", front)
+		hw.Write("aws_security_group.lb", lb)
+		hw.Write("aws_subnet.private", subnet)
+
+		hw.Interpolate(i)
+
+		err := hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mw)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(b), "security_groups = [aws_security_group.lb.id]")
+		assert.Contains(t, string(b), "vpc_id = aws_vpc.private.id")
+		assert.NotContains(t, string(b), "id = aws_security_group.front.id")
+	})
+	t.Run("SuccessSameTypeCycle", func(t *testing.T) {
+		var (
+			mw   = mxwriter.NewMux()
+			ctrl = gomock.NewController(t)
+			p    = mock.NewProvider(ctrl)
+			sga  = map[string]interface{}{
+				"source_security_group_id": "sg-b",
+			}
+			sgb = map[string]interface{}{
+				"source_security_group_id": "sg-c",
+			}
+			sgc = map[string]interface{}{
+				"source_security_group_id": "sg-a",
+			}
+			i = make(map[string]string)
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{Interpolate: true})
+		i["sg-a"] = "${aws_security_group.a.id}"
+		i["sg-b"] = "${aws_security_group.b.id}"
+		i["sg-c"] = "${aws_security_group.c.id}"
+		hw.Write("aws_security_group.a", sga)
+		hw.Write("aws_security_group.b", sgb)
+		hw.Write("aws_security_group.c", sgc)
+
+		hw.Interpolate(i)
+
+		err := hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mw)
+		require.NoError(t, err)
+
+		// The resources are walked sorted so A -> B and B -> C
+		// are interpolated and C -> A, which closes the cycle, is not
+		assert.Contains(t, string(b), "source_security_group_id = aws_security_group.b.id")
+		assert.Contains(t, string(b), "source_security_group_id = aws_security_group.c.id")
+		assert.Contains(t, string(b), `source_security_group_id = "sg-a"`)
+	})
+	t.Run("SuccessExclude", func(t *testing.T) {
+		var (
+			mw    = mxwriter.NewMux()
+			ctrl  = gomock.NewController(t)
+			p     = mock.NewProvider(ctrl)
+			value = map[string]interface{}{
+				"subnet_id": "subnet-1",
+				"vpc_id":    "vpc-1",
+			}
+			i = make(map[string]string)
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{Interpolate: true, InterpolationExclude: []string{"*.subnet_id"}})
+		i["subnet-1"] = "${aws_subnet.private.id}"
+		i["vpc-1"] = "${aws_vpc.main.id}"
+		hw.Write("aws_instance.front", value)
+
+		hw.Interpolate(i)
+
+		err := hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mw)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(b), `subnet_id = "subnet-1"`)
+		assert.Contains(t, string(b), "vpc_id    = aws_vpc.main.id")
+	})
+	t.Run("SuccessDependsOn", func(t *testing.T) {
+		var (
+			mw     = mxwriter.NewMux()
+			ctrl   = gomock.NewController(t)
+			p      = mock.NewProvider(ctrl)
+			policy = map[string]interface{}{
+				"policy": `{"Statement":[{"Resource":"arn:aws:s3:::logs/*"}]}`,
+			}
+			instance = map[string]interface{}{
+				"subnet_id": "subnet-1",
+			}
+			i = make(map[string]string)
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{Interpolate: true, DependsOn: true, InterpolationExclude: []string{"*.subnet_id"}})
+		i["arn:aws:s3:::logs"] = "${aws_s3_bucket.logs.arn}"
+		i["subnet-1"] = "${aws_subnet.private.id}"
+		hw.Write("aws_iam_policy.read", policy)
+		hw.Write("aws_instance.front", instance)
+
+		hw.Interpolate(i)
+
+		err := hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mw)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(b), "depends_on = [aws_s3_bucket.logs]")
+		assert.Contains(t, string(b), "depends_on = [aws_subnet.private]")
+		assert.Contains(t, string(b), `subnet_id  = "subnet-1"`)
+	})
+	t.Run("SuccessDependsOnCycle", func(t *testing.T) {
+		var (
+			mw   = mxwriter.NewMux()
+			ctrl = gomock.NewController(t)
+			p    = mock.NewProvider(ctrl)
+			a    = map[string]interface{}{
+				"policy": `{"Resource":"arn:aws:sqs:::b"}`,
+			}
+			b = map[string]interface{}{
+				"policy": `{"Resource":"arn:aws:sqs:::c"}`,
+			}
+			c = map[string]interface{}{
+				"policy": `{"Resource":"arn:aws:sqs:::a"}`,
+			}
+			i = make(map[string]string)
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{Interpolate: true, DependsOn: true})
+		i["arn:aws:sqs:::a"] = "${aws_sqs_queue.a.arn}"
+		i["arn:aws:sqs:::b"] = "${aws_sqs_queue.b.arn}"
+		i["arn:aws:sqs:::c"] = "${aws_sqs_queue.c.arn}"
+		hw.Write("aws_sqs_queue.a", a)
+		hw.Write("aws_sqs_queue.b", b)
+		hw.Write("aws_sqs_queue.c", c)
+
+		hw.Interpolate(i)
+
+		err := hw.Sync()
+		require.NoError(t, err)
+
+		bs, err := ioutil.ReadAll(mw)
+		require.NoError(t, err)
+
+		// A -> B and B -> C are kept and C -> A,
+		// which closes the cycle, is ignored
+		assert.Contains(t, string(bs), "depends_on = [aws_sqs_queue.b]")
+		assert.Contains(t, string(bs), "depends_on = [aws_sqs_queue.c]")
+		assert.NotContains(t, string(bs), "depends_on = [aws_sqs_queue.a]")
+	})
 	t.Run("SuccessNoInterpolation", func(t *testing.T) {
 		var (
 			mw    = mxwriter.NewMux()
@@ -1033,4 +1664,84 @@ func TestHCLWriter_Interpolate(t *testing.T) {
 
 		assert.Contains(t, string(b), "network = \"should-not-be-interpolated\"")
 	})
+	t.Run("SuccessExternalReferencesData", func(t *testing.T) {
+		var (
+			mw    = mxwriter.NewMux()
+			ctrl  = gomock.NewController(t)
+			p     = referencerProvider{Provider: mock.NewProvider(ctrl)}
+			value = map[string]interface{}{
+				"role":   "arn:aws:iam::123:role/external",
+				"bucket": "arn:aws:s3:::internal",
+			}
+			i = make(map[string]string)
+		)
+		p.EXPECT().String().Return("aws")
+		p.EXPECT().Source().Return("hashicorp/aws")
+
+		hw := hcl.NewWriter(mw, p, &writer.Options{Interpolate: true, ExternalReferencesData: true})
+		i["arn:aws:s3:::internal"] = "${aws_s3_bucket.internal.arn}"
+		hw.Write("type.name", value)
+
+		hw.Interpolate(i)
+
+		err := hw.Sync()
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(mw)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(b), `data "aws_iam_role" "arn_aws_iam__123_role_external"`)
+		assert.Contains(t, string(b), "role   = data.aws_iam_role.arn_aws_iam__123_role_external.arn")
+		assert.Contains(t, string(b), "bucket = aws_s3_bucket.internal.arn")
+	})
+}
+
+// referencerProvider is a mock.Provider that
+// also implements the provider.ExternalReferencer
+type referencerProvider struct {
+	*mock.Provider
+}
+
+func (referencerProvider) ExternalReference(rt, attr, v string) (provider.ExternalReference, bool) {
+	if !strings.HasPrefix(v, "arn:") {
+		return provider.ExternalReference{}, false
+	}
+	return provider.ExternalReference{
+		Kind:                provider.ReferenceGlobal,
+		DataSource:          "aws_iam_role",
+		DataSourceConfig:    map[string]interface{}{"name": "external"},
+		DataSourceAttribute: "arn",
+	}, true
 }
+
+// aliaserProvider is a mock.Provider that
+// also implements the provider.Aliaser
+type aliaserProvider struct {
+	*mock.Provider
+}
+
+func (aliaserProvider) Alias() string { return "" }
+
+func (aliaserProvider) Aliases() map[string]map[string]interface{} {
+	return map[string]map[string]interface{}{
+		"eu_west_1": map[string]interface{}{"region": "eu-west-1"},
+	}
+}
+
+// secretMatcherProvider is a mock.Provider that also implements
+// the provider.SecretMatcher for the PASSWORD variables
+type secretMatcherProvider struct {
+	*mock.Provider
+}
+
+func (secretMatcherProvider) IsSecret(rt, attr, key, value string) bool {
+	return attr == "variables" && strings.Contains(key, "PASSWORD")
+}
+
+// versionerProvider is a mock.Provider that also implements
+// the provider.Versioner
+type versionerProvider struct {
+	*mock.Provider
+}
+
+func (versionerProvider) Version() string { return "4.9.0" }
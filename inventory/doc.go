@@ -0,0 +1,3 @@
+// Package inventory has the Writer that dumps the imported
+// resources as JSON to be consumed by other tools
+package inventory
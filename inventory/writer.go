@@ -0,0 +1,201 @@
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/log"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// used to match a TF resource ${aws_instance.my-instance.id}
+var regexResource = regexp.MustCompile(`^\${([^.]+)\.([^.]+)\.[^}]+}$`)
+
+// Resource is an imported resource
+type Resource struct {
+	Provider string `json:"provider"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	ID       string `json:"id"`
+
+	// Attributes are all the attributes of
+	// the state of the resource
+	Attributes json.RawMessage `json:"attributes"`
+
+	// Dependencies are the addresses of the resources,
+	// like 'aws_vpc.main', referenced by the attributes
+	Dependencies []string `json:"dependencies"`
+}
+
+// Inventory is the JSON document
+// with all the Resources
+type Inventory struct {
+	Resources []Resource `json:"resources"`
+}
+
+// Writer is a Writer implementation that writes the Resources
+// with their attributes and dependencies as JSON, or NDJSON with
+// one Resource per line
+type Writer struct {
+	// Config has the Resources with the
+	// key (<resource_type>.<name>)
+	Config map[string]provider.Resource
+
+	// dependencies are the ones set
+	// from the Interpolate
+	dependencies map[string][]string
+
+	writer io.Writer
+	ndjson bool
+}
+
+// NewWriter returns a Writer initialization, if ndjson
+// the Resources are written one per line
+func NewWriter(w io.Writer, ndjson bool) *Writer {
+	return &Writer{
+		Config:       make(map[string]provider.Resource),
+		dependencies: make(map[string][]string),
+		writer:       w,
+		ndjson:       ndjson,
+	}
+}
+
+// Write expects a key similar to "aws_instance.your_name" and
+// the value to be a provider.Resource, repeated keys will report an error
+func (w *Writer) Write(key string, value interface{}) error {
+	if key == "" {
+		return errcode.ErrWriterRequiredKey
+	}
+
+	if value == nil {
+		return errcode.ErrWriterRequiredValue
+	}
+
+	if _, ok := w.Config[key]; ok {
+		return errors.Wrapf(errcode.ErrWriterAlreadyExistsKey, "with key %q", key)
+	}
+
+	if len(strings.Split(key, ".")) != 2 {
+		return errors.Wrapf(errcode.ErrWriterInvalidKey, "with key %q", key)
+	}
+
+	r, ok := value.(provider.Resource)
+	if !ok {
+		return errors.Wrapf(errcode.ErrWriterInvalidTypeValue, "expected provider.Resource, found %T", value)
+	}
+
+	log.Get().Log("func", "writer.Write(Inventory)", "msg", "writing to internal config", "key", key)
+	w.Config[key] = r
+
+	return nil
+}
+
+// Has checks if the given key it's already present or not
+func (w *Writer) Has(key string) (bool, error) {
+	_, ok := w.Config[key]
+	return ok, nil
+}
+
+// Interpolate sets the dependencies of each Resource from the
+// values of its attributes that are on the i
+func (w *Writer) Interpolate(i map[string]string) {
+	for key, r := range w.Config {
+		is := r.InstanceState()
+		if is == nil {
+			continue
+		}
+
+		deps := make(map[string]struct{})
+		for k, v := range is.Attributes {
+			// The ID is the one that other
+			// Resources reference
+			if k == "id" {
+				continue
+			}
+			ref, ok := i[v]
+			if !ok {
+				continue
+			}
+			m := regexResource.FindStringSubmatch(ref)
+			if m == nil {
+				continue
+			}
+			dep := fmt.Sprintf("%s.%s", m[1], m[2])
+			if dep == key {
+				continue
+			}
+			deps[dep] = struct{}{}
+		}
+
+		sdeps := make([]string, 0, len(deps))
+		for d := range deps {
+			sdeps = append(sdeps, d)
+		}
+		sort.Strings(sdeps)
+		w.dependencies[key] = sdeps
+	}
+}
+
+// Sync writes the Resources sorted by the
+// key to the internal w
+func (w *Writer) Sync() error {
+	keys := make([]string, 0, len(w.Config))
+	for k := range w.Config {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	resources := make([]Resource, 0, len(keys))
+	for _, k := range keys {
+		r := w.Config[k]
+
+		attrs := json.RawMessage("{}")
+		if rio := r.ResourceInstanceObject(); rio != nil && !rio.Value.IsNull() {
+			b, err := ctyjson.Marshal(rio.Value, rio.Value.Type())
+			if err != nil {
+				return errors.Wrapf(err, "unable to marshal the attributes of %s", k)
+			}
+			attrs = b
+		}
+
+		deps := w.dependencies[k]
+		if deps == nil {
+			deps = []string{}
+		}
+
+		resources = append(resources, Resource{
+			Provider:     r.Provider().String(),
+			Type:         r.Type(),
+			Name:         strings.Split(k, ".")[1],
+			ID:           r.ID(),
+			Attributes:   attrs,
+			Dependencies: deps,
+		})
+	}
+
+	enc := json.NewEncoder(w.writer)
+	enc.SetEscapeHTML(false)
+	if w.ndjson {
+		for _, r := range resources {
+			if err := enc.Encode(r); err != nil {
+				return errors.Wrap(err, "unable to encode the resources")
+			}
+		}
+		return nil
+	}
+
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(Inventory{Resources: resources}); err != nil {
+		return errors.Wrap(err, "unable to encode the resources")
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package inventory_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform/states"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/inventory"
+	"github.com/cycloidio/terracognita/mock"
+)
+
+func TestWriter_Write(t *testing.T) {
+	t.Run("ErrRequiredKey", func(t *testing.T) {
+		iw := inventory.NewWriter(&bytes.Buffer{}, false)
+		err := iw.Write("", "")
+		assert.Equal(t, errcode.ErrWriterRequiredKey, errors.Cause(err))
+	})
+	t.Run("ErrRequiredValue", func(t *testing.T) {
+		iw := inventory.NewWriter(&bytes.Buffer{}, false)
+		err := iw.Write("type.name", nil)
+		assert.Equal(t, errcode.ErrWriterRequiredValue, errors.Cause(err))
+	})
+	t.Run("ErrInvalidKey", func(t *testing.T) {
+		iw := inventory.NewWriter(&bytes.Buffer{}, false)
+		err := iw.Write("name", "")
+		assert.Equal(t, errcode.ErrWriterInvalidKey, errors.Cause(err))
+	})
+	t.Run("ErrInvalidTypeValue", func(t *testing.T) {
+		iw := inventory.NewWriter(&bytes.Buffer{}, false)
+		err := iw.Write("type.name", "")
+		assert.Equal(t, errcode.ErrWriterInvalidTypeValue, errors.Cause(err))
+	})
+}
+
+func TestWriter_Sync(t *testing.T) {
+	tests := []struct {
+		name   string
+		ndjson bool
+		out    string
+	}{
+		{
+			name: "JSON",
+			out: `{
+  "resources": [
+    {
+      "provider": "aws",
+      "type": "aws_subnet",
+      "name": "private",
+      "id": "subnet-1",
+      "attributes": {
+        "id": "subnet-1",
+        "vpc_id": "vpc-1"
+      },
+      "dependencies": [
+        "aws_vpc.main"
+      ]
+    },
+    {
+      "provider": "aws",
+      "type": "aws_vpc",
+      "name": "main",
+      "id": "vpc-1",
+      "attributes": {
+        "id": "vpc-1",
+        "vpc_id": null
+      },
+      "dependencies": []
+    }
+  ]
+}
+`,
+		},
+		{
+			name:   "NDJSON",
+			ndjson: true,
+			out: `{"provider":"aws","type":"aws_subnet","name":"private","id":"subnet-1","attributes":{"id":"subnet-1","vpc_id":"vpc-1"},"dependencies":["aws_vpc.main"]}
+{"provider":"aws","type":"aws_vpc","name":"main","id":"vpc-1","attributes":{"id":"vpc-1","vpc_id":null},"dependencies":[]}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctrl   = gomock.NewController(t)
+				p      = mock.NewProvider(ctrl)
+				vpc    = mock.NewResource(ctrl)
+				subnet = mock.NewResource(ctrl)
+				b      = &bytes.Buffer{}
+				iw     = inventory.NewWriter(b, tt.ndjson)
+			)
+			defer ctrl.Finish()
+
+			p.EXPECT().String().Return("aws").Times(2)
+
+			vpc.EXPECT().ID().Return("vpc-1")
+			vpc.EXPECT().Type().Return("aws_vpc")
+			vpc.EXPECT().Provider().Return(p)
+			vpc.EXPECT().InstanceState().Return(&terraform.InstanceState{
+				Attributes: map[string]string{"id": "vpc-1"},
+			})
+			vpc.EXPECT().ResourceInstanceObject().Return(&states.ResourceInstanceObject{
+				Value: cty.ObjectVal(map[string]cty.Value{
+					"id":     cty.StringVal("vpc-1"),
+					"vpc_id": cty.NullVal(cty.String),
+				}),
+			})
+
+			subnet.EXPECT().ID().Return("subnet-1")
+			subnet.EXPECT().Type().Return("aws_subnet")
+			subnet.EXPECT().Provider().Return(p)
+			subnet.EXPECT().InstanceState().Return(&terraform.InstanceState{
+				Attributes: map[string]string{"id": "subnet-1", "vpc_id": "vpc-1"},
+			})
+			subnet.EXPECT().ResourceInstanceObject().Return(&states.ResourceInstanceObject{
+				Value: cty.ObjectVal(map[string]cty.Value{
+					"id":     cty.StringVal("subnet-1"),
+					"vpc_id": cty.StringVal("vpc-1"),
+				}),
+			})
+
+			require.NoError(t, iw.Write("aws_vpc.main", vpc))
+			require.NoError(t, iw.Write("aws_subnet.private", subnet))
+
+			ok, err := iw.Has("aws_vpc.main")
+			require.NoError(t, err)
+			assert.True(t, ok)
+
+			iw.Interpolate(map[string]string{
+				"vpc-1":    "${aws_vpc.main.id}",
+				"subnet-1": "${aws_subnet.private.id}",
+			})
+			require.NoError(t, iw.Sync())
+
+			assert.Equal(t, tt.out, b.String())
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 func main() {
 	if err := cmd.RootCmd.Execute(); err != nil {
+		// The profiles are also written when it fails
+		cmd.StopProfile()
 		fmt.Println(err)
 		os.Exit(1)
 	}
@@ -0,0 +1,3 @@
+// Package profile provides the pprof profiles and the
+// execution trace of a run, used to find performance regressions
+package profile
@@ -0,0 +1,138 @@
+package profile
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+const (
+	// KindCPU writes the CPU profile to 'cpu.pprof'
+	KindCPU = "cpu"
+
+	// KindMem writes the heap profile to 'mem.pprof'
+	// when the Profiler is stopped
+	KindMem = "mem"
+
+	// KindTrace writes the execution trace to 'trace.out'
+	KindTrace = "trace"
+)
+
+// files are the names of the files written of each kind
+var files = map[string]string{
+	KindCPU:   "cpu.pprof",
+	KindMem:   "mem.pprof",
+	KindTrace: "trace.out",
+}
+
+// Profiler profiles the execution from Start until Stop
+type Profiler struct {
+	dir   string
+	kinds map[string]struct{}
+
+	cpu   *os.File
+	trace *os.File
+}
+
+// Start starts profiling the kinds, writing the files to the dir
+// which is created if it does not exist. The files are only
+// complete once the Profiler is stopped
+func Start(dir string, kinds []string) (*Profiler, error) {
+	p := &Profiler{
+		dir:   dir,
+		kinds: make(map[string]struct{}, len(kinds)),
+	}
+	for _, k := range kinds {
+		if _, ok := files[k]; !ok {
+			return nil, errors.Wrapf(errcode.ErrProfileKindNotSupported, "with value %q", k)
+		}
+		p.kinds[k] = struct{}{}
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "could not create the profile directory %s", dir)
+	}
+
+	if _, ok := p.kinds[KindCPU]; ok {
+		f, err := p.create(KindCPU)
+		if err != nil {
+			return nil, err
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, errors.Wrap(err, "could not start the CPU profile")
+		}
+		p.cpu = f
+	}
+
+	if _, ok := p.kinds[KindTrace]; ok {
+		f, err := p.create(KindTrace)
+		if err != nil {
+			p.Stop()
+			return nil, err
+		}
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			p.Stop()
+			return nil, errors.Wrap(err, "could not start the trace")
+		}
+		p.trace = f
+	}
+
+	return p, nil
+}
+
+// Stop stops the profiling and writes the
+// heap profile if it was requested
+func (p *Profiler) Stop() error {
+	if p.cpu != nil {
+		pprof.StopCPUProfile()
+		if err := p.cpu.Close(); err != nil {
+			return err
+		}
+		p.cpu = nil
+	}
+
+	if p.trace != nil {
+		trace.Stop()
+		if err := p.trace.Close(); err != nil {
+			return err
+		}
+		p.trace = nil
+	}
+
+	if _, ok := p.kinds[KindMem]; ok {
+		delete(p.kinds, KindMem)
+
+		f, err := p.create(KindMem)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		// Get up-to-date statistics
+		// of the allocations
+		runtime.GC()
+		if err := pprof.WriteHeapProfile(f); err != nil {
+			return errors.Wrap(err, "could not write the heap profile")
+		}
+	}
+
+	return nil
+}
+
+// create creates the file of the kind on the dir
+func (p *Profiler) create(kind string) (*os.File, error) {
+	fp := filepath.Join(p.dir, files[kind])
+	f, err := os.Create(fp)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not create the profile file %s", fp)
+	}
+	return f, nil
+}
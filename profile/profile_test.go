@@ -0,0 +1,45 @@
+package profile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/profile"
+)
+
+func TestStart(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "terracognita-profile")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		p, err := profile.Start(filepath.Join(dir, "profiles"), []string{profile.KindCPU, profile.KindMem, profile.KindTrace})
+		require.NoError(t, err)
+		require.NoError(t, p.Stop())
+
+		for _, f := range []string{"cpu.pprof", "mem.pprof", "trace.out"} {
+			fi, err := os.Stat(filepath.Join(dir, "profiles", f))
+			require.NoError(t, err, f)
+			assert.NotZero(t, fi.Size(), f)
+		}
+	})
+	t.Run("ErrKindNotSupported", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "terracognita-profile")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		_, err = profile.Start(dir, []string{profile.KindCPU, "block"})
+		assert.True(t, errors.Is(err, errcode.ErrProfileKindNotSupported))
+
+		// The CPU profile must not be started
+		_, err = os.Stat(filepath.Join(dir, "cpu.pprof"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
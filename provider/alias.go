@@ -0,0 +1,15 @@
+package provider
+
+// Aliaser is the interface that the Providers can implement when the
+// Resources are read with more than one configuration of the TF Provider,
+// like multiple regions, so each configuration is written as an aliased
+// provider block and the Resources reference the one they were read with
+type Aliaser interface {
+	// Alias returns the alias of the configuration
+	// of the Provider, empty for the default one
+	Alias() string
+
+	// Aliases returns the configuration of each one of
+	// the aliased providers by alias, without the default one
+	Aliases() map[string]map[string]interface{}
+}
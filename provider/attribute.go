@@ -0,0 +1,11 @@
+package provider
+
+// AttributeReferencer is the interface that the Providers can implement
+// to add attributes that can be referenced by other Resources but are not
+// exported by the TF Provider documentation, like the 'name' of a resource
+// that is referenced by it instead of by the ID
+type AttributeReferencer interface {
+	// AttributesReference returns the extra
+	// attributes of the resource type rt
+	AttributesReference(rt string) []string
+}
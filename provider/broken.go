@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cycloidio/terracognita/filter"
+)
+
+// BrokenTyper is the interface that the Providers can implement to
+// report the resource types that have known problems when mapped to
+// HCL or State with the version of the Terraform Provider used, so
+// they are skipped unless forced instead of writing a broken HCL
+type BrokenTyper interface {
+	// BrokenTypes returns the reason of the
+	// problem of each broken resource type
+	BrokenTypes() map[string]string
+}
+
+// skipBrokenTypes returns the types without the ones reported as broken by
+// the Provider p, unless forced on the f, and writes a warning to out for
+// each one of them
+func skipBrokenTypes(p Provider, f *filter.Filter, types []string, out io.Writer) []string {
+	bt, ok := p.(BrokenTyper)
+	if !ok {
+		return types
+	}
+
+	broken := bt.BrokenTypes()
+	if len(broken) == 0 {
+		return types
+	}
+
+	res := make([]string, 0, len(types))
+	skipped := make([]string, 0)
+	for _, t := range types {
+		if _, ok := broken[t]; ok && !f.IsForced(t) {
+			skipped = append(skipped, t)
+			continue
+		}
+		res = append(res, t)
+	}
+
+	sort.Strings(skipped)
+	for _, t := range skipped {
+		fmt.Fprintf(out, "Warning: skipping %s, %s (it can be imported with --force-types)\n", t, broken[t])
+	}
+
+	return res
+}
@@ -0,0 +1,11 @@
+package provider
+
+// Categorizer is the interface that the Providers can implement to
+// change the category, the file on the HCL output, of the Resources
+// so they can be organized by something else than the type, like
+// the Resource Group or the project they belong to
+type Categorizer interface {
+	// ResourceCategory returns the category of the r, the
+	// category is the one of the type of the Resource
+	ResourceCategory(r Resource, category string) string
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/filter"
+)
+
+// Checkpoint has the resource types imported and the ones pending
+// when an Import is stopped by the deadline of the context, so the
+// next Import can continue from it
+type Checkpoint struct {
+	Provider string   `json:"provider"`
+	Imported []string `json:"imported"`
+	Pending  []string `json:"pending"`
+}
+
+// Resume sets on the f the resource types pending so only
+// those are imported
+func (c Checkpoint) Resume(f *filter.Filter) {
+	pending := make(map[string]struct{}, len(c.Pending))
+	for _, t := range c.Pending {
+		pending[t] = struct{}{}
+	}
+
+	// The Targets have precedence over the Include
+	// so only the ones of the pending types are kept
+	if len(f.Targets) != 0 {
+		targets := make([]string, 0, len(f.Targets))
+		for _, t := range f.Targets {
+			if _, ok := pending[strings.Split(t, ".")[0]]; ok {
+				targets = append(targets, t)
+			}
+		}
+		f.Targets = targets
+		return
+	}
+
+	f.Include = c.Pending
+}
+
+// DeadlineError is returned by Import when the deadline of the context
+// is reached before importing all the resource types, the output is
+// written with the resource types imported until then
+type DeadlineError struct {
+	Checkpoint Checkpoint
+}
+
+func (e *DeadlineError) Error() string {
+	return fmt.Sprintf("%s: %d resource types pending", errcode.ErrProviderImportDeadline, len(e.Checkpoint.Pending))
+}
+
+// Unwrap returns the errcode.ErrProviderImportDeadline
+func (e *DeadlineError) Unwrap() error { return errcode.ErrProviderImportDeadline }
@@ -0,0 +1,10 @@
+package provider
+
+// ResourceConfigurer is the interface that the Providers can implement
+// to complete the HCL configuration of the Resources with the values
+// that can not be read from the TF Provider, like the packages of code
+type ResourceConfigurer interface {
+	// ConfigureResource sets on the cfg, which is the HCL
+	// configuration of the r, the values missing
+	ConfigureResource(r Resource, cfg map[string]interface{}) error
+}
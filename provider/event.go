@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cycloidio/terracognita/log"
+)
+
+// List of all the kinds of Event
+const (
+	// EventDiscovered is when the Resource is
+	// listed from the Provider
+	EventDiscovered = "discovered"
+
+	// EventMapped is when the Resource has been
+	// read from the TF Provider
+	EventMapped = "mapped"
+
+	// EventSkipped is when the Resource could not be
+	// read from the TF Provider so it's not imported
+	EventSkipped = "skipped"
+
+	// EventWritten is when the Resource has been
+	// written to the HCL and/or the TFState
+	EventWritten = "written"
+)
+
+// Event is the progress of a Resource during the Import
+type Event struct {
+	Kind string    `json:"event"`
+	Time time.Time `json:"time"`
+	Type string    `json:"type"`
+	ID   string    `json:"id"`
+
+	// Name is the name of the Resource on
+	// the HCL, only set on EventWritten
+	Name string `json:"name,omitempty"`
+}
+
+// EventWriter is the interface that the out of the Import can
+// implement to receive the Event of each Resource as it happens.
+// The WriteEvent can be called concurrently
+type EventWriter interface {
+	WriteEvent(e Event) error
+}
+
+// NDJSONWriter is an io.Writer that writes to the out and
+// implements the EventWriter by writing each Event as
+// a JSON line to the events
+type NDJSONWriter struct {
+	io.Writer
+
+	mxEvents sync.Mutex
+	events   *json.Encoder
+}
+
+// NewNDJSONWriter returns a new NDJSONWriter that writes the
+// logs to out and the Events as NDJSON to events
+func NewNDJSONWriter(out, events io.Writer) *NDJSONWriter {
+	return &NDJSONWriter{
+		Writer: out,
+		events: json.NewEncoder(events),
+	}
+}
+
+// WriteEvent writes the e as a JSON line
+func (w *NDJSONWriter) WriteEvent(e Event) error {
+	w.mxEvents.Lock()
+	defer w.mxEvents.Unlock()
+
+	return w.events.Encode(e)
+}
+
+// writeEvent writes the Event of kind for the r
+// to the out if it implements EventWriter
+func writeEvent(out io.Writer, kind string, r Resource) {
+	ew, ok := out.(EventWriter)
+	if !ok {
+		return
+	}
+
+	e := Event{
+		Kind: kind,
+		Time: time.Now().UTC(),
+		Type: r.Type(),
+		ID:   r.ID(),
+	}
+	if kind == EventWritten {
+		e.Name = r.Name()
+	}
+
+	if err := ew.WriteEvent(e); err != nil {
+		log.Get().Log("func", "provider.writeEvent", "msg", "could not write the event", "error", err)
+	}
+}
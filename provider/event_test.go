@@ -0,0 +1,83 @@
+package provider_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+func TestImportEvents(t *testing.T) {
+	var (
+		ctrl = gomock.NewController(t)
+		ctx  = context.Background()
+
+		p         = mock.NewProvider(ctrl)
+		hw        = mock.NewWriter(ctrl)
+		instance  = mock.NewResource(ctrl)
+		unread    = mock.NewResource(ctrl)
+		i         = make(map[string]string)
+		events    = &bytes.Buffer{}
+		logsOut   = provider.NewNDJSONWriter(ioutil.Discard, events)
+		resources = []provider.Resource{instance, unread}
+
+		f = &filter.Filter{
+			Include: []string{"aws_instance"},
+		}
+	)
+
+	defer ctrl.Finish()
+
+	p.EXPECT().HasResourceType("aws_instance").Return(true)
+	p.EXPECT().Resources(ctx, "aws_instance", f).Return(resources, nil)
+
+	for _, r := range resources {
+		r.EXPECT().ImportState().Return(nil, nil)
+		r.EXPECT().Type().Return("aws_instance").AnyTimes()
+	}
+	instance.EXPECT().ID().Return("i-1").AnyTimes()
+	unread.EXPECT().ID().Return("i-2").AnyTimes()
+
+	instance.EXPECT().InstanceState().Return(&terraform.InstanceState{}).Times(2)
+	instance.EXPECT().Read(f).Return(nil)
+	instance.EXPECT().HCL(hw).Return(nil)
+	instance.EXPECT().Name().Return("front")
+	instance.EXPECT().AttributesReference().Return(nil, nil)
+
+	unread.EXPECT().InstanceState().Return(nil)
+
+	hw.EXPECT().Sync().Return(nil)
+	hw.EXPECT().Interpolate(i)
+
+	err := provider.Import(ctx, p, hw, nil, f, logsOut)
+	require.NoError(t, err)
+
+	var kinds []string
+	for _, l := range strings.Split(strings.TrimSpace(events.String()), "\n") {
+		var e provider.Event
+		require.NoError(t, json.Unmarshal([]byte(l), &e))
+		assert.Equal(t, "aws_instance", e.Type)
+		kinds = append(kinds, e.Kind+" "+e.ID+" "+e.Name)
+	}
+
+	// The events of the reading and the writing
+	// are concurrent so the order is not fixed
+	assert.ElementsMatch(t, []string{
+		"discovered i-1 ",
+		"mapped i-1 ",
+		"discovered i-2 ",
+		"skipped i-2 ",
+		"written i-1 front",
+	}, kinds)
+}
@@ -22,10 +22,24 @@ import (
 // TF is still on zclconf/go-cty and we need hashicorp/go-cty
 // so it compiles
 type GRPCClient struct {
-	server   *schema.GRPCProviderServer
+	server   resourceServer
 	provider *schema.Provider
 }
 
+// resourceServer is the part of the tfprotov5.ProviderServer
+// used to import and read the Resources
+type resourceServer interface {
+	ReadResource(context.Context, *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error)
+	ImportResourceState(context.Context, *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error)
+}
+
+// resourceServerer is implemented by the Providers which Terraform
+// Provider does not run in memory, like the plugins, so the
+// Resources are imported and read through the resourceServer
+type resourceServerer interface {
+	resourceServer() resourceServer
+}
+
 // NewGRPCClient wraps the pv into a GRPCClient
 func NewGRPCClient(pv *schema.Provider) *GRPCClient {
 	sv := schema.NewGRPCProviderServer(pv)
@@ -35,6 +49,18 @@ func NewGRPCClient(pv *schema.Provider) *GRPCClient {
 	}
 }
 
+// newProviderGRPCClient returns the GRPCClient of the p, which is
+// the resourceServer of p if it has one or the TFProvider in memory
+func newProviderGRPCClient(p Provider) *GRPCClient {
+	if rs, ok := p.(resourceServerer); ok {
+		return &GRPCClient{
+			server:   rs.resourceServer(),
+			provider: p.TFProvider(),
+		}
+	}
+	return NewGRPCClient(p.TFProvider())
+}
+
 // ReadResource reads the Resource from the Provider
 func (c *GRPCClient) ReadResource(r ReadResourceRequest) (resp ReadResourceResponse) {
 	resSchema := c.getResourceSchema(r.TypeName)
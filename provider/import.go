@@ -15,8 +15,14 @@ import (
 	"github.com/pkg/errors"
 )
 
+// importQueueSize is the number of Resources read
+// that can be waiting to be written
+const importQueueSize = 100
+
 // Import imports from the Provider p all the resources filtered by f and writes
-// the result to the hcl or tfstate if those are not nil
+// the result to the hcl or tfstate if those are not nil.
+// If the deadline of the ctx is reached the resource types left are not imported,
+// the result is written with the ones imported and a *DeadlineError is returned
 func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filter.Filter, out io.Writer) error {
 	logger := log.Get()
 	logger = kitlog.With(logger, "func", "provider.Import")
@@ -25,53 +31,223 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 		return err
 	}
 
+	types, typesWithIDs, err := filteredTypes(p, f)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Importing with filters: %s", f)
+	logger.Log("filters", f.String())
+
+	types = skipBrokenTypes(p, f, types, out)
+
+	// resolver will contains the key/value to interpolate.
+	// For each resource, the attributes reference will be
+	// binded to a value: ${resource_type.resource_name.`key`} in order
+	// to replace each occurence of the key by the value in the HCL file.
+	// The values with other formats are resolved with the Matchers of p
+	resolver := NewProviderResolver(p)
+
+	// imported are all the Resources that have been
+	// read and written so we can check them for
+	// external references after the import
+	imported := make([]Resource, 0)
+
+	// The Resources are read on the background and sent to the queue
+	// while they are written, so the writing does not block the
+	// calls to the Provider. When the queue is full the reading
+	// waits until the writing catches up
+	queue := make(chan readResource, importQueueSize)
+	done := make(chan struct{})
+	resc := make(chan readResult, 1)
+	go func() {
+		resc <- readResources(ctx, p, types, typesWithIDs, f, out, queue, done)
+	}()
+
+	for rr := range queue {
+		ok, err := writeResource(rr, hcl, tfstate, resolver)
+		if err != nil {
+			// The reading is stopped and the queue
+			// drained so it can finish
+			close(done)
+			for range queue {
+			}
+			<-resc
+			return err
+		}
+		if ok {
+			imported = append(imported, rr.resource)
+			writeEvent(out, EventWritten, rr.resource)
+		}
+	}
+
+	rres := <-resc
+	if rres.err != nil {
+		return rres.err
+	}
+	importedTypes, pendingTypes := rres.importedTypes, rres.pendingTypes
+
+	if len(pendingTypes) != 0 {
+		fmt.Fprintf(out, "Deadline reached, %d resource types are pending\n", len(pendingTypes))
+		logger.Log("msg", "deadline reached", "pending", len(pendingTypes))
+	}
+
+	interpolation := resolver.Interpolation(imported)
+
+	if refs := ExternalReferences(p, imported, interpolation); len(refs) != 0 {
+		fmt.Fprintf(out, "External references:\n")
+		for _, ref := range refs {
+			fmt.Fprintf(out, "\t%s\n", ref)
+			logger.Log("msg", "external reference", "resource", ref.Resource, "attribute", ref.Attribute, "value", ref.Value, "kind", ref.Kind)
+		}
+	}
+
+	// The owners are only reported as the import
+	// is already done if they can not be inferred
+	owners, err := ResourceOwners(ctx, p, imported)
+	if err != nil {
+		fmt.Fprintf(out, "Warning: could not infer the owners of the resources: %s\n", err)
+		logger.Log("msg", "could not infer the owners", "error", err)
+	} else if len(owners) != 0 {
+		fmt.Fprintf(out, "Owners:\n")
+		for _, o := range owners {
+			fmt.Fprintf(out, "\t%s\n", o)
+			logger.Log("msg", "resource owner", "resource", o.Resource, "owner", o.Owner, "source", o.Source)
+		}
+	}
+
+	if as, ok := p.(APIStatser); ok {
+		stats := as.APIStats()
+		writeAPIStats(out, stats)
+		for _, st := range stats {
+			logger.Log("msg", "api stats", "service", st.Service, "operation", st.Operation, "calls", st.Calls, "retries", st.Retries, "throttles", st.Throttles, "duration", st.Duration, "max-duration", st.MaxDuration)
+		}
+	}
+
+	if hcl != nil {
+		hcl.Interpolate(interpolation)
+		fmt.Fprintf(out, "\rWriting HCL ...")
+		logger.Log("msg", "writing the HCL")
+
+		err = hcl.Sync()
+		if err != nil {
+			return errors.Wrapf(err, "error while Sync Config")
+		}
+
+		fmt.Fprintf(out, "\rWriting HCL Done!\n")
+		logger.Log("msg", "writing the HCL done")
+	}
+
+	if tfstate != nil {
+		tfstate.Interpolate(interpolation)
+		fmt.Fprintf(out, "\rWriting TFState ...")
+		logger.Log("msg", "writing the TFState")
+
+		err := tfstate.Sync()
+		if err != nil {
+			return errors.Wrapf(err, "error while Sync State")
+		}
+
+		fmt.Fprintf(out, "\rWriting TFState Done!\n")
+		logger.Log("msg", "writing the TFState done")
+	}
+
+	if len(pendingTypes) != 0 {
+		return &DeadlineError{
+			Checkpoint: Checkpoint{
+				Provider: p.String(),
+				Imported: importedTypes,
+				Pending:  pendingTypes,
+			},
+		}
+	}
+
+	return nil
+}
+
+// filteredTypes returns the types of the Provider p to read filtered by f, if
+// the f has Targets the IDs of each type are also returned
+func filteredTypes(p Provider, f *filter.Filter) ([]string, map[string][]string, error) {
 	var (
-		err          error
 		types        []string
 		typesWithIDs map[string][]string
 	)
 
+	// Validate if the ForceTypes filter is right
+	for _, ft := range f.ForceTypes {
+		if !p.HasResourceType(ft) {
+			return nil, nil, errors.Wrapf(errcode.ErrProviderResourceNotSupported, "type %s on ForceTypes filter", ft)
+		}
+	}
+
 	if len(f.Targets) != 0 {
 		typesWithIDs = f.TargetsTypesWithIDs()
 		for k := range typesWithIDs {
 			if !p.HasResourceType(k) {
-				return errors.Wrapf(errcode.ErrProviderResourceNotSupported, "type %s on Target filter", k)
+				return nil, nil, errors.Wrapf(errcode.ErrProviderResourceNotSupported, "type %s on Target filter", k)
 			}
 			types = append(types, k)
 		}
-	} else {
-		// Validate if the Include filter is right
-		if len(f.Include) != 0 {
-			for _, i := range f.Include {
-				if !p.HasResourceType(i) {
-					return errors.Wrapf(errcode.ErrProviderResourceNotSupported, "type %s on Include filter", i)
-				}
+		return types, typesWithIDs, nil
+	}
+
+	// Validate if the Include filter is right
+	if len(f.Include) != 0 {
+		for _, i := range f.Include {
+			if !p.HasResourceType(i) {
+				return nil, nil, errors.Wrapf(errcode.ErrProviderResourceNotSupported, "type %s on Include filter", i)
 			}
-			types = f.Include
-		} else {
-			types = p.ResourceTypes()
 		}
+		types = f.Include
+	} else {
+		types = p.ResourceTypes()
+	}
 
-		// Validate if the Exclude filter is right
-		if len(f.Exclude) != 0 {
-			for _, e := range f.Exclude {
-				if !p.HasResourceType(e) {
-					return errors.Wrapf(errcode.ErrProviderResourceNotSupported, "type %s on Exclude filter", e)
-				}
+	// Validate if the Exclude filter is right
+	if len(f.Exclude) != 0 {
+		for _, e := range f.Exclude {
+			if !p.HasResourceType(e) {
+				return nil, nil, errors.Wrapf(errcode.ErrProviderResourceNotSupported, "type %s on Exclude filter", e)
 			}
 		}
 	}
 
-	fmt.Fprintf(out, "Importing with filters: %s", f)
-	logger.Log("filters", f.String())
+	return types, nil, nil
+}
 
-	// interpolation will contains the key/value to interpolate.
-	// For each resource, the attributes reference will be
-	// binded to a value: ${resource_type.resource_name.`key`} in order
-	// to replace each occurence of the key by the value in the HCL file.
-	interpolation := make(map[string]string)
+// readResource is a Resource read that has to be written
+type readResource struct {
+	resource Resource
 
-	for _, t := range types {
+	// resourceType is the type that was
+	// read to get the resource
+	resourceType string
+
+	// parent is the Resource from which the resource
+	// has been imported, it can be the resource itself
+	parent Resource
+}
+
+// readResult is the result of the readResources
+type readResult struct {
+	// importedTypes and pendingTypes are used to build
+	// the Checkpoint if the deadline is reached
+	importedTypes []string
+	pendingTypes  []string
+
+	err error
+}
+
+// readResources reads the Resources of all the types from the Provider p and sends them
+// to the queue, which is closed at the end. If the done is closed it stops reading
+func readResources(ctx context.Context, p Provider, types []string, typesWithIDs map[string][]string, f *filter.Filter, out io.Writer, queue chan<- readResource, done <-chan struct{}) readResult {
+	defer close(queue)
+
+	logger := log.Get()
+	logger = kitlog.With(logger, "func", "provider.Import")
+
+	var res readResult
+	for idx, t := range types {
 		logger := kitlog.With(logger, "resource", t)
 
 		if f.IsExcluded(t) {
@@ -79,9 +255,17 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 			continue
 		}
 
+		if isDeadlineExceeded(ctx) {
+			res.pendingTypes = notExcluded(types[idx:], f)
+			break
+		}
+
 		logger.Log("msg", "fetching the list of resources")
 
-		var resources []Resource
+		var (
+			resources []Resource
+			err       error
+		)
 
 		if typesWithIDs != nil {
 			for _, ID := range typesWithIDs[t] {
@@ -89,13 +273,17 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 			}
 		} else {
 			resources, err = p.Resources(ctx, t, f)
-			if err != nil {
+			if err != nil && isDeadlineExceeded(ctx) {
+				res.pendingTypes = notExcluded(types[idx:], f)
+				break
+			} else if err != nil {
 				// we filter the error: if it's an error provider side, we continue
 				// the import but we print the error.
 				if errors.Is(err, errcode.ErrProviderAPI) {
 					logger.Log("msg", fmt.Sprintf("unable to import resource %s: %s\n", t, err.Error()))
 				} else {
-					return errors.WithStack(err)
+					res.err = errors.WithStack(err)
+					return res
 				}
 			}
 		}
@@ -103,25 +291,28 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 		resourceLen := len(resources)
 		for i, re := range resources {
 			logger := kitlog.With(logger, "id", re.ID(), "total", resourceLen, "current", i+1)
-			fmt.Fprintf(out, "\rImporting %s [%d/%d]", t, i+1, resourceLen)
+			fmt.Fprintf(out, "\rImporting %s [%d/%d] (queue %d/%d)", t, i+1, resourceLen, len(queue), cap(queue))
+			writeEvent(out, EventDiscovered, re)
 
 			logger.Log("msg", "reading from TF")
-			res, err := re.ImportState()
+			irs, err := re.ImportState()
 			if err != nil {
-				return err
+				res.err = err
+				return res
 			}
 
 			// If the InstanceState is nil after the ImportState it
 			// means that nothing was imported (potentially is not even Importable)
 			// so we have to skip the resource
 			if re.InstanceState() == nil {
+				writeEvent(out, EventSkipped, re)
 				continue
 			}
 
 			// In case there is more than one State to import
 			// we create a new slice with those elements and iterate
 			// over it
-			for _, r := range append([]Resource{re}, res...) {
+			for _, r := range append([]Resource{re}, irs...) {
 				err = util.RetryDefault(func() error { return r.Read(f) })
 				if err != nil {
 					cause := errors.Cause(err)
@@ -130,42 +321,16 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 					// So instead of failing and stopping execution we ignore them and continue (we log them if -v is specified)
 
 					logger.Log("error", cause)
+					writeEvent(out, EventSkipped, r)
 
 					continue
 				}
+				writeEvent(out, EventMapped, r)
 
-				if hcl != nil {
-					logger.Log("msg", "calculating HCL")
-					err = r.HCL(hcl)
-					if err != nil {
-						return errors.Wrapf(err, "error while calculating the Config of resource %q", t)
-					}
-				}
-
-				if tfstate != nil {
-					logger.Log("msg", "calculating TFState")
-					err = r.State(tfstate)
-					if err != nil {
-						return errors.Wrapf(err, "error while calculating the satate of resource %q", t)
-					}
-				}
-				state := r.InstanceState()
-
-				if state != nil {
-					// we construct a map[string]string to perform
-					// interpolation later. Keys are are the value of the
-					// attributes reference for each resource
-					attributes, err := re.AttributesReference()
-					if err != nil {
-						return errors.Wrapf(err, "unable to fetch attributes of resource")
-					}
-					for _, attribute := range attributes {
-						value, ok := state.Attributes[attribute]
-						if !ok || len(value) == 0 {
-							continue
-						}
-						interpolation[value] = fmt.Sprintf("${%s.%s.%s}", r.Type(), r.Name(), attribute)
-					}
+				select {
+				case queue <- readResource{resource: r, resourceType: t, parent: re}:
+				case <-done:
+					return res
 				}
 			}
 		}
@@ -173,35 +338,71 @@ func Import(ctx context.Context, p Provider, hcl, tfstate writer.Writer, f *filt
 			fmt.Fprintf(out, "\rImporting %s [%d/%d] Done!\n", t, resourceLen, resourceLen)
 		}
 		logger.Log("msg", "importing done")
+		res.importedTypes = append(res.importedTypes, t)
 	}
 
-	if hcl != nil {
-		hcl.Interpolate(interpolation)
-		fmt.Fprintf(out, "\rWriting HCL ...")
-		logger.Log("msg", "writing the HCL")
+	return res
+}
 
-		err = hcl.Sync()
+// writeResource writes the rr to the hcl and tfstate, if not nil, and
+// adds the values of its attributes reference to the interpolation.
+// It returns if the Resource has been imported, which means it has a state
+func writeResource(rr readResource, hcl, tfstate writer.Writer, resolver *Resolver) (bool, error) {
+	logger := log.Get()
+	logger = kitlog.With(logger, "func", "provider.Import", "resource", rr.resourceType)
+
+	r := rr.resource
+	if hcl != nil {
+		logger.Log("msg", "calculating HCL")
+		err := r.HCL(hcl)
 		if err != nil {
-			return errors.Wrapf(err, "error while Sync Config")
+			return false, errors.Wrapf(err, "error while calculating the Config of resource %q", rr.resourceType)
 		}
-
-		fmt.Fprintf(out, "\rWriting HCL Done!\n")
-		logger.Log("msg", "writing the HCL done")
 	}
 
 	if tfstate != nil {
-		tfstate.Interpolate(interpolation)
-		fmt.Fprintf(out, "\rWriting TFState ...")
-		logger.Log("msg", "writing the TFState")
-
-		err := tfstate.Sync()
+		logger.Log("msg", "calculating TFState")
+		err := r.State(tfstate)
 		if err != nil {
-			return errors.Wrapf(err, "error while Sync State")
+			return false, errors.Wrapf(err, "error while calculating the satate of resource %q", rr.resourceType)
 		}
+	}
 
-		fmt.Fprintf(out, "\rWriting TFState Done!\n")
-		logger.Log("msg", "writing the TFState done")
+	state := r.InstanceState()
+	if state == nil {
+		return false, nil
 	}
 
-	return nil
+	// we construct a map[string]string to perform
+	// interpolation later. Keys are are the value of the
+	// attributes reference for each resource
+	attributes, err := rr.parent.AttributesReference()
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to fetch attributes of resource")
+	}
+	for _, attribute := range attributes {
+		value, ok := state.Attributes[attribute]
+		if !ok || len(value) == 0 {
+			continue
+		}
+		resolver.Add(value, fmt.Sprintf("${%s.%s.%s}", r.Type(), r.Name(), attribute))
+	}
+
+	return true, nil
+}
+
+// isDeadlineExceeded checks if the deadline of the ctx has been reached
+func isDeadlineExceeded(ctx context.Context) bool {
+	return errors.Is(ctx.Err(), context.DeadlineExceeded)
+}
+
+// notExcluded returns the types that are not excluded by the f
+func notExcluded(types []string, f *filter.Filter) []string {
+	res := make([]string, 0, len(types))
+	for _, t := range types {
+		if !f.IsExcluded(t) {
+			res = append(res, t)
+		}
+	}
+	return res
 }
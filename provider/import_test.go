@@ -1,10 +1,12 @@
 package provider_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	"github.com/cycloidio/terracognita/errcode"
 	"github.com/cycloidio/terracognita/filter"
@@ -239,6 +241,56 @@ func TestImport(t *testing.T) {
 		err := provider.Import(ctx, p, hw, sw, f, ioutil.Discard)
 		require.NoError(t, err)
 	})
+	t.Run("SuccessWithBrokenTypes", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			ctx  = context.Background()
+
+			mp = mock.NewProvider(ctrl)
+			p  = brokenProvider{
+				Provider: mp,
+				broken: map[string]string{
+					"aws_instance": "broken instance",
+					"aws_iam_user": "broken user",
+				},
+			}
+			hw       = mock.NewWriter(ctrl)
+			sw       = mock.NewWriter(ctrl)
+			iamUser1 = mock.NewResource(ctrl)
+			i        = make(map[string]string)
+
+			f = &filter.Filter{
+				ForceTypes: []string{"aws_iam_user"},
+			}
+		)
+
+		defer ctrl.Finish()
+
+		mp.EXPECT().HasResourceType("aws_iam_user").Return(true)
+		mp.EXPECT().ResourceTypes().Return([]string{"aws_instance", "aws_iam_user", "aws_vpc"})
+
+		mp.EXPECT().Resources(ctx, "aws_iam_user", f).Return([]provider.Resource{iamUser1}, nil)
+		mp.EXPECT().Resources(ctx, "aws_vpc", f).Return([]provider.Resource{}, nil)
+
+		iamUser1.EXPECT().ID().Return("1")
+		iamUser1.EXPECT().ImportState().Return(nil, nil)
+		iamUser1.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		iamUser1.EXPECT().Read(f).Return(nil)
+		iamUser1.EXPECT().HCL(hw).Return(nil)
+		iamUser1.EXPECT().State(sw).Return(nil)
+		iamUser1.EXPECT().InstanceState().Return(nil)
+
+		hw.EXPECT().Sync().Return(nil)
+		hw.EXPECT().Interpolate(i)
+		sw.EXPECT().Sync().Return(nil)
+		sw.EXPECT().Interpolate(i)
+
+		out := &bytes.Buffer{}
+		err := provider.Import(ctx, p, hw, sw, f, out)
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "Warning: skipping aws_instance, broken instance")
+		assert.NotContains(t, out.String(), "skipping aws_iam_user")
+	})
 	t.Run("SuccessWithNoHCLWriter", func(t *testing.T) {
 		var (
 			ctrl = gomock.NewController(t)
@@ -495,6 +547,37 @@ func TestImport(t *testing.T) {
 		err := provider.Import(ctx, p, hw, sw, f, ioutil.Discard)
 		assert.Contains(t, err.Error(), "stop the import")
 	})
+	t.Run("ErrorWithHCLStopsReading", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			ctx  = context.Background()
+
+			p                 = mock.NewProvider(ctrl)
+			hw                = mock.NewWriter(ctrl)
+			sw                = mock.NewWriter(ctrl)
+			instanceResource1 = mock.NewResource(ctrl)
+
+			f = &filter.Filter{}
+		)
+
+		defer ctrl.Finish()
+
+		p.EXPECT().ResourceTypes().Return([]string{"aws_instance", "aws_iam_user"})
+
+		p.EXPECT().Resources(ctx, "aws_instance", f).Return([]provider.Resource{instanceResource1}, nil)
+		// The reading may have already started
+		// with the next type when the writing fails
+		p.EXPECT().Resources(ctx, "aws_iam_user", f).Return(nil, nil).AnyTimes()
+
+		instanceResource1.EXPECT().ID().Return("1")
+		instanceResource1.EXPECT().ImportState().Return(nil, nil)
+		instanceResource1.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		instanceResource1.EXPECT().Read(f).Return(nil)
+		instanceResource1.EXPECT().HCL(hw).Return(errors.New("should stop the import"))
+
+		err := provider.Import(ctx, p, hw, sw, f, ioutil.Discard)
+		assert.Contains(t, err.Error(), "stop the import")
+	})
 	t.Run("ErrorWithErrProviderAPI", func(t *testing.T) {
 		var (
 			ctrl = gomock.NewController(t)
@@ -546,4 +629,69 @@ func TestImport(t *testing.T) {
 		err := provider.Import(ctx, p, hw, sw, f, ioutil.Discard)
 		require.NoError(t, err)
 	})
+	t.Run("ErrorWithDeadline", func(t *testing.T) {
+		var (
+			ctrl        = gomock.NewController(t)
+			ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+
+			p                 = mock.NewProvider(ctrl)
+			hw                = mock.NewWriter(ctrl)
+			sw                = mock.NewWriter(ctrl)
+			i                 = make(map[string]string)
+			instanceResource1 = mock.NewResource(ctrl)
+
+			f = &filter.Filter{
+				Exclude: []string{"aws_iam_group"},
+			}
+		)
+
+		defer ctrl.Finish()
+		defer cancel()
+
+		p.EXPECT().ResourceTypes().Return([]string{"aws_instance", "aws_iam_user", "aws_iam_group", "aws_vpc"})
+		p.EXPECT().HasResourceType("aws_iam_group").Return(true)
+		p.EXPECT().String().Return("aws")
+
+		// The deadline is reached while reading the first type
+		// so the others are not imported
+		p.EXPECT().Resources(ctx, "aws_instance", f).DoAndReturn(func(ctx context.Context, t string, f *filter.Filter) ([]provider.Resource, error) {
+			<-ctx.Done()
+			return []provider.Resource{instanceResource1}, nil
+		})
+
+		instanceResource1.EXPECT().ID().Return("1")
+		instanceResource1.EXPECT().ImportState().Return(nil, nil)
+		instanceResource1.EXPECT().InstanceState().Return(&terraform.InstanceState{})
+		instanceResource1.EXPECT().Read(f).Return(nil)
+		instanceResource1.EXPECT().HCL(hw).Return(nil)
+		instanceResource1.EXPECT().State(sw).Return(nil)
+		instanceResource1.EXPECT().InstanceState().Return(nil)
+
+		hw.EXPECT().Sync().Return(nil)
+		hw.EXPECT().Interpolate(i)
+		sw.EXPECT().Sync().Return(nil)
+		sw.EXPECT().Interpolate(i)
+
+		err := provider.Import(ctx, p, hw, sw, f, ioutil.Discard)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, errcode.ErrProviderImportDeadline))
+
+		var derr *provider.DeadlineError
+		require.True(t, errors.As(err, &derr))
+		assert.Equal(t, provider.Checkpoint{
+			Provider: "aws",
+			Imported: []string{"aws_instance"},
+			Pending:  []string{"aws_iam_user", "aws_vpc"},
+		}, derr.Checkpoint)
+	})
 }
+
+// brokenProvider is a Provider that
+// reports the broken types
+type brokenProvider struct {
+	*mock.Provider
+
+	broken map[string]string
+}
+
+func (p brokenProvider) BrokenTypes() map[string]string { return p.broken }
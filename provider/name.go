@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/cycloidio/terracognita/util"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/pascaldekloe/name"
+)
+
+// NameTemplateFuncs are the functions, besides the builtin ones,
+// that can be used on the templates of the names of the Resources
+var NameTemplateFuncs = template.FuncMap{
+	"snakecase": func(s string) string { return strings.ToLower(name.Delimit(s, '_')) },
+	"lower":     strings.ToLower,
+	"upper":     strings.ToUpper,
+	"trim":      strings.TrimSpace,
+	"replace":   func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+	"default": func(d string, s string) string {
+		if s == "" {
+			return d
+		}
+		return s
+	},
+}
+
+// NameTemplateData is the data of a Resource
+// used to execute the template of the name
+type NameTemplateData struct {
+	// ID is the ID of the Resource
+	ID string
+
+	// Type is the type of the Resource (ex: aws_instance)
+	Type string
+
+	// Name is the 'name' attribute, if the Resource has it
+	Name string
+
+	// Tags are the tags (or labels) of the Resource
+	Tags map[string]string
+
+	// Attributes are the flatmap attributes of the State
+	Attributes map[string]string
+}
+
+// ParseNameTemplate parses the text of the template used
+// to name the Resources on the HCL and the State
+func ParseNameTemplate(text string) (*template.Template, error) {
+	t, err := template.New("name").Funcs(NameTemplateFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid name template %q: %w", text, err)
+	}
+	return t, nil
+}
+
+// ExecuteNameTemplate returns the name generated with the t for the data d, normalized
+// to be a valid identifier. If it's empty it means that no name could be generated
+func ExecuteNameTemplate(t *template.Template, d NameTemplateData) (string, error) {
+	var b bytes.Buffer
+	if err := t.Execute(&b, d); err != nil {
+		return "", fmt.Errorf("could not execute the name template for %s with ID %s: %w", d.Type, d.ID, err)
+	}
+
+	n := strings.Trim(util.NormalizeName(strings.TrimSpace(b.String())), "_")
+	if n == "" {
+		return "", nil
+	}
+
+	// The identifiers can not start with a number
+	if !hclsyntax.ValidIdentifier(n) || (n[0] >= '0' && n[0] <= '9') {
+		n = "r_" + n
+	}
+
+	return n, nil
+}
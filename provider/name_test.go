@@ -0,0 +1,52 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+func TestExecuteNameTemplate(t *testing.T) {
+	d := provider.NameTemplateData{
+		ID:   "i-0123",
+		Type: "aws_instance",
+		Name: "",
+		Tags: map[string]string{
+			"Name": "WebServer Prod",
+		},
+		Attributes: map[string]string{
+			"instance_type": "t3.micro",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{name: "Tag", template: "{{.Tags.Name | snakecase}}", expected: "web_server_prod"},
+		{name: "Attributes", template: "{{.Attributes.instance_type}}-{{.ID}}", expected: "t3_micro_i_0123"},
+		{name: "Default", template: `{{.Name | default .ID}}`, expected: "i_0123"},
+		{name: "MissingTag", template: "{{.Tags.Env}}", expected: ""},
+		{name: "StartsWithNumber", template: "{{.ID | replace \"i-\" \"\"}}", expected: "r_0123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := provider.ParseNameTemplate(tt.template)
+			require.NoError(t, err)
+
+			n, err := provider.ExecuteNameTemplate(tmpl, d)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, n)
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := provider.ParseNameTemplate("{{.Tags.Name | unknown}}")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cycloidio/terracognita/tag"
+)
+
+// OwnerTags are the tags, in order of preference, used to
+// infer the owner of a Resource, they are matched in lower
+// case so 'Owner' and 'OWNER' are also valid
+var OwnerTags = []string{"owner", "team", "created-by", "created_by", "createdby", "contact"}
+
+// ResourceOwner is the owner inferred of an imported
+// Resource, so it can be reviewed by the right team
+type ResourceOwner struct {
+	// Resource is the reference of the Resource
+	// with the format 'aws_instance.front'
+	Resource string
+
+	// Owner is the value of the tag or
+	// the identity returned by the Provider
+	Owner string
+
+	// Source is from where the Owner has been inferred,
+	// the tag key or the one returned by the Provider
+	Source string
+}
+
+// String returns the string representation of the ResourceOwner
+func (o ResourceOwner) String() string {
+	return fmt.Sprintf("%s: %s (%s)", o.Resource, o.Owner, o.Source)
+}
+
+// OwnerResolver is the interface that the Providers can implement to
+// infer the owner of the imported Resources, from the OwnerTags with
+// OwnerFromTags or from other sources like the audit logs
+type OwnerResolver interface {
+	// ResourceOwner returns the owner of the r and the source
+	// of it, if none is found the owner is empty
+	ResourceOwner(ctx context.Context, r Resource) (string, string, error)
+}
+
+// OwnerFromTags returns the value of the first of the OwnerTags that
+// the r has and the source of it, if none is found the owner is empty
+func OwnerFromTags(p Provider, r Resource) (string, string) {
+	if r.Data() == nil {
+		return "", ""
+	}
+
+	tags := tag.GetTags(p.TagKey(), r.Data())
+	for _, ot := range OwnerTags {
+		if v, ok := tag.Lookup(tags, ot, tag.NormalizationLower); ok && v != "" {
+			return v, fmt.Sprintf("tag %s", ot)
+		}
+	}
+
+	return "", ""
+}
+
+// ResourceOwners returns the ResourceOwner of each one of the resources
+// that has an owner, the ones without are not returned.
+// If p does not implement OwnerResolver it'll return nil
+func ResourceOwners(ctx context.Context, p Provider, resources []Resource) ([]ResourceOwner, error) {
+	or, ok := p.(OwnerResolver)
+	if !ok {
+		return nil, nil
+	}
+
+	owners := make([]ResourceOwner, 0)
+	for _, r := range resources {
+		o, s, err := or.ResourceOwner(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		if o == "" {
+			continue
+		}
+
+		owners = append(owners, ResourceOwner{
+			Resource: fmt.Sprintf("%s.%s", r.Type(), r.Name()),
+			Owner:    o,
+			Source:   s,
+		})
+	}
+
+	return owners, nil
+}
@@ -0,0 +1,106 @@
+package provider_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// ownerProvider is a Provider that implements
+// the OwnerResolver with the owner tags
+type ownerProvider struct {
+	*mock.Provider
+}
+
+func (p ownerProvider) ResourceOwner(ctx context.Context, r provider.Resource) (string, string, error) {
+	o, s := provider.OwnerFromTags(p, r)
+	if o == "" && r.ID() == "fail" {
+		return "", "", errors.New("failed")
+	}
+	return o, s, nil
+}
+
+func tagsData(t *testing.T, tags map[string]interface{}) *schema.ResourceData {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+
+	rd := r.Data(nil)
+	require.NoError(t, rd.Set("tags", tags))
+
+	return rd
+}
+
+func TestResourceOwners(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NotOwnerResolver", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		p := mock.NewProvider(ctrl)
+		r := mock.NewResource(ctrl)
+
+		owners, err := provider.ResourceOwners(ctx, p, []provider.Resource{r})
+		require.NoError(t, err)
+		assert.Nil(t, owners)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		p := ownerProvider{Provider: mock.NewProvider(ctrl)}
+		owner := mock.NewResource(ctrl)
+		team := mock.NewResource(ctrl)
+		none := mock.NewResource(ctrl)
+
+		p.Provider.EXPECT().TagKey().Return("tags").AnyTimes()
+
+		owner.EXPECT().Data().Return(tagsData(t, map[string]interface{}{"Owner": "jane", "team": "front"})).AnyTimes()
+		owner.EXPECT().Type().Return("aws_instance")
+		owner.EXPECT().Name().Return("front")
+
+		team.EXPECT().Data().Return(tagsData(t, map[string]interface{}{"TEAM": "back"})).AnyTimes()
+		team.EXPECT().Type().Return("aws_instance")
+		team.EXPECT().Name().Return("back")
+
+		none.EXPECT().Data().Return(tagsData(t, map[string]interface{}{"Name": "db"})).AnyTimes()
+		none.EXPECT().ID().Return("i-db")
+
+		owners, err := provider.ResourceOwners(ctx, p, []provider.Resource{owner, team, none})
+		require.NoError(t, err)
+		assert.Equal(t, []provider.ResourceOwner{
+			{Resource: "aws_instance.front", Owner: "jane", Source: "tag owner"},
+			{Resource: "aws_instance.back", Owner: "back", Source: "tag team"},
+		}, owners)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		p := ownerProvider{Provider: mock.NewProvider(ctrl)}
+		r := mock.NewResource(ctrl)
+
+		p.Provider.EXPECT().TagKey().Return("tags")
+		r.EXPECT().Data().Return(tagsData(t, map[string]interface{}{})).AnyTimes()
+		r.EXPECT().ID().Return("fail")
+
+		_, err := provider.ResourceOwners(ctx, p, []provider.Resource{r})
+		assert.EqualError(t, err, "failed")
+	})
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/pkg/errors"
+)
+
+// ParameterRegion is the parameter supported by all the
+// Providers, its value is the Region of the Provider
+const ParameterRegion = "region"
+
+// Parameterizer is the interface that the Providers can implement
+// to support more parameters than the ParameterRegion
+type Parameterizer interface {
+	// Parameter returns the value of the parameter name, if
+	// the name is not supported it has to return the
+	// errcode.ErrProviderParameterNotSupported
+	Parameter(ctx context.Context, name string) (string, error)
+}
+
+// Parameters returns the values of each one of the names for the
+// Provider p, the keys of the result are the names
+func Parameters(ctx context.Context, p Provider, names []string) (map[string]string, error) {
+	params := make(map[string]string, len(names))
+	for _, n := range names {
+		if n == ParameterRegion && p.Region() != "" {
+			params[n] = p.Region()
+			continue
+		}
+
+		pz, ok := p.(Parameterizer)
+		if !ok {
+			return nil, errors.Wrapf(errcode.ErrProviderParameterNotSupported, "with name %q", n)
+		}
+
+		v, err := pz.Parameter(ctx, n)
+		if err != nil {
+			return nil, errors.Wrapf(err, "with name %q", n)
+		}
+		if v == "" {
+			return nil, errors.Wrapf(errcode.ErrProviderParameterNoValue, "with name %q", n)
+		}
+		params[n] = v
+	}
+
+	return params, nil
+}
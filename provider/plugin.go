@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/errcode"
+)
+
+const (
+	// PluginPrefix is the prefix of the name of the
+	// binaries of the Provider plugins
+	PluginPrefix = "terracognita-provider-"
+
+	// pluginName is the name used to dispense the
+	// Provider from the plugin binaries
+	pluginName = "provider"
+)
+
+// PluginHandshake is the handshake between Terracognita and the Provider
+// plugins, the ProtocolVersion is increased on each incompatible change of
+// the serializable contract (the Plugin* requests and responses)
+var PluginHandshake = plugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "TERRACOGNITA_PROVIDER_PLUGIN",
+	MagicCookieValue: "d1b1c5b2-8d4a-4f5e-9a43-4a6f2f0f4b7e",
+}
+
+// PluginFunc is the function served by the Provider plugins with ServePlugin,
+// it initializes the Provider with the cfg which are the KEY=VALUE passed
+// by the user
+type PluginFunc func(ctx context.Context, cfg map[string]string) (Provider, error)
+
+// ServePlugin serves the Provider initialized by fn over gRPC, it has to
+// be called from the main of the plugin binary and blocks until Terracognita
+// stops the plugin
+func ServePlugin(fn PluginFunc) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: PluginHandshake,
+		Plugins:         plugin.PluginSet{pluginName: &grpcPlugin{newProvider: fn}},
+		GRPCServer:      plugin.DefaultGRPCServer,
+	})
+}
+
+// Plugins returns the Provider plugins on the dir, the keys are the
+// names of the plugins (terracognita-provider-NAME) and the values
+// the paths to them. If the dir does not exist no plugins are returned
+func Plugins(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, errors.Wrapf(err, "could not read the plugins directory %s", dir)
+	}
+
+	plugins := make(map[string]string)
+	for _, e := range entries {
+		n := e.Name()
+		if e.IsDir() || !strings.HasPrefix(n, PluginPrefix) {
+			continue
+		}
+
+		// Only the executables are plugins, on Windows
+		// the ones with the '.exe' extension
+		if runtime.GOOS == "windows" {
+			if filepath.Ext(n) != ".exe" {
+				continue
+			}
+			n = strings.TrimSuffix(n, ".exe")
+		} else {
+			info, err := e.Info()
+			if err != nil || info.Mode()&0111 == 0 || filepath.Ext(n) != "" {
+				continue
+			}
+		}
+
+		plugins[strings.TrimPrefix(n, PluginPrefix)] = filepath.Join(dir, e.Name())
+	}
+
+	return plugins, nil
+}
+
+// PluginNames returns the sorted names of the plugins
+func PluginNames(plugins map[string]string) []string {
+	names := make([]string, 0, len(plugins))
+	for n := range plugins {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Plugin is a Provider plugin running as a subprocess
+// of Terracognita which is used over gRPC
+type Plugin struct {
+	client *plugin.Client
+	rpc    *pluginClient
+}
+
+// OpenPlugin starts the Provider plugin with the name from the dir.
+// The plugins are independent binaries that serve the Provider with
+// ServePlugin, so they do not have to be built with the same Go version
+// or dependencies than Terracognita. The Plugin has to be closed after
+// using it to stop the subprocess
+func OpenPlugin(dir, name string) (*Plugin, error) {
+	plugins, err := Plugins(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	path, ok := plugins[name]
+	if !ok {
+		return nil, errors.Wrapf(errcode.ErrProviderPluginNotFound, "with name %q on %s", name, dir)
+	}
+
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  PluginHandshake,
+		Plugins:          plugin.PluginSet{pluginName: &grpcPlugin{}},
+		Cmd:              exec.Command(path),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		Logger: hclog.New(&hclog.LoggerOptions{
+			Name:   "plugin." + name,
+			Output: os.Stderr,
+			Level:  hclog.Warn,
+		}),
+	})
+
+	cp, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return nil, errors.Wrapf(errcode.ErrProviderPluginInvalid, "on %s: %s", path, err)
+	}
+
+	raw, err := cp.Dispense(pluginName)
+	if err != nil {
+		client.Kill()
+		return nil, errors.Wrapf(errcode.ErrProviderPluginInvalid, "on %s: %s", path, err)
+	}
+
+	rpc, ok := raw.(*pluginClient)
+	if !ok {
+		client.Kill()
+		return nil, errors.Wrapf(errcode.ErrProviderPluginInvalid, "on %s with type %T", path, raw)
+	}
+
+	return &Plugin{client: client, rpc: rpc}, nil
+}
+
+// NewProvider initializes the Provider of the plugin with the cfg
+func (pl *Plugin) NewProvider(ctx context.Context, cfg map[string]string) (Provider, error) {
+	return newPluginProvider(ctx, pl.rpc, cfg)
+}
+
+// Close stops the plugin subprocess
+func (pl *Plugin) Close() {
+	pl.client.Kill()
+}
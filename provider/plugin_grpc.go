@@ -0,0 +1,403 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cycloidio/terracognita/filter"
+)
+
+// The Provider plugins are served with a gRPC service which methods
+// receive and return a wrapperspb.BytesValue with the JSON of the
+// Plugin* requests and responses, which are the serializable contract
+// of the Provider between Terracognita and the plugins
+
+// pluginServiceName is the name of the gRPC service
+const pluginServiceName = "terracognita.plugin.Provider"
+
+// List of the methods of the gRPC service
+const (
+	pluginMethodConfigure           = "Configure"
+	pluginMethodResources           = "Resources"
+	pluginMethodImportResourceState = "ImportResourceState"
+	pluginMethodReadResource        = "ReadResource"
+)
+
+// PluginConfigureRequest initializes the Provider
+// of the plugin with the Config
+type PluginConfigureRequest struct {
+	Config map[string]string `json:"config"`
+}
+
+// PluginConfigureResponse has all the information of
+// the Provider initialized that does not change
+type PluginConfigureResponse struct {
+	Name          string                 `json:"name"`
+	Region        string                 `json:"region"`
+	TagKey        string                 `json:"tag_key"`
+	Source        string                 `json:"source"`
+	ResourceTypes []string               `json:"resource_types"`
+	Configuration map[string]interface{} `json:"configuration"`
+	Schema        PluginProviderSchema   `json:"schema"`
+}
+
+// PluginResourcesRequest lists the Resources of the ResourceType
+type PluginResourcesRequest struct {
+	ResourceType string         `json:"resource_type"`
+	Filter       *filter.Filter `json:"filter"`
+}
+
+// PluginResourcesResponse has the Resources listed
+type PluginResourcesResponse struct {
+	Resources []PluginResource `json:"resources"`
+}
+
+// PluginResource is a Resource of the Provider, which is
+// imported and read with the Terraform Provider of the plugin
+type PluginResource struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// PluginImportResourceStateRequest imports
+// the Resource with the ID of the TypeName
+type PluginImportResourceStateRequest struct {
+	TypeName string `json:"type_name"`
+	ID       string `json:"id"`
+}
+
+// PluginImportResourceStateResponse has the Resources imported
+// and the summary of the Terraform diagnostics if any
+type PluginImportResourceStateResponse struct {
+	ImportedResources []PluginImportedResource `json:"imported_resources"`
+	Diagnostics       []string                 `json:"diagnostics"`
+}
+
+// PluginImportedResource is a Resource imported, the State
+// is the msgpack of the Terraform state
+type PluginImportedResource struct {
+	TypeName string `json:"type_name"`
+	State    []byte `json:"state"`
+	Private  []byte `json:"private"`
+}
+
+// PluginReadResourceRequest reads the Resource of the TypeName
+// with the State, which is the msgpack of the Terraform state
+type PluginReadResourceRequest struct {
+	TypeName string `json:"type_name"`
+	State    []byte `json:"state"`
+	Private  []byte `json:"private"`
+}
+
+// PluginReadResourceResponse has the State read, and the summary
+// of the Terraform diagnostics if any
+type PluginReadResourceResponse struct {
+	State       []byte   `json:"state"`
+	Private     []byte   `json:"private"`
+	Diagnostics []string `json:"diagnostics"`
+}
+
+// grpcPlugin is the plugin.GRPCPlugin of the Provider plugins,
+// the newProvider is only needed on the plugin side
+type grpcPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+
+	newProvider PluginFunc
+}
+
+func (p *grpcPlugin) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	s.RegisterService(&pluginServiceDesc, &pluginServer{newProvider: p.newProvider})
+	return nil
+}
+
+func (p *grpcPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &pluginClient{conn: c}, nil
+}
+
+// pluginService is the handler of the gRPC service
+type pluginService interface {
+	handle(ctx context.Context, method string, in []byte) (interface{}, error)
+}
+
+// pluginServiceDesc is the description of the gRPC service, which is
+// defined by hand as all the methods have the same BytesValue messages
+var pluginServiceDesc = grpc.ServiceDesc{
+	ServiceName: pluginServiceName,
+	HandlerType: (*pluginService)(nil),
+	Methods: []grpc.MethodDesc{
+		pluginMethodDesc(pluginMethodConfigure),
+		pluginMethodDesc(pluginMethodResources),
+		pluginMethodDesc(pluginMethodImportResourceState),
+		pluginMethodDesc(pluginMethodReadResource),
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "terracognita/provider/plugin",
+}
+
+// pluginMethodDesc returns the grpc.MethodDesc of the method which
+// decodes the BytesValue and encodes the response of the pluginService
+func pluginMethodDesc(method string) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: method,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			in := new(wrapperspb.BytesValue)
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				res, err := srv.(pluginService).handle(ctx, method, req.(*wrapperspb.BytesValue).Value)
+				if err != nil {
+					return nil, err
+				}
+
+				b, err := json.Marshal(res)
+				if err != nil {
+					return nil, err
+				}
+
+				return wrapperspb.Bytes(b), nil
+			}
+
+			if interceptor == nil {
+				return handler(ctx, in)
+			}
+
+			return interceptor(ctx, in, &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: "/" + pluginServiceName + "/" + method,
+			}, handler)
+		},
+	}
+}
+
+// pluginServer is the pluginService on the plugin side
+// which serves the Provider initialized on Configure
+type pluginServer struct {
+	newProvider PluginFunc
+
+	provider Provider
+	server   *schema.GRPCProviderServer
+}
+
+func (s *pluginServer) handle(ctx context.Context, method string, in []byte) (interface{}, error) {
+	if method == pluginMethodConfigure {
+		var req PluginConfigureRequest
+		if err := json.Unmarshal(in, &req); err != nil {
+			return nil, err
+		}
+		return s.configure(ctx, req)
+	}
+
+	if s.provider == nil {
+		return nil, errors.Errorf("the provider has to be configured before calling %s", method)
+	}
+
+	switch method {
+	case pluginMethodResources:
+		var req PluginResourcesRequest
+		if err := json.Unmarshal(in, &req); err != nil {
+			return nil, err
+		}
+		return s.resources(ctx, req)
+	case pluginMethodImportResourceState:
+		var req PluginImportResourceStateRequest
+		if err := json.Unmarshal(in, &req); err != nil {
+			return nil, err
+		}
+		return s.importResourceState(ctx, req)
+	case pluginMethodReadResource:
+		var req PluginReadResourceRequest
+		if err := json.Unmarshal(in, &req); err != nil {
+			return nil, err
+		}
+		return s.readResource(ctx, req)
+	default:
+		return nil, errors.Errorf("unknown method %s", method)
+	}
+}
+
+func (s *pluginServer) configure(ctx context.Context, req PluginConfigureRequest) (*PluginConfigureResponse, error) {
+	p, err := s.newProvider(ctx, req.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	s.provider = p
+	s.server = schema.NewGRPCProviderServer(p.TFProvider())
+
+	return &PluginConfigureResponse{
+		Name:          p.String(),
+		Region:        p.Region(),
+		TagKey:        p.TagKey(),
+		Source:        p.Source(),
+		ResourceTypes: p.ResourceTypes(),
+		Configuration: p.Configuration(),
+		Schema:        pluginProviderSchema(p.TFProvider()),
+	}, nil
+}
+
+func (s *pluginServer) resources(ctx context.Context, req PluginResourcesRequest) (*PluginResourcesResponse, error) {
+	f := req.Filter
+	if f == nil {
+		f = &filter.Filter{}
+	}
+
+	rs, err := s.provider.Resources(ctx, req.ResourceType, f)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &PluginResourcesResponse{Resources: make([]PluginResource, 0, len(rs))}
+	for _, r := range rs {
+		res.Resources = append(res.Resources, PluginResource{ID: r.ID(), Type: r.Type()})
+	}
+
+	return res, nil
+}
+
+func (s *pluginServer) importResourceState(ctx context.Context, req PluginImportResourceStateRequest) (*PluginImportResourceStateResponse, error) {
+	protoResp, err := s.server.ImportResourceState(ctx, &tfprotov5.ImportResourceStateRequest{
+		TypeName: req.TypeName,
+		ID:       req.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &PluginImportResourceStateResponse{
+		ImportedResources: make([]PluginImportedResource, 0, len(protoResp.ImportedResources)),
+		Diagnostics:       diagnosticSummaries(protoResp.Diagnostics),
+	}
+	for _, ir := range protoResp.ImportedResources {
+		pir := PluginImportedResource{
+			TypeName: ir.TypeName,
+			Private:  ir.Private,
+		}
+		if ir.State != nil {
+			pir.State = ir.State.MsgPack
+		}
+		res.ImportedResources = append(res.ImportedResources, pir)
+	}
+
+	return res, nil
+}
+
+func (s *pluginServer) readResource(ctx context.Context, req PluginReadResourceRequest) (*PluginReadResourceResponse, error) {
+	protoResp, err := s.server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:     req.TypeName,
+		CurrentState: &tfprotov5.DynamicValue{MsgPack: req.State},
+		Private:      req.Private,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &PluginReadResourceResponse{
+		Private:     protoResp.Private,
+		Diagnostics: diagnosticSummaries(protoResp.Diagnostics),
+	}
+	if protoResp.NewState != nil {
+		res.State = protoResp.NewState.MsgPack
+	}
+
+	return res, nil
+}
+
+// pluginClient is the client of the gRPC service on the Terracognita
+// side, which is also the resourceServer of the Resources of the plugin
+type pluginClient struct {
+	conn *grpc.ClientConn
+}
+
+// invoke calls the method of the gRPC service with the req and
+// decodes the response of the plugin on res
+func (c *pluginClient) invoke(ctx context.Context, method string, req, res interface{}) error {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	out := new(wrapperspb.BytesValue)
+	err = c.conn.Invoke(ctx, "/"+pluginServiceName+"/"+method, wrapperspb.Bytes(b), out)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call %s on the plugin", method)
+	}
+
+	return json.Unmarshal(out.Value, res)
+}
+
+func (c *pluginClient) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	var res PluginImportResourceStateResponse
+	err := c.invoke(ctx, pluginMethodImportResourceState, PluginImportResourceStateRequest{
+		TypeName: req.TypeName,
+		ID:       req.ID,
+	}, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	protoResp := &tfprotov5.ImportResourceStateResponse{
+		ImportedResources: make([]*tfprotov5.ImportedResource, 0, len(res.ImportedResources)),
+		Diagnostics:       errorDiagnostics(res.Diagnostics),
+	}
+	for _, ir := range res.ImportedResources {
+		protoResp.ImportedResources = append(protoResp.ImportedResources, &tfprotov5.ImportedResource{
+			TypeName: ir.TypeName,
+			State:    &tfprotov5.DynamicValue{MsgPack: ir.State},
+			Private:  ir.Private,
+		})
+	}
+
+	return protoResp, nil
+}
+
+func (c *pluginClient) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	preq := PluginReadResourceRequest{
+		TypeName: req.TypeName,
+		Private:  req.Private,
+	}
+	if req.CurrentState != nil {
+		preq.State = req.CurrentState.MsgPack
+	}
+
+	var res PluginReadResourceResponse
+	err := c.invoke(ctx, pluginMethodReadResource, preq, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov5.ReadResourceResponse{
+		NewState:    &tfprotov5.DynamicValue{MsgPack: res.State},
+		Private:     res.Private,
+		Diagnostics: errorDiagnostics(res.Diagnostics),
+	}, nil
+}
+
+// diagnosticSummaries returns the summaries of the diags
+func diagnosticSummaries(diags []*tfprotov5.Diagnostic) []string {
+	summaries := make([]string, 0, len(diags))
+	for _, d := range diags {
+		summaries = append(summaries, d.Summary)
+	}
+	return summaries
+}
+
+// errorDiagnostics returns the summaries as error diagnostics
+func errorDiagnostics(summaries []string) []*tfprotov5.Diagnostic {
+	diags := make([]*tfprotov5.Diagnostic, 0, len(summaries))
+	for _, s := range summaries {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  s,
+		})
+	}
+	return diags
+}
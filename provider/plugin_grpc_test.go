@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cycloidio/terracognita/filter"
+)
+
+// fakeProvider is the Provider served by the plugin
+type fakeProvider struct {
+	tfp *schema.Provider
+	cfg map[string]string
+}
+
+func (p *fakeProvider) Region() string                { return p.cfg["region"] }
+func (p *fakeProvider) ResourceTypes() []string       { return []string{"fake_thing"} }
+func (p *fakeProvider) HasResourceType(t string) bool { return t == "fake_thing" }
+func (p *fakeProvider) Resources(ctx context.Context, t string, f *filter.Filter) ([]Resource, error) {
+	return []Resource{NewResource("1", t, p), NewResource("2", t, p)}, nil
+}
+func (p *fakeProvider) TFClient() interface{}        { return nil }
+func (p *fakeProvider) TFProvider() *schema.Provider { return p.tfp }
+func (p *fakeProvider) String() string               { return "fake" }
+func (p *fakeProvider) TagKey() string               { return "tags" }
+func (p *fakeProvider) Source() string               { return "cycloidio/fake" }
+func (p *fakeProvider) Configuration() map[string]interface{} {
+	return map[string]interface{}{"region": p.Region()}
+}
+
+func newFakeProvider(ctx context.Context, cfg map[string]string) (Provider, error) {
+	return &fakeProvider{
+		cfg: cfg,
+		tfp: &schema.Provider{
+			Schema: map[string]*schema.Schema{
+				"region": &schema.Schema{Type: schema.TypeString, Required: true},
+			},
+			ResourcesMap: map[string]*schema.Resource{
+				"fake_thing": &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":  &schema.Schema{Type: schema.TypeString, Optional: true},
+						"size":  &schema.Schema{Type: schema.TypeInt, Optional: true, Default: 2},
+						"tags":  &schema.Schema{Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						"ports": &schema.Schema{Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeInt}, Default: map[string]interface{}{"http": 80}},
+						"rules": &schema.Schema{Type: schema.TypeSet, Optional: true, Elem: &schema.Resource{Schema: map[string]*schema.Schema{"port": &schema.Schema{Type: schema.TypeInt, Required: true}}}},
+					},
+					Importer: &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+					ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+						d.Set("name", "thing-"+d.Id())
+						return nil
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// newTestPluginClient serves the newFakeProvider as a plugin
+// over an in memory connection and returns the client
+func newTestPluginClient(t *testing.T) *pluginClient {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	require.NoError(t, (&grpcPlugin{newProvider: newFakeProvider}).GRPCServer(nil, s))
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	c, err := (&grpcPlugin{}).GRPCClient(context.Background(), nil, conn)
+	require.NoError(t, err)
+
+	return c.(*pluginClient)
+}
+
+func TestPluginProvider(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctx := context.Background()
+		p, err := newPluginProvider(ctx, newTestPluginClient(t), map[string]string{"region": "eu-west-1"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "fake", p.String())
+		assert.Equal(t, "eu-west-1", p.Region())
+		assert.Equal(t, "cycloidio/fake", p.Source())
+		assert.Equal(t, map[string]interface{}{"region": "eu-west-1"}, p.Configuration())
+		assert.True(t, p.HasResourceType("fake_thing"))
+
+		// The schema is the same than the one of the plugin
+		expected, _ := newFakeProvider(ctx, nil)
+		ers := expected.TFProvider().ResourcesMap["fake_thing"]
+		rs := p.TFProvider().ResourcesMap["fake_thing"]
+		require.NotNil(t, rs)
+		assert.NotNil(t, rs.Importer)
+		assert.Equal(t, 2, rs.Schema["size"].Default)
+		assert.Equal(t, map[string]interface{}{"http": 80}, rs.Schema["ports"].Default)
+		assert.True(t, ers.CoreConfigSchema().ImpliedType().Equals(rs.CoreConfigSchema().ImpliedType()))
+		assert.True(t, p.TFProvider().Schema["region"].Required)
+
+		resources, err := p.Resources(ctx, "fake_thing", &filter.Filter{})
+		require.NoError(t, err)
+		require.Len(t, resources, 2)
+		assert.Equal(t, "2", resources[1].ID())
+
+		// The import and read are done by the plugin
+		r := resources[1]
+		_, err = r.ImportState()
+		require.NoError(t, err)
+		require.NoError(t, r.Read(&filter.Filter{}))
+		assert.Equal(t, "thing-2", r.Data().Get("name"))
+	})
+	t.Run("ErrorNotConfigured", func(t *testing.T) {
+		c := newTestPluginClient(t)
+
+		var res PluginResourcesResponse
+		err := c.invoke(context.Background(), pluginMethodResources, PluginResourcesRequest{ResourceType: "fake_thing"}, &res)
+		assert.Error(t, err)
+	})
+}
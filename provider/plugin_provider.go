@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/cycloidio/terracognita/filter"
+)
+
+// PluginProviderSchema is the serializable schema
+// of the Terraform Provider of a plugin
+type PluginProviderSchema struct {
+	Provider  map[string]*PluginSchema        `json:"provider"`
+	Resources map[string]PluginResourceSchema `json:"resources"`
+}
+
+// PluginResourceSchema is the serializable schema.Resource
+type PluginResourceSchema struct {
+	SchemaVersion int                      `json:"schema_version"`
+	Importable    bool                     `json:"importable"`
+	Schema        map[string]*PluginSchema `json:"schema"`
+}
+
+// PluginSchema is the serializable schema.Schema with
+// the attributes used to import and write the HCL
+type PluginSchema struct {
+	Type       schema.ValueType        `json:"type"`
+	ConfigMode schema.SchemaConfigMode `json:"config_mode,omitempty"`
+	Required   bool                    `json:"required,omitempty"`
+	Optional   bool                    `json:"optional,omitempty"`
+	Computed   bool                    `json:"computed,omitempty"`
+	Sensitive  bool                    `json:"sensitive,omitempty"`
+	Deprecated string                  `json:"deprecated,omitempty"`
+	Default    interface{}             `json:"default,omitempty"`
+	MinItems   int                     `json:"min_items,omitempty"`
+	MaxItems   int                     `json:"max_items,omitempty"`
+
+	// Elem is the schema of the elements of the
+	// TypeList, TypeSet and TypeMap of values
+	Elem *PluginSchema `json:"elem,omitempty"`
+
+	// ElemResource is the schema of the elements
+	// of the TypeList and TypeSet of blocks
+	ElemResource map[string]*PluginSchema `json:"elem_resource,omitempty"`
+}
+
+// pluginProviderSchema returns the serializable schema of the tfp
+func pluginProviderSchema(tfp *schema.Provider) PluginProviderSchema {
+	ps := PluginProviderSchema{
+		Provider:  pluginSchemaMap(tfp.Schema),
+		Resources: make(map[string]PluginResourceSchema, len(tfp.ResourcesMap)),
+	}
+	for rt, r := range tfp.ResourcesMap {
+		ps.Resources[rt] = PluginResourceSchema{
+			SchemaVersion: r.SchemaVersion,
+			Importable:    r.Importer != nil,
+			Schema:        pluginSchemaMap(r.Schema),
+		}
+	}
+	return ps
+}
+
+// pluginSchemaMap returns the serializable sm
+func pluginSchemaMap(sm map[string]*schema.Schema) map[string]*PluginSchema {
+	psm := make(map[string]*PluginSchema, len(sm))
+	for k, s := range sm {
+		psm[k] = pluginSchema(s)
+	}
+	return psm
+}
+
+// pluginSchema returns the serializable s
+func pluginSchema(s *schema.Schema) *PluginSchema {
+	ps := &PluginSchema{
+		Type:       s.Type,
+		ConfigMode: s.ConfigMode,
+		Required:   s.Required,
+		Optional:   s.Optional,
+		Computed:   s.Computed,
+		Sensitive:  s.Sensitive,
+		Deprecated: s.Deprecated,
+		Default:    s.Default,
+		MinItems:   s.MinItems,
+		MaxItems:   s.MaxItems,
+	}
+
+	switch e := s.Elem.(type) {
+	case *schema.Schema:
+		ps.Elem = pluginSchema(e)
+	case *schema.Resource:
+		ps.ElemResource = pluginSchemaMap(e.Schema)
+	}
+
+	return ps
+}
+
+// tfProvider returns the schema.Provider of the ps, which
+// has only the schema as the Resources are imported and
+// read by the plugin
+func (ps PluginProviderSchema) tfProvider() *schema.Provider {
+	tfp := &schema.Provider{
+		Schema:       tfSchemaMap(ps.Provider),
+		ResourcesMap: make(map[string]*schema.Resource, len(ps.Resources)),
+	}
+	for rt, prs := range ps.Resources {
+		r := &schema.Resource{
+			SchemaVersion: prs.SchemaVersion,
+			Schema:        tfSchemaMap(prs.Schema),
+		}
+		// The import is done by the plugin, the Importer
+		// is only needed to know that it can be imported
+		if prs.Importable {
+			r.Importer = &schema.ResourceImporter{}
+		}
+		tfp.ResourcesMap[rt] = r
+	}
+	return tfp
+}
+
+// tfSchemaMap returns the schema of the psm
+func tfSchemaMap(psm map[string]*PluginSchema) map[string]*schema.Schema {
+	sm := make(map[string]*schema.Schema, len(psm))
+	for k, ps := range psm {
+		sm[k] = ps.tfSchema()
+	}
+	return sm
+}
+
+// tfSchema returns the schema.Schema of the ps
+func (ps *PluginSchema) tfSchema() *schema.Schema {
+	s := &schema.Schema{
+		Type:       ps.Type,
+		ConfigMode: ps.ConfigMode,
+		Required:   ps.Required,
+		Optional:   ps.Optional,
+		Computed:   ps.Computed,
+		Sensitive:  ps.Sensitive,
+		Deprecated: ps.Deprecated,
+		Default:    ps.Default,
+		MinItems:   ps.MinItems,
+		MaxItems:   ps.MaxItems,
+	}
+
+	// The numbers are decoded from the JSON as float64, on the
+	// TypeList, TypeSet and TypeMap they are the ones of the Elem
+	switch ps.Type {
+	case schema.TypeInt:
+		s.Default = tfDefault(ps.Default, ps.Type)
+	case schema.TypeList, schema.TypeSet, schema.TypeMap:
+		if ps.Elem != nil {
+			s.Default = tfDefault(ps.Default, ps.Elem.Type)
+		}
+	}
+
+	if ps.Elem != nil {
+		s.Elem = ps.Elem.tfSchema()
+	} else if ps.ElemResource != nil {
+		s.Elem = &schema.Resource{Schema: tfSchemaMap(ps.ElemResource)}
+	}
+
+	return s
+}
+
+// tfDefault returns the default v with the float64 converted
+// to int if the t is TypeInt, also the elements of the lists
+// and maps of the TypeList, TypeSet and TypeMap
+func tfDefault(v interface{}, t schema.ValueType) interface{} {
+	if t != schema.TypeInt {
+		return v
+	}
+
+	switch d := v.(type) {
+	case float64:
+		return int(d)
+	case []interface{}:
+		l := make([]interface{}, 0, len(d))
+		for _, e := range d {
+			l = append(l, tfDefault(e, t))
+		}
+		return l
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(d))
+		for k, e := range d {
+			m[k] = tfDefault(e, t)
+		}
+		return m
+	}
+
+	return v
+}
+
+// pluginProvider is the Provider of a plugin
+// which calls it through the pluginClient
+type pluginProvider struct {
+	client *pluginClient
+	info   *PluginConfigureResponse
+	tfp    *schema.Provider
+}
+
+// newPluginProvider configures the Provider of the
+// plugin of the client with the cfg and returns it
+func newPluginProvider(ctx context.Context, client *pluginClient, cfg map[string]string) (*pluginProvider, error) {
+	var info PluginConfigureResponse
+	err := client.invoke(ctx, pluginMethodConfigure, PluginConfigureRequest{Config: cfg}, &info)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not configure the plugin")
+	}
+
+	return &pluginProvider{
+		client: client,
+		info:   &info,
+		tfp:    info.Schema.tfProvider(),
+	}, nil
+}
+
+func (p *pluginProvider) Region() string { return p.info.Region }
+
+func (p *pluginProvider) ResourceTypes() []string { return p.info.ResourceTypes }
+
+func (p *pluginProvider) HasResourceType(t string) bool {
+	for _, rt := range p.info.ResourceTypes {
+		if rt == t {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *pluginProvider) Resources(ctx context.Context, t string, f *filter.Filter) ([]Resource, error) {
+	var res PluginResourcesResponse
+	err := p.client.invoke(ctx, pluginMethodResources, PluginResourcesRequest{ResourceType: t, Filter: f}, &res)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not list the resources of %s", t)
+	}
+
+	resources := make([]Resource, 0, len(res.Resources))
+	for _, r := range res.Resources {
+		resources = append(resources, NewResource(r.ID, r.Type, p))
+	}
+
+	return resources, nil
+}
+
+// TFClient is nil as the Terraform Provider
+// is configured on the plugin
+func (p *pluginProvider) TFClient() interface{} { return nil }
+
+func (p *pluginProvider) TFProvider() *schema.Provider { return p.tfp }
+
+func (p *pluginProvider) String() string { return p.info.Name }
+
+func (p *pluginProvider) TagKey() string { return p.info.TagKey }
+
+func (p *pluginProvider) Source() string { return p.info.Source }
+
+func (p *pluginProvider) Configuration() map[string]interface{} { return p.info.Configuration }
+
+// resourceServer returns the pluginClient as the Resources
+// are imported and read by the Terraform Provider of the plugin
+func (p *pluginProvider) resourceServer() resourceServer { return p.client }
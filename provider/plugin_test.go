@@ -0,0 +1,54 @@
+package provider_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/provider"
+)
+
+func TestPlugins(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("the plugins on Windows are the .exe")
+		}
+
+		dir := t.TempDir()
+		for _, n := range []string{"terracognita-provider-oci", "terracognita-provider-linode", "other"} {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, n), nil, 0700))
+		}
+		// Not executable or with extension
+		for _, n := range []string{"terracognita-provider-gandi", "terracognita-provider-oci.go"} {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, n), nil, 0600))
+		}
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "terracognita-provider-oci.so"), nil, 0700))
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "terracognita-provider-dir"), 0700))
+
+		plugins, err := provider.Plugins(dir)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"oci":    filepath.Join(dir, "terracognita-provider-oci"),
+			"linode": filepath.Join(dir, "terracognita-provider-linode"),
+		}, plugins)
+		assert.Equal(t, []string{"linode", "oci"}, provider.PluginNames(plugins))
+	})
+	t.Run("NotExistingDir", func(t *testing.T) {
+		plugins, err := provider.Plugins(filepath.Join(t.TempDir(), "plugins"))
+		require.NoError(t, err)
+		assert.Empty(t, plugins)
+	})
+}
+
+func TestOpenPlugin(t *testing.T) {
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := provider.OpenPlugin(t.TempDir(), "oci")
+		assert.Equal(t, errcode.ErrProviderPluginNotFound, errors.Cause(err))
+	})
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+)
+
+// List of all the kinds of ExternalReference
+const (
+	// ReferenceCrossAccount is a reference to an entity
+	// that belongs to another account/project
+	ReferenceCrossAccount = "cross-account"
+
+	// ReferenceCrossRegion is a reference to an entity
+	// that lives on another region than the imported one
+	ReferenceCrossRegion = "cross-region"
+
+	// ReferenceGlobal is a reference to an entity of a
+	// global service that has not been imported
+	ReferenceGlobal = "global"
+
+	// ReferencePeering is a reference to an entity that
+	// is reachable through a peering connection
+	ReferencePeering = "peering"
+)
+
+// ExternalReference is a value of an imported Resource that
+// points to an entity outside of the imported scope
+type ExternalReference struct {
+	// Resource is the reference of the Resource holding
+	// the value with the format 'aws_instance.front'
+	Resource string
+
+	// Attribute is the attribute of the Resource
+	// holding the Value
+	Attribute string
+
+	// Value is the raw value that it's referenced
+	Value string
+
+	// Kind is one of the Reference* kinds
+	Kind string
+
+	// DataSource is the type of data source that could
+	// be used to reference the Value, if empty it means
+	// there is no data source for it
+	DataSource string
+
+	// DataSourceConfig is the configuration for the
+	// DataSource in order to fetch the Value
+	DataSourceConfig map[string]interface{}
+
+	// DataSourceAttribute is the attribute of the DataSource
+	// that has the Value
+	DataSourceAttribute string
+}
+
+// String returns the string representation of the ExternalReference
+func (e ExternalReference) String() string {
+	return fmt.Sprintf("%s.%s = %q (%s)", e.Resource, e.Attribute, e.Value, e.Kind)
+}
+
+// ExternalReferencer is the interface that the Providers can
+// implement to identify the values that point outside of the
+// imported scope
+type ExternalReferencer interface {
+	// ExternalReference checks if the value v of the attribute attr of a
+	// resource of type rt points outside of the imported scope and if
+	// it does it returns the ExternalReference with the Kind and DataSource
+	// defined
+	ExternalReference(rt, attr, v string) (ExternalReference, bool)
+}
+
+// ExternalReferences returns the list of ExternalReference of the resources,
+// the values that are part of the interpolation are ignored as they
+// are part of the imported scope.
+// If p does not implement ExternalReferencer it'll return nil
+func ExternalReferences(p Provider, resources []Resource, interpolation map[string]string) []ExternalReference {
+	er, ok := p.(ExternalReferencer)
+	if !ok {
+		return nil
+	}
+
+	refs := make([]ExternalReference, 0)
+	for _, r := range resources {
+		state := r.InstanceState()
+		if state == nil {
+			continue
+		}
+
+		// The attributes are sorted so the result is
+		// always the same between imports
+		attrs := make([]string, 0, len(state.Attributes))
+		for k := range state.Attributes {
+			attrs = append(attrs, k)
+		}
+		sort.Strings(attrs)
+
+		for _, attr := range attrs {
+			v := state.Attributes[attr]
+			if v == "" || v == state.ID {
+				continue
+			}
+			if _, ok := interpolation[v]; ok {
+				continue
+			}
+
+			ref, ok := er.ExternalReference(r.Type(), attr, v)
+			if !ok {
+				continue
+			}
+
+			ref.Resource = fmt.Sprintf("%s.%s", r.Type(), r.Name())
+			ref.Attribute = attr
+			ref.Value = v
+			refs = append(refs, ref)
+		}
+	}
+
+	return refs
+}
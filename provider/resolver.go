@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Matcher normalizes the values that point to a Resource, so the
+// ones with a different format than its attributes reference, like
+// an ARN instead of the ID, are resolved to the same Resource
+type Matcher interface {
+	// Match returns the normalized value of v and
+	// if the v has been matched
+	Match(v string) (string, bool)
+
+	// Priority is the order in which the Matchers are
+	// checked, the highest Priority is checked first
+	Priority() int
+}
+
+// InterpolationMatcher is the interface that the Providers can
+// implement to register the Matchers used to resolve the
+// values to interpolate
+type InterpolationMatcher interface {
+	// InterpolationMatchers returns the Matchers of the Provider
+	InterpolationMatchers() []Matcher
+}
+
+// Resolver resolves the values of the Resources to the address, like
+// '${aws_instance.front.id}', of the Resource they point to.
+// The values are first checked as they are and then with each
+// one of the Matchers by Priority
+type Resolver struct {
+	matchers []Matcher
+
+	// addresses are the addresses indexed
+	// by the value of the attribute reference
+	addresses map[string]string
+
+	// normalized are the addresses indexed by the
+	// normalized value, one for each Matcher
+	normalized []map[string]string
+}
+
+// NewResolver returns a new Resolver with the ms Matchers
+func NewResolver(ms ...Matcher) *Resolver {
+	matchers := make([]Matcher, len(ms))
+	copy(matchers, ms)
+	sort.SliceStable(matchers, func(i, j int) bool {
+		return matchers[i].Priority() > matchers[j].Priority()
+	})
+
+	normalized := make([]map[string]string, len(matchers))
+	for i := range normalized {
+		normalized[i] = make(map[string]string)
+	}
+
+	return &Resolver{
+		matchers:   matchers,
+		addresses:  make(map[string]string),
+		normalized: normalized,
+	}
+}
+
+// NewProviderResolver returns a new Resolver with the
+// Matchers of p if it implements InterpolationMatcher
+func NewProviderResolver(p Provider) *Resolver {
+	im, ok := p.(InterpolationMatcher)
+	if !ok {
+		return NewResolver()
+	}
+
+	return NewResolver(im.InterpolationMatchers()...)
+}
+
+// Add adds the address of the Resource which
+// attribute reference has the value v
+func (r *Resolver) Add(v, address string) {
+	r.addresses[v] = address
+
+	for i, m := range r.matchers {
+		if nv, ok := m.Match(v); ok {
+			r.normalized[i][nv] = address
+		}
+	}
+}
+
+// Resolve returns the address of the Resource that v points to
+func (r *Resolver) Resolve(v string) (string, bool) {
+	if a, ok := r.addresses[v]; ok {
+		return a, true
+	}
+
+	for i, m := range r.matchers {
+		nv, ok := m.Match(v)
+		if !ok {
+			continue
+		}
+		if a, ok := r.normalized[i][nv]; ok {
+			return a, true
+		}
+	}
+
+	return "", false
+}
+
+// Interpolation returns the values to interpolate of the resources,
+// indexed by the value with the address of the Resource as value,
+// which are the ones added and the ones resolved by the Matchers
+func (r *Resolver) Interpolation(resources []Resource) map[string]string {
+	interpolation := make(map[string]string, len(r.addresses))
+	for v, a := range r.addresses {
+		interpolation[v] = a
+	}
+
+	if len(r.matchers) == 0 {
+		return interpolation
+	}
+
+	for _, res := range resources {
+		state := res.InstanceState()
+		if state == nil {
+			continue
+		}
+
+		for _, v := range state.Attributes {
+			if v == "" {
+				continue
+			}
+			if _, ok := interpolation[v]; ok {
+				continue
+			}
+			if a, ok := r.Resolve(v); ok {
+				interpolation[v] = a
+			}
+		}
+	}
+
+	return interpolation
+}
+
+// RegexpMatcher is a Matcher which normalized value is the first
+// submatch of the regexp, or all the match if it has no groups
+type RegexpMatcher struct {
+	re       *regexp.Regexp
+	fold     bool
+	priority int
+}
+
+// NewRegexpMatcher returns a new RegexpMatcher with the re regexp,
+// if fold is true the normalized value is lower cased so the values
+// that are case insensitive are also matched
+func NewRegexpMatcher(re *regexp.Regexp, fold bool, priority int) *RegexpMatcher {
+	return &RegexpMatcher{
+		re:       re,
+		fold:     fold,
+		priority: priority,
+	}
+}
+
+// Match returns the normalized value of v
+func (m *RegexpMatcher) Match(v string) (string, bool) {
+	sm := m.re.FindStringSubmatch(v)
+	if sm == nil {
+		return "", false
+	}
+
+	nv := sm[0]
+	if len(sm) > 1 {
+		nv = sm[1]
+	}
+
+	if m.fold {
+		nv = strings.ToLower(nv)
+	}
+
+	return nv, true
+}
+
+// Priority returns the priority of the Matcher
+func (m *RegexpMatcher) Priority() int { return m.priority }
@@ -0,0 +1,97 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/provider"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegexpMatcher(t *testing.T) {
+	t.Run("Submatch", func(t *testing.T) {
+		m := provider.NewRegexpMatcher(regexp.MustCompile(`^(?:\d{12}/)?(sg-[0-9a-f]+)$`), false, 0)
+		nv, ok := m.Match("123456789012/sg-0a1b2c")
+		assert.True(t, ok)
+		assert.Equal(t, "sg-0a1b2c", nv)
+	})
+	t.Run("Match", func(t *testing.T) {
+		m := provider.NewRegexpMatcher(regexp.MustCompile(`^sg-[0-9a-f]+$`), false, 0)
+		nv, ok := m.Match("sg-0a1b2c")
+		assert.True(t, ok)
+		assert.Equal(t, "sg-0a1b2c", nv)
+	})
+	t.Run("Fold", func(t *testing.T) {
+		m := provider.NewRegexpMatcher(regexp.MustCompile(`(?i)^/subscriptions/.+$`), true, 0)
+		nv, ok := m.Match("/subscriptions/1/resourceGroups/RG")
+		assert.True(t, ok)
+		assert.Equal(t, "/subscriptions/1/resourcegroups/rg", nv)
+	})
+	t.Run("NoMatch", func(t *testing.T) {
+		m := provider.NewRegexpMatcher(regexp.MustCompile(`^sg-[0-9a-f]+$`), false, 0)
+		_, ok := m.Match("subnet-0a1b2c")
+		assert.False(t, ok)
+	})
+}
+
+func TestResolver(t *testing.T) {
+	t.Run("Exact", func(t *testing.T) {
+		r := provider.NewResolver()
+		r.Add("sg-1", "${aws_security_group.a.id}")
+
+		a, ok := r.Resolve("sg-1")
+		assert.True(t, ok)
+		assert.Equal(t, "${aws_security_group.a.id}", a)
+
+		_, ok = r.Resolve("123456789012/sg-1")
+		assert.False(t, ok)
+	})
+	t.Run("Matcher", func(t *testing.T) {
+		r := provider.NewResolver(provider.NewRegexpMatcher(regexp.MustCompile(`^(?:\d{12}/)?(sg-[0-9a-f]+)$`), false, 0))
+		r.Add("sg-1", "${aws_security_group.a.id}")
+
+		a, ok := r.Resolve("123456789012/sg-1")
+		assert.True(t, ok)
+		assert.Equal(t, "${aws_security_group.a.id}", a)
+	})
+	t.Run("Priority", func(t *testing.T) {
+		// Both normalize the value to a different one, the
+		// one with the highest Priority has to be used
+		r := provider.NewResolver(
+			provider.NewRegexpMatcher(regexp.MustCompile(`^([a-z]+)-[0-9]+$`), false, 1),
+			provider.NewRegexpMatcher(regexp.MustCompile(`^[a-z]+-([0-9]+)$`), false, 2),
+		)
+		r.Add("a-1", "${type.a.id}")
+		r.Add("b-2", "${type.b.id}")
+
+		a, ok := r.Resolve("b-1")
+		assert.True(t, ok)
+		assert.Equal(t, "${type.a.id}", a)
+	})
+	t.Run("Interpolation", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			res  = mock.NewResource(ctrl)
+		)
+		defer ctrl.Finish()
+
+		r := provider.NewResolver(provider.NewRegexpMatcher(regexp.MustCompile(`^(?:\d{12}/)?(sg-[0-9a-f]+)$`), false, 0))
+		r.Add("sg-1", "${aws_security_group.a.id}")
+
+		res.EXPECT().InstanceState().Return(&terraform.InstanceState{
+			Attributes: map[string]string{
+				"ingress.0.security_groups.0": "123456789012/sg-1",
+				"ingress.0.security_groups.1": "sg-2",
+				"description":                 "",
+			},
+		})
+
+		assert.Equal(t, map[string]string{
+			"sg-1":              "${aws_security_group.a.id}",
+			"123456789012/sg-1": "${aws_security_group.a.id}",
+		}, r.Interpolation([]provider.Resource{res}))
+	})
+}
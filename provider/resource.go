@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"text/template"
 
 	"github.com/chr4/pwgen"
 	"github.com/cycloidio/terracognita/errcode"
@@ -116,6 +117,11 @@ type resource struct {
 	// and State
 	configName string
 
+	// tagsNormalization is the one of the filter
+	// used to Read, so the 'tags.Name' used
+	// as configName is found with it
+	tagsNormalization string
+
 	resourceInstanceObject *states.ResourceInstanceObject
 
 	client *GRPCClient
@@ -139,25 +145,29 @@ func NewResource(id, rt string, p Provider) Resource {
 		id:           id,
 		resourceType: rt,
 		provider:     p,
-		client:       NewGRPCClient(p.TFProvider()),
+		client:       newProviderGRPCClient(p),
 	}
 }
 
 func (r *resource) AttributesReference() ([]string, error) {
-	resourceFunc, ok := providerResources[r.provider.String()]
-	if !ok {
-		return nil, errors.New(fmt.Sprintf("provider %s is not supported", r.provider.String()))
-	}
-	res, err := resourceFunc(r.resourceType)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to extract provider attributes")
-	}
-	result := make([]string, len(res.Attributes))
-	for i, attribute := range res.Attributes {
-		result[i] = attribute.Name
+	// The Providers without documentation of the
+	// attributes, like the plugins, are referenced
+	// by the ID and the AttributeReferencer ones
+	result := make([]string, 0)
+	if resourceFunc, ok := providerResources[r.provider.String()]; ok {
+		res, err := resourceFunc(r.resourceType)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to extract provider attributes")
+		}
+		for _, attribute := range res.Attributes {
+			result = append(result, attribute.Name)
+		}
 	}
 	// add "id" to the exported attributes
 	result = append(result, "id")
+	if ar, ok := r.provider.(AttributeReferencer); ok {
+		result = append(result, ar.AttributesReference(r.resourceType)...)
+	}
 	return result, nil
 }
 
@@ -271,6 +281,7 @@ func (r *resource) Read(f *filter.Filter) error {
 	}
 
 	r.data = r.TFResource().Data(r.state)
+	r.tagsNormalization = f.TagsNormalization
 
 	// Some resources can not be filtered by tags,
 	// so we have to do it manually
@@ -281,9 +292,15 @@ func (r *resource) Read(f *filter.Filter) error {
 			continue
 		}
 
+		// The keys with a different casing only
+		// match if the tags are normalized
+		if v, ok := tag.Lookup(tag.GetTags(r.Provider().TagKey(), r.data), t.Name, f.TagsNormalization); ok && v == t.Value {
+			continue
+		}
+
 		// Check if the filter tag match any other tags found
 		// https://github.com/cycloidio/terracognita/issues/223
-		if v, ok := tag.GetOtherTags(r.Provider().String(), r.data, t); ok && v == t.Value {
+		if v, ok := tag.GetOtherTags(r.Provider().String(), r.data, t, f.TagsNormalization); ok && v == t.Value {
 			continue
 		}
 
@@ -327,14 +344,12 @@ func (r *resource) State(w writer.Writer) error {
 		// If it does not have any configName we will generate one
 		// and store it, so net time it'll use that one on any config
 		if r.configName == "" {
-			configName := tag.GetNameFromTag(r.provider.TagKey(), r.data, r.id)
-			if ok, err := w.Has(fmt.Sprintf("%s.%s", r.resourceType, configName)); err != nil {
+			configName, err := r.newConfigName(w)
+			if err != nil {
 				return err
-			} else if ok {
-				configName = pwgen.Alpha(5)
 			}
 
-			err := w.Write(fmt.Sprintf("%s.%s", r.resourceType, configName), r)
+			err = w.Write(fmt.Sprintf("%s.%s", r.resourceType, configName), r)
 			if err != nil {
 				return err
 			}
@@ -357,6 +372,19 @@ func (r *resource) State(w writer.Writer) error {
 func (r *resource) HCL(w writer.Writer) error {
 	cfg := mergeFullConfig(r.data, r.tfResource.Schema, "")
 
+	if rc, ok := r.provider.(ResourceConfigurer); ok {
+		err := rc.ConfigureResource(r, cfg)
+		if err != nil {
+			return errors.Wrapf(err, "could not configure the resource %s with id %s", r.resourceType, r.id)
+		}
+	}
+
+	// The Resources read with an aliased configuration
+	// of the Provider have to reference it
+	if al, ok := r.provider.(Aliaser); ok && al.Alias() != "" {
+		cfg["provider"] = fmt.Sprintf("${%s.%s}", r.provider.String(), al.Alias())
+	}
+
 	resourceFunc, ok := providerResources[r.provider.String()]
 	if !ok {
 		return errors.New(fmt.Sprintf("provider %s is not supported", r.provider.String()))
@@ -367,19 +395,21 @@ func (r *resource) HCL(w writer.Writer) error {
 		return errors.New(fmt.Sprintf("provider %s with resource %s is not supported on the docs", r.provider.String(), r.Type()))
 	}
 	// This will convert all Category into snake_case
-	cfg[writer.ResourceCategoryKey] = strings.ToLower(name.Delimit(tfdoc.Category, '_'))
+	category := strings.ToLower(name.Delimit(tfdoc.Category, '_'))
+	if c, ok := r.provider.(Categorizer); ok {
+		category = c.ResourceCategory(r, category)
+	}
+	cfg[writer.ResourceCategoryKey] = category
 
 	// If it does not have any configName we will generate one
 	// and store it, so net time it'll use that one on any config
 	if r.configName == "" {
-		configName := tag.GetNameFromTag(r.provider.TagKey(), r.data, r.id)
-		if ok, err := w.Has(fmt.Sprintf("%s.%s", r.resourceType, configName)); err != nil {
+		configName, err := r.newConfigName(w)
+		if err != nil {
 			return err
-		} else if ok {
-			configName = pwgen.Alpha(5)
 		}
 
-		err := w.Write(fmt.Sprintf("%s.%s", r.resourceType, configName), cfg)
+		err = w.Write(fmt.Sprintf("%s.%s", r.resourceType, configName), cfg)
 		if err != nil {
 			return err
 		}
@@ -395,6 +425,50 @@ func (r *resource) HCL(w writer.Writer) error {
 	return nil
 }
 
+// newConfigName returns a name for the Resource that is not already used on the w,
+// generated with the template of w if it's a writer.Namer with one or with the
+// 'Name' tag or the ID
+func (r *resource) newConfigName(w writer.Writer) (string, error) {
+	var nt *template.Template
+	if n, ok := w.(writer.Namer); ok {
+		nt = n.NameTemplate()
+	}
+	if nt != nil {
+		configName, err := ExecuteNameTemplate(nt, NameTemplateData{
+			ID:         r.id,
+			Type:       r.resourceType,
+			Name:       r.state.Attributes["name"],
+			Tags:       tag.GetTags(r.provider.TagKey(), r.data),
+			Attributes: r.state.Attributes,
+		})
+		if err != nil {
+			return "", err
+		}
+
+		// The names generated are suffixed with a number
+		// on collision so they are the same on each run
+		if base := configName; configName != "" {
+			for i := 2; ; i++ {
+				if ok, err := w.Has(fmt.Sprintf("%s.%s", r.resourceType, configName)); err != nil {
+					return "", err
+				} else if !ok {
+					return configName, nil
+				}
+				configName = fmt.Sprintf("%s_%d", base, i)
+			}
+		}
+	}
+
+	configName := tag.GetNameFromTag(r.provider.TagKey(), r.data, r.id, r.tagsNormalization)
+	if ok, err := w.Has(fmt.Sprintf("%s.%s", r.resourceType, configName)); err != nil {
+		return "", err
+	} else if ok {
+		configName = pwgen.Alpha(5)
+	}
+
+	return configName, nil
+}
+
 func (r *resource) InstanceInfo() *terraform.InstanceInfo {
 	return &terraform.InstanceInfo{
 		Id:   r.id,
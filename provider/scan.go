@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cycloidio/terracognita/filter"
+	"github.com/cycloidio/terracognita/tag"
+)
+
+// ScannedResource is the information of a Resource
+// discovered by Scan
+type ScannedResource struct {
+	Type   string            `json:"type"`
+	ID     string            `json:"id"`
+	Name   string            `json:"name,omitempty"`
+	Region string            `json:"region"`
+	Tags   map[string]string `json:"tags,omitempty"`
+}
+
+// Scan reads from the Provider p all the resources filtered by f, like Import does,
+// but without calculating the HCL or State, so it can be used to know what
+// would be imported
+func Scan(ctx context.Context, p Provider, f *filter.Filter, out io.Writer) ([]ScannedResource, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
+	types, typesWithIDs, err := filteredTypes(p, f)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintf(out, "Scanning with filters: %s", f)
+
+	types = skipBrokenTypes(p, f, types, out)
+
+	queue := make(chan readResource, importQueueSize)
+	done := make(chan struct{})
+	resc := make(chan readResult, 1)
+	go func() {
+		resc <- readResources(ctx, p, types, typesWithIDs, f, out, queue, done)
+	}()
+
+	scanned := make([]ScannedResource, 0)
+	for rr := range queue {
+		state := rr.resource.InstanceState()
+		if state == nil {
+			continue
+		}
+
+		sr := ScannedResource{
+			Type:   rr.resourceType,
+			ID:     state.ID,
+			Name:   state.Attributes["name"],
+			Region: p.Region(),
+			Tags:   make(map[string]string),
+		}
+
+		// The tags are flattened on the Attributes
+		// as 'TAGKEY.NAME' with the 'TAGKEY.%' as
+		// the number of tags
+		prefix := p.TagKey() + "."
+		for k, v := range state.Attributes {
+			if !strings.HasPrefix(k, prefix) || k == prefix+"%" {
+				continue
+			}
+			sr.Tags[tag.NormalizeKey(f.TagsNormalization, strings.TrimPrefix(k, prefix))] = v
+		}
+
+		if sr.Name == "" {
+			sr.Name, _ = tag.Lookup(sr.Tags, "Name", f.TagsNormalization)
+		}
+
+		scanned = append(scanned, sr)
+	}
+
+	rres := <-resc
+	if rres.err != nil {
+		return nil, rres.err
+	}
+
+	return scanned, nil
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sensitiveNames are the names, or suffixes after a '_', of the string
+// attributes that have secrets even if they are not Sensitive on the
+// schema of all the Providers (ex: 'admin_password', 'connection_string')
+var sensitiveNames = []string{
+	"password", "passphrase", "secret", "secret_key", "private_key",
+	"connection_string", "api_key", "auth_token",
+}
+
+// SecretMatcher is the interface that the Providers can implement to
+// redact, with the redact secrets option, the values of the map attributes
+// that are secrets but are not Sensitive on the schema, like the
+// environment variables or the app settings
+type SecretMatcher interface {
+	// IsSecret returns true if the value of the key of the
+	// map attribute attr of the resource type rt is a secret
+	IsSecret(rt, attr, key, value string) bool
+}
+
+// IsSensitive checks if the attribute attr with the schema s has a secret, that
+// is if it's Sensitive on the schema or a string named as one of the sensitiveNames
+func IsSensitive(attr string, s *schema.Schema) bool {
+	if s.Sensitive {
+		return true
+	}
+	if s.Type != schema.TypeString {
+		return false
+	}
+	for _, n := range sensitiveNames {
+		if attr == n || strings.HasSuffix(attr, "_"+n) {
+			return true
+		}
+	}
+	return false
+}
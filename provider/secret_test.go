@@ -0,0 +1,33 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+func TestIsSensitive(t *testing.T) {
+	tests := []struct {
+		name     string
+		attr     string
+		schema   *schema.Schema
+		expected bool
+	}{
+		{name: "SensitiveSchema", attr: "value", schema: &schema.Schema{Type: schema.TypeString, Sensitive: true}, expected: true},
+		{name: "Name", attr: "password", schema: &schema.Schema{Type: schema.TypeString}, expected: true},
+		{name: "Suffix", attr: "admin_password", schema: &schema.Schema{Type: schema.TypeString}, expected: true},
+		{name: "ConnectionString", attr: "primary_connection_string", schema: &schema.Schema{Type: schema.TypeString}, expected: true},
+		{name: "NotSuffix", attr: "password_reset_required", schema: &schema.Schema{Type: schema.TypeString}, expected: false},
+		{name: "NotString", attr: "require_password", schema: &schema.Schema{Type: schema.TypeBool}, expected: false},
+		{name: "Other", attr: "key_name", schema: &schema.Schema{Type: schema.TypeString}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, provider.IsSensitive(tt.attr, tt.schema))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// slowestOperationsLen is the number of
+// operations reported as the slowest ones
+const slowestOperationsLen = 5
+
+// APIStats are the statistics of the calls done
+// to an operation of the API of the Provider
+type APIStats struct {
+	Service   string
+	Operation string
+
+	Calls     int
+	Retries   int
+	Throttles int
+
+	// Duration is the total duration of the calls
+	// and MaxDuration the one of the slowest call
+	Duration    time.Duration
+	MaxDuration time.Duration
+}
+
+// APIStatser is the interface that the Providers can implement
+// to report the statistics of the calls done to their API, so the
+// throttling can be diagnosed
+type APIStatser interface {
+	// APIStats returns the statistics of
+	// each operation called
+	APIStats() []APIStats
+}
+
+// writeAPIStats writes to out the calls, retries and throttles
+// of each service of the stats and the slowest operations
+func writeAPIStats(out io.Writer, stats []APIStats) {
+	if len(stats) == 0 {
+		return
+	}
+
+	services := make(map[string]*APIStats)
+	names := make([]string, 0)
+	for _, st := range stats {
+		s, ok := services[st.Service]
+		if !ok {
+			s = &APIStats{Service: st.Service}
+			services[st.Service] = s
+			names = append(names, st.Service)
+		}
+		s.Calls += st.Calls
+		s.Retries += st.Retries
+		s.Throttles += st.Throttles
+		s.Duration += st.Duration
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(out, "API calls:\n")
+	for _, n := range names {
+		s := services[n]
+		fmt.Fprintf(out, "\t%s: %d calls, %d retries, %d throttled (%s)\n", s.Service, s.Calls, s.Retries, s.Throttles, s.Duration.Round(time.Millisecond))
+	}
+
+	slowest := make([]APIStats, len(stats))
+	copy(slowest, stats)
+	sort.SliceStable(slowest, func(i, j int) bool {
+		return slowest[i].MaxDuration > slowest[j].MaxDuration
+	})
+	if len(slowest) > slowestOperationsLen {
+		slowest = slowest[:slowestOperationsLen]
+	}
+
+	fmt.Fprintf(out, "Slowest API operations:\n")
+	for _, s := range slowest {
+		fmt.Fprintf(out, "\t%s.%s: %s (%d calls)\n", s.Service, s.Operation, s.MaxDuration.Round(time.Millisecond), s.Calls)
+	}
+}
@@ -0,0 +1,9 @@
+package provider
+
+// Versioner is the interface that the Providers can implement to pin on the
+// 'required_providers' of the HCL the version of the Terraform Provider used
+// to read the Resources, so the HCL is planned with the same schema
+type Versioner interface {
+	// Version returns the version of the Terraform Provider
+	Version() string
+}
@@ -0,0 +1,264 @@
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	tfschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// RegistryURL is the URL of the Terraform Registry
+// used to check the latest versions of the Providers
+const RegistryURL = "https://registry.terraform.io"
+
+// List of all the reasons of a Drift
+const (
+	// DriftNotFound means that the resource type
+	// is no longer on the latest Provider
+	DriftNotFound = "not found"
+
+	// DriftDeprecated means that the resource type
+	// is deprecated on the latest Provider and may be removed
+	DriftDeprecated = "deprecated"
+
+	// DriftRemovedAttributes means that some attributes
+	// of the resource type are no longer on the latest Provider
+	DriftRemovedAttributes = "removed attributes"
+
+	// DriftRequiredAttributes means that some attributes
+	// of the resource type are required on the latest Provider
+	// but are not on the supported one
+	DriftRequiredAttributes = "required attributes"
+)
+
+// Drift is a supported resource type which schema
+// on the latest Provider is not the one expected
+type Drift struct {
+	ResourceType string
+
+	// Reason is one of the Drift* reasons
+	Reason string
+
+	// Message has the details of the Reason if any,
+	// like the attributes
+	Message string
+}
+
+// String returns the string representation of the Drift
+func (d Drift) String() string {
+	if d.Message != "" {
+		return fmt.Sprintf("%s: %s (%s)", d.ResourceType, d.Reason, d.Message)
+	}
+	return fmt.Sprintf("%s: %s", d.ResourceType, d.Reason)
+}
+
+// ProviderSchema is the schema of a Provider with the
+// format of 'terraform providers schema -json'
+type ProviderSchema struct {
+	ResourceSchemas map[string]ResourceSchema `json:"resource_schemas"`
+}
+
+// ResourceSchema is the schema of a resource type
+type ResourceSchema struct {
+	Block Block `json:"block"`
+}
+
+// Block is a block of a ResourceSchema with the
+// attributes and the nested blocks
+type Block struct {
+	Attributes map[string]Attribute `json:"attributes"`
+	BlockTypes map[string]BlockType `json:"block_types"`
+	Deprecated bool                 `json:"deprecated"`
+}
+
+// Attribute is an attribute of a Block
+type Attribute struct {
+	Required bool `json:"required"`
+	Optional bool `json:"optional"`
+	Computed bool `json:"computed"`
+}
+
+// BlockType is a nested Block
+type BlockType struct {
+	Block    Block `json:"block"`
+	MinItems int   `json:"min_items"`
+}
+
+// ReadProviderSchema reads the output of 'terraform providers schema -json'
+// from r and returns the schema of the Provider with the source (ex: hashicorp/aws)
+func ReadProviderSchema(r io.Reader, source string) (*ProviderSchema, error) {
+	var body struct {
+		ProviderSchemas map[string]*ProviderSchema `json:"provider_schemas"`
+	}
+	err := json.NewDecoder(r).Decode(&body)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid providers schema")
+	}
+
+	// The keys are the full address of the Provider which
+	// has the registry host, ex: registry.terraform.io/hashicorp/aws
+	for addr, ps := range body.ProviderSchemas {
+		if addr == source || strings.HasSuffix(addr, "/"+source) {
+			return ps, nil
+		}
+	}
+
+	return nil, errors.Errorf("the provider %q is not on the providers schema", source)
+}
+
+// FetchProviderSchema initializes on a temporary directory the Provider with the
+// source and version using the Terraform binary bin (terraform or tofu), which
+// downloads it from the registry, and returns its schema
+func FetchProviderSchema(ctx context.Context, bin, source, version string) (*ProviderSchema, error) {
+	dir, err := ioutil.TempDir("", "terracognita-schema")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	name := source[strings.LastIndex(source, "/")+1:]
+	cfg := fmt.Sprintf("terraform {\n  required_providers {\n    %s = {\n      source  = %q\n      version = %q\n    }\n  }\n}\n", name, source, version)
+	err = ioutil.WriteFile(filepath.Join(dir, "main.tf"), []byte(cfg), 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, bin, "init", "-backend=false", "-input=false", "-no-color")
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not initialize the provider %q %s: %s", source, version, stderr.String())
+	}
+
+	var stdout bytes.Buffer
+	stderr.Reset()
+	cmd = exec.CommandContext(ctx, bin, "providers", "schema", "-json")
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get the schema of the provider %q %s: %s", source, version, stderr.String())
+	}
+
+	return ReadProviderSchema(&stdout, source)
+}
+
+// Drifts compares the resource types rts on the schema of the supported
+// Provider tfp against the schema of the latest Provider and returns
+// the ones that drifted sorted by resource type
+func Drifts(tfp *tfschema.Provider, latest *ProviderSchema, rts []string) []Drift {
+	drifts := make([]Drift, 0)
+	for _, rt := range rts {
+		lrs, ok := latest.ResourceSchemas[rt]
+		if !ok {
+			drifts = append(drifts, Drift{ResourceType: rt, Reason: DriftNotFound})
+			continue
+		}
+		if lrs.Block.Deprecated {
+			drifts = append(drifts, Drift{ResourceType: rt, Reason: DriftDeprecated})
+		}
+
+		r, ok := tfp.ResourcesMap[rt]
+		if !ok {
+			continue
+		}
+
+		current := make(map[string]bool)
+		currentAttributes(r.Schema, "", current)
+		next := make(map[string]bool)
+		latestAttributes(lrs.Block, "", next)
+
+		var removed, required []string
+		for k := range current {
+			if _, ok := next[k]; !ok {
+				removed = append(removed, k)
+			}
+		}
+		for k, req := range next {
+			if req && !current[k] {
+				required = append(required, k)
+			}
+		}
+
+		if len(removed) != 0 {
+			sort.Strings(removed)
+			drifts = append(drifts, Drift{ResourceType: rt, Reason: DriftRemovedAttributes, Message: strings.Join(removed, ", ")})
+		}
+		if len(required) != 0 {
+			sort.Strings(required)
+			drifts = append(drifts, Drift{ResourceType: rt, Reason: DriftRequiredAttributes, Message: strings.Join(required, ", ")})
+		}
+	}
+
+	sort.SliceStable(drifts, func(i, j int) bool { return drifts[i].ResourceType < drifts[j].ResourceType })
+
+	return drifts
+}
+
+// currentAttributes sets on attrs the path of all the attributes,
+// and nested blocks, of the sch with if they are required
+func currentAttributes(sch map[string]*tfschema.Schema, prefix string, attrs map[string]bool) {
+	for k, s := range sch {
+		key := prefix + k
+		attrs[key] = s.Required || s.MinItems > 0
+		if r, ok := s.Elem.(*tfschema.Resource); ok {
+			currentAttributes(r.Schema, key+".", attrs)
+		}
+	}
+}
+
+// latestAttributes sets on attrs the path of all the attributes,
+// and nested blocks, of the b with if they are required
+func latestAttributes(b Block, prefix string, attrs map[string]bool) {
+	for k, a := range b.Attributes {
+		attrs[prefix+k] = a.Required
+	}
+	for k, bt := range b.BlockTypes {
+		key := prefix + k
+		attrs[key] = bt.MinItems > 0
+		latestAttributes(bt.Block, key+".", attrs)
+	}
+}
+
+// LatestVersion returns the latest version of the Provider with
+// the source (ex: hashicorp/aws) published on the registry
+func LatestVersion(ctx context.Context, c *http.Client, registry, source string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/providers/%s", registry, source), nil)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not get the provider %q from the registry", source)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", errors.Errorf("could not get the provider %q from the registry, status code %d", source, res.StatusCode)
+	}
+
+	var body struct {
+		Version string `json:"version"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid response of the registry for the provider %q", source)
+	}
+
+	return body.Version, nil
+}
@@ -0,0 +1,117 @@
+package schema_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cycloidio/terracognita/schema"
+	tfschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrifts(t *testing.T) {
+	tfp := &tfschema.Provider{
+		ResourcesMap: map[string]*tfschema.Resource{
+			"aws_instance": &tfschema.Resource{
+				Schema: map[string]*tfschema.Schema{
+					"ami":       &tfschema.Schema{Type: tfschema.TypeString, Required: true},
+					"cpu_count": &tfschema.Schema{Type: tfschema.TypeInt, Optional: true},
+					"ebs_block_device": &tfschema.Schema{
+						Type:     tfschema.TypeSet,
+						Optional: true,
+						Elem: &tfschema.Resource{
+							Schema: map[string]*tfschema.Schema{
+								"iops": &tfschema.Schema{Type: tfschema.TypeInt, Optional: true},
+							},
+						},
+					},
+				},
+			},
+			"aws_vpc": &tfschema.Resource{
+				Schema: map[string]*tfschema.Schema{
+					"cidr_block": &tfschema.Schema{Type: tfschema.TypeString, Optional: true},
+				},
+			},
+		},
+	}
+
+	latest, err := schema.ReadProviderSchema(strings.NewReader(`{
+		"format_version": "1.0",
+		"provider_schemas": {
+			"registry.terraform.io/hashicorp/aws": {
+				"resource_schemas": {
+					"aws_instance": {
+						"block": {
+							"attributes": {
+								"ami": {"type": "string", "required": true},
+								"instance_type": {"type": "string", "required": true}
+							},
+							"block_types": {
+								"ebs_block_device": {
+									"nesting_mode": "set",
+									"block": {
+										"attributes": {
+											"throughput": {"type": "number", "optional": true}
+										}
+									}
+								}
+							}
+						}
+					},
+					"aws_vpc": {
+						"block": {
+							"attributes": {
+								"cidr_block": {"type": "string", "optional": true}
+							},
+							"deprecated": true
+						}
+					}
+				}
+			}
+		}
+	}`), "hashicorp/aws")
+	require.NoError(t, err)
+
+	drifts := schema.Drifts(tfp, latest, []string{"aws_vpc", "aws_instance", "aws_alb"})
+	assert.Equal(t, []schema.Drift{
+		schema.Drift{ResourceType: "aws_alb", Reason: schema.DriftNotFound},
+		schema.Drift{ResourceType: "aws_instance", Reason: schema.DriftRemovedAttributes, Message: "cpu_count, ebs_block_device.iops"},
+		schema.Drift{ResourceType: "aws_instance", Reason: schema.DriftRequiredAttributes, Message: "instance_type"},
+		schema.Drift{ResourceType: "aws_vpc", Reason: schema.DriftDeprecated},
+	}, drifts)
+}
+
+func TestReadProviderSchema(t *testing.T) {
+	t.Run("ErrorNotFound", func(t *testing.T) {
+		_, err := schema.ReadProviderSchema(strings.NewReader(`{"provider_schemas": {"registry.terraform.io/hashicorp/google": {}}}`), "hashicorp/aws")
+		assert.Error(t, err)
+	})
+}
+
+func TestLatestVersion(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/v1/providers/hashicorp/aws", r.URL.Path)
+			fmt.Fprint(w, `{"namespace":"hashicorp","name":"aws","version":"4.30.0"}`)
+		}))
+		defer ts.Close()
+
+		v, err := schema.LatestVersion(context.Background(), ts.Client(), ts.URL, "hashicorp/aws")
+		require.NoError(t, err)
+		assert.Equal(t, "4.30.0", v)
+	})
+	t.Run("ErrorStatusCode", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer ts.Close()
+
+		_, err := schema.LatestVersion(context.Background(), ts.Client(), ts.URL, "hashicorp/aws")
+		assert.Error(t, err)
+	})
+}
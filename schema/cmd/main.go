@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+
+	"github.com/cycloidio/terracognita/aws"
+	"github.com/cycloidio/terracognita/azurerm"
+	"github.com/cycloidio/terracognita/google"
+	"github.com/cycloidio/terracognita/schema"
+	"github.com/cycloidio/terracognita/vsphere"
+)
+
+// This generator writes the schema.Schema with the current ResourceTypes
+// of each Provider, increasing the version if they have changed
+func main() {
+	output := flag.String("output", "", "The destination of the generated schema")
+	flag.Parse()
+
+	if *output == "" {
+		fmt.Println("The -output is required")
+		os.Exit(1)
+	}
+
+	s := schema.Schema{
+		Providers: map[string][]string{
+			"aws":     aws.ResourceTypeStrings(),
+			"azurerm": azurerm.ResourceTypeStrings(),
+			"google":  google.ResourceTypeStrings(),
+			"vsphere": vsphere.ResourceTypeStrings(),
+		},
+	}
+
+	var current schema.Schema
+	b, err := ioutil.ReadFile(*output)
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	if err == nil {
+		if err := json.Unmarshal(b, &current); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+
+	s.Version = current.Version
+	if !reflect.DeepEqual(s.Providers, current.Providers) {
+		s.Version++
+	}
+
+	b, err = json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	err = ioutil.WriteFile(*output, append(b, '\n'), 0644)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
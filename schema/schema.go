@@ -0,0 +1,66 @@
+// Package schema has a versioned snapshot of the Resources supported
+// by each Provider, embedded on the binary from the resources.json
+// so it can be introspected and compared between releases.
+//
+// The snapshot is only used for introspection, the source of truth are
+// the ResourceTypes of each Provider: the resources.json is generated from
+// them with 'go generate' and the Version is increased each time the list
+// changes, it must not be edited by hand.
+package schema
+
+import (
+	_ "embed"
+	"encoding/json"
+	"sort"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/pkg/errors"
+)
+
+//go:generate go run ./cmd -output resources.json
+
+//go:embed resources.json
+var resources []byte
+
+// Schema is the list of Resources supported by each one of the Providers
+type Schema struct {
+	// Version is increased each time the list
+	// of Resources of any Provider changes
+	Version int `json:"version"`
+
+	// Providers has the Provider name as key
+	// and the supported Resources as values
+	Providers map[string][]string `json:"providers"`
+}
+
+// Get returns the embedded Schema
+func Get() (*Schema, error) {
+	var s Schema
+	err := json.Unmarshal(resources, &s)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid embedded schema")
+	}
+
+	return &s, nil
+}
+
+// ProviderNames returns the sorted list of the Providers on the Schema
+func (s *Schema) ProviderNames() []string {
+	names := make([]string, 0, len(s.Providers))
+	for n := range s.Providers {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Resources returns the Resources supported by the Provider p
+func (s *Schema) Resources(p string) ([]string, error) {
+	rs, ok := s.Providers[p]
+	if !ok {
+		return nil, errors.Wrapf(errcode.ErrSchemaProviderNotFound, "with name %q", p)
+	}
+
+	return rs, nil
+}
@@ -0,0 +1,47 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/cycloidio/terracognita/aws"
+	"github.com/cycloidio/terracognita/azurerm"
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/google"
+	"github.com/cycloidio/terracognita/schema"
+	"github.com/cycloidio/terracognita/vsphere"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	s, err := schema.Get()
+	require.NoError(t, err)
+
+	assert.NotZero(t, s.Version)
+	assert.Equal(t, []string{"aws", "azurerm", "google", "vsphere"}, s.ProviderNames())
+
+	// If this fails run 'go generate ./schema'
+	// to update the resources.json
+	t.Run("UpToDate", func(t *testing.T) {
+		for p, rs := range map[string][]string{
+			"aws":     aws.ResourceTypeStrings(),
+			"azurerm": azurerm.ResourceTypeStrings(),
+			"google":  google.ResourceTypeStrings(),
+			"vsphere": vsphere.ResourceTypeStrings(),
+		} {
+			srs, err := s.Resources(p)
+			require.NoError(t, err)
+			assert.Equal(t, rs, srs, p)
+		}
+	})
+}
+
+func TestSchema_Resources(t *testing.T) {
+	t.Run("ErrProviderNotFound", func(t *testing.T) {
+		s := schema.Schema{Providers: map[string][]string{"aws": []string{"aws_instance"}}}
+
+		_, err := s.Resources("google")
+		assert.Equal(t, errcode.ErrSchemaProviderNotFound, errors.Cause(err))
+	})
+}
@@ -0,0 +1,166 @@
+package state
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+)
+
+// DriftResult is the difference between a TFState
+// and the resources currently on the Provider
+type DriftResult struct {
+	// Changed are the resources which attributes
+	// are different from the ones on the TFState
+	Changed []DriftChange `json:"changed"`
+
+	// Missing are the addresses of the resources of
+	// the TFState that are no longer on the Provider
+	Missing []string `json:"missing"`
+
+	// Unmanaged are the addresses of the resources
+	// on the Provider that are not on the TFState
+	Unmanaged []string `json:"unmanaged"`
+}
+
+// DriftChange is a resource of the TFState which
+// attributes have changed on the Provider
+type DriftChange struct {
+	Address string `json:"address"`
+
+	// Attributes are the names of the
+	// top level attributes changed
+	Attributes []string `json:"attributes"`
+}
+
+// HasDrift checks if there is any difference
+func (d DriftResult) HasDrift() bool {
+	return len(d.Changed) != 0 || len(d.Missing) != 0 || len(d.Unmanaged) != 0
+}
+
+// Drift compares the resources of the current TFState, read from the Provider,
+// with the ones of the prev, matching them by the provider, the type and the ID.
+// The resources of the prev for which inScope returns false are not reported as
+// Missing, as they were not expected to be read (ex: the types excluded)
+func Drift(prev, current *statefile.File, inScope func(resourceType string) bool) DriftResult {
+	res := DriftResult{
+		Changed:   make([]DriftChange, 0),
+		Missing:   make([]string, 0),
+		Unmanaged: make([]string, 0),
+	}
+
+	currentIdx := indexInstances(current)
+	prevIdx := indexInstances(prev)
+
+	for k, pi := range prevIdx {
+		ci, ok := currentIdx[k]
+		if !ok {
+			if inScope == nil || inScope(pi.resourceType) {
+				res.Missing = append(res.Missing, pi.address)
+			}
+			continue
+		}
+
+		if attrs := changedAttributes(pi.attributes, ci.attributes); len(attrs) != 0 {
+			res.Changed = append(res.Changed, DriftChange{
+				Address:    pi.address,
+				Attributes: attrs,
+			})
+		}
+	}
+
+	for k, ci := range currentIdx {
+		if _, ok := prevIdx[k]; !ok {
+			res.Unmanaged = append(res.Unmanaged, ci.address)
+		}
+	}
+
+	sort.Slice(res.Changed, func(i, j int) bool { return res.Changed[i].Address < res.Changed[j].Address })
+	sort.Strings(res.Missing)
+	sort.Strings(res.Unmanaged)
+
+	return res
+}
+
+// driftInstance is a resource instance with
+// the information needed to compare it
+type driftInstance struct {
+	address      string
+	resourceType string
+	attributes   map[string]interface{}
+}
+
+// indexInstances returns the managed resource instances of the f
+// with ID indexed by the provider, the type and the ID
+func indexInstances(f *statefile.File) map[string]driftInstance {
+	idx := make(map[string]driftInstance)
+	if f == nil || f.State == nil {
+		return idx
+	}
+
+	for _, ms := range f.State.Modules {
+		for _, rs := range ms.Resources {
+			// The data sources are not
+			// managed by the TFState
+			if rs.Addr.Resource.Mode != addrs.ManagedResourceMode {
+				continue
+			}
+			for k, is := range rs.Instances {
+				id, ok := instanceID(is)
+				if !ok {
+					continue
+				}
+				idx[resourceKey(rs, id)] = driftInstance{
+					address:      rs.Addr.Instance(k).String(),
+					resourceType: rs.Addr.Resource.Type,
+					attributes:   instanceAttributes(is),
+				}
+			}
+		}
+	}
+
+	return idx
+}
+
+// instanceAttributes returns the attributes
+// of the current object of the is
+func instanceAttributes(is *states.ResourceInstance) map[string]interface{} {
+	attrs := make(map[string]interface{})
+	if is.Current.AttrsJSON != nil {
+		// If it can not be decoded all the
+		// attributes are compared as empty
+		_ = json.Unmarshal(is.Current.AttrsJSON, &attrs)
+		return attrs
+	}
+
+	for k, v := range is.Current.AttrsFlat {
+		attrs[k] = v
+	}
+	return attrs
+}
+
+// changedAttributes returns the sorted names of the attributes that have
+// different values on prev and current, the ones that are not set on one
+// of them are considered as null
+func changedAttributes(prev, current map[string]interface{}) []string {
+	keys := make(map[string]struct{}, len(prev))
+	for k := range prev {
+		keys[k] = struct{}{}
+	}
+	for k := range current {
+		keys[k] = struct{}{}
+	}
+
+	res := make([]string, 0)
+	for k := range keys {
+		if !reflect.DeepEqual(prev[k], current[k]) {
+			res = append(res, k)
+		}
+	}
+	sort.Strings(res)
+
+	return res
+}
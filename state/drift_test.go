@@ -0,0 +1,72 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cycloidio/terracognita/state"
+)
+
+func newStateFileWithAttrs(resources map[string]string) *statefile.File {
+	s := states.NewState()
+	for addr, attrs := range resources {
+		ra, diags := addrs.ParseAbsResourceInstanceStr(addr)
+		if diags.HasErrors() {
+			panic(diags.Err())
+		}
+		s.EnsureModule(addrs.RootModuleInstance).SetResourceInstanceCurrent(ra.Resource, &states.ResourceInstanceObjectSrc{
+			AttrsJSON: []byte(attrs),
+			Status:    states.ObjectReady,
+		}, addrs.AbsProviderConfig{
+			Module:   addrs.RootModule,
+			Provider: addrs.NewDefaultProvider("aws"),
+		})
+	}
+	f := statemgr.NewStateFile()
+	f.State = s
+	return f
+}
+
+func TestDrift(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		prev := newStateFileWithAttrs(map[string]string{
+			"aws_vpc.main":       `{"id":"vpc-1","cidr_block":"10.0.0.0/16","tags":{"Name":"main"}}`,
+			"aws_subnet.private": `{"id":"subnet-1","cidr_block":"10.0.1.0/24"}`,
+			"aws_subnet.deleted": `{"id":"subnet-2","cidr_block":"10.0.2.0/24"}`,
+			"aws_instance.other": `{"id":"i-1"}`,
+		})
+		current := newStateFileWithAttrs(map[string]string{
+			"aws_vpc.vpc_1":       `{"id":"vpc-1","cidr_block":"10.0.0.0/16","tags":{"Name":"changed"}}`,
+			"aws_subnet.subnet_1": `{"id":"subnet-1","cidr_block":"10.0.1.0/24"}`,
+			"aws_subnet.subnet_3": `{"id":"subnet-3","cidr_block":"10.0.3.0/24"}`,
+		})
+
+		res := state.Drift(prev, current, func(rt string) bool { return rt != "aws_instance" })
+
+		assert.Equal(t, state.DriftResult{
+			Changed: []state.DriftChange{
+				{Address: "aws_vpc.main", Attributes: []string{"tags"}},
+			},
+			Missing:   []string{"aws_subnet.deleted"},
+			Unmanaged: []string{"aws_subnet.subnet_3"},
+		}, res)
+		assert.True(t, res.HasDrift())
+	})
+	t.Run("NoDrift", func(t *testing.T) {
+		prev := newStateFileWithAttrs(map[string]string{
+			"aws_vpc.main": `{"id":"vpc-1","cidr_block":"10.0.0.0/16"}`,
+		})
+		current := newStateFileWithAttrs(map[string]string{
+			"aws_vpc.vpc_1": `{"id":"vpc-1","cidr_block":"10.0.0.0/16"}`,
+		})
+
+		res := state.Drift(prev, current, nil)
+
+		assert.False(t, res.HasDrift())
+	})
+}
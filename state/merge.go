@@ -0,0 +1,131 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/pkg/errors"
+)
+
+// MergeResult is the result of merging a TFState into another one
+type MergeResult struct {
+	// Added are the addresses of the resources added
+	Added []string
+
+	// Skipped are the addresses of the resources
+	// already present, with the same provider, type and ID
+	Skipped []string
+
+	// Conflicts are the addresses of the resources that
+	// are already used by another resource, which are
+	// not added so they are not clobbered
+	Conflicts []string
+}
+
+// Merge adds to the dst the resources of the src that are not already on it,
+// matching them by the provider, the type and the ID, so the resources of
+// the dst are never modified. The lineage of the dst is kept and the serial
+// is incremented if any resource has been added
+func Merge(dst, src *statefile.File) MergeResult {
+	var res MergeResult
+
+	existing := make(map[string]struct{})
+	for _, ms := range dst.State.Modules {
+		for _, rs := range ms.Resources {
+			for _, is := range rs.Instances {
+				if id, ok := instanceID(is); ok {
+					existing[resourceKey(rs, id)] = struct{}{}
+				}
+			}
+		}
+	}
+
+	for _, ms := range src.State.Modules {
+		for _, rs := range ms.Resources {
+			for k, is := range rs.Instances {
+				if is.Current == nil {
+					continue
+				}
+				addr := rs.Addr.Instance(k)
+				if id, ok := instanceID(is); ok {
+					if _, ok := existing[resourceKey(rs, id)]; ok {
+						res.Skipped = append(res.Skipped, addr.String())
+						continue
+					}
+				}
+
+				if dst.State.ResourceInstance(addr) != nil {
+					res.Conflicts = append(res.Conflicts, addr.String())
+					continue
+				}
+
+				dst.State.EnsureModule(ms.Addr).SetResourceInstanceCurrent(addr.Resource, is.Current, rs.ProviderConfig)
+				res.Added = append(res.Added, addr.String())
+			}
+		}
+	}
+
+	if len(res.Added) != 0 {
+		dst.Serial++
+	}
+
+	sort.Strings(res.Added)
+	sort.Strings(res.Skipped)
+	sort.Strings(res.Conflicts)
+
+	return res
+}
+
+// MergeFile reads the TFState of src and merges it into the one of dst, if
+// the dst is nil (no TFState yet) the src is returned as it is
+func MergeFile(dst *statefile.File, src io.Reader) (*statefile.File, MergeResult, error) {
+	sf, err := statefile.Read(src)
+	if err != nil {
+		return nil, MergeResult{}, errors.Wrap(err, "unable to read the TFState generated")
+	}
+
+	if dst == nil {
+		var res MergeResult
+		for _, ms := range sf.State.Modules {
+			for _, rs := range ms.Resources {
+				for k := range rs.Instances {
+					res.Added = append(res.Added, rs.Addr.Instance(k).String())
+				}
+			}
+		}
+		sort.Strings(res.Added)
+		return sf, res, nil
+	}
+
+	return dst, Merge(dst, sf), nil
+}
+
+// resourceKey returns the key used to match
+// the resource rs with the id
+func resourceKey(rs *states.Resource, id string) string {
+	return fmt.Sprintf("%s/%s/%s", rs.ProviderConfig.Provider.Type, rs.Addr.Resource.Type, id)
+}
+
+// instanceID returns the ID of the current object of the is
+func instanceID(is *states.ResourceInstance) (string, bool) {
+	if is.Current == nil {
+		return "", false
+	}
+
+	if is.Current.AttrsJSON != nil {
+		var attrs struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(is.Current.AttrsJSON, &attrs); err != nil || attrs.ID == "" {
+			return "", false
+		}
+		return attrs.ID, true
+	}
+
+	id, ok := is.Current.AttrsFlat["id"]
+	return id, ok && id != ""
+}
@@ -0,0 +1,86 @@
+package state_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hashicorp/terraform/addrs"
+	"github.com/hashicorp/terraform/states"
+	"github.com/hashicorp/terraform/states/statefile"
+	"github.com/hashicorp/terraform/states/statemgr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/state"
+)
+
+func newStateFile(serial uint64, resources map[string]string) *statefile.File {
+	s := states.NewState()
+	for addr, id := range resources {
+		ra, diags := addrs.ParseAbsResourceInstanceStr(addr)
+		if diags.HasErrors() {
+			panic(diags.Err())
+		}
+		s.EnsureModule(addrs.RootModuleInstance).SetResourceInstanceCurrent(ra.Resource, &states.ResourceInstanceObjectSrc{
+			AttrsJSON: []byte(`{"id":"` + id + `"}`),
+			Status:    states.ObjectReady,
+		}, addrs.AbsProviderConfig{
+			Module:   addrs.RootModule,
+			Provider: addrs.NewDefaultProvider("aws"),
+		})
+	}
+	f := statemgr.NewStateFile()
+	f.Serial = serial
+	f.Lineage = "lineage"
+	f.State = s
+	return f
+}
+
+func TestMerge(t *testing.T) {
+	dst := newStateFile(3, map[string]string{
+		"aws_vpc.main":       "vpc-1",
+		"aws_subnet.private": "subnet-user",
+	})
+	src := newStateFile(0, map[string]string{
+		"aws_vpc.imported":   "vpc-1",
+		"aws_subnet.private": "subnet-1",
+		"aws_subnet.public":  "subnet-2",
+	})
+
+	res := state.Merge(dst, src)
+
+	assert.Equal(t, state.MergeResult{
+		Added:     []string{"aws_subnet.public"},
+		Skipped:   []string{"aws_vpc.imported"},
+		Conflicts: []string{"aws_subnet.private"},
+	}, res)
+	assert.Equal(t, uint64(4), dst.Serial)
+	assert.Equal(t, "lineage", dst.Lineage)
+	assert.Len(t, dst.State.RootModule().Resources, 3)
+	assert.JSONEq(t, `{"id":"subnet-user"}`, string(dst.State.RootModule().Resources["aws_subnet.private"].Instances[addrs.NoKey].Current.AttrsJSON))
+}
+
+func TestMergeFile(t *testing.T) {
+	t.Run("NoState", func(t *testing.T) {
+		var b bytes.Buffer
+		require.NoError(t, statefile.Write(newStateFile(1, map[string]string{"aws_vpc.main": "vpc-1"}), &b))
+
+		sf, res, err := state.MergeFile(nil, &b)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"aws_vpc.main"}, res.Added)
+		assert.Equal(t, uint64(1), sf.Serial)
+	})
+	t.Run("State", func(t *testing.T) {
+		var b bytes.Buffer
+		require.NoError(t, statefile.Write(newStateFile(1, map[string]string{"aws_subnet.private": "subnet-1"}), &b))
+
+		dst := newStateFile(7, map[string]string{"aws_vpc.main": "vpc-1"})
+		sf, res, err := state.MergeFile(dst, &b)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"aws_subnet.private"}, res.Added)
+		assert.Equal(t, uint64(8), sf.Serial)
+		assert.Equal(t, "lineage", sf.Lineage)
+	})
+}
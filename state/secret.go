@@ -0,0 +1,60 @@
+package state
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/cycloidio/terracognita/provider"
+)
+
+// redactSecrets returns the v with the values of the sensitive attributes of
+// the sch of the resource type rt, and the values of the maps matched by the
+// sm (if any), set to null so they are not written on the TFState
+func redactSecrets(v cty.Value, sch map[string]*schema.Schema, sm provider.SecretMatcher, rt string) (cty.Value, error) {
+	return cty.Transform(v, func(p cty.Path, v cty.Value) (cty.Value, error) {
+		if v.IsNull() || !v.IsKnown() || !isSecret(p, v, sch, sm, rt) {
+			return v, nil
+		}
+		return cty.NullVal(v.Type()), nil
+	})
+}
+
+// isSecret checks if the value v on the path p
+// is a secret following the sch of the resource type rt
+func isSecret(p cty.Path, v cty.Value, sch map[string]*schema.Schema, sm provider.SecretMatcher, rt string) bool {
+	var (
+		as   *schema.Schema
+		attr string
+	)
+	for i, step := range p {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			if sch == nil {
+				return false
+			}
+			attr = s.Name
+			as = sch[attr]
+			if as == nil {
+				return false
+			}
+			if i == len(p)-1 {
+				return provider.IsSensitive(attr, as)
+			}
+			sch = nil
+			if r, ok := as.Elem.(*schema.Resource); ok {
+				sch = r.Schema
+			}
+		case cty.IndexStep:
+			// The values of the maps are
+			// matched by the key and value
+			if i != len(p)-1 || as == nil || as.Type != schema.TypeMap || sm == nil {
+				continue
+			}
+			if s.Key.Type() != cty.String || v.Type() != cty.String {
+				return false
+			}
+			return sm.IsSecret(rt, attr, s.Key.AsString(), v.AsString())
+		}
+	}
+	return false
+}
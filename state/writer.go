@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"sort"
 	"strings"
+	"text/template"
 
 	"github.com/cycloidio/terracognita/errcode"
 	"github.com/cycloidio/terracognita/log"
@@ -85,9 +87,16 @@ func (w *Writer) Write(key string, value interface{}) error {
 		},
 	}
 
+	rp := r.Provider()
 	absProviderConf := addrs.AbsProviderConfig{
 		Module:   nil,
-		Provider: addrs.NewDefaultProvider(r.Provider().String()),
+		Provider: addrs.NewDefaultProvider(rp.String()),
+	}
+
+	// The Resources read with an aliased configuration of the Provider
+	// reference it as on the HCL, if not the plan would move them
+	if al, ok := rp.(provider.Aliaser); ok {
+		absProviderConf.Alias = al.Alias()
 	}
 
 	zt, err := util.HashicorpToZclonfType(r.ImpliedType())
@@ -95,7 +104,19 @@ func (w *Writer) Write(key string, value interface{}) error {
 		return err
 	}
 
-	src, err := r.ResourceInstanceObject().Encode(zt, uint64(r.TFResource().SchemaVersion))
+	rio := r.ResourceInstanceObject()
+	if w.opts.RedactSecretsState {
+		sm, _ := rp.(provider.SecretMatcher)
+		v, err := redactSecrets(rio.Value, r.TFResource().Schema, sm, r.Type())
+		if err != nil {
+			return errors.Wrapf(err, "could not redact the secrets of %q", key)
+		}
+		o := *rio
+		o.Value = v
+		rio = &o
+	}
+
+	src, err := rio.Encode(zt, uint64(r.TFResource().SchemaVersion))
 	if err != nil {
 		return err
 	}
@@ -133,6 +154,15 @@ func (w *Writer) Sync() error {
 	return nil
 }
 
+// NameTemplate returns the template of the Options
+// used to name the resources, nil if there is none
+func (w *Writer) NameTemplate() *template.Template {
+	if w.opts == nil {
+		return nil
+	}
+	return w.opts.NameTemplate
+}
+
 // Interpolate will defined dependencies for each component using
 // the `i` map built in the import.
 func (w *Writer) Interpolate(i map[string]string) {
@@ -178,8 +208,15 @@ func (w *Writer) Interpolate(i map[string]string) {
 					deps[rsc] = struct{}{}
 				}
 			}
+			// The deps are sorted so the dependencies have
+			// always the same order between imports
+			sdeps := make([]string, 0, len(deps))
+			for dependency := range deps {
+				sdeps = append(sdeps, dependency)
+			}
+			sort.Strings(sdeps)
 			for _, instance := range resource.Instances {
-				for dependency := range deps {
+				for _, dependency := range sdeps {
 					// dependency is like google_compute_instance.instance-name
 					s := strings.Split(dependency, ".")
 					rt := s[0]
@@ -223,6 +223,135 @@ func TestSync(t *testing.T) {
 
 		assert.Equal(t, est, st)
 	})
+	t.Run("SuccessRedactSecretsState", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			b    = &bytes.Buffer{}
+			sw   = state.NewWriter(b, &writer.Options{Interpolate: true, RedactSecretsState: true})
+			prv  = mock.NewProvider(ctrl)
+			res  = mock.NewResource(ctrl)
+			tp   = "aws_ssm_parameter"
+		)
+
+		defer ctrl.Finish()
+
+		tpt, err := util.HashicorpToZclonfType(aws.Provider().ResourcesMap[tp].CoreConfigSchema().ImpliedType())
+		require.NoError(t, err)
+
+		s, err := hcl2shim.HCL2ValueFromFlatmap(map[string]string{"name": "db_password", "type": "SecureString", "value": "secret"}, tpt)
+		require.NoError(t, err)
+
+		res.EXPECT().Type().Return(tp).AnyTimes()
+		res.EXPECT().Provider().Return(prv)
+		res.EXPECT().TFResource().Return(aws.Provider().ResourcesMap[tp]).AnyTimes()
+		res.EXPECT().ImpliedType().Return(aws.Provider().ResourcesMap[tp].CoreConfigSchema().ImpliedType())
+		res.EXPECT().ResourceInstanceObject().Return(providers.ImportedResource{
+			TypeName: tp,
+			State:    s,
+		}.AsInstanceObject())
+
+		prv.EXPECT().String().Return("aws")
+
+		err = sw.Write("aws_ssm_parameter.db", res)
+		require.NoError(t, err)
+
+		err = sw.Sync()
+		require.NoError(t, err)
+
+		var st struct {
+			Resources []struct {
+				Instances []struct {
+					Attributes map[string]interface{} `json:"attributes"`
+				} `json:"instances"`
+			} `json:"resources"`
+		}
+		err = json.Unmarshal(b.Bytes(), &st)
+		require.NoError(t, err)
+
+		attrs := st.Resources[0].Instances[0].Attributes
+		assert.Equal(t, "db_password", attrs["name"])
+		assert.Equal(t, "SecureString", attrs["type"])
+		assert.Nil(t, attrs["value"])
+	})
+	t.Run("SuccessWithAlias", func(t *testing.T) {
+		var (
+			ctrl  = gomock.NewController(t)
+			b     = &bytes.Buffer{}
+			sw    = state.NewWriter(b, &writer.Options{Interpolate: true})
+			prv   = aliaserProvider{Provider: mock.NewProvider(ctrl)}
+			res   = mock.NewResource(ctrl)
+			tp    = "aws_iam_user"
+			state = `{
+   "lineage":"lineage",
+   "outputs":{},
+   "resources":[
+      {
+         "instances":[
+            {
+               "attributes":{
+                  "arn":null,
+                  "force_destroy":null,
+                  "id":null,
+                  "name":"Pepito",
+                  "path":null,
+                  "permissions_boundary":null,
+                  "tags":null,
+                  "tags_all":null,
+                  "unique_id":null
+               },
+               "schema_version":0,
+               "sensitive_attributes": []
+            }
+         ],
+         "mode":"managed",
+         "name":"name",
+         "provider": "provider[\"registry.terraform.io/hashicorp/aws\"].eu_west_1",
+         "type":"aws_iam_user"
+      }
+   ],
+   "serial":0,
+   "terraform_version": "1.1.9",
+   "version":4
+}`
+		)
+
+		defer ctrl.Finish()
+
+		tpt, err := util.HashicorpToZclonfType(aws.Provider().ResourcesMap[tp].CoreConfigSchema().ImpliedType())
+		require.NoError(t, err)
+
+		s, err := hcl2shim.HCL2ValueFromFlatmap(map[string]string{"name": "Pepito"}, tpt)
+		require.NoError(t, err)
+
+		res.EXPECT().Type().Return(tp)
+		res.EXPECT().Provider().Return(prv)
+		res.EXPECT().TFResource().Return(aws.Provider().ResourcesMap[tp])
+		res.EXPECT().ImpliedType().Return(aws.Provider().ResourcesMap[tp].CoreConfigSchema().ImpliedType())
+		res.EXPECT().ResourceInstanceObject().Return(providers.ImportedResource{
+			TypeName: tp,
+			State:    s,
+		}.AsInstanceObject())
+
+		prv.EXPECT().String().Return("aws")
+
+		err = sw.Write("aws_iam_user.name", res)
+		require.NoError(t, err)
+
+		err = sw.Sync()
+		require.NoError(t, err)
+
+		var st map[string]interface{}
+		err = json.Unmarshal(b.Bytes(), &st)
+		require.NoError(t, err)
+
+		st["lineage"] = "lineage"
+
+		var est map[string]interface{}
+		err = json.Unmarshal([]byte(state), &est)
+		require.NoError(t, err)
+
+		assert.Equal(t, est, st)
+	})
 	t.Run("SuccessWithModule", func(t *testing.T) {
 		var (
 			ctrl  = gomock.NewController(t)
@@ -732,3 +861,13 @@ func TestDependencies(t *testing.T) {
 		assert.Equal(t, est, st)
 	})
 }
+
+// aliaserProvider is a mock.Provider that
+// also implements the provider.Aliaser
+type aliaserProvider struct {
+	*mock.Provider
+}
+
+func (aliaserProvider) Alias() string { return "eu_west_1" }
+
+func (aliaserProvider) Aliases() map[string]map[string]interface{} { return nil }
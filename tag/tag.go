@@ -3,6 +3,7 @@ package tag
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -20,6 +21,24 @@ import (
 // of the resources on TF (defined on configs/configschema/internal_validate.go)
 var nameRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
 
+const (
+	// NormalizationPreserve compares the keys
+	// of the tags as they are, it's the default
+	NormalizationPreserve = "preserve"
+
+	// NormalizationLower compares the keys of the
+	// tags in lower case, so 'Env' and 'env' are the same
+	NormalizationLower = "lower"
+
+	// NormalizationCanonical compares the keys of the tags
+	// with each word capitalized, so 'env' and 'ENV' are 'Env'
+	// and 'cost-center' is 'Cost-Center'
+	NormalizationCanonical = "canonical"
+)
+
+// Normalizations is the list of all the normalizations supported
+var Normalizations = []string{NormalizationPreserve, NormalizationLower, NormalizationCanonical}
+
 // Tag it's an easy representation of
 // a ec2.Filter for tags
 type Tag struct {
@@ -63,16 +82,84 @@ func (t Tag) ToNeptuneFilter() *neptune.Filter {
 	}
 }
 
+// IsValidNormalization checks if the n is one of the Normalizations,
+// the empty one is valid as it's the NormalizationPreserve
+func IsValidNormalization(n string) bool {
+	if n == "" {
+		return true
+	}
+
+	for _, nz := range Normalizations {
+		if n == nz {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NormalizeKey returns the key of a tag normalized with the n
+func NormalizeKey(n, key string) string {
+	switch n {
+	case NormalizationLower:
+		return strings.ToLower(key)
+	case NormalizationCanonical:
+		b := []byte(strings.ToLower(key))
+		upper := true
+		for i, c := range b {
+			if upper && c >= 'a' && c <= 'z' {
+				b[i] = c - 'a' + 'A'
+			}
+			upper = !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9')
+		}
+		return string(b)
+	default:
+		return key
+	}
+}
+
+// Lookup returns the value of the tag name from the tags comparing the
+// keys normalized with n. If more than one key matches, the one equal
+// to the name is used and if none is, the first one sorted, so
+// the result is always the same
+func Lookup(tags map[string]string, name, n string) (string, bool) {
+	if v, ok := tags[name]; ok {
+		return v, true
+	}
+
+	if n == "" || n == NormalizationPreserve {
+		return "", false
+	}
+
+	nname := NormalizeKey(n, name)
+	keys := make([]string, 0)
+	for k := range tags {
+		if NormalizeKey(n, k) == nname {
+			keys = append(keys, k)
+		}
+	}
+
+	if len(keys) == 0 {
+		return "", false
+	}
+
+	sort.Strings(keys)
+
+	return tags[keys[0]], true
+}
+
 // GetNameFromTag returns the 'tags.Name' from the src or the fallback
-// if it's not defined.
+// if it's not defined, the key 'Name' is compared normalized with the n.
 // Also validates that the 'tags.Name' and fallback are valid, if not it
 // generates a random one
-func GetNameFromTag(key string, srd *schema.ResourceData, fallback string) string {
+func GetNameFromTag(key string, srd *schema.ResourceData, fallback, normalization string) string {
 	fallback = strings.ToLower(fallback)
 
 	var n string
 	if name, ok := srd.GetOk(fmt.Sprintf("%s.Name", key)); ok {
 		n = strings.ToLower(name.(string))
+	} else if name, ok := Lookup(GetTags(key, srd), "Name", normalization); ok {
+		n = strings.ToLower(name)
 	}
 
 	forcedN := util.NormalizeName(n)
@@ -91,14 +178,38 @@ func GetNameFromTag(key string, srd *schema.ResourceData, fallback string) strin
 	}
 }
 
+// GetTags returns the tags of the srd defined on the key
+// as a map[string]string so they can be used on Lookup
+func GetTags(key string, srd *schema.ResourceData) map[string]string {
+	res := make(map[string]string)
+	v, ok := srd.GetOk(key)
+	if !ok {
+		return res
+	}
+
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return res
+	}
+
+	for k, tv := range m {
+		if s, ok := tv.(string); ok {
+			res[k] = s
+		}
+	}
+
+	return res
+}
+
 // isValidResourceName checks with the TF regex
 // for names to validate if it's valid
 func isValidResourceName(name string) bool {
 	return nameRegexp.MatchString(name)
 }
 
-// GetOtherTags used to check other possible tag attributes on resources
-func GetOtherTags(provider string, srd *schema.ResourceData, filterTag Tag) (string, bool) {
+// GetOtherTags used to check other possible tag attributes on resources,
+// the keys are compared normalized with the normalization
+func GetOtherTags(provider string, srd *schema.ResourceData, filterTag Tag, normalization string) (string, bool) {
 	// keep the same logic as r.data.GetOk
 	otherTagsMap := make(map[string]string)
 
@@ -124,9 +235,5 @@ func GetOtherTags(provider string, srd *schema.ResourceData, filterTag Tag) (str
 	}
 
 	// return the tag value if key (tag name) found
-	if val, ok := otherTagsMap[filterTag.Name]; ok {
-		return val, true
-	}
-
-	return "", false
+	return Lookup(otherTagsMap, filterTag.Name, normalization)
 }
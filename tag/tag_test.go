@@ -84,14 +84,63 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNormalizeKey(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Normalization string
+		Key           string
+		Result        string
+	}{
+		{Name: "Empty", Normalization: "", Key: "cost-CENTER", Result: "cost-CENTER"},
+		{Name: "Preserve", Normalization: tag.NormalizationPreserve, Key: "cost-CENTER", Result: "cost-CENTER"},
+		{Name: "Lower", Normalization: tag.NormalizationLower, Key: "cost-CENTER", Result: "cost-center"},
+		{Name: "Canonical", Normalization: tag.NormalizationCanonical, Key: "cost-CENTER", Result: "Cost-Center"},
+		{Name: "CanonicalSeparators", Normalization: tag.NormalizationCanonical, Key: "aws:cloudformation:stack_name", Result: "Aws:Cloudformation:Stack_Name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Result, tag.NormalizeKey(tt.Normalization, tt.Key))
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tags := map[string]string{
+		"env":  "prod",
+		"ENV":  "dev",
+		"Team": "ops",
+	}
+
+	t.Run("Preserve", func(t *testing.T) {
+		_, ok := tag.Lookup(tags, "Env", tag.NormalizationPreserve)
+		assert.False(t, ok)
+	})
+	t.Run("Exact", func(t *testing.T) {
+		v, ok := tag.Lookup(tags, "env", tag.NormalizationLower)
+		require.True(t, ok)
+		assert.Equal(t, "prod", v)
+	})
+	t.Run("Normalized", func(t *testing.T) {
+		v, ok := tag.Lookup(tags, "Env", tag.NormalizationCanonical)
+		require.True(t, ok)
+		assert.Equal(t, "dev", v)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, ok := tag.Lookup(tags, "owner", tag.NormalizationLower)
+		assert.False(t, ok)
+	})
+}
+
 func TestGetNameFromTag(t *testing.T) {
 	var tagKey = "Name"
 	tests := []struct {
-		Name     string
-		Key      string
-		SRD      *schema.ResourceData
-		Fallback string
-		Result   string
+		Name          string
+		Key           string
+		SRD           *schema.ResourceData
+		Fallback      string
+		Normalization string
+		Result        string
 	}{
 		{
 			Name:     "WithTags",
@@ -149,6 +198,21 @@ func TestGetNameFromTag(t *testing.T) {
 			Fallback: "...",
 			Result:   "",
 		},
+		{
+			Name:     "WithTagsLowerName",
+			Key:      "tags",
+			SRD:      createSRD(t, "tags", "name", "res"),
+			Fallback: "fallback",
+			Result:   "fallback",
+		},
+		{
+			Name:          "WithTagsLowerNameNormalized",
+			Key:           "tags",
+			SRD:           createSRD(t, "tags", "name", "res"),
+			Fallback:      "fallback",
+			Normalization: tag.NormalizationCanonical,
+			Result:        "res",
+		},
 		{
 			Name:     "WithNoTags",
 			Key:      "tags",
@@ -167,7 +231,7 @@ func TestGetNameFromTag(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.Name, func(t *testing.T) {
-			name := tag.GetNameFromTag(tt.Key, tt.SRD, tt.Fallback)
+			name := tag.GetNameFromTag(tt.Key, tt.SRD, tt.Fallback, tt.Normalization)
 			if tt.Result == "" {
 				assert.Len(t, name, 5)
 			} else {
@@ -254,12 +318,13 @@ func TestGetOtherTags(t *testing.T) {
 	var filterTagKey = "TagName"
 	var filterTagValue = "TagValue"
 	tests := []struct {
-		Name      string
-		Provider  string
-		FilterTag tag.Tag
-		SRD       *schema.ResourceData
-		Match     bool
-		Result    string
+		Name          string
+		Provider      string
+		FilterTag     tag.Tag
+		SRD           *schema.ResourceData
+		Normalization string
+		Match         bool
+		Result        string
 	}{
 		{
 			Name:      "WithoutTagButTags",
@@ -285,11 +350,28 @@ func TestGetOtherTags(t *testing.T) {
 			Match:     true,
 			Result:    filterTagValue,
 		},
+		{
+			Name:      "WithTagLowerNoMatch",
+			Provider:  "aws",
+			FilterTag: tag.Tag{Name: filterTagKey, Value: filterTagValue},
+			SRD:       createSRDOtherTags(t, "tag", "tagname", filterTagValue),
+			Match:     false,
+			Result:    "",
+		},
+		{
+			Name:          "WithTagLowerAndMatchNormalized",
+			Provider:      "aws",
+			FilterTag:     tag.Tag{Name: filterTagKey, Value: filterTagValue},
+			SRD:           createSRDOtherTags(t, "tag", "tagname", filterTagValue),
+			Normalization: tag.NormalizationLower,
+			Match:         true,
+			Result:        filterTagValue,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.Name, func(t *testing.T) {
-			value, match := tag.GetOtherTags(tt.Provider, tt.SRD, tt.FilterTag)
+			value, match := tag.GetOtherTags(tt.Provider, tt.SRD, tt.FilterTag, tt.Normalization)
 			assert.Equal(t, tt.Match, match)
 			assert.Equal(t, tt.Result, value)
 		})
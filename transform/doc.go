@@ -0,0 +1,4 @@
+// Package transform provides the transformations that
+// can be applied to the attribute values before writing
+// them, so the generated code can be used on other environments
+package transform
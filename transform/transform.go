@@ -0,0 +1,103 @@
+package transform
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/pkg/errors"
+)
+
+// Rule is a transformation applied to the attribute values,
+// it can be a regexp replace (Match and Replace) or a map
+// lookup (Values) of the full value
+type Rule struct {
+	// Attributes limits the Rule to only those attributes, the format
+	// is 'aws_instance.user_data' or 'aws_instance' for all the attributes
+	// of the resource type. If empty it applies to all of them
+	Attributes []string `json:"attributes" yaml:"attributes"`
+
+	// Match is the regexp to match on the value
+	Match string `json:"match" yaml:"match"`
+
+	// Replace is the replacement of the Match, it supports the
+	// regexp.Expand syntax like $1
+	Replace string `json:"replace" yaml:"replace"`
+
+	// Values is a lookup of the full value to the
+	// value to replace it with
+	Values map[string]string `json:"values" yaml:"values"`
+
+	re *regexp.Regexp
+}
+
+// Transformations is the list of Rules to apply in order
+// and the Data blocks needed by the Rules replacements
+type Transformations struct {
+	Rules []Rule `json:"rules" yaml:"rules"`
+
+	// Data are the data blocks that have to be declared
+	// as the Rules reference them. The key has the format
+	// 'aws_caller_identity.current' and the value is the
+	// configuration of the data block
+	Data map[string]map[string]string `json:"data" yaml:"data"`
+}
+
+// Validate validates that the Rules are valid
+// and compiles the Match of each one
+func (t *Transformations) Validate() error {
+	for i, r := range t.Rules {
+		if r.Match == "" && len(r.Values) == 0 {
+			return errors.Wrapf(errcode.ErrTransformInvalidRule, "the rule %d has no 'match' nor 'values'", i)
+		}
+		if r.Match != "" && len(r.Values) != 0 {
+			return errors.Wrapf(errcode.ErrTransformInvalidRule, "the rule %d has 'match' and 'values'", i)
+		}
+		if r.Match != "" {
+			re, err := regexp.Compile(r.Match)
+			if err != nil {
+				return errors.Wrapf(errcode.ErrTransformInvalidRule, "the rule %d has an invalid 'match': %s", i, err)
+			}
+			t.Rules[i].re = re
+		}
+	}
+
+	for k := range t.Data {
+		if len(strings.Split(k, ".")) != 2 {
+			return errors.Wrapf(errcode.ErrTransformInvalidRule, "the data %q has an invalid format. The expected format is 'aws_caller_identity.current'", k)
+		}
+	}
+
+	return nil
+}
+
+// Apply applies all the Rules to the value v of the attribute
+// attr of the resource type rt and returns the result
+func (t *Transformations) Apply(rt, attr, v string) string {
+	for _, r := range t.Rules {
+		if !r.appliesTo(rt, attr) {
+			continue
+		}
+		if r.re != nil {
+			v = r.re.ReplaceAllString(v, r.Replace)
+		} else if nv, ok := r.Values[v]; ok {
+			v = nv
+		}
+	}
+	return v
+}
+
+// appliesTo checks if the Rule has to be applied
+// to the attribute attr of the resource type rt
+func (r Rule) appliesTo(rt, attr string) bool {
+	if len(r.Attributes) == 0 {
+		return true
+	}
+	for _, a := range r.Attributes {
+		if a == rt || a == fmt.Sprintf("%s.%s", rt, attr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package transform_test
+
+import (
+	"testing"
+
+	"github.com/cycloidio/terracognita/errcode"
+	"github.com/cycloidio/terracognita/transform"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		trs := transform.Transformations{
+			Rules: []transform.Rule{
+				{Match: `internal\.example\.com`, Replace: "example.com"},
+				{Values: map[string]string{"a": "b"}},
+			},
+			Data: map[string]map[string]string{
+				"aws_caller_identity.current": nil,
+			},
+		}
+		require.NoError(t, trs.Validate())
+	})
+	t.Run("ErrTransformInvalidRule", func(t *testing.T) {
+		tests := []struct {
+			Name string
+			Trs  transform.Transformations
+		}{
+			{
+				Name: "Empty",
+				Trs:  transform.Transformations{Rules: []transform.Rule{{}}},
+			},
+			{
+				Name: "MatchAndValues",
+				Trs:  transform.Transformations{Rules: []transform.Rule{{Match: "a", Values: map[string]string{"a": "b"}}}},
+			},
+			{
+				Name: "InvalidMatch",
+				Trs:  transform.Transformations{Rules: []transform.Rule{{Match: "("}}},
+			},
+			{
+				Name: "InvalidData",
+				Trs:  transform.Transformations{Data: map[string]map[string]string{"aws_caller_identity": nil}},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				err := tt.Trs.Validate()
+				assert.True(t, errors.Is(err, errcode.ErrTransformInvalidRule))
+			})
+		}
+	})
+}
+
+func TestApply(t *testing.T) {
+	trs := transform.Transformations{
+		Rules: []transform.Rule{
+			{
+				Match:   `arn:aws:iam::123456789012:`,
+				Replace: "arn:aws:iam::${data.aws_caller_identity.current.account_id}:",
+			},
+			{
+				Attributes: []string{"aws_instance.user_data"},
+				Match:      `(\w+)\.internal\.example\.com`,
+				Replace:    "$1.example.com",
+			},
+			{
+				Attributes: []string{"aws_instance"},
+				Values:     map[string]string{"t2.micro": "t3.micro"},
+			},
+		},
+	}
+	require.NoError(t, trs.Validate())
+
+	tests := []struct {
+		Name     string
+		RT       string
+		Attr     string
+		In       string
+		Expected string
+	}{
+		{
+			Name:     "Regexp",
+			RT:       "aws_iam_role_policy_attachment",
+			Attr:     "policy_arn",
+			In:       "arn:aws:iam::123456789012:policy/admin",
+			Expected: "arn:aws:iam::${data.aws_caller_identity.current.account_id}:policy/admin",
+		},
+		{
+			Name:     "RegexpWithAttribute",
+			RT:       "aws_instance",
+			Attr:     "user_data",
+			In:       "curl api.internal.example.com",
+			Expected: "curl api.example.com",
+		},
+		{
+			Name:     "RegexpWithOtherAttribute",
+			RT:       "aws_instance",
+			Attr:     "tags.Name",
+			In:       "api.internal.example.com",
+			Expected: "api.internal.example.com",
+		},
+		{
+			Name:     "Values",
+			RT:       "aws_instance",
+			Attr:     "instance_type",
+			In:       "t2.micro",
+			Expected: "t3.micro",
+		},
+		{
+			Name:     "ValuesWithOtherType",
+			RT:       "aws_launch_template",
+			Attr:     "instance_type",
+			In:       "t2.micro",
+			Expected: "t2.micro",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, trs.Apply(tt.RT, tt.Attr, tt.In))
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package util
+
+import "strings"
+
+// ReferenceTokens splits the v, like a JSON policy document, into the
+// values that may reference other entities. The wildcard suffixes,
+// like the '/*' of the ARNs of the objects of a bucket, are removed
+func ReferenceTokens(v string) []string {
+	fs := strings.FieldsFunc(v, func(r rune) bool {
+		switch r {
+		case '"', '\'', ',', '[', ']', '{', '}', ' ', '\t', '\n', '\r':
+			return true
+		}
+		return false
+	})
+
+	tokens := make([]string, 0, len(fs))
+	for _, f := range fs {
+		f = strings.TrimSuffix(f, "/*")
+		if f != "" {
+			tokens = append(tokens, f)
+		}
+	}
+	return tokens
+}
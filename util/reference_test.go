@@ -0,0 +1,37 @@
+package util_test
+
+import (
+	"testing"
+
+	"github.com/cycloidio/terracognita/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReferenceTokens(t *testing.T) {
+	tests := []struct {
+		Name     string
+		In       string
+		Expected []string
+	}{
+		{
+			Name:     "Value",
+			In:       "sg-123",
+			Expected: []string{"sg-123"},
+		},
+		{
+			Name:     "Policy",
+			In:       `{"Statement":[{"Resource":["arn:aws:s3:::bucket","arn:aws:s3:::bucket/*"]}]}`,
+			Expected: []string{"Statement", ":", "Resource", ":", "arn:aws:s3:::bucket", "arn:aws:s3:::bucket"},
+		},
+		{
+			Name:     "Empty",
+			In:       "",
+			Expected: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, util.ReferenceTokens(tt.In))
+		})
+	}
+}
@@ -15,6 +15,10 @@ import (
 	"github.com/pkg/errors"
 )
 
+// TFProviderVersion is the version of the vSphere Terraform
+// Provider used to import the resources
+const TFProviderVersion = "2.2.0"
+
 type vsphere struct {
 	tfVSphereClient interface{}
 	tfProvider      *schema.Provider
@@ -114,4 +118,6 @@ func (vs vsphere) Resources(ctx context.Context, resourceType string, f *filter.
 
 func (vs vsphere) Source() string { return "hashicorp/vsphere" }
 
+func (vs vsphere) Version() string { return TFProviderVersion }
+
 func (vs vsphere) Configuration() map[string]interface{} { return vs.configuration }
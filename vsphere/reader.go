@@ -5,14 +5,21 @@ import (
 
 	"net/url"
 
+	"github.com/cycloidio/terracognita/log"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/session/cache"
+	"github.com/vmware/govmomi/vapi/rest"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/soap"
 )
 
 type reader struct {
 	*find.Finder
+
+	// rest is the vAPI client used for the Tags and
+	// Content Libraries, it's nil when the endpoint does
+	// not support it (ex: standalone ESXi)
+	rest *rest.Client
 }
 
 type optional struct {
@@ -66,7 +73,14 @@ func newVSphereReader(ctx context.Context, soapURL string, o optional) (*reader,
 		return nil, err
 	}
 
+	rc := rest.NewClient(c)
+	if err := rc.Login(ctx, u.User); err != nil {
+		log.Get().Log("func", "vsphere.newVSphereReader", "msg", "could not login to the vAPI endpoint, tags and content libraries will not be imported", "error", err)
+		rc = nil
+	}
+
 	return &reader{
 		Finder: find.NewFinder(c),
+		rest:   rc,
 	}, nil
 }
@@ -2,11 +2,16 @@ package vsphere
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 
 	"github.com/cycloidio/terracognita/filter"
 	"github.com/cycloidio/terracognita/provider"
 	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/tags"
+	"github.com/vmware/govmomi/vim25/mo"
 )
 
 // ResourceType is the type used to define all the Resources
@@ -24,24 +29,38 @@ const (
 	// Inventory
 	datacenter
 	folder
+	tag
+	tagCategory // tag_category
+
+	// Networking
+	distributedPortGroup     // distributed_port_group
+	distributedVirtualSwitch // distributed_virtual_switch
 
 	// Storage
 	datastoreCluster // datastore_cluster
 
 	// Virtual Machine
-	virtualMachine // virtual_machine
+	contentLibrary     // content_library
+	contentLibraryItem // content_library_item
+	virtualMachine     // virtual_machine
 )
 
 type rtFn func(ctx context.Context, vs *vsphere, vm *reader, resourceType string, filters *filter.Filter) ([]provider.Resource, error)
 
 var (
 	resources = map[ResourceType]rtFn{
-		computeCluster:   getComputeClusters,
-		resourcePool:     getResourcePools,
-		datacenter:       getDatacenters,
-		folder:           getFolders,
-		datastoreCluster: getDatastoreClusters,
-		virtualMachine:   getVirtualMachines,
+		computeCluster:           getComputeClusters,
+		resourcePool:             getResourcePools,
+		datacenter:               getDatacenters,
+		folder:                   getFolders,
+		tag:                      getTags,
+		tagCategory:              getTagCategories,
+		distributedPortGroup:     getDistributedPortGroups,
+		distributedVirtualSwitch: getDistributedVirtualSwitches,
+		datastoreCluster:         getDatastoreClusters,
+		contentLibrary:           getContentLibraries,
+		contentLibraryItem:       getContentLibraryItems,
+		virtualMachine:           getVirtualMachines,
 	}
 )
 
@@ -158,3 +177,175 @@ func getComputeClusters(ctx context.Context, vs *vsphere, r *reader, resourceTyp
 
 	return resources, nil
 }
+
+func getDistributedVirtualSwitches(ctx context.Context, vs *vsphere, r *reader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	nets, err := r.Finder.NetworkList(ctx, "/...")
+	if err != nil {
+		var nferr *find.NotFoundError
+		if errors.As(err, &nferr) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0, len(nets))
+	for _, n := range nets {
+		// The Finder returns the VmwareDistributedVirtualSwitch
+		// for the vSphere Distributed Switches and the
+		// DistributedVirtualSwitch for the third party ones
+		switch n.(type) {
+		case *object.VmwareDistributedVirtualSwitch, *object.DistributedVirtualSwitch:
+		default:
+			continue
+		}
+		r := provider.NewResource(n.GetInventoryPath(), resourceType, vs)
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func getDistributedPortGroups(ctx context.Context, vs *vsphere, r *reader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	nets, err := r.Finder.NetworkList(ctx, "/...")
+	if err != nil {
+		var nferr *find.NotFoundError
+		if errors.As(err, &nferr) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0, len(nets))
+	for _, n := range nets {
+		pg, ok := n.(*object.DistributedVirtualPortgroup)
+		if !ok {
+			continue
+		}
+
+		// The uplink Port Groups are managed by the
+		// vsphere_distributed_virtual_switch so we ignore them
+		var mpg mo.DistributedVirtualPortgroup
+		err = pg.Properties(ctx, pg.Reference(), []string{"config.uplink"}, &mpg)
+		if err != nil {
+			return nil, err
+		}
+		if mpg.Config.Uplink != nil && *mpg.Config.Uplink {
+			continue
+		}
+
+		r := provider.NewResource(pg.InventoryPath, resourceType, vs)
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func getTagCategories(ctx context.Context, vs *vsphere, r *reader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if r.rest == nil {
+		return nil, nil
+	}
+
+	categories, err := tags.NewManager(r.rest).GetCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0, len(categories))
+	for _, c := range categories {
+		// The import ID of the vsphere_tag_category is the name
+		r := provider.NewResource(c.Name, resourceType, vs)
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// tagImportID is the ID format expected by
+// the import of the vsphere_tag
+type tagImportID struct {
+	CategoryName string `json:"category_name"`
+	TagName      string `json:"tag_name"`
+}
+
+func getTags(ctx context.Context, vs *vsphere, r *reader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if r.rest == nil {
+		return nil, nil
+	}
+
+	m := tags.NewManager(r.rest)
+	categories, err := m.GetCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryNames := make(map[string]string, len(categories))
+	for _, c := range categories {
+		categoryNames[c.ID] = c.Name
+	}
+
+	tgs, err := m.GetTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0, len(tgs))
+	for _, t := range tgs {
+		id, err := json.Marshal(tagImportID{
+			CategoryName: categoryNames[t.CategoryID],
+			TagName:      t.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		r := provider.NewResource(string(id), resourceType, vs)
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func getContentLibraries(ctx context.Context, vs *vsphere, r *reader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if r.rest == nil {
+		return nil, nil
+	}
+
+	libs, err := library.NewManager(r.rest).GetLibraries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0, len(libs))
+	for _, l := range libs {
+		r := provider.NewResource(l.ID, resourceType, vs)
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+func getContentLibraryItems(ctx context.Context, vs *vsphere, r *reader, resourceType string, filters *filter.Filter) ([]provider.Resource, error) {
+	if r.rest == nil {
+		return nil, nil
+	}
+
+	m := library.NewManager(r.rest)
+	libs, err := m.GetLibraries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]provider.Resource, 0)
+	for _, l := range libs {
+		items, err := m.GetLibraryItems(ctx, l.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range items {
+			r := provider.NewResource(i.ID, resourceType, vs)
+			resources = append(resources, r)
+		}
+	}
+
+	return resources, nil
+}
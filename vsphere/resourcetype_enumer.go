@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _ResourceTypeName = "vsphere_compute_clustervsphere_resource_poolvsphere_datacentervsphere_foldervsphere_datastore_clustervsphere_virtual_machine"
+const _ResourceTypeName = "vsphere_compute_clustervsphere_resource_poolvsphere_datacentervsphere_foldervsphere_tagvsphere_tag_categoryvsphere_distributed_port_groupvsphere_distributed_virtual_switchvsphere_datastore_clustervsphere_content_libraryvsphere_content_library_itemvsphere_virtual_machine"
 
-var _ResourceTypeIndex = [...]uint8{0, 23, 44, 62, 76, 101, 124}
+var _ResourceTypeIndex = [...]uint16{0, 23, 44, 62, 76, 87, 107, 137, 171, 196, 219, 247, 270}
 
-const _ResourceTypeLowerName = "vsphere_compute_clustervsphere_resource_poolvsphere_datacentervsphere_foldervsphere_datastore_clustervsphere_virtual_machine"
+const _ResourceTypeLowerName = "vsphere_compute_clustervsphere_resource_poolvsphere_datacentervsphere_foldervsphere_tagvsphere_tag_categoryvsphere_distributed_port_groupvsphere_distributed_virtual_switchvsphere_datastore_clustervsphere_content_libraryvsphere_content_library_itemvsphere_virtual_machine"
 
 func (i ResourceType) String() string {
 	i -= 1
@@ -29,11 +29,17 @@ func _ResourceTypeNoOp() {
 	_ = x[resourcePool-(2)]
 	_ = x[datacenter-(3)]
 	_ = x[folder-(4)]
-	_ = x[datastoreCluster-(5)]
-	_ = x[virtualMachine-(6)]
+	_ = x[tag-(5)]
+	_ = x[tagCategory-(6)]
+	_ = x[distributedPortGroup-(7)]
+	_ = x[distributedVirtualSwitch-(8)]
+	_ = x[datastoreCluster-(9)]
+	_ = x[contentLibrary-(10)]
+	_ = x[contentLibraryItem-(11)]
+	_ = x[virtualMachine-(12)]
 }
 
-var _ResourceTypeValues = []ResourceType{computeCluster, resourcePool, datacenter, folder, datastoreCluster, virtualMachine}
+var _ResourceTypeValues = []ResourceType{computeCluster, resourcePool, datacenter, folder, tag, tagCategory, distributedPortGroup, distributedVirtualSwitch, datastoreCluster, contentLibrary, contentLibraryItem, virtualMachine}
 
 var _ResourceTypeNameToValueMap = map[string]ResourceType{
 	_ResourceTypeName[0:23]:         computeCluster,
@@ -44,10 +50,22 @@ var _ResourceTypeNameToValueMap = map[string]ResourceType{
 	_ResourceTypeLowerName[44:62]:   datacenter,
 	_ResourceTypeName[62:76]:        folder,
 	_ResourceTypeLowerName[62:76]:   folder,
-	_ResourceTypeName[76:101]:       datastoreCluster,
-	_ResourceTypeLowerName[76:101]:  datastoreCluster,
-	_ResourceTypeName[101:124]:      virtualMachine,
-	_ResourceTypeLowerName[101:124]: virtualMachine,
+	_ResourceTypeName[76:87]:        tag,
+	_ResourceTypeLowerName[76:87]:   tag,
+	_ResourceTypeName[87:107]:       tagCategory,
+	_ResourceTypeLowerName[87:107]:  tagCategory,
+	_ResourceTypeName[107:137]:      distributedPortGroup,
+	_ResourceTypeLowerName[107:137]: distributedPortGroup,
+	_ResourceTypeName[137:171]:      distributedVirtualSwitch,
+	_ResourceTypeLowerName[137:171]: distributedVirtualSwitch,
+	_ResourceTypeName[171:196]:      datastoreCluster,
+	_ResourceTypeLowerName[171:196]: datastoreCluster,
+	_ResourceTypeName[196:219]:      contentLibrary,
+	_ResourceTypeLowerName[196:219]: contentLibrary,
+	_ResourceTypeName[219:247]:      contentLibraryItem,
+	_ResourceTypeLowerName[219:247]: contentLibraryItem,
+	_ResourceTypeName[247:270]:      virtualMachine,
+	_ResourceTypeLowerName[247:270]: virtualMachine,
 }
 
 var _ResourceTypeNames = []string{
@@ -55,8 +73,14 @@ var _ResourceTypeNames = []string{
 	_ResourceTypeName[23:44],
 	_ResourceTypeName[44:62],
 	_ResourceTypeName[62:76],
-	_ResourceTypeName[76:101],
-	_ResourceTypeName[101:124],
+	_ResourceTypeName[76:87],
+	_ResourceTypeName[87:107],
+	_ResourceTypeName[107:137],
+	_ResourceTypeName[137:171],
+	_ResourceTypeName[171:196],
+	_ResourceTypeName[196:219],
+	_ResourceTypeName[219:247],
+	_ResourceTypeName[247:270],
 }
 
 // ResourceTypeString retrieves an enum value from the enum constants string name.
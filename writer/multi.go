@@ -0,0 +1,71 @@
+package writer
+
+import "text/template"
+
+// Multi is a Writer that writes to
+// all the Writers it has
+type Multi struct {
+	writers []Writer
+}
+
+// NewMulti returns a Multi writing to all the ws
+func NewMulti(ws ...Writer) *Multi {
+	return &Multi{
+		writers: ws,
+	}
+}
+
+// Write writes the value to all the Writers,
+// it stops on the first error
+func (m *Multi) Write(key string, value interface{}) error {
+	for _, w := range m.writers {
+		if err := w.Write(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Has checks if the key it's already
+// written on any of the Writers
+func (m *Multi) Has(key string) (bool, error) {
+	for _, w := range m.writers {
+		ok, err := w.Has(key)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Sync syncs all the Writers,
+// it stops on the first error
+func (m *Multi) Sync() error {
+	for _, w := range m.writers {
+		if err := w.Sync(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Interpolate interpolates all the Writers
+func (m *Multi) Interpolate(i map[string]string) {
+	for _, w := range m.writers {
+		w.Interpolate(i)
+	}
+}
+
+// NameTemplate returns the template of the
+// first Writer that has one, if any
+func (m *Multi) NameTemplate() *template.Template {
+	for _, w := range m.writers {
+		if n, ok := w.(Namer); ok && n.NameTemplate() != nil {
+			return n.NameTemplate()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package writer_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cycloidio/terracognita/mock"
+	"github.com/cycloidio/terracognita/writer"
+)
+
+func TestMulti(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			w1   = mock.NewWriter(ctrl)
+			w2   = mock.NewWriter(ctrl)
+			i    = map[string]string{"a": "b"}
+			mw   = writer.NewMulti(w1, w2)
+		)
+		defer ctrl.Finish()
+
+		w1.EXPECT().Write("type.name", "value").Return(nil)
+		w2.EXPECT().Write("type.name", "value").Return(nil)
+		w1.EXPECT().Has("type.name").Return(false, nil)
+		w2.EXPECT().Has("type.name").Return(true, nil)
+		w1.EXPECT().Interpolate(i)
+		w2.EXPECT().Interpolate(i)
+		w1.EXPECT().Sync().Return(nil)
+		w2.EXPECT().Sync().Return(nil)
+
+		require.NoError(t, mw.Write("type.name", "value"))
+
+		ok, err := mw.Has("type.name")
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		mw.Interpolate(i)
+		require.NoError(t, mw.Sync())
+	})
+	t.Run("Error", func(t *testing.T) {
+		var (
+			ctrl = gomock.NewController(t)
+			w1   = mock.NewWriter(ctrl)
+			w2   = mock.NewWriter(ctrl)
+			werr = errors.New("failed")
+			mw   = writer.NewMulti(w1, w2)
+		)
+		defer ctrl.Finish()
+
+		w1.EXPECT().Write("type.name", "value").Return(werr)
+		w1.EXPECT().Sync().Return(werr)
+
+		assert.Equal(t, werr, mw.Write("type.name", "value"))
+		assert.Equal(t, werr, mw.Sync())
+	})
+}
@@ -1,5 +1,25 @@
 package writer
 
+import (
+	"text/template"
+
+	"github.com/cycloidio/terracognita/transform"
+)
+
+const (
+	// ModuleLayoutService splits the Module in one
+	// Module per category (ex: ec2, rds)
+	ModuleLayoutService = "service"
+
+	// ModuleLayoutRegion splits the Module in one Module
+	// per region, or aliased configuration of the Provider
+	ModuleLayoutRegion = "region"
+
+	// ModuleLayoutResourceGroup splits the Module in
+	// one Module per Resource Group (azurerm)
+	ModuleLayoutResourceGroup = "resource-group"
+)
+
 // Options given to the writers
 type Options struct {
 	// Interpolate means the ability to interpolate
@@ -7,6 +27,17 @@ type Options struct {
 	// in a TFState
 	Interpolate bool
 
+	// InterpolationExclude are the attributes, with the format
+	// 'TYPE.ATTRIBUTE' and glob patterns (ex: '*.subnet_id'),
+	// which values are not interpolated on the HCL
+	InterpolationExclude []string
+
+	// DependsOn makes the HCL add a 'depends_on' with the
+	// resources that each resource depends on but can not
+	// be referenced by interpolation, like the ones on a
+	// policy document or the InterpolationExclude
+	DependsOn bool
+
 	// Module tells the Writers (basically HCL) that will
 	// need also to write a Module, and the value is the
 	// name it has
@@ -17,14 +48,61 @@ type Options struct {
 	// means use all attributes as variables
 	ModuleVariables map[string]struct{}
 
+	// ModuleLayout splits the Module in nested Modules, on
+	// 'modules/<name>', with one of the ModuleLayout*. If
+	// empty all the resources are on the same Module
+	ModuleLayout string
+
 	// HCLProviderBlock make the HCL generate or not the
 	// 'provider "" {}' block
 	HCLProviderBlock bool
 
+	// HCLProviderVariables are the keys of the configuration
+	// of the 'provider "" {}' block, besides the required ones,
+	// to set with variables. The ones without value default
+	// to null so the TF Provider reads them from the environment
+	HCLProviderVariables []string
+
 	// TerraformCategoryKey allows to write the Terraform
 	// block containing the required version of the provider
 	// and provider block elsewhere than the module/default file
 	TerraformCategoryKey string
+
+	// ExternalReferencesData make the HCL generate 'data' blocks
+	// for the values that reference entities outside of the
+	// imported scope, when the Provider supports it
+	ExternalReferencesData bool
+
+	// Transformations are applied to the HCL attribute
+	// values before writing them, if nil none is applied
+	Transformations *transform.Transformations
+
+	// Parameters are the values to replace with variables
+	// on all the generated HCL, the key is the name of the
+	// variable and the value the one to replace
+	Parameters map[string]string
+
+	// ExtractVariables is the minimum number of times a
+	// literal value has to be repeated on the generated HCL
+	// to be replaced with a variable, like the Parameters.
+	// If 0 no value is extracted
+	ExtractVariables int
+
+	// RedactSecrets replaces the values of the sensitive
+	// attributes with variables on the generated HCL
+	RedactSecrets bool
+
+	// RedactSecretsState omits the values of the sensitive
+	// attributes, set to null, from the generated TFState
+	RedactSecretsState bool
+
+	// JSON makes the HCL writer use the Terraform
+	// JSON syntax (.tf.json) instead of the HCL one
+	JSON bool
+
+	// NameTemplate is the template used to name the
+	// resources, if nil the 'Name' tag or the ID is used
+	NameTemplate *template.Template
 }
 
 // HasModule will check if the Module is empty or not
@@ -1,5 +1,7 @@
 package writer
 
+import "text/template"
+
 //go:generate mockgen -destination=../mock/writer.go -mock_names=Writer=Writer -package mock github.com/cycloidio/terracognita/writer Writer
 
 const (
@@ -37,3 +39,11 @@ type Writer interface {
 	// with TF interpolation
 	Interpolate(map[string]string)
 }
+
+// Namer is implemented by the Writers that have a
+// template to name the Resources written on them
+type Namer interface {
+	// NameTemplate returns the template used to name
+	// the Resources, nil if they are not named with one
+	NameTemplate() *template.Template
+}